@@ -0,0 +1,198 @@
+// archive_source.go — Builds lint inputs from tar/zip archive entries without extracting them.
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// validateArchiveFlags rejects options that need files on disk.
+func validateArchiveFlags(hasPaths bool, fixing bool, gitScoped bool) error {
+	switch {
+	case hasPaths:
+		return errors.New("--archive cannot be combined with path arguments")
+	case fixing:
+		return errors.New("--archive cannot be combined with --fix or --fix-dry-run")
+	case gitScoped:
+		return errors.New("--archive cannot be combined with --changed or --staged")
+	}
+	return nil
+}
+
+// readArchiveFiles returns lintable source files from a .tar, .tar.gz/.tgz, or .zip archive.
+// Paths are the in-archive paths; --ext and --since filters apply to entry names and mtimes.
+// Entries larger than maxSize bytes (0 means unlimited) are returned as skipped paths. They
+// are skipped by their recorded size before reading, and reads stop past maxSize, so a
+// large or lying entry is never held in memory.
+func readArchiveFiles(archivePath string, extensions map[string]bool, since time.Duration, skips lintDirSkips, maxSize int64) ([]*model.UnifiedFileModel, []string, error) {
+	var cutoff time.Time
+	if since > 0 {
+		cutoff = time.Now().Add(-since)
+	}
+
+	files := make([]*model.UnifiedFileModel, 0)
+	skipped := make([]string, 0)
+	seen := map[string]bool{}
+	visit := func(name string, size int64, modTime time.Time, open func() (io.Reader, error)) error {
+		entryPath, ok := archiveEntryPath(name)
+		if !ok || seen[entryPath] || !isLintSourceFile(entryPath) || archiveEntrySkipped(entryPath, skips) {
+			return nil
+		}
+		if len(filterFilePathsByExtensions([]string{entryPath}, extensions)) == 0 {
+			return nil
+		}
+		if !cutoff.IsZero() && modTime.Before(cutoff) {
+			return nil
+		}
+		seen[entryPath] = true
+		if maxSize > 0 && size > maxSize {
+			skipped = append(skipped, entryPath)
+			return nil
+		}
+		r, err := open()
+		if err != nil {
+			return fmt.Errorf("%s: %w", entryPath, err)
+		}
+		if maxSize > 0 {
+			r = io.LimitReader(r, maxSize+1)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", entryPath, err)
+		}
+		if maxSize > 0 && int64(len(data)) > maxSize {
+			skipped = append(skipped, entryPath)
+			return nil
+		}
+		files = append(files, &model.UnifiedFileModel{
+			Path:       entryPath,
+			Language:   detectLanguage(entryPath),
+			Source:     data,
+			LineCount:  countLines(data),
+			IsTestFile: looksLikeTestFile(entryPath),
+		})
+		return nil
+	}
+
+	lower := strings.ToLower(archivePath)
+	var err error
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		err = walkZipArchive(archivePath, visit)
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		err = walkTarArchive(archivePath, true, visit)
+	case strings.HasSuffix(lower, ".tar"):
+		err = walkTarArchive(archivePath, false, visit)
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive format %q (expected .tar, .tar.gz, .tgz, or .zip)", archivePath)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	sort.Strings(skipped)
+	return files, skipped, nil
+}
+
+type archiveVisitor func(name string, size int64, modTime time.Time, open func() (io.Reader, error)) error
+
+func walkTarArchive(archivePath string, gzipped bool, visit archiveVisitor) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if gzipped {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("open gzip stream: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar entry: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := visit(header.Name, header.Size, header.ModTime, func() (io.Reader, error) { return tr, nil }); err != nil {
+			return err
+		}
+	}
+}
+
+func walkZipArchive(archivePath string, visit archiveVisitor) error {
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	for _, entry := range zr.File {
+		if !entry.Mode().IsRegular() {
+			continue
+		}
+		var rc io.ReadCloser
+		size := int64(entry.UncompressedSize64)
+		if entry.UncompressedSize64 > math.MaxInt64 {
+			size = math.MaxInt64
+		}
+		err := visit(entry.Name, size, entry.Modified, func() (io.Reader, error) {
+			opened, err := entry.Open()
+			rc = opened
+			return opened, err
+		})
+		if rc != nil {
+			rc.Close()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveEntryPath normalizes an entry name to a clean relative slash path.
+func archiveEntryPath(name string) (string, bool) {
+	cleaned := path.Clean("/" + strings.ReplaceAll(name, "\\", "/"))
+	cleaned = strings.TrimPrefix(cleaned, "/")
+	if cleaned == "" || cleaned == "." {
+		return "", false
+	}
+	return cleaned, true
+}
+
+// archiveEntrySkipped applies the directory skips used when walking the file system.
+func archiveEntrySkipped(entryPath string, skips lintDirSkips) bool {
+	dir := path.Dir(entryPath)
+	for dir != "." && dir != "/" {
+		if skips.skip(dir) {
+			return true
+		}
+		dir = path.Dir(dir)
+	}
+	return false
+}
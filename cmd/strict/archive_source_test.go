@@ -0,0 +1,187 @@
+// archive_source_test.go — Tests for reading lint inputs from tar and zip archives.
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var archiveTestEntries = map[string]string{
+	"src/app.go":                 "package src\n",
+	"./src/app_test.go":          "package src\n",
+	"web/index.ts":               "export const x = 1;\n",
+	"README.md":                  "# docs\n",
+	"node_modules/dep/index.js":  "module.exports = 1;\n",
+	"src/gen/api.pb.go":          "package gen\n",
+	"tests/fixtures/bad/main.go": "package main\n",
+}
+
+func writeTestTarGz(t *testing.T, dest string, modTime time.Time) {
+	t.Helper()
+	f, err := os.Create(dest)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "src/", Typeflag: tar.TypeDir, Mode: 0o755}); err != nil {
+		t.Fatalf("write dir header: %v", err)
+	}
+	for name, body := range archiveTestEntries {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(body)), ModTime: modTime}); err != nil {
+			t.Fatalf("write header: %v", err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatalf("write body: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+}
+
+func writeTestZip(t *testing.T, dest string) {
+	t.Helper()
+	f, err := os.Create(dest)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	for name, body := range archiveTestEntries {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("create entry: %v", err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatalf("write entry: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+}
+
+func archiveFilePaths(t *testing.T, archivePath string, extensions map[string]bool, since time.Duration) []string {
+	t.Helper()
+	files, _, err := readArchiveFiles(archivePath, extensions, since, lintDirSkips{}, 0)
+	if err != nil {
+		t.Fatalf("readArchiveFiles() error = %v", err)
+	}
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	return paths
+}
+
+func TestReadArchiveFilesFromTarGzAndZip(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tarPath := filepath.Join(dir, "src.tar.gz")
+	zipPath := filepath.Join(dir, "src.zip")
+	writeTestTarGz(t, tarPath, time.Now())
+	writeTestZip(t, zipPath)
+
+	want := []string{"src/app.go", "src/app_test.go", "web/index.ts"}
+	for _, archivePath := range []string{tarPath, zipPath} {
+		if got := archiveFilePaths(t, archivePath, nil, 0); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s paths = %v, want %v", filepath.Base(archivePath), got, want)
+		}
+	}
+
+	files, _, err := readArchiveFiles(tarPath, map[string]bool{".go": true}, 0, lintDirSkips{}, 0)
+	if err != nil {
+		t.Fatalf("readArchiveFiles() error = %v", err)
+	}
+	if len(files) != 2 || !files[1].IsTestFile || files[0].Language != "go" || string(files[0].Source) != "package src\n" {
+		t.Fatalf("unexpected go entries: %+v", files)
+	}
+}
+
+func TestReadArchiveFilesAppliesSinceToEntryModTimes(t *testing.T) {
+	t.Parallel()
+
+	archivePath := filepath.Join(t.TempDir(), "old.tar.gz")
+	writeTestTarGz(t, archivePath, time.Now().Add(-72*time.Hour))
+	if got := archiveFilePaths(t, archivePath, nil, time.Hour); len(got) != 0 {
+		t.Fatalf("paths = %v, want none older than --since", got)
+	}
+}
+
+func TestReadArchiveFilesRejectsUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := readArchiveFiles("src.rar", nil, 0, lintDirSkips{}, 0); err == nil {
+		t.Fatalf("expected unsupported format error")
+	}
+}
+
+func TestValidateArchiveFlags(t *testing.T) {
+	t.Parallel()
+
+	if err := validateArchiveFlags(false, false, false); err != nil {
+		t.Fatalf("validateArchiveFlags() error = %v", err)
+	}
+	for _, tc := range [][3]bool{{true, false, false}, {false, true, false}, {false, false, true}} {
+		if err := validateArchiveFlags(tc[0], tc[1], tc[2]); err == nil {
+			t.Fatalf("validateArchiveFlags(%v) should fail", tc)
+		}
+	}
+}
+
+func TestReadArchiveFilesSkipsEntriesOverMaxSize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tarPath := filepath.Join(dir, "src.tar.gz")
+	zipPath := filepath.Join(dir, "src.zip")
+	writeTestTarGz(t, tarPath, time.Now())
+	writeTestZip(t, zipPath)
+
+	for _, archivePath := range []string{tarPath, zipPath} {
+		files, skipped, err := readArchiveFiles(archivePath, nil, 0, lintDirSkips{}, 13)
+		if err != nil {
+			t.Fatalf("readArchiveFiles(%s) error = %v", filepath.Base(archivePath), err)
+		}
+		if len(files) != 2 || files[0].Path != "src/app.go" || !reflect.DeepEqual(skipped, []string{"web/index.ts"}) {
+			t.Fatalf("%s: files = %d, skipped = %v", filepath.Base(archivePath), len(files), skipped)
+		}
+	}
+
+	// An entry that outgrows its recorded size fails to read instead of being loaded whole.
+	liar := filepath.Join(dir, "liar.zip")
+	f, err := os.Create(liar)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	body := bytes.Repeat([]byte("x"), 1<<16)
+	zw := zip.NewWriter(f)
+	w, err := zw.CreateRaw(&zip.FileHeader{Name: "bomb.go", Method: zip.Store, CompressedSize64: uint64(len(body)), UncompressedSize64: 8, CRC32: crc32.ChecksumIEEE(body)})
+	if err != nil {
+		t.Fatalf("create entry: %v", err)
+	}
+	if _, err := w.Write(body); err != nil {
+		t.Fatalf("write entry: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	f.Close()
+	if files, _, err := readArchiveFiles(liar, nil, 0, lintDirSkips{}, 32); err == nil {
+		t.Fatalf("expected an error for an entry larger than its header, got %d file(s)", len(files))
+	}
+}
@@ -0,0 +1,92 @@
+// baseline_merge.go — `strict baseline-merge`: combine baselines from sharded runs.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mergeBaselines unions the entries of the baseline files at paths, dropping entries
+// with the same baseline key, and sorts the result the way a bootstrapped baseline is
+// sorted. Every file must carry the same version, and it must be one this build writes.
+// It returns the merged entries and how many duplicates were dropped.
+func mergeBaselines(paths []string) ([]baselineEntry, int, error) {
+	merged := make([]baselineEntry, 0)
+	seen := map[string]bool{}
+	duplicates := 0
+	firstVersion, firstPath := "", ""
+	for i, pathValue := range paths {
+		data, err := os.ReadFile(pathValue)
+		if err != nil {
+			return nil, 0, fmt.Errorf("read baseline %s: %w", pathValue, err)
+		}
+		var doc baselineFile
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, 0, fmt.Errorf("parse baseline %s: %w", pathValue, err)
+		}
+		if i == 0 {
+			firstVersion, firstPath = doc.Version, pathValue
+		} else if doc.Version != firstVersion {
+			return nil, 0, fmt.Errorf("baseline %s has version %q but %s has version %q", pathValue, doc.Version, firstPath, firstVersion)
+		}
+
+		for _, entry := range doc.Entries {
+			key := baselineKeyFromEntry(entry)
+			if seen[key] {
+				duplicates++
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, baselineEntry{
+				RuleID:    strings.TrimSpace(entry.RuleID),
+				FilePath:  filepath.ToSlash(strings.TrimSpace(entry.FilePath)),
+				StartLine: entry.StartLine,
+				Message:   strings.TrimSpace(entry.Message),
+			})
+		}
+	}
+	if len(paths) > 0 && firstVersion != baselineFormatVersion {
+		return nil, 0, fmt.Errorf("unsupported baseline version %q in %s (supported: %s)", firstVersion, firstPath, baselineFormatVersion)
+	}
+	sortBaselineEntries(merged)
+	return merged, duplicates, nil
+}
+
+func runBaselineMerge(args []string) {
+	fs := flag.NewFlagSet("baseline-merge", flag.ExitOnError)
+	outPath := fs.String("out", "", "Path to write the merged baseline to (required)")
+	fs.Usage = func() {
+		fmt.Println("Usage: strict baseline-merge --out <file> <baseline> [baseline...]")
+		fmt.Println()
+		fmt.Println("Union the entries of baselines bootstrapped by sharded lint runs into one baseline,")
+		fmt.Println("dropping duplicates and sorting entries the way a single run would.")
+		fs.PrintDefaults()
+	}
+	parseFlagSetOrExit(fs, args)
+
+	target := strings.TrimSpace(*outPath)
+	if target == "" {
+		fmt.Fprintln(os.Stderr, "Error: --out is required")
+		os.Exit(2)
+	}
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: baseline-merge requires at least one baseline file")
+		os.Exit(2)
+	}
+
+	entries, duplicates, err := mergeBaselines(inputs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if err := writeBaselineFile(target, entries); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Merged %d baseline(s) into %s with %d entry(s); %d duplicate(s) dropped.\n", len(inputs), target, len(entries), duplicates)
+}
@@ -0,0 +1,74 @@
+// baseline_merge_test.go — Tests for merging sharded baselines.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMergeBaselinesUnionsAndSortsEntries(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	shard1 := filepath.Join(dir, "shard1.json")
+	shard2 := filepath.Join(dir, "shard2.json")
+	if err := writeBaselineFile(shard1, []baselineEntry{
+		{RuleID: "RULE-B", FilePath: "b.go", StartLine: 2, Message: "two"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "one"},
+	}); err != nil {
+		t.Fatalf("write shard1: %v", err)
+	}
+	if err := writeBaselineFile(shard2, []baselineEntry{
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: " one "},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 0, Message: "header"},
+	}); err != nil {
+		t.Fatalf("write shard2: %v", err)
+	}
+
+	entries, duplicates, err := mergeBaselines([]string{shard1, shard2})
+	if err != nil {
+		t.Fatalf("mergeBaselines() error = %v", err)
+	}
+	if duplicates != 1 {
+		t.Fatalf("duplicates = %d, want 1", duplicates)
+	}
+	want := []baselineEntry{
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 0, Message: "header"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "one"},
+		{RuleID: "RULE-B", FilePath: "b.go", StartLine: 2, Message: "two"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("entries = %+v, want %+v", entries, want)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Fatalf("entries[%d] = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+}
+
+func TestMergeBaselinesRejectsVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	shard1 := filepath.Join(dir, "shard1.json")
+	shard2 := filepath.Join(dir, "shard2.json")
+	if err := writeBaselineFile(shard1, nil); err != nil {
+		t.Fatalf("write shard1: %v", err)
+	}
+	if err := os.WriteFile(shard2, []byte(`{"version":"2","entries":[]}`), 0o644); err != nil {
+		t.Fatalf("write shard2: %v", err)
+	}
+
+	_, _, err := mergeBaselines([]string{shard1, shard2})
+	if err == nil || !strings.Contains(err.Error(), `version "2"`) {
+		t.Fatalf("mergeBaselines() error = %v, want version mismatch", err)
+	}
+
+	_, _, err = mergeBaselines([]string{shard2})
+	if err == nil || !strings.Contains(err.Error(), "unsupported baseline version") {
+		t.Fatalf("mergeBaselines() error = %v, want unsupported version", err)
+	}
+}
@@ -0,0 +1,53 @@
+// baseline_prune.go — Removes resolved entries from an existing baseline file.
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// pruneBaseline rewrites the baseline without entries that are no longer produced.
+// Only entries for rules that ran and files that were checked are eligible, so a
+// scoped run (--rule, --category, --changed, ...) never drops unrelated suppressions.
+func pruneBaseline(state *baselineState, rules []model.Rule, files []*model.UnifiedFileModel) error {
+	if state == nil || !state.Enabled || state.Bootstrapped || len(state.Resolved) == 0 {
+		return nil
+	}
+
+	ranRules := map[string]bool{}
+	for _, rule := range rules {
+		ranRules[strings.TrimSpace(rule.ID())] = true
+	}
+	checkedFiles := map[string]bool{}
+	for _, file := range files {
+		checkedFiles[filepath.ToSlash(file.Path)] = true
+	}
+
+	prunable := map[string]bool{}
+	for _, entry := range state.Resolved {
+		if ranRules[strings.TrimSpace(entry.RuleID)] && checkedFiles[filepath.ToSlash(strings.TrimSpace(entry.FilePath))] {
+			prunable[baselineKeyFromEntry(entry)] = true
+		}
+	}
+	if len(prunable) == 0 {
+		return nil
+	}
+
+	kept := make([]baselineEntry, 0, len(state.Entries))
+	for _, entry := range state.Entries {
+		if prunable[baselineKeyFromEntry(entry)] {
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	if err := writeBaselineFile(state.Path, kept); err != nil {
+		return err
+	}
+
+	state.Pruned = len(state.Entries) - len(kept)
+	state.Entries = kept
+	state.EntryCount = len(kept)
+	return nil
+}
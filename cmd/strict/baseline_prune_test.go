@@ -0,0 +1,82 @@
+// baseline_prune_test.go — Tests for baseline pruning.
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestPruneBaselineDropsOnlyResolvedEntriesInScope(t *testing.T) {
+	t.Parallel()
+
+	pathValue := filepath.Join(t.TempDir(), "baseline.json")
+	active := baselineEntry{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "still here"}
+	resolved := baselineEntry{RuleID: "RULE-A", FilePath: "a.go", StartLine: 5, Message: "fixed"}
+	otherRule := baselineEntry{RuleID: "RULE-B", FilePath: "a.go", StartLine: 2, Message: "rule not run"}
+	otherFile := baselineEntry{RuleID: "RULE-A", FilePath: "b.go", StartLine: 1, Message: "file not checked"}
+	entries := []baselineEntry{active, resolved, otherRule, otherFile}
+	if err := writeBaselineFile(pathValue, entries); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+
+	state := baselineState{
+		Enabled:    true,
+		Path:       pathValue,
+		EntryCount: len(entries),
+		Entries:    entries,
+		Resolved:   []baselineEntry{resolved, otherRule, otherFile},
+	}
+	rules := []model.Rule{fakeRule{id: "RULE-A"}}
+	files := []*model.UnifiedFileModel{{Path: "a.go"}}
+
+	if err := pruneBaseline(&state, rules, files); err != nil {
+		t.Fatalf("pruneBaseline() error = %v", err)
+	}
+	if state.Pruned != 1 {
+		t.Fatalf("Pruned = %d, want 1", state.Pruned)
+	}
+	if state.EntryCount != 3 {
+		t.Fatalf("EntryCount = %d, want 3", state.EntryCount)
+	}
+
+	data, err := os.ReadFile(pathValue)
+	if err != nil {
+		t.Fatalf("read baseline: %v", err)
+	}
+	var doc baselineFile
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("parse baseline: %v", err)
+	}
+	if len(doc.Entries) != 3 {
+		t.Fatalf("baseline entries = %d, want 3", len(doc.Entries))
+	}
+	for _, entry := range doc.Entries {
+		if entry == resolved {
+			t.Fatalf("resolved entry should be pruned: %+v", doc.Entries)
+		}
+	}
+}
+
+func TestPruneBaselineSkipsBootstrappedBaseline(t *testing.T) {
+	t.Parallel()
+
+	state := baselineState{
+		Enabled:      true,
+		Bootstrapped: true,
+		Path:         filepath.Join(t.TempDir(), "missing", "baseline.json"),
+		Resolved:     []baselineEntry{{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1}},
+	}
+	if err := pruneBaseline(&state, []model.Rule{fakeRule{id: "RULE-A"}}, []*model.UnifiedFileModel{{Path: "a.go"}}); err != nil {
+		t.Fatalf("pruneBaseline() error = %v", err)
+	}
+	if state.Pruned != 0 {
+		t.Fatalf("Pruned = %d, want 0", state.Pruned)
+	}
+	if _, err := os.Stat(state.Path); !os.IsNotExist(err) {
+		t.Fatalf("bootstrapped baseline should not be rewritten, stat err = %v", err)
+	}
+}
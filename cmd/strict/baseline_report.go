@@ -0,0 +1,227 @@
+// baseline_report.go — `strict baseline-report`: baseline health after a lint run.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+)
+
+type baselineReport struct {
+	Baseline  string               `json:"baseline"`
+	Total     int                  `json:"total"`
+	Active    int                  `json:"active"`
+	Resolved  int                  `json:"resolved"`
+	Unchecked int                  `json:"unchecked"`
+	TopRules  []baselineRuleReport `json:"topRules"`
+}
+
+type baselineRuleReport struct {
+	RuleID     string `json:"ruleId"`
+	Suppressed int    `json:"suppressed"`
+	Resolved   int    `json:"resolved"`
+}
+
+// buildBaselineReport classifies each baseline entry as active (still produced),
+// resolved (stale), or unchecked. Like pruneBaseline, only entries for rules that ran
+// and files that were checked can count as resolved, so a scoped run does not report
+// unrelated suppressions as paid down. Top rules are ordered by suppressed count.
+func buildBaselineReport(state baselineState, rules []model.Rule, files []*model.UnifiedFileModel, top int) baselineReport {
+	report := baselineReport{Baseline: state.Path, Total: state.EntryCount, TopRules: make([]baselineRuleReport, 0)}
+
+	ranRules := map[string]bool{}
+	for _, rule := range rules {
+		ranRules[strings.TrimSpace(rule.ID())] = true
+	}
+	checkedFiles := map[string]bool{}
+	for _, file := range files {
+		checkedFiles[filepath.ToSlash(file.Path)] = true
+	}
+	resolved := map[string]bool{}
+	for _, entry := range state.Resolved {
+		resolved[baselineKeyFromEntry(entry)] = true
+	}
+
+	byRule := map[string]*baselineRuleReport{}
+	for _, entry := range state.Entries {
+		ruleID := strings.TrimSpace(entry.RuleID)
+		if !ranRules[ruleID] || !checkedFiles[filepath.ToSlash(strings.TrimSpace(entry.FilePath))] {
+			report.Unchecked++
+			continue
+		}
+		counts, ok := byRule[ruleID]
+		if !ok {
+			counts = &baselineRuleReport{RuleID: ruleID}
+			byRule[ruleID] = counts
+		}
+		if resolved[baselineKeyFromEntry(entry)] {
+			report.Resolved++
+			counts.Resolved++
+			continue
+		}
+		report.Active++
+		counts.Suppressed++
+	}
+
+	for _, counts := range byRule {
+		if counts.Suppressed > 0 {
+			report.TopRules = append(report.TopRules, *counts)
+		}
+	}
+	sort.Slice(report.TopRules, func(i, j int) bool {
+		if report.TopRules[i].Suppressed != report.TopRules[j].Suppressed {
+			return report.TopRules[i].Suppressed > report.TopRules[j].Suppressed
+		}
+		return report.TopRules[i].RuleID < report.TopRules[j].RuleID
+	})
+	if top > 0 && len(report.TopRules) > top {
+		report.TopRules = report.TopRules[:top]
+	}
+	return report
+}
+
+func writeBaselineReportText(w io.Writer, report baselineReport) {
+	fmt.Fprintf(w, "Baseline: %s\n", report.Baseline)
+	fmt.Fprintf(w, "  Entries:   %d\n", report.Total)
+	fmt.Fprintf(w, "  Active:    %d\n", report.Active)
+	fmt.Fprintf(w, "  Resolved:  %d\n", report.Resolved)
+	if report.Unchecked > 0 {
+		fmt.Fprintf(w, "  Unchecked: %d (rule not run or file not linted)\n", report.Unchecked)
+	}
+	if len(report.TopRules) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Top rules by suppressed count:")
+	width := 0
+	for _, r := range report.TopRules {
+		if len(r.RuleID) > width {
+			width = len(r.RuleID)
+		}
+	}
+	for _, r := range report.TopRules {
+		fmt.Fprintf(w, "  %-*s  %d suppressed, %d resolved\n", width, r.RuleID, r.Suppressed, r.Resolved)
+	}
+}
+
+func runBaselineReport(args []string) {
+	fs := flag.NewFlagSet("baseline-report", flag.ExitOnError)
+	baselinePath := fs.String("baseline", "", "Path to the baseline file to report on (required)")
+	format := fs.String("format", "text", "Output format (text, json)")
+	configPath := fs.String("config", ".stricture.yml", "Path to configuration file")
+	noConfig := fs.Bool("no-config", false, "Ignore config file and use built-in defaults")
+	var ruleFilters repeatableFlag
+	fs.Var(&ruleFilters, "rule", "Run a single rule by ID (can be repeated)")
+	rulesFrom := fs.String("rules-from", "", "Run the rules listed one ID per line in this file (# comments allowed)")
+	category := fs.String("category", "", "Run all rules in a category")
+	top := fs.Int("top", 10, "Number of rules to list by suppressed count (0 lists all)")
+	fs.Usage = func() {
+		fmt.Println("Usage: strict baseline-report --baseline <file> [options] [paths...]")
+		fmt.Println()
+		fmt.Println("Lint paths and report how many baseline entries are still active, how many are")
+		fmt.Println("resolved, and which rules carry the most suppressed violations.")
+		fs.PrintDefaults()
+	}
+	parseFlagSetOrExit(fs, args)
+
+	pathValue := strings.TrimSpace(*baselinePath)
+	if pathValue == "" {
+		fmt.Fprintln(os.Stderr, "Error: --baseline is required")
+		os.Exit(2)
+	}
+	if _, err := os.Stat(pathValue); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: baseline %s: %v\n", pathValue, err)
+		os.Exit(2)
+	}
+	outputFormat := strings.ToLower(strings.TrimSpace(*format))
+	if outputFormat != "text" && outputFormat != "json" {
+		fmt.Fprintf(os.Stderr, "Error: unsupported format %q (valid: text, json)\n", *format)
+		os.Exit(2)
+	}
+	if *top < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --top must be >= 0, got %d\n", *top)
+		os.Exit(2)
+	}
+	if err := validateRulesFromFlags(*rulesFrom, *category); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	registry := buildRegistry()
+	cfg := config.Default()
+	if !*noConfig {
+		resolvedConfigPath := resolveConfigPath(*configPath)
+		if loaded, err := config.Load(resolvedConfigPath); err == nil {
+			cfg = loaded
+		} else if !errors.Is(err, model.ErrConfigNotFound) {
+			fmt.Fprintf(os.Stderr, "Error: invalid config %s: %v\n", resolvedConfigPath, err)
+			os.Exit(1)
+		}
+		if err := registerPluginRules(registry, resolvedConfigPath, cfg.Plugins); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: load plugins: %v\n", err)
+			os.Exit(2)
+		}
+	}
+	requestedRules := ruleFilters.Values()
+	if strings.TrimSpace(*rulesFrom) != "" {
+		listed, err := readRulesFromList(*rulesFrom, registry)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --rules-from: %v\n", err)
+			os.Exit(2)
+		}
+		requestedRules = append(requestedRules, listed...)
+	}
+	rules, err := resolveLintRules(registry, cfg, requestedRules, *category, nil, false)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	filePaths, err := collectLintFilePaths(paths, lintDirSkips{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: collect files: %v\n", err)
+		os.Exit(1)
+	}
+	files, err := buildUnifiedFiles(filePaths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: parse files: %v\n", err)
+		os.Exit(1)
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, file := range files {
+		ctx.Files[file.Path] = file
+	}
+
+	violations := runLintRules(files, rules, ctx, 0, runtime.NumCPU(), 1, cfg)
+	state, err := applyBaseline(pathValue, &violations, baselineOptions{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	report := buildBaselineReport(state, rules, files, *top)
+
+	if outputFormat == "json" {
+		encoded, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: marshal baseline report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(encoded))
+		return
+	}
+	writeBaselineReportText(os.Stdout, report)
+}
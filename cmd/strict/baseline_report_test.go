@@ -0,0 +1,70 @@
+// baseline_report_test.go — Tests for baseline health reporting.
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestBuildBaselineReportClassifiesEntries(t *testing.T) {
+	t.Parallel()
+
+	pathValue := filepath.Join(t.TempDir(), "baseline.json")
+	entries := []baselineEntry{
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "one"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 2, Message: "two"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 3, Message: "fixed"},
+		{RuleID: "RULE-B", FilePath: "a.go", StartLine: 4, Message: "one"},
+		{RuleID: "RULE-C", FilePath: "a.go", StartLine: 5, Message: "rule not run"},
+		{RuleID: "RULE-A", FilePath: "b.go", StartLine: 1, Message: "file not checked"},
+	}
+	if err := writeBaselineFile(pathValue, entries); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	violations := []model.Violation{
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "one"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 2, Message: "two"},
+		{RuleID: "RULE-B", FilePath: "a.go", StartLine: 4, Message: "one"},
+		{RuleID: "RULE-B", FilePath: "a.go", StartLine: 9, Message: "new"},
+	}
+	state, err := applyBaseline(pathValue, &violations, baselineOptions{})
+	if err != nil {
+		t.Fatalf("applyBaseline() error = %v", err)
+	}
+
+	rules := []model.Rule{fakeRule{id: "RULE-A"}, fakeRule{id: "RULE-B"}}
+	files := []*model.UnifiedFileModel{{Path: "a.go"}}
+	report := buildBaselineReport(state, rules, files, 0)
+
+	if report.Total != 6 || report.Active != 3 || report.Resolved != 1 || report.Unchecked != 2 {
+		t.Fatalf("report totals = %+v, want total=6 active=3 resolved=1 unchecked=2", report)
+	}
+	want := []baselineRuleReport{
+		{RuleID: "RULE-A", Suppressed: 2, Resolved: 1},
+		{RuleID: "RULE-B", Suppressed: 1},
+	}
+	if len(report.TopRules) != len(want) {
+		t.Fatalf("TopRules = %+v, want %+v", report.TopRules, want)
+	}
+	for i := range want {
+		if report.TopRules[i] != want[i] {
+			t.Fatalf("TopRules[%d] = %+v, want %+v", i, report.TopRules[i], want[i])
+		}
+	}
+
+	if limited := buildBaselineReport(state, rules, files, 1); len(limited.TopRules) != 1 || limited.TopRules[0].RuleID != "RULE-A" {
+		t.Fatalf("top=1 should keep only RULE-A, got %+v", limited.TopRules)
+	}
+
+	var out bytes.Buffer
+	writeBaselineReportText(&out, report)
+	for _, fragment := range []string{"Entries:   6", "Active:    3", "Resolved:  1", "Unchecked: 2", "RULE-A  2 suppressed, 1 resolved"} {
+		if !strings.Contains(out.String(), fragment) {
+			t.Fatalf("text report missing %q:\n%s", fragment, out.String())
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// cache_dir.go — Where lint keeps cached data: .stricture-cache or a shared --cache-dir / STRICTURE_CACHE_DIR.
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+)
+
+// cacheDirEnv names a shared cache directory, used when --cache-dir is not given.
+const cacheDirEnv = "STRICTURE_CACHE_DIR"
+
+// localCacheDir is the default, project-local cache directory.
+const localCacheDir = ".stricture-cache"
+
+// resolveCacheDirs returns the directory for this project's cached data and the
+// directory for remote plugins. Without a shared directory (flag first, then the
+// environment) both live under .stricture-cache. In a shared directory, project data
+// goes under projects/<name>-<hash of the project root>, so two checkouts never read
+// each other's entries, while plugins, already keyed by checksum, share plugins/.
+func resolveCacheDirs(flagValue string, envValue string, projectRoot string) (projectDir string, pluginDir string) {
+	shared := strings.TrimSpace(flagValue)
+	if shared == "" {
+		shared = strings.TrimSpace(envValue)
+	}
+	if shared == "" {
+		return localCacheDir, filepath.Join(localCacheDir, "plugins")
+	}
+	return filepath.Join(shared, "projects", projectCacheKey(projectRoot)), filepath.Join(shared, "plugins")
+}
+
+// projectCacheKey names a project's directory in a shared cache after the project
+// root's base name, for people browsing the cache, and its hash, for uniqueness.
+func projectCacheKey(projectRoot string) string {
+	root := filepath.ToSlash(filepath.Clean(projectRoot))
+	sum := sha256.Sum256([]byte(root))
+	name := filepath.Base(filepath.FromSlash(root))
+	if name == "." || name == string(filepath.Separator) {
+		name = "root"
+	}
+	return name + "-" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,36 @@
+// cache_dir_test.go — Tests for resolving the local or shared cache directory.
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveCacheDirs(t *testing.T) {
+	t.Parallel()
+
+	projectDir, pluginDir := resolveCacheDirs("", "", "/work/app")
+	if projectDir != ".stricture-cache" || pluginDir != filepath.Join(".stricture-cache", "plugins") {
+		t.Fatalf("local dirs = %q, %q", projectDir, pluginDir)
+	}
+
+	projectDir, pluginDir = resolveCacheDirs("", "/cache", "/work/app")
+	if !strings.HasPrefix(projectDir, filepath.Join("/cache", "projects", "app-")) || pluginDir != filepath.Join("/cache", "plugins") {
+		t.Fatalf("env dirs = %q, %q", projectDir, pluginDir)
+	}
+
+	flagDir, _ := resolveCacheDirs("/ci-cache", "/cache", "/work/app")
+	if !strings.HasPrefix(flagDir, filepath.Join("/ci-cache", "projects")) {
+		t.Fatalf("--cache-dir should win over the environment, got %q", flagDir)
+	}
+
+	same, _ := resolveCacheDirs("", "/cache", "/work/app/")
+	other, _ := resolveCacheDirs("", "/cache", "/home/ci/app")
+	if same != projectDir {
+		t.Fatalf("equivalent roots should share a directory: %q vs %q", same, projectDir)
+	}
+	if other == projectDir {
+		t.Fatalf("projects with the same name in different roots must not collide: %q", other)
+	}
+}
@@ -0,0 +1,110 @@
+// catalog.go — `strict catalog`: machine-readable rule catalog with examples.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+type catalogEntry struct {
+	ID                  string              `json:"id"`
+	Category            string              `json:"category"`
+	Description         string              `json:"description"`
+	Why                 string              `json:"why"`
+	DefaultSeverity     string              `json:"defaultSeverity"`
+	Fixable             string              `json:"fixable"`
+	Stability           string              `json:"stability"`
+	RequiresManifest    bool                `json:"requiresManifest"`
+	NeedsProjectContext bool                `json:"needsProjectContext"`
+	Examples            []model.RuleExample `json:"examples"`
+}
+
+type ruleCatalog struct {
+	Version string         `json:"version"`
+	Tool    string         `json:"toolVersion"`
+	Rules   []catalogEntry `json:"rules"`
+}
+
+// buildRuleCatalog lists registry rules in display order, optionally limited to one
+// category. Rules without examples get an empty examples list.
+func buildRuleCatalog(registry *model.RuleRegistry, category string) ruleCatalog {
+	catalog := ruleCatalog{Version: "1", Tool: version, Rules: make([]catalogEntry, 0)}
+	for _, r := range sortedRulesForDisplay(registry) {
+		if category != "" && !strings.EqualFold(strings.TrimSpace(r.Category()), category) {
+			continue
+		}
+		meta := ruleMetadata(r.ID())
+		examples := model.RuleExamples(r)
+		if examples == nil {
+			examples = []model.RuleExample{}
+		}
+		catalog.Rules = append(catalog.Rules, catalogEntry{
+			ID:                  r.ID(),
+			Category:            strings.ToLower(strings.TrimSpace(r.Category())),
+			Description:         r.Description(),
+			Why:                 r.Why(),
+			DefaultSeverity:     r.DefaultSeverity(),
+			Fixable:             meta.Fixability,
+			Stability:           model.RuleStability(r),
+			RequiresManifest:    meta.RequiresManifest,
+			NeedsProjectContext: r.NeedsProjectContext(),
+			Examples:            examples,
+		})
+	}
+	return catalog
+}
+
+func runCatalog(args []string) {
+	fs := flag.NewFlagSet("catalog", flag.ExitOnError)
+	configPath := fs.String("config", ".stricture.yml", "Path to configuration file (plugins listed there are included)")
+	category := fs.String("category", "", "Only include rules in this category")
+	outputPath := fs.String("output", "", "Write the catalog to file instead of stdout")
+	fs.Usage = func() {
+		fmt.Println("Usage: strict catalog [options]")
+		fmt.Println()
+		fmt.Println("Print every registered rule with its metadata and bad/good examples as JSON.")
+		fs.PrintDefaults()
+	}
+	parseFlagSetOrExit(fs, args)
+
+	registry, err := registryWithConfigPlugins(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	categoryValue := strings.ToLower(strings.TrimSpace(*category))
+	if categoryValue != "" && !registryHasCategory(registry, categoryValue) {
+		fmt.Fprintf(os.Stderr, "Error: unknown category %q (available: %s)\n", *category, strings.Join(registry.Categories(), ", "))
+		os.Exit(2)
+	}
+
+	encoded, err := json.MarshalIndent(buildRuleCatalog(registry, categoryValue), "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: marshal catalog: %v\n", err)
+		os.Exit(1)
+	}
+	encoded = append(encoded, '\n')
+
+	target := strings.TrimSpace(*outputPath)
+	if target == "" {
+		if _, err := os.Stdout.Write(encoded); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: write output: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: create output directory for %s: %v\n", target, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(target, encoded, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: write output file %s: %v\n", target, err)
+		os.Exit(1)
+	}
+}
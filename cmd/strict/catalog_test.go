@@ -0,0 +1,46 @@
+// catalog_test.go — Tests for the machine-readable rule catalog.
+package main
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestBuildRuleCatalogIncludesBuiltinExamples(t *testing.T) {
+	t.Parallel()
+
+	catalog := buildRuleCatalog(buildRegistry(), "")
+	if catalog.Version != "1" || len(catalog.Rules) != len(buildRegistry().All()) {
+		t.Fatalf("catalog has %d rules (version %q), want every registered rule", len(catalog.Rules), catalog.Version)
+	}
+	for _, entry := range catalog.Rules {
+		if len(entry.Examples) == 0 {
+			t.Fatalf("built-in rule %s has no examples", entry.ID)
+		}
+		for _, ex := range entry.Examples {
+			if ex.Language == "" || ex.Bad == "" || ex.Good == "" {
+				t.Fatalf("rule %s has an incomplete example: %+v", entry.ID, ex)
+			}
+		}
+	}
+}
+
+func TestBuildRuleCatalogFiltersCategoryAndDegradesWithoutExamples(t *testing.T) {
+	t.Parallel()
+
+	registry := model.NewRuleRegistry()
+	registry.Register(categorizedRule{fakeRule: fakeRule{id: "SEC-no-eval"}, category: "security"})
+	registry.Register(categorizedRule{fakeRule: fakeRule{id: "A11Y-alt-text"}, category: "a11y"})
+
+	catalog := buildRuleCatalog(registry, "security")
+	if len(catalog.Rules) != 1 || catalog.Rules[0].ID != "SEC-no-eval" {
+		t.Fatalf("catalog rules = %+v, want only SEC-no-eval", catalog.Rules)
+	}
+	if catalog.Rules[0].Examples == nil || len(catalog.Rules[0].Examples) != 0 {
+		t.Fatalf("rules without examples should report an empty list, got %#v", catalog.Rules[0].Examples)
+	}
+	if catalog.Rules[0].Fixable != "No" {
+		t.Fatalf("fixable = %q, want No", catalog.Rules[0].Fixable)
+	}
+}
@@ -0,0 +1,74 @@
+// diff_context.go — `--diff-context N`: attach surrounding source lines to each violation.
+package main
+
+import (
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// maxDiffContext bounds --diff-context so a large N cannot inline whole files.
+const maxDiffContext = 20
+
+// attachDiffContext sets Snippet on each violation whose file is among the linted
+// files. Violations without a usable line (or on unread files) are left unchanged.
+func attachDiffContext(violations []model.Violation, files map[string]*model.UnifiedFileModel, context int) {
+	lines := map[string][]string{}
+	for i := range violations {
+		v := &violations[i]
+		file := files[v.FilePath]
+		if file == nil {
+			continue
+		}
+		fileLines, ok := lines[v.FilePath]
+		if !ok {
+			fileLines = sourceLines(file.Source)
+			lines[v.FilePath] = fileLines
+		}
+		v.Snippet = sourceSnippet(fileLines, v.StartLine, v.EndLine, context)
+	}
+}
+
+// sourceLines splits source into lines without their terminators; a trailing newline
+// does not produce an extra empty line.
+func sourceLines(source []byte) []string {
+	if len(source) == 0 {
+		return nil
+	}
+	text := strings.TrimSuffix(string(source), "\n")
+	out := strings.Split(text, "\n")
+	for i, line := range out {
+		out[i] = strings.TrimSuffix(line, "\r")
+	}
+	return out
+}
+
+// sourceSnippet returns lines start..end (end defaults to start) widened by context on
+// each side and clamped to the file. It returns nil when start is past the end of file.
+func sourceSnippet(lines []string, start int, end int, context int) *model.ViolationSnippet {
+	if len(lines) == 0 {
+		return nil
+	}
+	if start < 1 {
+		start = 1
+	}
+	if start > len(lines) {
+		return nil
+	}
+	if end < start {
+		end = start
+	}
+	first := start - context
+	if first < 1 {
+		first = 1
+	}
+	last := end + context
+	if last > len(lines) {
+		last = len(lines)
+	}
+	return &model.ViolationSnippet{
+		StartLine: first,
+		EndLine:   last,
+		Lines:     append([]string(nil), lines[first-1:last]...),
+	}
+}
@@ -0,0 +1,53 @@
+// diff_context_test.go — Tests for --diff-context snippets.
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestSourceSnippetClampsToFile(t *testing.T) {
+	t.Parallel()
+
+	lines := sourceLines([]byte("one\r\ntwo\nthree\nfour\nfive\n"))
+	if len(lines) != 5 || lines[0] != "one" {
+		t.Fatalf("sourceLines = %q", lines)
+	}
+
+	tests := []struct {
+		name               string
+		start, end, ctx    int
+		wantFirst, wantEnd int
+	}{
+		{name: "middle", start: 3, ctx: 1, wantFirst: 2, wantEnd: 4},
+		{name: "start of file", start: 1, ctx: 2, wantFirst: 1, wantEnd: 3},
+		{name: "end of file", start: 5, ctx: 3, wantFirst: 2, wantEnd: 5},
+		{name: "range", start: 2, end: 3, ctx: 0, wantFirst: 2, wantEnd: 3},
+		{name: "line zero", start: 0, ctx: 0, wantFirst: 1, wantEnd: 1},
+	}
+	for _, tc := range tests {
+		got := sourceSnippet(lines, tc.start, tc.end, tc.ctx)
+		if got == nil || got.StartLine != tc.wantFirst || got.EndLine != tc.wantEnd || !reflect.DeepEqual(got.Lines, lines[tc.wantFirst-1:tc.wantEnd]) {
+			t.Fatalf("%s: snippet = %+v, want lines %d-%d", tc.name, got, tc.wantFirst, tc.wantEnd)
+		}
+	}
+	if got := sourceSnippet(lines, 9, 0, 1); got != nil {
+		t.Fatalf("a line past EOF should yield no snippet, got %+v", got)
+	}
+}
+
+func TestAttachDiffContextSkipsUnknownFiles(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]*model.UnifiedFileModel{"a.go": {Path: "a.go", Source: []byte("package a\n\nfunc A() {}\n")}}
+	violations := []model.Violation{{FilePath: "a.go", StartLine: 3}, {FilePath: "gone.go", StartLine: 1}}
+	attachDiffContext(violations, files, 1)
+	if violations[0].Snippet == nil || violations[0].Snippet.StartLine != 2 || violations[0].Snippet.EndLine != 3 {
+		t.Fatalf("snippet = %+v", violations[0].Snippet)
+	}
+	if violations[1].Snippet != nil {
+		t.Fatalf("violations on unread files should have no snippet, got %+v", violations[1].Snippet)
+	}
+}
@@ -0,0 +1,65 @@
+// dir_skips.go — Which directories a lint walk leaves out (--include-dir, --no-default-skips).
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// defaultSkippedDirNames are directory names skipped wherever they appear.
+var defaultSkippedDirNames = []string{"node_modules", "bin", localCacheDir, "docs", "tests"}
+
+// defaultSkippedDirPaths are path segments skipped wherever they appear, so fixtures stay
+// out of the walk even when tests/ itself is included.
+var defaultSkippedDirPaths = []string{"tests/fixtures", "tests/benchmark"}
+
+// lintDirSkips decides which directories a lint walk leaves out. The zero value applies
+// the built-in skips. .git is always skipped.
+type lintDirSkips struct {
+	included   map[string]bool
+	noDefaults bool
+}
+
+// newLintDirSkips removes each --include-dir entry from the built-in skips, or drops them
+// all with --no-default-skips. An entry must name a built-in skip, by directory name
+// (`tests`) or path (`tests/fixtures`).
+func newLintDirSkips(include []string, noDefaults bool) (lintDirSkips, error) {
+	known := map[string]bool{}
+	for _, name := range append(append([]string(nil), defaultSkippedDirNames...), defaultSkippedDirPaths...) {
+		known[name] = true
+	}
+	skips := lintDirSkips{included: map[string]bool{}, noDefaults: noDefaults}
+	for _, raw := range include {
+		name := strings.Trim(filepath.ToSlash(strings.TrimSpace(raw)), "/")
+		if !known[name] {
+			return lintDirSkips{}, fmt.Errorf("--include-dir %q is not skipped by default (skipped: %s)", raw,
+				strings.Join(append(append([]string(nil), defaultSkippedDirNames...), defaultSkippedDirPaths...), ", "))
+		}
+		skips.included[name] = true
+	}
+	return skips, nil
+}
+
+func (s lintDirSkips) skip(dir string) bool {
+	base := filepath.Base(dir)
+	if base == ".git" {
+		return true
+	}
+	if s.noDefaults {
+		return false
+	}
+	for _, name := range defaultSkippedDirNames {
+		if base == name && !s.included[name] {
+			return true
+		}
+	}
+
+	normalized := filepath.ToSlash(dir)
+	for _, segment := range defaultSkippedDirPaths {
+		if strings.Contains(normalized, segment) && !s.included[segment] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// dir_skips_test.go — Tests for --include-dir and --no-default-skips.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewLintDirSkips(t *testing.T) {
+	t.Parallel()
+
+	skips, err := newLintDirSkips([]string{"tests", "docs/"}, false)
+	if err != nil {
+		t.Fatalf("newLintDirSkips() error = %v", err)
+	}
+	if skips.skip("tests") || skips.skip("pkg/docs") {
+		t.Fatalf("included directories should be walked")
+	}
+	if !skips.skip("tests/fixtures/sample") || !skips.skip("node_modules") {
+		t.Fatalf("fixtures and other defaults should still be skipped")
+	}
+
+	skips, err = newLintDirSkips([]string{"tests", "tests/fixtures"}, false)
+	if err != nil {
+		t.Fatalf("newLintDirSkips() error = %v", err)
+	}
+	if skips.skip("tests/fixtures/sample") {
+		t.Fatalf("tests/fixtures can be included by path")
+	}
+
+	skips, err = newLintDirSkips(nil, true)
+	if err != nil {
+		t.Fatalf("newLintDirSkips() error = %v", err)
+	}
+	if skips.skip("tests/fixtures") || skips.skip("node_modules") {
+		t.Fatalf("--no-default-skips should drop the built-in list")
+	}
+	if !skips.skip(".git") {
+		t.Fatalf(".git is always skipped")
+	}
+
+	if _, err := newLintDirSkips([]string{"src"}, false); err == nil || !strings.Contains(err.Error(), `--include-dir "src" is not skipped by default`) {
+		t.Fatalf("unknown directory error = %v", err)
+	}
+}
+
+func TestCollectLintFilePathsIncludesSkippedDirs(t *testing.T) {
+	dir := t.TempDir()
+	for _, rel := range []string{"tests/e2e/login.go", "tests/fixtures/bad.go", "src/app.go"} {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("package x\n"), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	got, err := collectLintFilePaths([]string{dir}, lintDirSkips{})
+	if err != nil {
+		t.Fatalf("collectLintFilePaths() error = %v", err)
+	}
+	root := filepath.ToSlash(dir)
+	if want := []string{root + "/src/app.go"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("default skips = %v, want %v", got, want)
+	}
+
+	skips, _ := newLintDirSkips([]string{"tests"}, false)
+	got, err = collectLintFilePaths([]string{dir}, skips)
+	if err != nil {
+		t.Fatalf("collectLintFilePaths() error = %v", err)
+	}
+	if want := []string{root + "/src/app.go", root + "/tests/e2e/login.go"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("--include-dir tests = %v, want %v", got, want)
+	}
+}
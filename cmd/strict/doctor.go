@@ -0,0 +1,227 @@
+// doctor.go — `strict doctor`: diagnose config, git, plugin, registry, and cache setup.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/plugins"
+)
+
+const (
+	doctorPass = "pass"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+// doctorCheck is one line of the doctor checklist. Hint says how to fix a warn or fail.
+type doctorCheck struct {
+	Name   string
+	Status string
+	Detail string
+	Hint   string
+}
+
+// diagnoseSetup runs the doctor checks in display order. lookPath locates executables
+// (exec.LookPath outside tests); cacheDir is the directory lint writes cached data to.
+func diagnoseSetup(configPath string, cacheDir string, lookPath func(string) (string, error)) []doctorCheck {
+	checks := make([]doctorCheck, 0, 6)
+
+	resolvedConfigPath := resolveConfigPath(configPath)
+	var cfg *config.Config
+	loaded, err := config.Load(resolvedConfigPath)
+	switch {
+	case err == nil:
+		cfg = loaded
+		checks = append(checks, doctorCheck{Name: "Config", Status: doctorPass, Detail: "found " + resolvedConfigPath})
+	case errors.Is(err, model.ErrConfigNotFound):
+		checks = append(checks, doctorCheck{
+			Name:   "Config",
+			Status: doctorWarn,
+			Detail: fmt.Sprintf("no %s in this directory or any parent; built-in defaults apply", configPath),
+			Hint:   "Run 'strict init' to create one.",
+		})
+	default:
+		checks = append(checks, doctorCheck{
+			Name:   "Config",
+			Status: doctorFail,
+			Detail: fmt.Sprintf("invalid config %s: %v", resolvedConfigPath, err),
+			Hint:   fmt.Sprintf("Run 'strict validate-config %s' and fix the reported problem.", resolvedConfigPath),
+		})
+	}
+
+	checks = append(checks, diagnoseGit(lookPath))
+
+	registry := buildRegistry()
+	builtin := len(registry.All())
+	if cfg != nil {
+		for _, pluginPath := range resolvePluginPaths(resolvedConfigPath, cfg.Plugins) {
+			check, rules := diagnosePlugin(pluginPath, registry)
+			checks = append(checks, check)
+			for _, r := range rules {
+				registry.Register(r)
+			}
+		}
+	}
+
+	total := len(registry.All())
+	if total == 0 {
+		checks = append(checks, doctorCheck{
+			Name:   "Rules",
+			Status: doctorFail,
+			Detail: "no rules registered",
+			Hint:   "Reinstall strict; this build has no built-in rules.",
+		})
+	} else {
+		checks = append(checks, doctorCheck{
+			Name:   "Rules",
+			Status: doctorPass,
+			Detail: fmt.Sprintf("%d rules registered (%d built-in, %d from plugins)", total, builtin, total-builtin),
+		})
+	}
+
+	checks = append(checks, diagnoseCacheDir(cacheDir))
+	return checks
+}
+
+// diagnoseGit checks that git is on PATH and that the working directory is inside a
+// work tree, which --changed, --staged, and --since rely on.
+func diagnoseGit(lookPath func(string) (string, error)) doctorCheck {
+	gitPath, err := lookPath("git")
+	if err != nil {
+		return doctorCheck{
+			Name:   "Git",
+			Status: doctorFail,
+			Detail: "git not found on PATH",
+			Hint:   "Install git and add it to PATH; --changed, --staged, and --since need it.",
+		}
+	}
+	if _, err := gitOutput("rev-parse", "--is-inside-work-tree"); err != nil {
+		return doctorCheck{
+			Name:   "Git",
+			Status: doctorWarn,
+			Detail: fmt.Sprintf("%s found, but this directory is not inside a git work tree", gitPath),
+			Hint:   "Run strict from a git checkout to use --changed, --staged, and --since.",
+		}
+	}
+	return doctorCheck{Name: "Git", Status: doctorPass, Detail: gitPath}
+}
+
+// diagnosePlugin loads one plugin on its own so a failure names the plugin at fault,
+// and rejects rule IDs that are already registered. It returns the rules to register.
+func diagnosePlugin(pluginPath string, registry *model.RuleRegistry) (doctorCheck, []model.Rule) {
+	name := "Plugin " + pluginPath
+	hint := "Fix the path in the plugins list of your config, or remove the entry."
+	if !strings.Contains(pluginPath, "://") {
+		if _, err := os.Stat(pluginPath); err != nil {
+			return doctorCheck{Name: name, Status: doctorFail, Detail: fmt.Sprintf("cannot resolve plugin: %v", err), Hint: hint}, nil
+		}
+	}
+	rules, err := plugins.Load([]string{pluginPath})
+	if err != nil {
+		return doctorCheck{Name: name, Status: doctorFail, Detail: fmt.Sprintf("load failed: %v", err), Hint: hint}, nil
+	}
+	for _, r := range rules {
+		if _, exists := registry.ByID(r.ID()); exists {
+			return doctorCheck{
+				Name:   name,
+				Status: doctorFail,
+				Detail: fmt.Sprintf("rule ID %q is already registered", r.ID()),
+				Hint:   "Rename the plugin rule so it does not shadow a built-in or another plugin's rule.",
+			}, nil
+		}
+	}
+	return doctorCheck{Name: name, Status: doctorPass, Detail: fmt.Sprintf("loaded %d rule(s)", len(rules))}, rules
+}
+
+// diagnoseCacheDir checks that dir, or the nearest existing parent it would be created
+// in, accepts new files. The probe file is removed again.
+func diagnoseCacheDir(dir string) doctorCheck {
+	existing := dir
+	for {
+		if info, err := os.Stat(existing); err == nil {
+			if !info.IsDir() {
+				return doctorCheck{
+					Name:   "Cache",
+					Status: doctorFail,
+					Detail: fmt.Sprintf("%s is not a directory", existing),
+					Hint:   fmt.Sprintf("Remove or rename %s so the cache directory can be created.", existing),
+				}
+			}
+			break
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			break
+		}
+		existing = parent
+	}
+
+	probe, err := os.CreateTemp(existing, ".stricture-doctor-*")
+	if err != nil {
+		return doctorCheck{
+			Name:   "Cache",
+			Status: doctorFail,
+			Detail: fmt.Sprintf("%s is not writable: %v", existing, err),
+			Hint:   "Make the directory writable, or run strict with --no-cache.",
+		}
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	if existing != dir {
+		return doctorCheck{Name: "Cache", Status: doctorPass, Detail: fmt.Sprintf("%s can be created in %s", dir, existing)}
+	}
+	return doctorCheck{Name: "Cache", Status: doctorPass, Detail: dir + " is writable"}
+}
+
+// writeDoctorReport prints the checklist and returns the number of failed checks.
+func writeDoctorReport(w io.Writer, checks []doctorCheck) int {
+	failed := 0
+	fmt.Fprintln(w, "Stricture doctor")
+	for _, check := range checks {
+		if check.Status == doctorFail {
+			failed++
+		}
+		fmt.Fprintf(w, "  [%s] %s: %s\n", strings.ToUpper(check.Status), check.Name, check.Detail)
+		if check.Hint != "" {
+			fmt.Fprintf(w, "         hint: %s\n", check.Hint)
+		}
+	}
+	fmt.Fprintln(w)
+	if failed > 0 {
+		fmt.Fprintf(w, "%d check(s) failed.\n", failed)
+	} else {
+		fmt.Fprintln(w, "All checks passed.")
+	}
+	return failed
+}
+
+func runDoctor(args []string) {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	configPath := fs.String("config", ".stricture.yml", "Path to configuration file")
+	cacheDir := fs.String("cache-dir", "", "Shared cache directory to check (default: $"+cacheDirEnv+", else .stricture-cache)")
+	fs.Usage = func() {
+		fmt.Println("Usage: strict doctor [options]")
+		fmt.Println()
+		fmt.Println("Check config discovery, git, plugins, registered rules, and cache writability,")
+		fmt.Println("and print a hint for each problem found.")
+		fs.PrintDefaults()
+	}
+	parseFlagSetOrExit(fs, args)
+
+	projectCacheDir, pluginCacheDir := resolveCacheDirs(*cacheDir, os.Getenv(cacheDirEnv), currentProjectRoot())
+	plugins.RemoteCacheDir = pluginCacheDir
+	checks := diagnoseSetup(*configPath, projectCacheDir, exec.LookPath)
+	if writeDoctorReport(os.Stdout, checks) > 0 {
+		os.Exit(1)
+	}
+}
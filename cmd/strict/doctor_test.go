@@ -0,0 +1,117 @@
+// doctor_test.go — Tests for the doctor setup checklist.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const doctorTestPlugin = `rules:
+  - id: CUSTOM-no-fmt-print
+    category: custom
+    severity: warn
+    description: "Disallow fmt.Print"
+    match:
+      languages: ["go"]
+    check:
+      must_not_contain:
+        pattern: "fmt\\.Print"
+        message: "Use structured logging instead of fmt.Print"
+`
+
+func doctorChecksByName(checks []doctorCheck) map[string]doctorCheck {
+	byName := map[string]doctorCheck{}
+	for _, check := range checks {
+		byName[check.Name] = check
+	}
+	return byName
+}
+
+func TestDiagnoseSetupReportsPluginsAndRuleCount(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "custom.yml"), []byte(doctorTestPlugin), 0o644); err != nil {
+		t.Fatalf("write plugin: %v", err)
+	}
+	configPath := filepath.Join(dir, ".stricture.yml")
+	if err := os.WriteFile(configPath, []byte("version: \"1.0\"\nplugins:\n  - custom.yml\n  - missing.yml\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	checks := diagnoseSetup(configPath, filepath.Join(dir, ".stricture-cache"), exec.LookPath)
+	byName := doctorChecksByName(checks)
+	if got := byName["Config"]; got.Status != doctorPass {
+		t.Fatalf("config check = %+v, want pass", got)
+	}
+	if got := byName["Plugin "+filepath.Join(dir, "custom.yml")]; got.Status != doctorPass || got.Detail != "loaded 1 rule(s)" {
+		t.Fatalf("custom plugin check = %+v", got)
+	}
+	if got := byName["Plugin "+filepath.Join(dir, "missing.yml")]; got.Status != doctorFail || got.Hint == "" {
+		t.Fatalf("missing plugin check = %+v, want fail with hint", got)
+	}
+	builtin := len(buildRegistry().All())
+	wantRules := fmt.Sprintf("%d rules registered (%d built-in, 1 from plugins)", builtin+1, builtin)
+	if got := byName["Rules"]; got.Status != doctorPass || got.Detail != wantRules {
+		t.Fatalf("rules check = %+v, want %q", got, wantRules)
+	}
+	if got := byName["Cache"]; got.Status != doctorPass || !strings.Contains(got.Detail, "can be created in "+dir) {
+		t.Fatalf("cache check = %+v", got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".stricture-cache")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("doctor must not create the cache directory, stat err = %v", err)
+	}
+}
+
+func TestDiagnoseSetupMissingConfigAndGit(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	noGit := func(string) (string, error) { return "", exec.ErrNotFound }
+	byName := doctorChecksByName(diagnoseSetup(filepath.Join(dir, ".stricture.yml"), dir, noGit))
+
+	if got := byName["Config"]; got.Status != doctorWarn || !strings.Contains(got.Hint, "strict init") {
+		t.Fatalf("config check = %+v, want warn suggesting strict init", got)
+	}
+	if got := byName["Git"]; got.Status != doctorFail || got.Detail != "git not found on PATH" {
+		t.Fatalf("git check = %+v, want fail", got)
+	}
+	if got := byName["Cache"]; got.Status != doctorPass || got.Detail != dir+" is writable" {
+		t.Fatalf("cache check = %+v", got)
+	}
+}
+
+func TestDiagnoseCacheDirRejectsFile(t *testing.T) {
+	t.Parallel()
+
+	target := filepath.Join(t.TempDir(), ".stricture-cache")
+	if err := os.WriteFile(target, []byte("x"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if got := diagnoseCacheDir(filepath.Join(target, "plugins")); got.Status != doctorFail || !strings.Contains(got.Detail, "not a directory") {
+		t.Fatalf("cache check = %+v, want fail", got)
+	}
+}
+
+func TestWriteDoctorReportCountsFailures(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	failed := writeDoctorReport(&out, []doctorCheck{
+		{Name: "Config", Status: doctorPass, Detail: "found .stricture.yml"},
+		{Name: "Git", Status: doctorFail, Detail: "git not found on PATH", Hint: "Install git."},
+	})
+	if failed != 1 {
+		t.Fatalf("failed = %d, want 1", failed)
+	}
+	want := "Stricture doctor\n  [PASS] Config: found .stricture.yml\n  [FAIL] Git: git not found on PATH\n         hint: Install git.\n\n1 check(s) failed.\n"
+	if out.String() != want {
+		t.Fatalf("report =\n%s\nwant\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,88 @@
+// files_from.go — Reads the lint file set from a newline-delimited list (--files-from).
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// validateFilesFromFlags rejects options that choose the file set another way.
+func validateFilesFromFlags(hasPaths bool, gitScoped bool, archive bool) error {
+	switch {
+	case hasPaths:
+		return errors.New("--files-from cannot be combined with path arguments")
+	case gitScoped:
+		return errors.New("--files-from cannot be combined with --changed or --staged")
+	case archive:
+		return errors.New("--files-from cannot be combined with --archive")
+	}
+	return nil
+}
+
+// readFilesFromList returns the lintable files named in source, one path per line, or
+// in stdin when source is "-". Directories are not walked. Blank lines, paths that no
+// longer exist (deleted files in a change list), directories, unsupported extensions,
+// generated files, and symlinks leaving the project are skipped.
+func readFilesFromList(source string, stdin io.Reader) ([]string, error) {
+	var in io.Reader = stdin
+	if source != "-" {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	projectRoot := currentProjectRoot()
+	files := make([]string, 0)
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		pathValue := strings.TrimSpace(scanner.Text())
+		if pathValue == "" || !isLintSourceFile(pathValue) {
+			continue
+		}
+		info, err := os.Stat(pathValue)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+		outside, err := symlinkResolvesOutsideProject(pathValue, projectRoot)
+		if err != nil {
+			return nil, err
+		}
+		if outside {
+			continue
+		}
+		canonical := filepath.ToSlash(filepath.Clean(pathValue))
+		if !seen[canonical] {
+			seen[canonical] = true
+			files = append(files, canonical)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", filesFromName(source), err)
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func filesFromName(source string) string {
+	if source == "-" {
+		return "stdin"
+	}
+	return source
+}
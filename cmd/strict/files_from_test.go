@@ -0,0 +1,74 @@
+// files_from_test.go — Tests for reading the lint file set with --files-from.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadFilesFromList(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.go", "b.ts", "README.md", "api.pb.go", "sub/c.py"} {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(p, []byte("x\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", p, err)
+		}
+	}
+	slash := filepath.ToSlash(dir)
+	list := strings.Join([]string{
+		slash + "/b.ts",
+		"",
+		"  " + slash + "/a.go\r",
+		slash + "/README.md",
+		slash + "/api.pb.go",
+		slash + "/deleted.go",
+		slash + "/sub",
+		slash + "/a.go",
+	}, "\n")
+
+	got, err := readFilesFromList("-", strings.NewReader(list))
+	if err != nil {
+		t.Fatalf("readFilesFromList() error = %v", err)
+	}
+	want := []string{slash + "/a.go", slash + "/b.ts"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("readFilesFromList() = %v, want %v", got, want)
+	}
+
+	listPath := filepath.Join(dir, "files.txt")
+	if err := os.WriteFile(listPath, []byte(list), 0o644); err != nil {
+		t.Fatalf("write list: %v", err)
+	}
+	fromFile, err := readFilesFromList(listPath, strings.NewReader(""))
+	if err != nil {
+		t.Fatalf("readFilesFromList(file) error = %v", err)
+	}
+	if !reflect.DeepEqual(fromFile, want) {
+		t.Fatalf("readFilesFromList(file) = %v, want %v", fromFile, want)
+	}
+
+	if _, err := readFilesFromList(filepath.Join(dir, "missing.txt"), nil); err == nil {
+		t.Fatal("expected error for a missing list file")
+	}
+}
+
+func TestValidateFilesFromFlags(t *testing.T) {
+	t.Parallel()
+
+	if err := validateFilesFromFlags(false, false, false); err != nil {
+		t.Fatalf("validateFilesFromFlags() error = %v", err)
+	}
+	for _, tc := range [][3]bool{{true, false, false}, {false, true, false}, {false, false, true}} {
+		if err := validateFilesFromFlags(tc[0], tc[1], tc[2]); err == nil {
+			t.Fatalf("validateFilesFromFlags(%v) should fail", tc)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// fix_interactive.go — `--fix --interactive`: confirm each planned fix operation before applying it.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/fix"
+)
+
+// maxFixPreviewLines caps the diff shown for one edit operation.
+const maxFixPreviewLines = 40
+
+// stdinIsTerminal reports whether prompts can be answered interactively. The null
+// device is also a character device, so it is ruled out explicitly.
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	if null, err := os.Stat(os.DevNull); err == nil && os.SameFile(info, null) {
+		return false
+	}
+	return true
+}
+
+// runInteractiveFix shows each operation and asks y/n/all/quit before handing it to
+// apply. Operations are offered in fix.Apply order (edits before renames). It returns
+// the operations that were applied; quitting, or reaching end of input, keeps the ones
+// already applied and skips the rest.
+func runInteractiveFix(ops []fix.Operation, in io.Reader, out io.Writer, apply func(fix.Operation) error) ([]fix.Operation, error) {
+	ordered := append([]fix.Operation(nil), ops...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Kind == ordered[j].Kind {
+			return ordered[i].Path < ordered[j].Path
+		}
+		return ordered[i].Kind == "edit"
+	})
+
+	reader := bufio.NewReader(in)
+	applied := make([]fix.Operation, 0, len(ordered))
+	acceptAll := false
+	for i, op := range ordered {
+		if !acceptAll {
+			fmt.Fprintf(out, "\n[%d/%d] [%s] %s\n", i+1, len(ordered), op.RuleID, op.Description)
+			fmt.Fprint(out, formatFixPreview(op))
+			answer, err := promptFixAnswer(reader, out)
+			if err != nil {
+				return applied, err
+			}
+			switch answer {
+			case "n":
+				continue
+			case "q":
+				return applied, nil
+			case "a":
+				acceptAll = true
+			}
+		}
+		if err := apply(op); err != nil {
+			return applied, err
+		}
+		applied = append(applied, op)
+	}
+	return applied, nil
+}
+
+func promptFixAnswer(reader *bufio.Reader, out io.Writer) (string, error) {
+	for {
+		fmt.Fprint(out, "Apply this fix? [y]es/[n]o/[a]ll/[q]uit: ")
+		line, err := reader.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "y", "yes":
+			return "y", nil
+		case "n", "no":
+			return "n", nil
+		case "a", "all":
+			return "a", nil
+		case "q", "quit":
+			return "q", nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				fmt.Fprintln(out)
+				return "q", nil
+			}
+			return "", fmt.Errorf("read answer: %w", err)
+		}
+	}
+}
+
+// formatFixPreview renders the proposed change: the move for renames, or the changed
+// lines of an edit between the common leading and trailing lines of the file.
+func formatFixPreview(op fix.Operation) string {
+	var out strings.Builder
+	switch op.Kind {
+	case "rename":
+		fmt.Fprintf(&out, "  rename %s -> %s\n", filepath.ToSlash(op.Path), filepath.ToSlash(op.NewPath))
+	case "edit":
+		before := ""
+		if data, err := os.ReadFile(op.Path); err == nil {
+			before = string(data)
+		}
+		oldLines := strings.Split(before, "\n")
+		newLines := strings.Split(string(op.Content), "\n")
+		prefix := 0
+		for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+			prefix++
+		}
+		suffix := 0
+		for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+			oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+			suffix++
+		}
+		fmt.Fprintf(&out, "  --- %s\n  +++ %s\n  @@ line %d @@\n", filepath.ToSlash(op.Path), filepath.ToSlash(op.Path), prefix+1)
+		diff := make([]string, 0)
+		for _, line := range oldLines[prefix : len(oldLines)-suffix] {
+			diff = append(diff, "  -"+line)
+		}
+		for _, line := range newLines[prefix : len(newLines)-suffix] {
+			diff = append(diff, "  +"+line)
+		}
+		if len(diff) > maxFixPreviewLines {
+			hidden := len(diff) - maxFixPreviewLines
+			diff = append(diff[:maxFixPreviewLines], fmt.Sprintf("  ... %d more line(s)", hidden))
+		}
+		out.WriteString(strings.Join(diff, "\n"))
+		out.WriteString("\n")
+	}
+	return out.String()
+}
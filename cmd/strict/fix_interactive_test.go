@@ -0,0 +1,83 @@
+// fix_interactive_test.go — Tests for interactive fix confirmation.
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/fix"
+)
+
+func interactiveTestOps() []fix.Operation {
+	return []fix.Operation{
+		{RuleID: "CONV-test-file-location", Kind: "rename", Path: "a_test.go", NewPath: "tests/a_test.go", Description: "Move a_test.go"},
+		{RuleID: "CONV-file-header", Kind: "edit", Path: "b.go", Description: "Add header to b.go"},
+		{RuleID: "CONV-file-header", Kind: "edit", Path: "c.go", Description: "Add header to c.go"},
+	}
+}
+
+func TestRunInteractiveFixAppliesAcceptedOperations(t *testing.T) {
+	t.Parallel()
+
+	var applied []string
+	apply := func(op fix.Operation) error {
+		applied = append(applied, op.Path)
+		return nil
+	}
+	var out bytes.Buffer
+	got, err := runInteractiveFix(interactiveTestOps(), strings.NewReader("maybe\nn\ny\nq\n"), &out, apply)
+	if err != nil {
+		t.Fatalf("runInteractiveFix() error = %v", err)
+	}
+	// Edits come first (b.go, c.go), then the rename.
+	if len(got) != 1 || got[0].Path != "c.go" || strings.Join(applied, ",") != "c.go" {
+		t.Fatalf("applied = %v (returned %+v), want only c.go", applied, got)
+	}
+	if !strings.Contains(out.String(), "[1/3] [CONV-file-header] Add header to b.go") {
+		t.Fatalf("prompt should describe each operation, got:\n%s", out.String())
+	}
+	if strings.Count(out.String(), "Apply this fix?") != 4 {
+		t.Fatalf("an unrecognized answer should re-prompt, got:\n%s", out.String())
+	}
+}
+
+func TestRunInteractiveFixAllAndEOF(t *testing.T) {
+	t.Parallel()
+
+	count := 0
+	apply := func(fix.Operation) error {
+		count++
+		return nil
+	}
+	got, err := runInteractiveFix(interactiveTestOps(), strings.NewReader("n\nall\n"), &bytes.Buffer{}, apply)
+	if err != nil || len(got) != 2 || count != 2 {
+		t.Fatalf("all should apply the remaining operations: got=%d count=%d err=%v", len(got), count, err)
+	}
+
+	count = 0
+	got, err = runInteractiveFix(interactiveTestOps(), strings.NewReader("y"), &bytes.Buffer{}, apply)
+	if err != nil || len(got) != 1 || count != 1 {
+		t.Fatalf("end of input should stop after the answered operation: got=%d count=%d err=%v", len(got), count, err)
+	}
+}
+
+func TestFormatFixPreviewShowsChangedLines(t *testing.T) {
+	t.Parallel()
+
+	target := filepath.Join(t.TempDir(), "a.go")
+	if err := os.WriteFile(target, []byte("package a\n\nfunc A() {}\n"), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+	preview := formatFixPreview(fix.Operation{Kind: "edit", Path: target, Content: []byte("// a.go — A.\npackage a\n\nfunc A() {}\n")})
+	if !strings.Contains(preview, "@@ line 1 @@") || !strings.Contains(preview, "  +// a.go — A.") || strings.Contains(preview, "-package a") {
+		t.Fatalf("unexpected preview:\n%s", preview)
+	}
+
+	rename := formatFixPreview(fix.Operation{Kind: "rename", Path: "a_test.go", NewPath: "tests/a_test.go"})
+	if rename != "  rename a_test.go -> tests/a_test.go\n" {
+		t.Fatalf("rename preview = %q", rename)
+	}
+}
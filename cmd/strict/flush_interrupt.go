@@ -0,0 +1,121 @@
+// flush_interrupt.go — --flush-on-interrupt: stop on Ctrl-C and report the results collected so far.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+)
+
+// interruptedExitCode is the conventional 128+SIGINT status, distinct from the 1 for
+// errors found and the 2 for usage errors.
+const interruptedExitCode = 130
+
+// notifyInterrupt returns a channel closed on the first SIGINT, after which default
+// handling is restored so a second Ctrl-C aborts at once. stop releases the handler.
+func notifyInterrupt(w io.Writer) (interrupted <-chan struct{}, stop func()) {
+	signals := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		if _, ok := <-signals; ok {
+			signal.Stop(signals)
+			fmt.Fprintln(w, "Interrupted: finishing files in progress, then writing partial results (Ctrl-C again to abort).")
+			close(done)
+		}
+	}()
+	return done, func() {
+		signal.Stop(signals)
+		close(signals)
+	}
+}
+
+// runLintRulesUntilInterrupt is runLintRules that stops handing out files once
+// interrupt is closed. Files already being checked finish. It returns the violations,
+// the files that were checked (in input order), and whether the run was interrupted.
+func runLintRulesUntilInterrupt(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int, concurrency int, ruleConcurrency int, cfg *config.Config, interrupt <-chan struct{}) ([]model.Violation, []*model.UnifiedFileModel, bool) {
+	if concurrency <= 1 || len(files) <= 1 || maxViolations > 0 {
+		violations := make([]model.Violation, 0)
+		for i, file := range files {
+			select {
+			case <-interrupt:
+				return violations, files[:i], true
+			default:
+			}
+			remaining := 0
+			if maxViolations > 0 {
+				remaining = maxViolations - len(violations)
+				if remaining <= 0 {
+					break
+				}
+			}
+			violations = append(violations, runLintRulesForFile(file, rules, ctx, remaining, ruleConcurrency, cfg)...)
+		}
+		return violations, files, false
+	}
+
+	workerCount := concurrency
+	if workerCount > len(files) {
+		workerCount = len(files)
+	}
+	type fileResult struct {
+		index      int
+		violations []model.Violation
+	}
+	jobs := make(chan int)
+	results := make(chan fileResult, len(files))
+
+	var wg sync.WaitGroup
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				results <- fileResult{index: index, violations: runLintRulesForFile(files[index], rules, ctx, 0, ruleConcurrency, cfg)}
+			}
+		}()
+	}
+
+	interrupted := false
+feed:
+	for i := range files {
+		// Check first: select picks at random when a worker is also ready.
+		select {
+		case <-interrupt:
+			interrupted = true
+			break feed
+		default:
+		}
+		select {
+		case jobs <- i:
+		case <-interrupt:
+			interrupted = true
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	close(results)
+
+	checked := make([]bool, len(files))
+	violations := make([]model.Violation, 0)
+	for result := range results {
+		checked[result.index] = true
+		violations = append(violations, result.violations...)
+	}
+	if !interrupted {
+		return violations, files, false
+	}
+	checkedFiles := make([]*model.UnifiedFileModel, 0, len(files))
+	for i, file := range files {
+		if checked[i] {
+			checkedFiles = append(checkedFiles, file)
+		}
+	}
+	return violations, checkedFiles, true
+}
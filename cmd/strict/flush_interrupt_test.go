@@ -0,0 +1,78 @@
+// flush_interrupt_test.go — Tests for stopping a lint run early on interrupt.
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// interruptingRule closes interrupt after checking its first file.
+type interruptingRule struct {
+	fakeRule
+	once      *sync.Once
+	interrupt chan struct{}
+}
+
+func (r interruptingRule) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, _ model.RuleConfig) []model.Violation {
+	r.once.Do(func() { close(r.interrupt) })
+	return []model.Violation{{RuleID: r.id, Severity: "error", FilePath: file.Path, StartLine: 1, Message: "found"}}
+}
+
+func interruptTestFiles() []*model.UnifiedFileModel {
+	return []*model.UnifiedFileModel{{Path: "a.go"}, {Path: "b.go"}, {Path: "c.go"}}
+}
+
+func TestRunLintRulesUntilInterruptCompletesWithoutSignal(t *testing.T) {
+	t.Parallel()
+
+	rules := []model.Rule{fakeRule{id: "RULE-a", violations: []model.Violation{{Severity: "error", StartLine: 1, Message: "a"}}}}
+	for _, concurrency := range []int{1, 4} {
+		violations, checked, interrupted := runLintRulesUntilInterrupt(interruptTestFiles(), rules, &model.ProjectContext{}, 0, concurrency, 1, nil, make(chan struct{}))
+		if interrupted || len(checked) != 3 || len(violations) != 3 {
+			t.Fatalf("concurrency=%d: interrupted=%v checked=%d violations=%d", concurrency, interrupted, len(checked), len(violations))
+		}
+	}
+}
+
+func TestRunLintRulesUntilInterruptStopsAfterFilesInProgress(t *testing.T) {
+	t.Parallel()
+
+	interrupt := make(chan struct{})
+	rules := []model.Rule{interruptingRule{fakeRule: fakeRule{id: "RULE-a"}, once: &sync.Once{}, interrupt: interrupt}}
+	violations, checked, interrupted := runLintRulesUntilInterrupt(interruptTestFiles(), rules, &model.ProjectContext{}, 0, 1, 1, nil, interrupt)
+	if !interrupted {
+		t.Fatalf("run should report the interrupt")
+	}
+	if len(checked) != 1 || checked[0].Path != "a.go" || len(violations) != 1 {
+		t.Fatalf("checked=%v violations=%+v, want only a.go", checked, violations)
+	}
+
+	closed := make(chan struct{})
+	close(closed)
+	violations, checked, interrupted = runLintRulesUntilInterrupt(interruptTestFiles(), rules, &model.ProjectContext{}, 0, 4, 1, nil, closed)
+	if !interrupted || len(checked) != 0 || len(violations) != 0 {
+		t.Fatalf("parallel run interrupted=%v checked=%d violations=%d", interrupted, len(checked), len(violations))
+	}
+}
+
+func TestFormatSummaryLineMarksPartialRuns(t *testing.T) {
+	t.Parallel()
+
+	summary := map[string]interface{}{
+		"filesChecked": 2, "filesWithIssues": 1, "totalViolations": 1, "errors": 1, "warnings": 0, "elapsedMs": int64(5),
+	}
+	if got := formatSummaryLine(summary); strings.Contains(got, "partial") {
+		t.Fatalf("complete run summary = %q", got)
+	}
+	summary["partial"] = true
+	summary["filesSkipped"] = 3
+	if got := formatSummaryLine(summary); !strings.HasSuffix(got, "elapsedMs=5 partial=true skipped=3\n") {
+		t.Fatalf("partial summary = %q", got)
+	}
+	if !reporterSummary(summary).Partial {
+		t.Fatalf("reporterSummary should carry partial")
+	}
+}
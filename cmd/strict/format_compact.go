@@ -0,0 +1,27 @@
+// format_compact.go — Compact text output that groups findings under each file path.
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// writeCompactViolations prints each file path once, followed by its indented findings.
+// Violations must already be sorted by file path.
+func writeCompactViolations(out *strings.Builder, violations []model.Violation, colorEnabled bool) {
+	currentPath := ""
+	for i, v := range violations {
+		if i == 0 || v.FilePath != currentPath {
+			currentPath = v.FilePath
+			fmt.Fprintln(out, currentPath)
+		}
+		location := fmt.Sprintf("%d", v.StartLine)
+		if v.StartColumn > 0 {
+			location = fmt.Sprintf("%d:%d", v.StartLine, v.StartColumn)
+		}
+		severityLabel := colorizeSeverityLabel(v.Severity, strings.ToUpper(v.Severity), colorEnabled)
+		fmt.Fprintf(out, "  %s %s %s %s\n", location, severityLabel, v.RuleID, v.Message)
+	}
+}
@@ -0,0 +1,41 @@
+// format_compact_test.go — Tests for compact text output.
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestWriteCompactViolationsGroupsByFile(t *testing.T) {
+	t.Parallel()
+
+	violations := []model.Violation{
+		{FilePath: "a.go", StartLine: 3, StartColumn: 5, Severity: "error", RuleID: "RULE-A", Message: "first"},
+		{FilePath: "a.go", StartLine: 9, Severity: "warn", RuleID: "RULE-B", Message: "second"},
+		{FilePath: "b.go", StartLine: 1, Severity: "error", RuleID: "RULE-A", Message: "third"},
+	}
+
+	var out strings.Builder
+	writeCompactViolations(&out, violations, false)
+
+	want := "a.go\n" +
+		"  3:5 ERROR RULE-A first\n" +
+		"  9 WARN RULE-B second\n" +
+		"b.go\n" +
+		"  1 ERROR RULE-A third\n"
+	if out.String() != want {
+		t.Fatalf("compact output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestWriteCompactViolationsHonorsColor(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+	writeCompactViolations(&out, []model.Violation{{FilePath: "a.go", StartLine: 1, Severity: "error", RuleID: "RULE-A", Message: "m"}}, true)
+	if !strings.Contains(out.String(), "\x1b[31mERROR\x1b[0m") {
+		t.Fatalf("compact output should colorize severity, got %q", out.String())
+	}
+}
@@ -0,0 +1,126 @@
+// golden.go — `--golden`: lock a run's violations exactly and fail on any difference.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// goldenFormatVersion is the golden file version this build reads and writes.
+const goldenFormatVersion = "1"
+
+// goldenFile is a committed, exact record of a run's violations. Unlike a baseline it
+// has no timestamp, so regenerating an unchanged run leaves the file byte-identical.
+type goldenFile struct {
+	Version    string        `json:"version"`
+	Violations []goldenEntry `json:"violations"`
+}
+
+type goldenEntry struct {
+	RuleID    string `json:"ruleId"`
+	FilePath  string `json:"filePath"`
+	StartLine int    `json:"startLine"`
+	Severity  string `json:"severity"`
+	Message   string `json:"message"`
+}
+
+func (e goldenEntry) key() string {
+	return fmt.Sprintf("%s|%s|%d|%s|%s", e.RuleID, e.FilePath, e.StartLine, e.Severity, e.Message)
+}
+
+func (e goldenEntry) String() string {
+	return fmt.Sprintf("%s:%d: %s %s: %s", e.FilePath, e.StartLine, strings.ToUpper(e.Severity), e.RuleID, e.Message)
+}
+
+// goldenEntries converts violations to sorted golden entries.
+func goldenEntries(violations []model.Violation) []goldenEntry {
+	entries := make([]goldenEntry, 0, len(violations))
+	for _, v := range violations {
+		entries = append(entries, goldenEntry{
+			RuleID:    strings.TrimSpace(v.RuleID),
+			FilePath:  filepath.ToSlash(v.FilePath),
+			StartLine: v.StartLine,
+			Severity:  strings.ToLower(strings.TrimSpace(v.Severity)),
+			Message:   strings.TrimSpace(v.Message),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key() < entries[j].key() })
+	return entries
+}
+
+func readGoldenFile(pathValue string) ([]goldenEntry, error) {
+	data, err := os.ReadFile(pathValue)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("golden %s does not exist (run with --golden-update to create it)", pathValue)
+		}
+		return nil, fmt.Errorf("read golden %s: %w", pathValue, err)
+	}
+	var doc goldenFile
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse golden %s: %w", pathValue, err)
+	}
+	if doc.Version != goldenFormatVersion {
+		return nil, fmt.Errorf("golden %s has version %q, want %q (regenerate it with --golden-update)", pathValue, doc.Version, goldenFormatVersion)
+	}
+	return doc.Violations, nil
+}
+
+func writeGoldenFile(pathValue string, entries []goldenEntry) error {
+	encoded, err := json.MarshalIndent(goldenFile{Version: goldenFormatVersion, Violations: entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal golden %s: %w", pathValue, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(pathValue), 0o755); err != nil {
+		return fmt.Errorf("create golden directory for %s: %w", pathValue, err)
+	}
+	if err := os.WriteFile(pathValue, append(encoded, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write golden %s: %w", pathValue, err)
+	}
+	return nil
+}
+
+// diffGolden compares current with golden as multisets: added are findings the golden
+// does not have, removed are golden findings the run no longer produces. Both are sorted.
+func diffGolden(golden []goldenEntry, current []goldenEntry) ([]goldenEntry, []goldenEntry) {
+	remaining := map[string]int{}
+	for _, e := range golden {
+		remaining[e.key()]++
+	}
+	added := make([]goldenEntry, 0)
+	for _, e := range current {
+		if remaining[e.key()] > 0 {
+			remaining[e.key()]--
+			continue
+		}
+		added = append(added, e)
+	}
+	removed := make([]goldenEntry, 0)
+	for _, e := range golden {
+		if remaining[e.key()] > 0 {
+			remaining[e.key()]--
+			removed = append(removed, e)
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].key() < removed[j].key() })
+	return added, removed
+}
+
+// writeGoldenDiff prints the comparison with the golden: a count line, then `+` for each
+// added and `-` for each removed finding.
+func writeGoldenDiff(w io.Writer, pathValue string, added []goldenEntry, removed []goldenEntry) {
+	fmt.Fprintf(w, "Golden: added=%d removed=%d (golden=%s)\n", len(added), len(removed), filepath.ToSlash(pathValue))
+	for _, e := range added {
+		fmt.Fprintf(w, "+ %s\n", e)
+	}
+	for _, e := range removed {
+		fmt.Fprintf(w, "- %s\n", e)
+	}
+}
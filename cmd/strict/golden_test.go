@@ -0,0 +1,63 @@
+// golden_test.go — Tests for --golden comparison and regeneration.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestGoldenRoundTripAndDiff(t *testing.T) {
+	t.Parallel()
+
+	pathValue := filepath.Join(t.TempDir(), "lint.golden.json")
+	if _, err := readGoldenFile(pathValue); err == nil || !strings.Contains(err.Error(), "--golden-update") {
+		t.Fatalf("missing golden should point at --golden-update, got %v", err)
+	}
+
+	locked := []model.Violation{
+		{RuleID: "CONV-file-header", FilePath: "src/a.go", StartLine: 1, Severity: "error", Message: "missing header"},
+		{RuleID: "TQ-no-shallow-assertions", FilePath: "src/a_test.go", StartLine: 9, Severity: "warn", Message: "shallow"},
+		{RuleID: "TQ-no-shallow-assertions", FilePath: "src/a_test.go", StartLine: 9, Severity: "warn", Message: "shallow"},
+	}
+	if err := writeGoldenFile(pathValue, goldenEntries(locked)); err != nil {
+		t.Fatal(err)
+	}
+	first, err := os.ReadFile(pathValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeGoldenFile(pathValue, goldenEntries(locked)); err != nil {
+		t.Fatal(err)
+	}
+	if second, _ := os.ReadFile(pathValue); string(second) != string(first) {
+		t.Fatal("regenerating an unchanged golden should not change the file")
+	}
+	golden, err := readGoldenFile(pathValue)
+	if err != nil || len(golden) != 3 {
+		t.Fatalf("golden = %v, %v", golden, err)
+	}
+
+	if added, removed := diffGolden(golden, goldenEntries(locked)); len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("identical runs should not differ: +%v -%v", added, removed)
+	}
+
+	current := []model.Violation{locked[0], locked[1], {RuleID: "CONV-file-header", FilePath: "src/b.go", StartLine: 1, Severity: "error", Message: "missing header"}}
+	current[0].Severity = "warn"
+	added, removed := diffGolden(golden, goldenEntries(current))
+	if len(added) != 2 || len(removed) != 2 {
+		t.Fatalf("added = %v, removed = %v", added, removed)
+	}
+
+	var out strings.Builder
+	writeGoldenDiff(&out, pathValue, added, removed)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], "Golden: added=2 removed=2") ||
+		lines[1] != "+ src/a.go:1: WARN CONV-file-header: missing header" ||
+		lines[4] != "- src/a_test.go:9: WARN TQ-no-shallow-assertions: shallow" {
+		t.Fatalf("diff output:\n%s", out.String())
+	}
+}
@@ -0,0 +1,84 @@
+// graph.go — `strict graph`: emit the package import graph as Graphviz DOT.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/rules/arch"
+)
+
+// writeImportGraphDOT renders g as a DOT digraph. Packages on a cycle and the edges
+// between them are drawn in red.
+func writeImportGraphDOT(w io.Writer, g arch.ImportGraph) error {
+	cycleOf := map[string]int{}
+	for i, cycle := range g.Cycles() {
+		for _, node := range cycle {
+			cycleOf[node] = i + 1
+		}
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph imports {")
+	fmt.Fprintln(out, "  rankdir=LR;")
+	fmt.Fprintln(out, "  node [shape=box];")
+	for _, node := range g.Nodes {
+		if cycleOf[node] > 0 {
+			fmt.Fprintf(out, "  %s [color=red, fontcolor=red];\n", strconv.Quote(node))
+			continue
+		}
+		fmt.Fprintf(out, "  %s;\n", strconv.Quote(node))
+	}
+	for _, from := range g.Nodes {
+		for _, to := range g.Edges[from] {
+			if cycleOf[from] > 0 && cycleOf[from] == cycleOf[to] {
+				fmt.Fprintf(out, "  %s -> %s [color=red];\n", strconv.Quote(from), strconv.Quote(to))
+				continue
+			}
+			fmt.Fprintf(out, "  %s -> %s;\n", strconv.Quote(from), strconv.Quote(to))
+		}
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+func runGraph(args []string) {
+	fs := flag.NewFlagSet("graph", flag.ExitOnError)
+	format := fs.String("format", "dot", "Output format: dot")
+	fs.Usage = func() {
+		fmt.Println("Usage: strict graph [--format dot] [paths...]")
+		fmt.Println()
+		fmt.Println("Print the package import graph of the given paths (default .), with cycles in red.")
+		fs.PrintDefaults()
+	}
+	parseFlagSetOrExit(fs, args)
+	if value := strings.ToLower(strings.TrimSpace(*format)); value != "dot" {
+		fmt.Fprintf(os.Stderr, "Error: unsupported graph format %q (supported: dot)\n", *format)
+		os.Exit(2)
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	filePaths, err := collectLintFilePaths(paths, lintDirSkips{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: collect files: %v\n", err)
+		os.Exit(1)
+	}
+	files, err := buildUnifiedFiles(filePaths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: parse files: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := writeImportGraphDOT(os.Stdout, arch.BuildImportGraph(files)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: write graph: %v\n", err)
+		os.Exit(1)
+	}
+}
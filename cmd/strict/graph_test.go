@@ -0,0 +1,43 @@
+// graph_test.go — Tests for DOT rendering of the import graph.
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stricture/stricture/internal/rules/arch"
+)
+
+func TestWriteImportGraphDOTHighlightsCycles(t *testing.T) {
+	t.Parallel()
+
+	g := arch.ImportGraph{
+		Nodes: []string{"api", "repo", "service", "util"},
+		Edges: map[string][]string{
+			"api":     {"service", "util"},
+			"service": {"repo"},
+			"repo":    {"api"},
+		},
+	}
+	var out bytes.Buffer
+	if err := writeImportGraphDOT(&out, g); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	want := `digraph imports {
+  rankdir=LR;
+  node [shape=box];
+  "api" [color=red, fontcolor=red];
+  "repo" [color=red, fontcolor=red];
+  "service" [color=red, fontcolor=red];
+  "util";
+  "api" -> "service" [color=red];
+  "api" -> "util";
+  "repo" -> "api" [color=red];
+  "service" -> "repo" [color=red];
+}
+`
+	if out.String() != want {
+		t.Fatalf("dot output =\n%s\nwant\n%s", out.String(), want)
+	}
+}
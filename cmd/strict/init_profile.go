@@ -0,0 +1,60 @@
+// init_profile.go — `strict init --profile`: generate starter configs from the rule registry.
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultInitProfile is the profile `strict init` writes when --profile is omitted.
+const defaultInitProfile = "recommended"
+
+// initProfiles lists the accepted --profile values in the order shown in help text.
+var initProfiles = []string{"minimal", "recommended", "strict"}
+
+// initProfileSeverity picks the severity a profile assigns to rule.
+//
+//	recommended  each rule's default severity
+//	strict       every rule is an error
+//	minimal      every rule warns, except contract rules, whose drift breaks callers at runtime
+func initProfileSeverity(profile string, rule model.Rule) string {
+	switch profile {
+	case "strict":
+		return "error"
+	case "minimal":
+		if rule.Category() == "ctr" {
+			return rule.DefaultSeverity()
+		}
+		return "warn"
+	default:
+		return rule.DefaultSeverity()
+	}
+}
+
+// initConfigForProfile renders a .stricture.yml enabling every registered rule at the
+// severity profile assigns, in registry order.
+func initConfigForProfile(registry *model.RuleRegistry, profile string) (string, error) {
+	profile = strings.ToLower(strings.TrimSpace(profile))
+	if profile == "" {
+		profile = defaultInitProfile
+	}
+	known := false
+	for _, name := range initProfiles {
+		if name == profile {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return "", fmt.Errorf("unknown profile %q (valid: %s)", profile, strings.Join(initProfiles, ", "))
+	}
+
+	var b strings.Builder
+	b.WriteString("version: \"1.0\"\n\nrules:\n")
+	for _, rule := range registry.All() {
+		fmt.Fprintf(&b, "  %s: %s\n", rule.ID(), initProfileSeverity(profile, rule))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,89 @@
+// init_profile_test.go — Tests for registry-generated init profiles.
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/config"
+)
+
+func initProfileRules(t *testing.T, profile string) map[string]string {
+	t.Helper()
+	content, err := initConfigForProfile(buildRegistry(), profile)
+	if err != nil {
+		t.Fatalf("initConfigForProfile(%q): %v", profile, err)
+	}
+	cfg, err := config.LoadFromBytes([]byte(content))
+	if err != nil {
+		t.Fatalf("profile %q does not parse: %v\n%s", profile, err, content)
+	}
+	rules := make(map[string]string, len(cfg.Rules))
+	for id, rule := range cfg.Rules {
+		rules[id] = rule.Severity
+	}
+	return rules
+}
+
+func TestInitProfilesCoverEveryRegisteredRule(t *testing.T) {
+	t.Parallel()
+
+	registry := buildRegistry()
+	for _, profile := range initProfiles {
+		rules := initProfileRules(t, profile)
+		if len(rules) != len(registry.All()) {
+			t.Fatalf("profile %q has %d rules, registry has %d", profile, len(rules), len(registry.All()))
+		}
+		for _, rule := range registry.All() {
+			if _, ok := rules[rule.ID()]; !ok {
+				t.Fatalf("profile %q is missing %s", profile, rule.ID())
+			}
+		}
+	}
+}
+
+func TestInitProfileSeverities(t *testing.T) {
+	t.Parallel()
+
+	recommended := initProfileRules(t, "recommended")
+	strict := initProfileRules(t, "strict")
+	minimal := initProfileRules(t, "minimal")
+	for _, rule := range buildRegistry().All() {
+		id := rule.ID()
+		if recommended[id] != rule.DefaultSeverity() {
+			t.Fatalf("recommended %s = %q, want default %q", id, recommended[id], rule.DefaultSeverity())
+		}
+		if strict[id] != "error" {
+			t.Fatalf("strict %s = %q, want error", id, strict[id])
+		}
+		want := "warn"
+		if rule.Category() == "ctr" {
+			want = rule.DefaultSeverity()
+		}
+		if minimal[id] != want {
+			t.Fatalf("minimal %s = %q, want %q", id, minimal[id], want)
+		}
+	}
+}
+
+func TestInitConfigForProfileDefaultsToRecommended(t *testing.T) {
+	t.Parallel()
+
+	empty, err := initConfigForProfile(buildRegistry(), "")
+	if err != nil {
+		t.Fatalf("empty profile: %v", err)
+	}
+	recommended, _ := initConfigForProfile(buildRegistry(), "Recommended")
+	if empty != recommended {
+		t.Fatalf("empty profile should render recommended")
+	}
+}
+
+func TestInitConfigForProfileRejectsUnknownProfile(t *testing.T) {
+	t.Parallel()
+
+	_, err := initConfigForProfile(buildRegistry(), "paranoid")
+	if err == nil || !strings.Contains(err.Error(), "minimal, recommended, strict") {
+		t.Fatalf("err = %v, want unknown profile listing valid values", err)
+	}
+}
@@ -0,0 +1,147 @@
+// install_hook.go — `strict install-hook`: install or remove a git pre-commit hook.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// preCommitHookMarker identifies hooks written by install-hook, so reinstalling and
+// uninstalling never touch a hook someone else wrote.
+const preCommitHookMarker = "# Installed by strict install-hook."
+
+// preCommitHookBackupSuffix names the copy of a pre-existing hook set aside on install.
+const preCommitHookBackupSuffix = ".stricture-backup"
+
+// preCommitHookScript lints the staged files and stops at the first violation.
+const preCommitHookScript = "#!/bin/sh\n" +
+	preCommitHookMarker + " Remove with: strict install-hook --uninstall\n" +
+	"exec strict lint --staged --max-violations 1\n"
+
+// installPreCommitHook writes the pre-commit hook into hooksDir. A hook that was not
+// written by install-hook is renamed to pre-commit.stricture-backup first; backup is
+// that path, or "" when nothing was moved.
+func installPreCommitHook(hooksDir string) (hookPath string, backup string, err error) {
+	hookPath = filepath.Join(hooksDir, "pre-commit")
+	existing, err := os.ReadFile(hookPath)
+	switch {
+	case err == nil && !bytes.Contains(existing, []byte(preCommitHookMarker)):
+		backup = hookPath + preCommitHookBackupSuffix
+		if _, statErr := os.Stat(backup); statErr == nil {
+			return hookPath, "", fmt.Errorf("%s already has a backup at %s; move one of them aside first", hookPath, backup)
+		}
+		if err := os.Rename(hookPath, backup); err != nil {
+			return hookPath, "", fmt.Errorf("back up existing hook: %w", err)
+		}
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		return hookPath, "", fmt.Errorf("read existing hook: %w", err)
+	}
+
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return hookPath, backup, fmt.Errorf("create hooks directory: %w", err)
+	}
+	if err := os.WriteFile(hookPath, []byte(preCommitHookScript), 0o755); err != nil {
+		return hookPath, backup, fmt.Errorf("write hook: %w", err)
+	}
+	// WriteFile keeps the mode of a file that already existed.
+	if err := os.Chmod(hookPath, 0o755); err != nil {
+		return hookPath, backup, fmt.Errorf("make hook executable: %w", err)
+	}
+	return hookPath, backup, nil
+}
+
+// uninstallPreCommitHook removes a hook written by install-hook and restores the backup
+// taken at install time, if any. restored reports whether a backup was put back.
+func uninstallPreCommitHook(hooksDir string) (hookPath string, restored bool, err error) {
+	hookPath = filepath.Join(hooksDir, "pre-commit")
+	existing, err := os.ReadFile(hookPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return hookPath, false, fmt.Errorf("no pre-commit hook installed at %s", hookPath)
+	}
+	if err != nil {
+		return hookPath, false, fmt.Errorf("read hook: %w", err)
+	}
+	if !bytes.Contains(existing, []byte(preCommitHookMarker)) {
+		return hookPath, false, fmt.Errorf("%s was not installed by strict install-hook; leaving it in place", hookPath)
+	}
+	if err := os.Remove(hookPath); err != nil {
+		return hookPath, false, fmt.Errorf("remove hook: %w", err)
+	}
+
+	backup := hookPath + preCommitHookBackupSuffix
+	if _, err := os.Stat(backup); err != nil {
+		return hookPath, false, nil
+	}
+	if err := os.Rename(backup, hookPath); err != nil {
+		return hookPath, false, fmt.Errorf("restore %s: %w", backup, err)
+	}
+	return hookPath, true, nil
+}
+
+// gitHooksDir returns the repository's hooks directory, honoring core.hooksPath and
+// linked worktrees.
+func gitHooksDir() (string, error) {
+	if _, err := gitOutput("rev-parse", "--show-toplevel"); err != nil {
+		return "", fmt.Errorf("install-hook must run inside a git repository: %w", err)
+	}
+	raw, err := gitOutput("rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	dir := strings.TrimSpace(raw)
+	if dir == "" {
+		return "", fmt.Errorf("unable to resolve git hooks directory")
+	}
+	return filepath.Abs(dir)
+}
+
+func runInstallHook(args []string) {
+	fs := flag.NewFlagSet("install-hook", flag.ExitOnError)
+	uninstall := fs.Bool("uninstall", false, "Remove the hook installed by install-hook and restore any backed-up hook")
+	fs.Usage = func() {
+		fmt.Println("Usage: strict install-hook [--uninstall]")
+		fmt.Println()
+		fmt.Println("Write .git/hooks/pre-commit to run 'strict lint --staged --max-violations 1'.")
+		fmt.Println("An existing hook is kept as pre-commit" + preCommitHookBackupSuffix + ".")
+		fs.PrintDefaults()
+	}
+	parseFlagSetOrExit(fs, args)
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Error: install-hook takes no arguments, got %q\n", fs.Arg(0))
+		os.Exit(2)
+	}
+
+	hooksDir, err := gitHooksDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *uninstall {
+		hookPath, restored, err := uninstallPreCommitHook(hooksDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Removed %s\n", hookPath)
+		if restored {
+			fmt.Printf("Restored previous hook from %s%s\n", hookPath, preCommitHookBackupSuffix)
+		}
+		return
+	}
+
+	hookPath, backup, err := installPreCommitHook(hooksDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if backup != "" {
+		fmt.Printf("Backed up existing hook to %s\n", backup)
+	}
+	fmt.Printf("Installed %s\n", hookPath)
+}
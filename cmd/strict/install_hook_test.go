@@ -0,0 +1,89 @@
+// install_hook_test.go — Tests for installing and removing the pre-commit hook.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInstallPreCommitHookWritesExecutableScript(t *testing.T) {
+	t.Parallel()
+
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	hookPath, backup, err := installPreCommitHook(hooksDir)
+	if err != nil {
+		t.Fatalf("install: %v", err)
+	}
+	if backup != "" {
+		t.Fatalf("backup = %q, want none for a fresh install", backup)
+	}
+	info, err := os.Stat(hookPath)
+	if err != nil {
+		t.Fatalf("stat hook: %v", err)
+	}
+	if info.Mode().Perm()&0o111 == 0 {
+		t.Fatalf("hook mode = %v, want executable", info.Mode())
+	}
+	content, _ := os.ReadFile(hookPath)
+	if !strings.Contains(string(content), "strict lint --staged --max-violations 1") {
+		t.Fatalf("hook script = %q", content)
+	}
+
+	// Reinstalling over our own hook does not take a backup.
+	if _, backup, err := installPreCommitHook(hooksDir); err != nil || backup != "" {
+		t.Fatalf("reinstall backup=%q err=%v", backup, err)
+	}
+}
+
+func TestInstallPreCommitHookBacksUpAndUninstallRestores(t *testing.T) {
+	t.Parallel()
+
+	hooksDir := t.TempDir()
+	original := "#!/bin/sh\nmake check\n"
+	if err := os.WriteFile(filepath.Join(hooksDir, "pre-commit"), []byte(original), 0o755); err != nil {
+		t.Fatalf("write existing hook: %v", err)
+	}
+
+	hookPath, backup, err := installPreCommitHook(hooksDir)
+	if err != nil {
+		t.Fatalf("install: %v", err)
+	}
+	if backup != hookPath+preCommitHookBackupSuffix {
+		t.Fatalf("backup = %q", backup)
+	}
+	if saved, _ := os.ReadFile(backup); string(saved) != original {
+		t.Fatalf("backup content = %q, want %q", saved, original)
+	}
+
+	if _, restored, err := uninstallPreCommitHook(hooksDir); err != nil || !restored {
+		t.Fatalf("uninstall restored=%v err=%v", restored, err)
+	}
+	if content, _ := os.ReadFile(hookPath); string(content) != original {
+		t.Fatalf("restored hook = %q, want %q", content, original)
+	}
+	if _, err := os.Stat(backup); !os.IsNotExist(err) {
+		t.Fatalf("backup should be gone after restore, stat err=%v", err)
+	}
+}
+
+func TestUninstallPreCommitHookLeavesForeignHook(t *testing.T) {
+	t.Parallel()
+
+	hooksDir := t.TempDir()
+	if _, _, err := uninstallPreCommitHook(hooksDir); err == nil || !strings.Contains(err.Error(), "no pre-commit hook") {
+		t.Fatalf("uninstall without hook err = %v", err)
+	}
+
+	hookPath := filepath.Join(hooksDir, "pre-commit")
+	if err := os.WriteFile(hookPath, []byte("#!/bin/sh\nexit 0\n"), 0o755); err != nil {
+		t.Fatalf("write foreign hook: %v", err)
+	}
+	if _, _, err := uninstallPreCommitHook(hooksDir); err == nil || !strings.Contains(err.Error(), "not installed by strict install-hook") {
+		t.Fatalf("uninstall of foreign hook err = %v", err)
+	}
+	if _, err := os.Stat(hookPath); err != nil {
+		t.Fatalf("foreign hook should remain: %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"io"
 	"io/fs"
 	"os"
 	"os/exec"
@@ -32,6 +33,7 @@ import (
 	manifestpkg "github.com/stricture/stricture/internal/manifest"
 	"github.com/stricture/stricture/internal/model"
 	"github.com/stricture/stricture/internal/plugins"
+	"github.com/stricture/stricture/internal/reporter"
 	"github.com/stricture/stricture/internal/rules/arch"
 	"github.com/stricture/stricture/internal/rules/conv"
 	"github.com/stricture/stricture/internal/rules/ctr"
@@ -64,15 +66,27 @@ func main() {
 	case "lineage-escalate":
 		runLineageEscalate(os.Args[2:])
 	case "list-rules":
-		runListRules()
+		runListRules(os.Args[2:])
 	case "explain":
 		runExplain(os.Args[2:])
+	case "catalog":
+		runCatalog(os.Args[2:])
 	case "validate-config":
 		runValidateConfig(os.Args[2:])
+	case "doctor":
+		runDoctor(os.Args[2:])
+	case "install-hook":
+		runInstallHook(os.Args[2:])
+	case "graph":
+		runGraph(os.Args[2:])
 	case "lint":
 		runLint(os.Args[2:])
 	case "audit":
 		runAudit(os.Args[2:])
+	case "baseline-report":
+		runBaselineReport(os.Args[2:])
+	case "baseline-merge":
+		runBaselineMerge(os.Args[2:])
 	case "trace":
 		runTrace(os.Args[2:])
 	case "policy":
@@ -106,6 +120,8 @@ func printUsage() {
 	fmt.Println("  init              Create a default .stricture.yml")
 	fmt.Println("  inspect <file>    Parse a file and print its UnifiedFileModel as JSON")
 	fmt.Println("  audit             Run cross-service strictness audit checks")
+	fmt.Println("  baseline-report   Report active, resolved, and top suppressed rules in a baseline")
+	fmt.Println("  baseline-merge    Merge baselines from sharded runs into one baseline file")
 	fmt.Println("  trace <file>      Validate a trace artifact against basic constraints")
 	fmt.Println("  policy            Policy URL binding and compliance checks")
 	fmt.Println("  inspect-lineage   Parse strict-source annotations from a file")
@@ -114,7 +130,11 @@ func printUsage() {
 	fmt.Println("  lineage-escalate  Resolve emergency contacts upstream from a service")
 	fmt.Println("  list-rules        List all registered rules")
 	fmt.Println("  explain           Show details for a specific rule")
+	fmt.Println("  catalog           Print the rule catalog with examples as JSON")
 	fmt.Println("  validate-config   Check that a .stricture.yml file is valid")
+	fmt.Println("  doctor            Diagnose config, git, plugin, and cache setup problems")
+	fmt.Println("  install-hook      Install a git pre-commit hook that lints staged files")
+	fmt.Println("  graph             Print the package import graph as Graphviz DOT")
 	fmt.Println("  version           Print version and exit")
 	fmt.Println("  help              Print this help message")
 	fmt.Println()
@@ -123,7 +143,7 @@ func printUsage() {
 
 func printUnknownCommand(command string) {
 	fmt.Fprintf(os.Stderr, "Error: unknown command %q\n", command)
-	fmt.Fprintln(os.Stderr, "Valid commands: lint, fix, init, inspect, audit, trace, policy, inspect-lineage, lineage-export, lineage-diff, lineage-escalate, list-rules, explain, validate-config, version, help")
+	fmt.Fprintln(os.Stderr, "Valid commands: lint, fix, init, inspect, audit, baseline-report, baseline-merge, trace, policy, inspect-lineage, lineage-export, lineage-diff, lineage-escalate, list-rules, explain, catalog, validate-config, doctor, install-hook, graph, version, help")
 }
 
 func looksLikePathArg(value string) bool {
@@ -181,30 +201,59 @@ func runLint(args []string) {
 	}
 
 	fs := flag.NewFlagSet("lint", flag.ExitOnError)
-	format := fs.String("format", "text", "Output format (text, json, sarif, junit)")
+	format := fs.String("format", "text", "Output format (text, compact, json, sarif, junit)")
 	configPath := fs.String("config", ".stricture.yml", "Path to configuration file")
 	noConfig := fs.Bool("no-config", false, "Ignore config file and use built-in defaults")
 	var ruleFilters repeatableFlag
 	fs.Var(&ruleFilters, "rule", "Run a single rule by ID (can be repeated)")
+	rulesFrom := fs.String("rules-from", "", "Run the rules listed one ID per line in this file (# comments allowed)")
+	var ruleOptionSpecs ruleOptionFlag
+	fs.Var(&ruleOptionSpecs, "rule-option", "Override a rule option as RULE-ID.key=value (can be repeated)")
 	category := fs.String("category", "", "Run all rules in a category")
+	noExperimental := fs.Bool("no-experimental", false, "Skip experimental rules unless requested with --rule")
 	extFilter := fs.String("ext", "", "Only lint files with this extension (example: .go or .ts)")
+	sinceFilter := fs.String("since", "", "Only lint files modified within this duration (example: 2h or 3d)")
+	maxFileSize := fs.Int64("max-file-size", defaultMaxFileSize, "Skip files larger than this many bytes (0 = unlimited)")
+	filesFrom := fs.String("files-from", "", "Lint exactly the files listed one per line in this file (- for stdin)")
+	var includeDirs repeatableFlag
+	fs.Var(&includeDirs, "include-dir", "Lint a directory that is skipped by default, such as tests (can be repeated)")
+	noDefaultSkips := fs.Bool("no-default-skips", false, "Do not skip tests, docs, bin, node_modules, or fixture directories (.git is still skipped)")
+	archivePath := fs.String("archive", "", "Lint files inside a .tar, .tar.gz/.tgz, or .zip archive without extracting it")
 	severityLevel := fs.String("severity", "", "Only report violations at this level or above (error, warn)")
 	quiet := fs.Bool("quiet", false, "Only show errors, not warnings")
 	forceColor := fs.Bool("color", false, "Force color output in text format")
 	forceNoColor := fs.Bool("no-color", false, "Disable color output in text format")
 	verbose := fs.Bool("verbose", false, "Show rule timing and debug info")
 	concurrency := fs.Int("concurrency", runtime.NumCPU(), "Max parallel file processing")
+	ruleConcurrency := fs.Int("concurrency-rules", 1, "Max rules run in parallel within a single file")
 	outputPath := fs.String("output", "", "Write report to file instead of stdout")
+	outputDir := fs.String("output-dir", "", "Write one report per linted file under this directory, mirroring the source tree")
+	reportTitle := fs.String("report-title", reporter.DefaultTitle, "Tool/suite name for JSON, SARIF, and JUnit reports")
 	maxViolations := fs.Int("max-violations", 0, "Stop after N violations (0 = unlimited)")
-	baselinePath := fs.String("baseline", "", "Path to baseline file (existing violations are suppressed; missing file bootstraps baseline)")
+	summaryOnly := fs.Bool("summary-only", false, "Print only the summary counts (text, compact, json)")
+	outputTemplate := fs.String("output-template", "", "Render each violation with this Go text/template instead of the text format")
+	summaryTemplate := fs.String("summary-template", "", "With --output-template, render the summary with this Go text/template")
+	var baselineValues repeatableFlag
+	fs.Var(&baselineValues, "baseline", "Path to baseline file (existing violations are suppressed; missing file bootstraps baseline); repeat as RULE=path or CATEGORY=path to scope one")
 	diffMode := fs.Bool("diff", false, "When used with --baseline, include added/resolved diff details against baseline")
+	baselinePrune := fs.Bool("baseline-prune", false, "When used with --baseline, remove resolved entries from the baseline file")
+	goldenPath := fs.String("golden", "", "Compare the violations with this golden JSON file and fail on any difference")
+	goldenUpdate := fs.Bool("golden-update", false, "With --golden, write the current violations to the golden file instead of comparing")
+	sarifIncludeSuppressed := fs.Bool("sarif-include-suppressed", false, "With --baseline and --format sarif, emit baselined findings as suppressed results instead of dropping them")
 	changedOnly := fs.Bool("changed", false, "Lint only changed files in git working tree/index")
 	stagedOnly := fs.Bool("staged", false, "Lint only staged files in git index")
 	fixApply := fs.Bool("fix", false, "Apply auto-fixes for fixable violations")
 	fixDryRun := fs.Bool("fix-dry-run", false, "Show what --fix would change without modifying files")
 	fixBackup := fs.Bool("fix-backup", false, "When used with --fix, create .bak files before modifying sources")
+	fixInteractive := fs.Bool("interactive", false, "When used with --fix, confirm each fix operation before applying it")
+	diffContext := fs.Int("diff-context", -1, fmt.Sprintf("Attach each violation's source lines plus N lines of context to JSON output as snippet (0-%d)", maxDiffContext))
+	failOnParseError := fs.Bool("fail-on-parse-error", false, "Abort the run when a file cannot be read instead of reporting PARSE-error")
+	failOnNoFiles := fs.Bool("fail-on-no-files", false, fmt.Sprintf("Exit %d when no files are left to lint after filters", noFilesExitCode))
+	flushOnInterrupt := fs.Bool("flush-on-interrupt", false, "On Ctrl-C, stop linting and report the violations found so far (exit 130)")
 	cacheEnabled := fs.Bool("cache", false, "Enable caching (default behavior)")
 	noCache := fs.Bool("no-cache", false, "Disable caching")
+	resultsCacheEnabled := fs.Bool("results-cache", false, "Reuse cached rule results for files whose content and rule set are unchanged")
+	cacheDir := fs.String("cache-dir", "", "Keep cached data in this shared directory, keyed by project (default: $"+cacheDirEnv+", else .stricture-cache)")
 	parseFlagSetOrExit(fs, flagArgs)
 
 	if *fixApply && *fixDryRun {
@@ -215,18 +264,107 @@ func runLint(args []string) {
 		fmt.Fprintln(os.Stderr, "Error: --fix-backup requires --fix")
 		os.Exit(2)
 	}
+	if *fixInteractive && !*fixApply {
+		fmt.Fprintln(os.Stderr, "Error: --interactive requires --fix")
+		os.Exit(2)
+	}
+	if *fixInteractive && !stdinIsTerminal() {
+		fmt.Fprintln(os.Stderr, "Warning: --interactive ignored because stdin is not a terminal; applying all fixes")
+		*fixInteractive = false
+	}
+	if *diffContext < -1 || *diffContext > maxDiffContext {
+		fmt.Fprintf(os.Stderr, "Error: --diff-context must be between 0 and %d, got %d\n", maxDiffContext, *diffContext)
+		os.Exit(2)
+	}
+	if *maxFileSize < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --max-file-size must be 0 (unlimited) or a positive byte count, got %d\n", *maxFileSize)
+		os.Exit(2)
+	}
 	if *changedOnly && *stagedOnly {
 		fmt.Fprintln(os.Stderr, "Error: --changed and --staged are mutually exclusive")
 		os.Exit(2)
 	}
-	if *diffMode && strings.TrimSpace(*baselinePath) == "" {
+	if err := validateRulesFromFlags(*rulesFrom, *category); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if *diffMode && len(baselineValues) == 0 {
 		fmt.Fprintln(os.Stderr, "Error: --diff requires --baseline")
 		os.Exit(2)
 	}
+	if *baselinePrune && len(baselineValues) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: --baseline-prune requires --baseline")
+		os.Exit(2)
+	}
+	goldenTarget := strings.TrimSpace(*goldenPath)
+	if *goldenUpdate && goldenTarget == "" {
+		fmt.Fprintln(os.Stderr, "Error: --golden-update requires --golden")
+		os.Exit(2)
+	}
+	var goldenExpected []goldenEntry
+	if goldenTarget != "" && !*goldenUpdate {
+		expected, err := readGoldenFile(goldenTarget)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+		goldenExpected = expected
+	}
+	if strings.TrimSpace(*outputPath) != "" && strings.TrimSpace(*outputDir) != "" {
+		fmt.Fprintln(os.Stderr, "Error: --output and --output-dir are mutually exclusive")
+		os.Exit(2)
+	}
+	title := strings.TrimSpace(*reportTitle)
+	if title == "" {
+		fmt.Fprintln(os.Stderr, "Error: --report-title must not be empty")
+		os.Exit(2)
+	}
+	archiveSource := strings.TrimSpace(*archivePath)
+	if archiveSource != "" {
+		if err := validateArchiveFlags(len(pathArgs) > 0, *fixApply || *fixDryRun, *changedOnly || *stagedOnly); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+	}
+
+	dirSkips, err := newLintDirSkips(includeDirs.Values(), *noDefaultSkips)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	filesFromSource := strings.TrimSpace(*filesFrom)
+	if filesFromSource != "" {
+		if err := validateFilesFromFlags(len(pathArgs) > 0, *changedOnly || *stagedOnly, archiveSource != ""); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+	}
 
-	validFormats := map[string]bool{"text": true, "json": true, "sarif": true, "junit": true}
+	validFormats := map[string]bool{"text": true, "compact": true, "json": true, "sarif": true, "junit": true}
 	if !validFormats[*format] {
-		fmt.Fprintf(os.Stderr, "Error: invalid format %q (valid: text, json, sarif, junit)\n", *format)
+		fmt.Fprintf(os.Stderr, "Error: invalid format %q (valid: text, compact, json, sarif, junit)\n", *format)
+		os.Exit(2)
+	}
+	if *summaryOnly && !summaryOnlyFormats[*format] {
+		fmt.Fprintf(os.Stderr, "Error: --summary-only supports text, compact, and json, not %s\n", *format)
+		os.Exit(2)
+	}
+	if err := validateOutputTemplateFlags(*format, *outputTemplate, *summaryTemplate, *summaryOnly); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	templates, err := parseOutputTemplates(*outputTemplate, *summaryTemplate)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if *sarifIncludeSuppressed && (len(baselineValues) == 0 || *format != "sarif") {
+		fmt.Fprintln(os.Stderr, "Error: --sarif-include-suppressed requires --baseline and --format sarif")
+		os.Exit(2)
+	}
+	if *flushOnInterrupt && (*fixApply || *baselinePrune) {
+		fmt.Fprintln(os.Stderr, "Error: --flush-on-interrupt cannot be combined with --fix or --baseline-prune")
 		os.Exit(2)
 	}
 	if *maxViolations < 0 {
@@ -241,10 +379,18 @@ func runLint(args []string) {
 		fmt.Fprintln(os.Stderr, "Error: --cache and --no-cache are mutually exclusive")
 		os.Exit(2)
 	}
+	if *resultsCacheEnabled && *noCache {
+		fmt.Fprintln(os.Stderr, "Error: --results-cache cannot be combined with --no-cache")
+		os.Exit(2)
+	}
 	if *concurrency < 1 {
 		fmt.Fprintln(os.Stderr, "Error: --concurrency must be >= 1")
 		os.Exit(2)
 	}
+	if *ruleConcurrency < 1 {
+		fmt.Fprintln(os.Stderr, "Error: --concurrency-rules must be >= 1")
+		os.Exit(2)
+	}
 	cacheActive := !*noCache
 	if *cacheEnabled {
 		cacheActive = true
@@ -269,6 +415,19 @@ func runLint(args []string) {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(2)
 	}
+	sinceWindow, err := parseSinceDuration(*sinceFilter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	ruleOptionOverrides, err := parseRuleOptionOverrides(ruleOptionSpecs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	projectCacheDir, pluginCacheDir := resolveCacheDirs(*cacheDir, os.Getenv(cacheDirEnv), currentProjectRoot())
+	plugins.RemoteCacheDir = pluginCacheDir
 
 	registry := buildRegistry()
 
@@ -282,16 +441,9 @@ func runLint(args []string) {
 			os.Exit(1)
 		}
 
-		if len(cfg.Plugins) > 0 {
-			pluginPaths := resolvePluginPaths(resolvedConfigPath, cfg.Plugins)
-			pluginRules, err := plugins.Load(pluginPaths)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error: load plugins: %v\n", err)
-				os.Exit(2)
-			}
-			for _, r := range pluginRules {
-				registry.Register(r)
-			}
+		if err := registerPluginRules(registry, resolvedConfigPath, cfg.Plugins); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: load plugins: %v\n", err)
+			os.Exit(2)
 		}
 
 		if unknown := config.UnknownRuleIDs(cfg, registry); len(unknown) > 0 {
@@ -299,17 +451,33 @@ func runLint(args []string) {
 		}
 	}
 
-	selectedRules, err := resolveLintRules(registry, cfg, ruleFilters.Values(), *category)
+	requestedRules := ruleFilters.Values()
+	if strings.TrimSpace(*rulesFrom) != "" {
+		listed, err := readRulesFromList(*rulesFrom, registry)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --rules-from: %v\n", err)
+			os.Exit(2)
+		}
+		requestedRules = append(requestedRules, listed...)
+	}
+	selectedRules, err := resolveLintRules(registry, cfg, requestedRules, *category, ruleOptionOverrides, *noExperimental)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(2)
 	}
+	warnDeprecatedRules(os.Stderr, selectedRules)
+	baselineSpecs, err := parseBaselineSpecs(baselineValues.Values(), registry)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	baselineCategories := ruleCategories(registry)
 
 	paths := pathArgs
 	if len(paths) == 0 {
 		paths = []string{"."}
 	}
-	baselineConfigured := strings.TrimSpace(*baselinePath) != ""
+	baselineConfigured := len(baselineSpecs) > 0
 	effectiveMaxViolations := *maxViolations
 	if baselineConfigured {
 		// Baseline filtering happens after rule evaluation; disabling early stop avoids
@@ -317,44 +485,99 @@ func runLint(args []string) {
 		effectiveMaxViolations = 0
 	}
 
-	filePaths, err := collectLintFilePaths(paths)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: collect files: %v\n", err)
-		os.Exit(1)
-	}
-	filePaths = filterFilePathsByExtensions(filePaths, extensionAllowlist)
-	verbosef(*verbose, "Verbose: collected %d candidate file(s)\n", len(filePaths))
-	if *changedOnly || *stagedOnly {
-		scoped, err := resolveGitScopedFileSet(*changedOnly, *stagedOnly)
+	// sinceCutoff is fixed before linting so the re-lint after --fix keeps the same window.
+	sinceCutoff := time.Now().Add(-sinceWindow)
+	var filePaths []string
+	// listedPaths keeps the --files-from entries for the re-lint after --fix.
+	var listedPaths []string
+	var files []*model.UnifiedFileModel
+	var parseErrors []model.Violation
+	if archiveSource != "" {
+		var skipped []string
+		files, skipped, err = readArchiveFiles(archiveSource, extensionAllowlist, sinceWindow, dirSkips, *maxFileSize)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(2)
+			fmt.Fprintf(os.Stderr, "Error: read archive: %v\n", err)
+			os.Exit(1)
+		}
+		verbosef(*verbose, "Verbose: read %d candidate file(s) from archive %s\n", len(files), archiveSource)
+		if !*quiet {
+			warnSkippedLargeFiles(os.Stderr, skipped, *maxFileSize)
+		}
+	} else {
+		if filesFromSource != "" {
+			listedPaths, err = readFilesFromList(filesFromSource, os.Stdin)
+			filePaths = listedPaths
+		} else {
+			filePaths, err = collectLintFilePaths(paths, dirSkips)
 		}
-
-		cwd, err := os.Getwd()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: get working directory: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error: collect files: %v\n", err)
 			os.Exit(1)
 		}
-		filtered := make([]string, 0, len(filePaths))
-		for _, p := range filePaths {
-			if scoped[pathKeyFromBase(cwd, p)] {
-				filtered = append(filtered, p)
+		filePaths = filterFilePathsByExtensions(filePaths, extensionAllowlist)
+		if sinceWindow > 0 {
+			filePaths, err = filterFilePathsByModTime(filePaths, sinceCutoff)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: collect files: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		verbosef(*verbose, "Verbose: collected %d candidate file(s)\n", len(filePaths))
+		if *changedOnly || *stagedOnly {
+			scoped, err := resolveGitScopedFileSet(*changedOnly, *stagedOnly)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(2)
+			}
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: get working directory: %v\n", err)
+				os.Exit(1)
+			}
+			filtered := make([]string, 0, len(filePaths))
+			for _, p := range filePaths {
+				if scoped[pathKeyFromBase(cwd, p)] {
+					filtered = append(filtered, p)
+				}
+			}
+			filePaths = filtered
+		}
+		var skipped []string
+		filePaths, skipped = filterFilePathsBySize(filePaths, *maxFileSize)
+		if !*quiet {
+			warnSkippedLargeFiles(os.Stderr, skipped, *maxFileSize)
+		}
+		if *failOnParseError {
+			files, err = buildUnifiedFiles(filePaths)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: parse files: %v\n", err)
+				os.Exit(1)
 			}
+		} else {
+			files, parseErrors = buildUnifiedFilesTolerant(filePaths)
+			verbosef(*verbose && len(parseErrors) > 0, "Verbose: %d file(s) could not be read and are reported as %s\n", len(parseErrors), parseErrorRuleID)
+		}
+	}
+	if *failOnNoFiles && len(files) == 0 && len(parseErrors) == 0 {
+		source := archiveSource
+		if filesFromSource != "" {
+			source = filesFromSource
 		}
-		filePaths = filtered
+		reportNoFiles(os.Stderr, paths, source)
+		os.Exit(noFilesExitCode)
 	}
 	cacheState := "off"
 	if cacheActive {
 		cacheState = "on"
 	}
-	verbosef(*verbose, "Verbose: using %d file(s) after scope filters; rules=%d cache=%s\n", len(filePaths), len(selectedRules), cacheState)
-
-	files, err := buildUnifiedFiles(filePaths)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: parse files: %v\n", err)
-		os.Exit(1)
+	var results *resultsCache
+	if cacheActive && *resultsCacheEnabled {
+		results = newResultsCache(filepath.Join(projectCacheDir, "results"), resultsCacheRuleSetHash(selectedRules, cfg, resolvePluginPaths(resolvedConfigPath, cfg.Plugins)))
+		selectedRules = results.attach(selectedRules)
+		cacheState = "on (results)"
 	}
+	verbosef(*verbose, "Verbose: using %d file(s) after scope filters; rules=%d cache=%s cacheDir=%s\n", len(files), len(selectedRules), cacheState, projectCacheDir)
 
 	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
 	for _, file := range files {
@@ -362,13 +585,38 @@ func runLint(args []string) {
 	}
 
 	start := time.Now()
-	violations := runLintRules(files, selectedRules, ctx, effectiveMaxViolations, *concurrency)
+	var violations []model.Violation
+	interrupted := false
+	filesSkipped := 0
+	if *flushOnInterrupt {
+		interrupt, stopInterrupt := notifyInterrupt(os.Stderr)
+		total := len(files)
+		violations, files, interrupted = runLintRulesUntilInterrupt(files, selectedRules, ctx, effectiveMaxViolations, *concurrency, *ruleConcurrency, cfg, interrupt)
+		stopInterrupt()
+		filesSkipped = total - len(files)
+	} else {
+		violations = runLintRules(files, selectedRules, ctx, effectiveMaxViolations, *concurrency, *ruleConcurrency, cfg)
+	}
+	violations = append(violations, parseErrors...)
 	baselineOpts := baselineOptions{BootstrapIfMissing: !*diffMode}
-	baselineInfo, err := applyBaseline(strings.TrimSpace(*baselinePath), &violations, baselineOpts)
+	runBaselineSpecs := baselineSpecs
+	if interrupted {
+		runBaselineSpecs = make([]baselineSpec, 0, len(baselineSpecs))
+		for _, spec := range baselineSpecs {
+			if _, err := os.Stat(spec.Path); os.IsNotExist(err) {
+				// A baseline bootstrapped from a partial run would miss every skipped file.
+				fmt.Fprintf(os.Stderr, "Warning: run interrupted; not creating baseline %s from partial results\n", spec.Path)
+				continue
+			}
+			runBaselineSpecs = append(runBaselineSpecs, spec)
+		}
+	}
+	baselineStates, err := applyScopedBaselines(runBaselineSpecs, baselineCategories, &violations, baselineOpts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(2)
 	}
+	baselineInfo := mergeBaselineStates(baselineStates)
 	violations = filterViolationsBySeverity(violations, minSeverity)
 	elapsed := time.Since(start).Milliseconds()
 
@@ -381,7 +629,22 @@ func runLint(args []string) {
 		}
 		fixOps = planned
 
-		if *fixApply && len(fixOps) > 0 {
+		if *fixApply && *fixInteractive && len(fixOps) > 0 {
+			backedUp := map[string]bool{}
+			fixOps, err = runInteractiveFix(fixOps, os.Stdin, os.Stderr, func(op fix.Operation) error {
+				if *fixBackup && !backedUp[filepath.Clean(op.Path)] {
+					if err := writeFixBackups([]fix.Operation{op}); err != nil {
+						return fmt.Errorf("create fix backups: %w", err)
+					}
+					backedUp[filepath.Clean(op.Path)] = true
+				}
+				return fix.Apply([]fix.Operation{op})
+			})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: apply fixes: %v\n", err)
+				os.Exit(1)
+			}
+		} else if *fixApply && len(fixOps) > 0 {
 			if *fixBackup {
 				if err := writeFixBackups(fixOps); err != nil {
 					fmt.Fprintf(os.Stderr, "Error: create fix backups: %v\n", err)
@@ -392,32 +655,71 @@ func runLint(args []string) {
 				fmt.Fprintf(os.Stderr, "Error: apply fixes: %v\n", err)
 				os.Exit(1)
 			}
+		}
 
-			rewrittenPaths := rewritePathsAfterFix(paths, fixOps)
-			filePaths, err = collectLintFilePaths(rewrittenPaths)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error: collect files after fix: %v\n", err)
-				os.Exit(1)
+		if *fixApply && len(fixOps) > 0 {
+			if filesFromSource != "" {
+				filePaths = rewritePathsAfterFix(listedPaths, fixOps)
+			} else {
+				rewrittenPaths := rewritePathsAfterFix(paths, fixOps)
+				filePaths, err = collectLintFilePaths(rewrittenPaths, dirSkips)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: collect files after fix: %v\n", err)
+					os.Exit(1)
+				}
 			}
-			files, err = buildUnifiedFiles(filePaths)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error: parse files after fix: %v\n", err)
-				os.Exit(1)
+			filePaths = filterFilePathsByExtensions(filePaths, extensionAllowlist)
+			if sinceWindow > 0 {
+				filePaths, err = filterFilePathsByModTime(filePaths, sinceCutoff)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: collect files after fix: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			filePaths, _ = filterFilePathsBySize(filePaths, *maxFileSize)
+			if *failOnParseError {
+				files, err = buildUnifiedFiles(filePaths)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: parse files after fix: %v\n", err)
+					os.Exit(1)
+				}
+			} else {
+				files, parseErrors = buildUnifiedFilesTolerant(filePaths)
 			}
 			ctx = &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
 			for _, file := range files {
 				ctx.Files[file.Path] = file
 			}
-			violations = runLintRules(files, selectedRules, ctx, effectiveMaxViolations, *concurrency)
-			baselineInfo, err = applyBaseline(strings.TrimSpace(*baselinePath), &violations, baselineOpts)
+			violations = runLintRules(files, selectedRules, ctx, effectiveMaxViolations, *concurrency, *ruleConcurrency, cfg)
+			violations = append(violations, parseErrors...)
+			baselineStates, err = applyScopedBaselines(baselineSpecs, baselineCategories, &violations, baselineOpts)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(2)
 			}
+			baselineInfo = mergeBaselineStates(baselineStates)
 			violations = filterViolationsBySeverity(violations, minSeverity)
 		}
 	}
 
+	if results != nil {
+		if err := results.save(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		hits, misses := results.stats()
+		verbosef(*verbose, "Verbose: results cache served %d of %d rule check(s)\n", hits, hits+misses)
+	}
+
+	if *baselinePrune {
+		for i := range baselineStates {
+			if err := pruneBaseline(&baselineStates[i], selectedRules, files); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: prune baseline: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		baselineInfo = mergeBaselineStates(baselineStates)
+	}
+
 	sort.Slice(violations, func(i, j int) bool {
 		if violations[i].FilePath != violations[j].FilePath {
 			return violations[i].FilePath < violations[j].FilePath
@@ -430,6 +732,32 @@ func runLint(args []string) {
 	if *maxViolations > 0 && len(violations) > *maxViolations {
 		violations = violations[:*maxViolations]
 	}
+	if *diffContext >= 0 {
+		attachDiffContext(violations, ctx.Files, *diffContext)
+	}
+	if *format == "json" || *format == "sarif" || templates != nil {
+		fix.MarkFixable(violations)
+	}
+
+	var goldenAdded, goldenRemoved []goldenEntry
+	goldenCompared := false
+	if goldenTarget != "" {
+		current := goldenEntries(violations)
+		switch {
+		case interrupted:
+			fmt.Fprintf(os.Stderr, "Warning: run interrupted; not using golden %s with partial results\n", goldenTarget)
+		case *goldenUpdate:
+			if err := writeGoldenFile(goldenTarget, current); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "Golden updated at %s with %d violation(s).\n", goldenTarget, len(current))
+		default:
+			goldenAdded, goldenRemoved = diffGolden(goldenExpected, current)
+			goldenCompared = true
+			writeGoldenDiff(os.Stderr, goldenTarget, goldenAdded, goldenRemoved)
+		}
+	}
 
 	filesWithIssues := map[string]bool{}
 	errorCount := 0
@@ -452,89 +780,152 @@ func runLint(args []string) {
 		"warnings":        warnCount,
 		"elapsedMs":       elapsed,
 	}
+	if interrupted {
+		summary["partial"] = true
+		summary["filesSkipped"] = filesSkipped
+	}
 	if baselineInfo.Enabled {
 		summary["baselinePath"] = filepath.ToSlash(baselineInfo.Path)
 		summary["baselineSuppressed"] = baselineInfo.Suppressed
 		summary["baselineBootstrapped"] = baselineInfo.Bootstrapped
+		if *baselinePrune {
+			summary["baselinePruned"] = baselineInfo.Pruned
+		}
+	}
+	if goldenCompared {
+		summary["goldenPath"] = filepath.ToSlash(goldenTarget)
+		summary["goldenAdded"] = len(goldenAdded)
+		summary["goldenRemoved"] = len(goldenRemoved)
 	}
 	if *diffMode {
 		summary["diffEnabled"] = true
 		summary["diffAdded"] = len(baselineInfo.Added)
 		summary["diffResolved"] = len(baselineInfo.Resolved)
 	}
+	// In golden mode the golden decides the outcome: matching it passes even with errors.
+	failed := errorCount > 0
+	if goldenTarget != "" && !interrupted {
+		failed = len(goldenAdded)+len(goldenRemoved) > 0
+	}
 	verbosef(*verbose, "Verbose: lint complete in %dms (violations=%d errors=%d warnings=%d)\n", elapsed, len(violations), errorCount, warnCount)
 
-	var report []byte
-	colorEnabled := shouldUseColor(*forceColor, *forceNoColor, strings.TrimSpace(*outputPath))
-	switch *format {
-	case "json", "sarif", "junit":
-		payload := map[string]interface{}{
-			"version":    "1",
-			"violations": violations,
-			"summary":    summary,
-		}
-		if baselineInfo.Enabled {
-			payload["baseline"] = map[string]interface{}{
-				"path":         filepath.ToSlash(baselineInfo.Path),
-				"suppressed":   baselineInfo.Suppressed,
-				"bootstrapped": baselineInfo.Bootstrapped,
-				"entryCount":   baselineInfo.EntryCount,
-			}
+	colorEnabled := shouldUseColor(*forceColor, *forceNoColor, strings.TrimSpace(*outputPath)) && strings.TrimSpace(*outputDir) == ""
+	renderReport := func(reportFiles []string, violations []model.Violation, summary map[string]interface{}) ([]byte, error) {
+		if templates != nil {
+			return templates.render(violations, summary, *summaryOnly)
 		}
-		if *diffMode {
-			payload["diff"] = map[string]interface{}{
-				"enabled":  true,
-				"added":    baselineInfo.Added,
-				"resolved": baselineInfo.Resolved,
-				"summary": map[string]int{
-					"added":    len(baselineInfo.Added),
-					"resolved": len(baselineInfo.Resolved),
-				},
-			}
+		if *summaryOnly {
+			return renderSummaryOnly(*format, title, summary)
 		}
-		if *fixApply || *fixDryRun {
-			payload["fixes"] = renderFixOperations(fixOps)
-			payload["fixMode"] = map[string]bool{
-				"apply":   *fixApply,
-				"dryRun":  *fixDryRun,
-				"applied": *fixApply,
+		switch *format {
+		case "sarif":
+			sarif := &reporter.SARIF{Title: title, Version: version, Rules: selectedRules, Files: ctx.Files}
+			if *sarifIncludeSuppressed {
+				sarif.Suppressed = suppressedForReport(baselineInfo.SuppressedViolations, reportFiles, minSeverity)
+				sarif.SuppressedJustification = "Matched an entry in baseline " + filepath.ToSlash(baselineInfo.Path)
+			}
+			return renderReporter(sarif, violations, summary)
+		case "junit":
+			return renderReporter(&reporter.JUnit{Title: title, Files: reportFiles}, violations, summary)
+		case "json":
+			payload := map[string]interface{}{
+				"version":    "1",
+				"violations": violations,
+				"summary":    summary,
+			}
+			if title != reporter.DefaultTitle {
+				payload["title"] = title
+			}
+			if baselineInfo.Enabled {
+				baselinePayload := map[string]interface{}{
+					"path":         filepath.ToSlash(baselineInfo.Path),
+					"suppressed":   baselineInfo.Suppressed,
+					"bootstrapped": baselineInfo.Bootstrapped,
+					"entryCount":   baselineInfo.EntryCount,
+				}
+				if *baselinePrune {
+					baselinePayload["pruned"] = baselineInfo.Pruned
+				}
+				payload["baseline"] = baselinePayload
+			}
+			if *diffMode {
+				payload["diff"] = map[string]interface{}{
+					"enabled":  true,
+					"added":    baselineInfo.Added,
+					"resolved": baselineInfo.Resolved,
+					"summary": map[string]int{
+						"added":    len(baselineInfo.Added),
+						"resolved": len(baselineInfo.Resolved),
+					},
+				}
+			}
+			if *fixApply || *fixDryRun {
+				payload["fixes"] = renderFixOperations(fixOps)
+				payload["fixMode"] = map[string]bool{
+					"apply":   *fixApply,
+					"dryRun":  *fixDryRun,
+					"applied": *fixApply,
+				}
+			}
+			encoded, err := json.MarshalIndent(payload, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return append(encoded, '\n'), nil
+		default:
+			var out strings.Builder
+			for _, state := range baselineStates {
+				if state.Bootstrapped {
+					fmt.Fprintf(&out, "Baseline created at %s with %d entry(s); existing violations suppressed.\n", state.Path, state.EntryCount)
+				} else if state.Suppressed > 0 {
+					fmt.Fprintf(&out, "Baseline suppressed %d violation(s) from %s.\n", state.Suppressed, state.Path)
+				}
+				if *baselinePrune {
+					fmt.Fprintf(&out, "Baseline pruned %d resolved entry(s) from %s.\n", state.Pruned, state.Path)
+				}
+			}
+			if *diffMode {
+				fmt.Fprintf(&out, "Diff: added=%d resolved=%d (baseline=%s)\n", len(baselineInfo.Added), len(baselineInfo.Resolved), baselineInfo.Path)
+			}
+			if *fixApply || *fixDryRun {
+				out.WriteString(formatFixSummary(fixOps, *fixDryRun))
+			}
+
+			if len(violations) == 0 {
+				fmt.Fprintln(&out, "No violations found.")
+			} else if *format == "compact" {
+				writeCompactViolations(&out, violations, colorEnabled)
+			} else {
+				for _, v := range violations {
+					severityLabel := strings.ToUpper(v.Severity)
+					severityLabel = colorizeSeverityLabel(v.Severity, severityLabel, colorEnabled)
+					fmt.Fprintf(&out, "%s:%d: %s %s: %s\n", v.FilePath, v.StartLine, severityLabel, v.RuleID, v.Message)
+				}
 			}
+			out.WriteString(formatSummaryLine(summary))
+			return []byte(out.String()), nil
 		}
-		encoded, err := json.MarshalIndent(payload, "", "  ")
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: write %s output: %v\n", *format, err)
+	}
+
+	targetDir := strings.TrimSpace(*outputDir)
+	if targetDir != "" {
+		if _, err := writePerFileReports(targetDir, *format, files, violations, elapsed, renderReport); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
-		report = append(report, encoded...)
-		report = append(report, '\n')
-	default:
-		var out strings.Builder
-		if baselineInfo.Enabled {
-			if baselineInfo.Bootstrapped {
-				fmt.Fprintf(&out, "Baseline created at %s with %d entry(s); existing violations suppressed.\n", baselineInfo.Path, baselineInfo.EntryCount)
-			} else if baselineInfo.Suppressed > 0 {
-				fmt.Fprintf(&out, "Baseline suppressed %d violation(s) from %s.\n", baselineInfo.Suppressed, baselineInfo.Path)
-			}
+		if interrupted {
+			os.Exit(interruptedExitCode)
 		}
-		if *diffMode {
-			fmt.Fprintf(&out, "Diff: added=%d resolved=%d (baseline=%s)\n", len(baselineInfo.Added), len(baselineInfo.Resolved), baselineInfo.Path)
-		}
-		if *fixApply || *fixDryRun {
-			out.WriteString(formatFixSummary(fixOps, *fixDryRun))
+		if failed {
+			os.Exit(1)
 		}
+		return
+	}
 
-		if len(violations) == 0 {
-			fmt.Fprintln(&out, "No violations found.")
-		} else {
-			for _, v := range violations {
-				severityLabel := strings.ToUpper(v.Severity)
-				severityLabel = colorizeSeverityLabel(v.Severity, severityLabel, colorEnabled)
-				fmt.Fprintf(&out, "%s:%d: %s %s: %s\n", v.FilePath, v.StartLine, severityLabel, v.RuleID, v.Message)
-			}
-		}
-		fmt.Fprintf(&out, "Summary: files=%d issues=%d violations=%d errors=%d warnings=%d elapsedMs=%d\n",
-			summary["filesChecked"], summary["filesWithIssues"], summary["totalViolations"], summary["errors"], summary["warnings"], summary["elapsedMs"])
-		report = []byte(out.String())
+	report, err := renderReport(lintedFilePaths(files), violations, summary)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: write %s output: %v\n", *format, err)
+		os.Exit(1)
 	}
 
 	targetOutput := strings.TrimSpace(*outputPath)
@@ -554,7 +945,10 @@ func runLint(args []string) {
 		}
 	}
 
-	if errorCount > 0 {
+	if interrupted {
+		os.Exit(interruptedExitCode)
+	}
+	if failed {
 		os.Exit(1)
 	}
 }
@@ -582,6 +976,7 @@ func runAudit(args []string) {
 	strictness := fs.String("strictness", "", "Strictness override (minimal|basic|standard|strict|exhaustive)")
 	format := fs.String("format", "text", "Output format (text, json, sarif, junit)")
 	outputPath := fs.String("output", "", "Write report to file instead of stdout")
+	reportTitle := fs.String("report-title", reporter.DefaultTitle, "Tool/suite name for JSON, SARIF, and JUnit reports")
 	configPath := fs.String("config", ".stricture.yml", "Path to configuration file")
 	noConfig := fs.Bool("no-config", false, "Ignore config file and use built-in defaults")
 	parseFlagSetOrExit(fs, flagArgs)
@@ -613,7 +1008,7 @@ func runAudit(args []string) {
 		fmt.Fprintf(os.Stderr, "Info: strictness override %q is accepted.\n", strictnessValue)
 	}
 
-	lintArgs := []string{"--category", "ctr", "--format", *format}
+	lintArgs := []string{"--category", "ctr", "--format", *format, "--report-title", *reportTitle}
 	if strings.TrimSpace(*configPath) != "" {
 		lintArgs = append(lintArgs, "--config", *configPath)
 	}
@@ -639,6 +1034,7 @@ func printAuditUsage() {
 	fmt.Println("  --strictness <lvl>   Strictness override (compatibility flag)")
 	fmt.Println("  --format <fmt>       Output format: text, json, sarif, junit")
 	fmt.Println("  --output <file>      Write report to file")
+	fmt.Println("  --report-title <t>   Tool/suite name in JSON, SARIF, and JUnit reports")
 	fmt.Println("  --config <path>      Use a specific config file")
 	fmt.Println("  --no-config          Ignore .stricture.yml, use defaults only")
 }
@@ -905,26 +1301,56 @@ func parseFlagSetOrExit(fs *flag.FlagSet, args []string) {
 
 func splitLintArgs(args []string) ([]string, []string, error) {
 	valueFlags := map[string]bool{
-		"-format":          true,
-		"--format":         true,
-		"-config":          true,
-		"--config":         true,
-		"-rule":            true,
-		"--rule":           true,
-		"-category":        true,
-		"--category":       true,
-		"-ext":             true,
-		"--ext":            true,
-		"-severity":        true,
-		"--severity":       true,
-		"-concurrency":     true,
-		"--concurrency":    true,
-		"-output":          true,
-		"--output":         true,
-		"-max-violations":  true,
-		"--max-violations": true,
-		"-baseline":        true,
-		"--baseline":       true,
+		"-format":             true,
+		"--format":            true,
+		"-config":             true,
+		"--config":            true,
+		"-rule":               true,
+		"--rule":              true,
+		"-rule-option":        true,
+		"--rule-option":       true,
+		"-category":           true,
+		"--category":          true,
+		"-golden":             true,
+		"--golden":            true,
+		"-ext":                true,
+		"--ext":               true,
+		"-since":              true,
+		"--since":             true,
+		"-max-file-size":      true,
+		"--max-file-size":     true,
+		"-files-from":         true,
+		"--files-from":        true,
+		"-rules-from":         true,
+		"--rules-from":        true,
+		"-include-dir":        true,
+		"--include-dir":       true,
+		"-archive":            true,
+		"--archive":           true,
+		"-severity":           true,
+		"--severity":          true,
+		"-concurrency":        true,
+		"--concurrency":       true,
+		"-concurrency-rules":  true,
+		"--concurrency-rules": true,
+		"-output":             true,
+		"--output":            true,
+		"-output-dir":         true,
+		"--output-dir":        true,
+		"-output-template":    true,
+		"--output-template":   true,
+		"-summary-template":   true,
+		"--summary-template":  true,
+		"-cache-dir":          true,
+		"--cache-dir":         true,
+		"-report-title":       true,
+		"--report-title":      true,
+		"-max-violations":     true,
+		"--max-violations":    true,
+		"-baseline":           true,
+		"--baseline":          true,
+		"-diff-context":       true,
+		"--diff-context":      true,
 	}
 
 	flagArgs := make([]string, 0, len(args))
@@ -1005,18 +1431,20 @@ func splitTraceArgs(args []string) (string, []string, error) {
 
 func splitAuditArgs(args []string) ([]string, []string, error) {
 	valueFlags := map[string]bool{
-		"-manifest":    true,
-		"--manifest":   true,
-		"-service":     true,
-		"--service":    true,
-		"-strictness":  true,
-		"--strictness": true,
-		"-format":      true,
-		"--format":     true,
-		"-output":      true,
-		"--output":     true,
-		"-config":      true,
-		"--config":     true,
+		"-manifest":      true,
+		"--manifest":     true,
+		"-service":       true,
+		"--service":      true,
+		"-strictness":    true,
+		"--strictness":   true,
+		"-format":        true,
+		"--format":       true,
+		"-report-title":  true,
+		"--report-title": true,
+		"-output":        true,
+		"--output":       true,
+		"-config":        true,
+		"--config":       true,
 	}
 
 	flagArgs := make([]string, 0, len(args))
@@ -1144,10 +1572,11 @@ func runInit(args []string) {
 	fs := flag.NewFlagSet("init", flag.ExitOnError)
 	force := fs.Bool("force", false, "Overwrite existing .stricture.yml if it exists")
 	pathValue := fs.String("path", ".stricture.yml", "Destination config path")
+	profile := fs.String("profile", defaultInitProfile, "Rule preset: "+strings.Join(initProfiles, ", "))
 	fs.Usage = func() {
 		fmt.Println("Usage: strict init [options]")
 		fmt.Println()
-		fmt.Println("Create a default .stricture.yml with recommended settings.")
+		fmt.Println("Create a .stricture.yml enabling every rule, with severities from --profile.")
 		fs.PrintDefaults()
 	}
 	parseFlagSetOrExit(fs, args)
@@ -1157,12 +1586,17 @@ func runInit(args []string) {
 		target = ".stricture.yml"
 	}
 
+	content, err := initConfigForProfile(buildRegistry(), *profile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
 	if _, err := os.Stat(target); err == nil && !*force {
 		fmt.Fprintf(os.Stderr, "Error: %s already exists. Re-run with --force to overwrite.\n", target)
 		os.Exit(2)
 	}
 
-	content := defaultInitConfig()
 	if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: write %s: %v\n", target, err)
 		os.Exit(1)
@@ -1180,12 +1614,19 @@ type baselineState struct {
 	Entries      []baselineEntry
 	Added        []model.Violation
 	Resolved     []baselineEntry
+	Pruned       int
+
+	// SuppressedViolations are the findings the baseline hid from this run.
+	SuppressedViolations []model.Violation
 }
 
 type baselineOptions struct {
 	BootstrapIfMissing bool
 }
 
+// baselineFormatVersion is the baseline file version this build reads and writes.
+const baselineFormatVersion = "1"
+
 type baselineFile struct {
 	Version     string          `json:"version"`
 	GeneratedAt string          `json:"generatedAt"`
@@ -1229,40 +1670,17 @@ func applyBaseline(pathValue string, violations *[]model.Violation, options base
 				Message:   strings.TrimSpace(v.Message),
 			})
 		}
-		sort.Slice(entries, func(i, j int) bool {
-			if entries[i].FilePath != entries[j].FilePath {
-				return entries[i].FilePath < entries[j].FilePath
-			}
-			if entries[i].StartLine != entries[j].StartLine {
-				return entries[i].StartLine < entries[j].StartLine
-			}
-			if entries[i].RuleID != entries[j].RuleID {
-				return entries[i].RuleID < entries[j].RuleID
-			}
-			return entries[i].Message < entries[j].Message
-		})
+		sortBaselineEntries(entries)
 
-		doc := baselineFile{
-			Version:     "1",
-			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
-			Entries:     entries,
-		}
-		encoded, err := json.MarshalIndent(doc, "", "  ")
-		if err != nil {
-			return state, fmt.Errorf("marshal baseline %s: %w", pathValue, err)
-		}
-		encoded = append(encoded, '\n')
-		if err := os.MkdirAll(filepath.Dir(pathValue), 0o755); err != nil {
-			return state, fmt.Errorf("create baseline directory for %s: %w", pathValue, err)
-		}
-		if err := os.WriteFile(pathValue, encoded, 0o644); err != nil {
-			return state, fmt.Errorf("write baseline %s: %w", pathValue, err)
+		if err := writeBaselineFile(pathValue, entries); err != nil {
+			return state, err
 		}
 
 		state.EntryCount = len(entries)
 		state.Suppressed = len(*violations)
 		state.Bootstrapped = true
 		state.Entries = entries
+		state.SuppressedViolations = append([]model.Violation(nil), (*violations)...)
 		state.Resolved = []baselineEntry{}
 		state.Added = []model.Violation{}
 		*violations = []model.Violation{}
@@ -1284,6 +1702,7 @@ func applyBaseline(pathValue string, violations *[]model.Violation, options base
 	for _, v := range *violations {
 		if lookup[baselineKeyFromViolation(v)] {
 			state.Suppressed++
+			state.SuppressedViolations = append(state.SuppressedViolations, v)
 			continue
 		}
 		filtered = append(filtered, v)
@@ -1297,6 +1716,26 @@ func applyBaseline(pathValue string, violations *[]model.Violation, options base
 	return state, nil
 }
 
+func writeBaselineFile(pathValue string, entries []baselineEntry) error {
+	doc := baselineFile{
+		Version:     baselineFormatVersion,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Entries:     entries,
+	}
+	encoded, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal baseline %s: %w", pathValue, err)
+	}
+	encoded = append(encoded, '\n')
+	if err := os.MkdirAll(filepath.Dir(pathValue), 0o755); err != nil {
+		return fmt.Errorf("create baseline directory for %s: %w", pathValue, err)
+	}
+	if err := os.WriteFile(pathValue, encoded, 0o644); err != nil {
+		return fmt.Errorf("write baseline %s: %w", pathValue, err)
+	}
+	return nil
+}
+
 func baselineResolvedEntries(current []model.Violation, entries []baselineEntry) []baselineEntry {
 	currentLookup := map[string]bool{}
 	for _, v := range current {
@@ -1310,19 +1749,25 @@ func baselineResolvedEntries(current []model.Violation, entries []baselineEntry)
 		}
 		out = append(out, entry)
 	}
-	sort.Slice(out, func(i, j int) bool {
-		if out[i].FilePath != out[j].FilePath {
-			return out[i].FilePath < out[j].FilePath
+	sortBaselineEntries(out)
+	return out
+}
+
+// sortBaselineEntries orders entries by file, line, rule, and message, so baseline
+// files are stable across runs.
+func sortBaselineEntries(entries []baselineEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].FilePath != entries[j].FilePath {
+			return entries[i].FilePath < entries[j].FilePath
 		}
-		if out[i].StartLine != out[j].StartLine {
-			return out[i].StartLine < out[j].StartLine
+		if entries[i].StartLine != entries[j].StartLine {
+			return entries[i].StartLine < entries[j].StartLine
 		}
-		if out[i].RuleID != out[j].RuleID {
-			return out[i].RuleID < out[j].RuleID
+		if entries[i].RuleID != entries[j].RuleID {
+			return entries[i].RuleID < entries[j].RuleID
 		}
-		return out[i].Message < out[j].Message
+		return entries[i].Message < entries[j].Message
 	})
-	return out
 }
 
 func baselineKeyFromEntry(entry baselineEntry) string {
@@ -1341,9 +1786,20 @@ func baselineKeyFromViolation(v model.Violation) string {
 		strings.TrimSpace(v.Message))
 }
 
-func resolveLintRules(registry *model.RuleRegistry, cfg *config.Config, requestedRules []string, category string) ([]model.Rule, error) {
+// resolveLintRules selects and configures the rules to run. With excludeExperimental,
+// experimental rules are left out unless requested by ID.
+func resolveLintRules(registry *model.RuleRegistry, cfg *config.Config, requestedRules []string, category string, optionOverrides map[string]map[string]interface{}, excludeExperimental bool) ([]model.Rule, error) {
 	selected := make([]model.Rule, 0)
 	targetCategory := strings.ToLower(strings.TrimSpace(category))
+	if targetCategory != "" && !registryHasCategory(registry, targetCategory) {
+		return nil, fmt.Errorf("unknown category %q (available: %s)", category, strings.Join(registry.Categories(), ", "))
+	}
+
+	for id := range optionOverrides {
+		if _, ok := registry.ByID(id); !ok {
+			return nil, fmt.Errorf("unknown rule %q in --rule-option", id)
+		}
+	}
 
 	ruleFilter := map[string]bool{}
 	for _, raw := range requestedRules {
@@ -1381,6 +1837,11 @@ func resolveLintRules(registry *model.RuleRegistry, cfg *config.Config, requeste
 				candidates = append(candidates, r)
 			}
 		}
+		if targetCategory != "" && !rulesInCategory(candidates, targetCategory) {
+			// The config never mentions this category (typically one added by a plugin),
+			// so run every registered rule in it.
+			candidates = append(candidates[:0], registry.All()...)
+		}
 	default:
 		candidates = append(candidates, registry.All()...)
 	}
@@ -1392,6 +1853,9 @@ func resolveLintRules(registry *model.RuleRegistry, cfg *config.Config, requeste
 		if targetCategory != "" && strings.ToLower(r.Category()) != targetCategory {
 			continue
 		}
+		if excludeExperimental && !hasRuleFilter && model.RuleStability(r) == model.StabilityExperimental {
+			continue
+		}
 
 		ruleCfg := model.RuleConfig{
 			Severity: r.DefaultSeverity(),
@@ -1407,19 +1871,43 @@ func resolveLintRules(registry *model.RuleRegistry, cfg *config.Config, requeste
 				}
 			}
 		}
+		if cliOptions, ok := optionOverrides[r.ID()]; ok {
+			ruleCfg.Options = mergeRuleOptions(ruleCfg.Options, cliOptions)
+		}
 		if strings.EqualFold(ruleCfg.Severity, "off") {
 			continue
 		}
 
-		selected = append(selected, lintRuleWithConfig{Rule: r, Config: ruleCfg})
+		exclude, err := config.RuleExclude(ruleCfg.Options)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %v", r.ID(), err)
+		}
+
+		selected = append(selected, lintRuleWithConfig{Rule: r, Config: ruleCfg, Exclude: config.CompilePathGlobs(exclude)})
 	}
 
 	return selected, nil
 }
 
+// warnDeprecatedRules prints one warning per selected deprecated rule.
+func warnDeprecatedRules(w io.Writer, rules []model.Rule) {
+	for _, r := range rules {
+		if wrapped, ok := r.(lintRuleWithConfig); ok {
+			r = wrapped.Rule
+		}
+		if model.RuleStability(r) == model.StabilityDeprecated {
+			fmt.Fprintf(w, "Warning: rule %s is deprecated and may be removed in a future release\n", r.ID())
+		}
+	}
+}
+
 type lintRuleWithConfig struct {
 	model.Rule
 	Config model.RuleConfig
+	// Exclude holds the rule's `exclude` globs; matching files are not checked.
+	Exclude config.PathGlobs
+	// Results, when set, serves and records this rule's violations per unchanged file.
+	Results *resultsCache
 }
 
 func rewritePathsAfterFix(paths []string, ops []fix.Operation) []string {
@@ -1596,7 +2084,7 @@ func filterFilePathsByExtensions(paths []string, allowlist map[string]bool) []st
 	return filtered
 }
 
-func collectLintFilePaths(paths []string) ([]string, error) {
+func collectLintFilePaths(paths []string, skips lintDirSkips) ([]string, error) {
 	files := make([]string, 0)
 	seen := map[string]bool{}
 	projectRoot := currentProjectRoot()
@@ -1635,7 +2123,7 @@ func collectLintFilePaths(paths []string) ([]string, error) {
 				return walkErr
 			}
 			if entry.IsDir() {
-				if shouldSkipLintDir(current) {
+				if skips.skip(current) {
 					return filepath.SkipDir
 				}
 				return nil
@@ -1817,17 +2305,6 @@ func isPathWithinRoot(pathValue string, root string) bool {
 	return !strings.HasPrefix(rel, ".."+string(filepath.Separator))
 }
 
-func shouldSkipLintDir(dir string) bool {
-	base := filepath.Base(dir)
-	switch base {
-	case ".git", "node_modules", "bin", ".stricture-cache", "docs", "tests":
-		return true
-	}
-
-	normalized := filepath.ToSlash(dir)
-	return strings.Contains(normalized, "tests/fixtures") || strings.Contains(normalized, "tests/benchmark")
-}
-
 func isLintSourceFile(path string) bool {
 	if isGeneratedSourceFile(path) {
 		return false
@@ -1850,18 +2327,10 @@ func isGeneratedSourceFile(path string) bool {
 func buildUnifiedFiles(paths []string) ([]*model.UnifiedFileModel, error) {
 	files := make([]*model.UnifiedFileModel, 0, len(paths))
 	for _, pathValue := range paths {
-		data, err := os.ReadFile(pathValue)
+		file, err := loadUnifiedFile(pathValue)
 		if err != nil {
 			return nil, err
 		}
-
-		file := &model.UnifiedFileModel{
-			Path:       filepath.ToSlash(pathValue),
-			Language:   detectLanguage(pathValue),
-			Source:     data,
-			LineCount:  countLines(data),
-			IsTestFile: looksLikeTestFile(pathValue),
-		}
 		files = append(files, file)
 	}
 	return files, nil
@@ -1889,74 +2358,34 @@ func looksLikeTestFile(pathValue string) bool {
 		strings.HasSuffix(name, "test.java")
 }
 
-func runLintRules(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int, concurrency int) []model.Violation {
+// runLintRules evaluates rules over files. Severities are remapped through cfg's
+// per-path overrides before violations are returned, so counts and exit codes
+// reflect the remapped values. concurrency bounds parallel files; ruleConcurrency
+// bounds parallel rules within one file.
+func runLintRules(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int, concurrency int, ruleConcurrency int, cfg *config.Config) []model.Violation {
 	if concurrency <= 1 || len(files) <= 1 || maxViolations > 0 {
 		// Preserve exact fail-fast behavior when maxViolations is configured.
-		return runLintRulesSequential(files, rules, ctx, maxViolations)
+		return runLintRulesSequential(files, rules, ctx, maxViolations, ruleConcurrency, cfg)
 	}
-	return runLintRulesParallel(files, rules, ctx, concurrency)
+	return runLintRulesParallel(files, rules, ctx, concurrency, ruleConcurrency, cfg)
 }
 
-func runLintRulesSequential(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int) []model.Violation {
+func runLintRulesSequential(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int, ruleConcurrency int, cfg *config.Config) []model.Violation {
 	violations := make([]model.Violation, 0)
-	stop := false
 	for _, file := range files {
-		if stop {
-			break
-		}
-		policy := suppression.Compile(file.Source)
-		for _, rawRule := range rules {
-			if stop {
+		remaining := 0
+		if maxViolations > 0 {
+			remaining = maxViolations - len(violations)
+			if remaining <= 0 {
 				break
 			}
-			ruleCfg := model.RuleConfig{Severity: rawRule.DefaultSeverity(), Options: map[string]interface{}{}}
-			if withCfg, ok := rawRule.(lintRuleWithConfig); ok {
-				rawRule = withCfg.Rule
-				ruleCfg = withCfg.Config
-			}
-
-			func() {
-				defer func() {
-					if recovered := recover(); recovered != nil {
-						violations = append(violations, model.Violation{
-							RuleID:    rawRule.ID(),
-							Severity:  "error",
-							Message:   fmt.Sprintf("Rule panicked: %v", recovered),
-							FilePath:  file.Path,
-							StartLine: 1,
-						})
-						if maxViolations > 0 && len(violations) >= maxViolations {
-							stop = true
-						}
-					}
-				}()
-				rawViolations := rawRule.Check(file, ctx, ruleCfg)
-				for _, v := range rawViolations {
-					ruleID := strings.TrimSpace(v.RuleID)
-					if ruleID == "" {
-						ruleID = rawRule.ID()
-						v.RuleID = ruleID
-					}
-					line := v.StartLine
-					if line <= 0 {
-						line = 1
-					}
-					if policy.Suppressed(ruleID, line) {
-						continue
-					}
-					violations = append(violations, v)
-					if maxViolations > 0 && len(violations) >= maxViolations {
-						stop = true
-						break
-					}
-				}
-			}()
 		}
+		violations = append(violations, runLintRulesForFile(file, rules, ctx, remaining, ruleConcurrency, cfg)...)
 	}
 	return violations
 }
 
-func runLintRulesParallel(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, concurrency int) []model.Violation {
+func runLintRulesParallel(files []*model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, concurrency int, ruleConcurrency int, cfg *config.Config) []model.Violation {
 	workerCount := concurrency
 	if workerCount > len(files) {
 		workerCount = len(files)
@@ -1969,7 +2398,7 @@ func runLintRulesParallel(files []*model.UnifiedFileModel, rules []model.Rule, c
 	worker := func() {
 		defer wg.Done()
 		for file := range jobs {
-			results <- runLintRulesForFile(file, rules, ctx, 0)
+			results <- runLintRulesForFile(file, rules, ctx, 0, ruleConcurrency, cfg)
 		}
 	}
 
@@ -1993,56 +2422,74 @@ func runLintRulesParallel(files []*model.UnifiedFileModel, rules []model.Rule, c
 	return violations
 }
 
-func runLintRulesForFile(file *model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int) []model.Violation {
-	violations := make([]model.Violation, 0)
-	stop := false
+// runLintRulesForFile checks one file. With maxViolations > 0 rules run in order and
+// stop at the limit; otherwise rules may run concurrently (see runFileRulesConcurrently).
+func runLintRulesForFile(file *model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, maxViolations int, ruleConcurrency int, cfg *config.Config) []model.Violation {
 	policy := suppression.Compile(file.Source)
+	if ruleConcurrency > 1 && maxViolations == 0 && len(rules) > 1 {
+		return runFileRulesConcurrently(file, rules, ctx, policy, ruleConcurrency, cfg)
+	}
+	violations := make([]model.Violation, 0)
 	for _, rawRule := range rules {
-		if stop {
-			break
+		violations = append(violations, checkLintRule(file, rawRule, ctx, policy, cfg)...)
+		if maxViolations > 0 && len(violations) >= maxViolations {
+			return violations[:maxViolations]
 		}
-		ruleCfg := model.RuleConfig{Severity: rawRule.DefaultSeverity(), Options: map[string]interface{}{}}
-		if withCfg, ok := rawRule.(lintRuleWithConfig); ok {
-			rawRule = withCfg.Rule
-			ruleCfg = withCfg.Config
-		}
-
-		func() {
-			defer func() {
-				if recovered := recover(); recovered != nil {
-					violations = append(violations, model.Violation{
-						RuleID:    rawRule.ID(),
-						Severity:  "error",
-						Message:   fmt.Sprintf("Rule panicked: %v", recovered),
-						FilePath:  file.Path,
-						StartLine: 1,
-					})
-					if maxViolations > 0 && len(violations) >= maxViolations {
-						stop = true
-					}
-				}
-			}()
-			rawViolations := rawRule.Check(file, ctx, ruleCfg)
-			for _, v := range rawViolations {
-				ruleID := strings.TrimSpace(v.RuleID)
-				if ruleID == "" {
-					ruleID = rawRule.ID()
-					v.RuleID = ruleID
-				}
-				line := v.StartLine
-				if line <= 0 {
-					line = 1
-				}
-				if policy.Suppressed(ruleID, line) {
-					continue
-				}
-				violations = append(violations, v)
-				if maxViolations > 0 && len(violations) >= maxViolations {
-					stop = true
-					break
-				}
+	}
+	return violations
+}
+
+// checkLintRule runs one rule on one file, skipping files the rule's `exclude` globs
+// match, filling in missing rule IDs and applying inline suppressions and severity
+// overrides. A panicking rule yields a single "Rule panicked" error instead of aborting
+// the run. With --results-cache, results for an unchanged file come from the cache
+// instead.
+func checkLintRule(file *model.UnifiedFileModel, rawRule model.Rule, ctx *model.ProjectContext, policy *suppression.Policy, cfg *config.Config) (violations []model.Violation) {
+	ruleCfg := model.RuleConfig{Severity: rawRule.DefaultSeverity(), Options: map[string]interface{}{}}
+	if withCfg, ok := rawRule.(lintRuleWithConfig); ok {
+		if withCfg.Exclude.Matches(file.Path) {
+			return nil
+		}
+		rawRule = withCfg.Rule
+		ruleCfg = withCfg.Config
+		if results := withCfg.Results; results != nil {
+			if cached, ok := results.lookup(file, rawRule.ID()); ok {
+				return cached
 			}
-		}()
+			// Registered before the recover below so a panic result is stored too.
+			defer func() { results.store(file, rawRule.ID(), violations) }()
+		}
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			violations = append(violations, model.Violation{
+				RuleID:    rawRule.ID(),
+				Severity:  "error",
+				Message:   fmt.Sprintf("Rule panicked: %v", recovered),
+				FilePath:  file.Path,
+				StartLine: 1,
+			})
+		}
+	}()
+	rawViolations := rawRule.Check(file, ctx, ruleCfg)
+	for _, v := range rawViolations {
+		ruleID := strings.TrimSpace(v.RuleID)
+		if ruleID == "" {
+			ruleID = rawRule.ID()
+			v.RuleID = ruleID
+		}
+		line := v.StartLine
+		if line <= 0 {
+			line = 1
+		}
+		if policy.Suppressed(ruleID, line) {
+			continue
+		}
+		if !remapViolationSeverity(cfg, &v) {
+			continue
+		}
+		violations = append(violations, v)
 	}
 	return violations
 }
@@ -2247,12 +2694,29 @@ func parseGoInspect(path string, source []byte) (*model.UnifiedFileModel, error)
 				if !ok {
 					continue
 				}
-				ufm.Types = append(ufm.Types, model.TypeModel{
+				typ := model.TypeModel{
 					Name:      ts.Name.Name,
+					Kind:      "type",
 					Exported:  ast.IsExported(ts.Name.Name),
 					StartLine: fset.Position(ts.Pos()).Line,
 					EndLine:   fset.Position(ts.End()).Line,
-				})
+				}
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					typ.Kind = "struct"
+				case *ast.InterfaceType:
+					typ.Kind = "interface"
+					typ.Methods = []string{}
+					for _, field := range t.Methods.List {
+						if _, ok := field.Type.(*ast.FuncType); !ok {
+							continue
+						}
+						for _, name := range field.Names {
+							typ.Methods = append(typ.Methods, name.Name)
+						}
+					}
+				}
+				ufm.Types = append(ufm.Types, typ)
 			}
 		}
 	}
@@ -2506,8 +2970,16 @@ func runLineageEscalate(args []string) {
 }
 
 // runListRules prints a table of all registered rules.
-func runListRules() {
-	registry := buildRegistry()
+func runListRules(args []string) {
+	fs := flag.NewFlagSet("list-rules", flag.ExitOnError)
+	configPath := fs.String("config", ".stricture.yml", "Path to configuration file (plugins listed there are included)")
+	parseFlagSetOrExit(fs, args)
+
+	registry, err := registryWithConfigPlugins(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "ID\tCATEGORY\tDEFAULT\tFIXABLE\tDESCRIPTION")
@@ -2518,6 +2990,9 @@ func runListRules() {
 		if meta.RequiresManifest {
 			desc += " (requires manifest)"
 		}
+		if stability := model.RuleStability(r); stability != model.StabilityStable {
+			desc += " (" + stability + ")"
+		}
 		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
 			r.ID(), strings.ToUpper(r.Category()), r.DefaultSeverity(), meta.Fixability, desc)
 	}
@@ -2559,6 +3034,7 @@ func runExplain(args []string) {
 	fmt.Printf("Category: %s\n", strings.ToUpper(ruleDef.Category()))
 	fmt.Printf("Default Severity: %s\n", ruleDef.DefaultSeverity())
 	fmt.Printf("Fixable: %s\n", meta.Fixability)
+	fmt.Printf("Stability: %s\n", model.RuleStability(ruleDef))
 	fmt.Printf("Needs Project Context: %t\n", ruleDef.NeedsProjectContext())
 	fmt.Printf("Requires Manifest: %s\n", requiresManifest)
 	fmt.Printf("Description: %s\n", ruleDef.Description())
@@ -2620,9 +3096,9 @@ type ruleMeta struct {
 
 func ruleMetadata(ruleID string) ruleMeta {
 	switch ruleID {
-	case "CONV-file-header", "CONV-file-naming", "CONV-test-file-location":
+	case "CONV-file-header", "CONV-file-naming", "CONV-test-file-location", "CONV-no-tabs-or-spaces-mismatch", "CONV-consistent-quote-style", "CONV-no-redundant-else-after-return", "CONV-struct-field-alignment":
 		return ruleMeta{Fixability: "Yes"}
-	case "TQ-mock-scope":
+	case "TQ-mock-scope", "CONV-error-format":
 		return ruleMeta{Fixability: "Partial"}
 	case "CTR-strictness-parity", "CTR-manifest-conformance":
 		return ruleMeta{Fixability: "No", RequiresManifest: true}
@@ -2633,9 +3109,13 @@ func ruleMetadata(ruleID string) ruleMeta {
 
 func sortedRulesForDisplay(registry *model.RuleRegistry) []model.Rule {
 	all := append([]model.Rule(nil), registry.All()...)
+	rank := map[string]int{}
+	for i, category := range registry.Categories() {
+		rank[category] = i
+	}
 	sort.SliceStable(all, func(i, j int) bool {
-		ci := categoryOrder(strings.ToLower(all[i].Category()))
-		cj := categoryOrder(strings.ToLower(all[j].Category()))
+		ci := rank[strings.ToLower(strings.TrimSpace(all[i].Category()))]
+		cj := rank[strings.ToLower(strings.TrimSpace(all[j].Category()))]
 		if ci != cj {
 			return ci < cj
 		}
@@ -2644,58 +3124,6 @@ func sortedRulesForDisplay(registry *model.RuleRegistry) []model.Rule {
 	return all
 }
 
-func categoryOrder(category string) int {
-	switch strings.ToLower(category) {
-	case "tq":
-		return 0
-	case "arch":
-		return 1
-	case "conv":
-		return 2
-	case "ctr":
-		return 3
-	default:
-		return 4
-	}
-}
-
-func defaultInitConfig() string {
-	return `version: "1.0"
-
-rules:
-  CONV-file-naming: error
-  CONV-file-header: error
-  CONV-error-format: error
-  CONV-export-naming: error
-  CONV-test-file-location: error
-  CONV-required-exports: error
-  ARCH-dependency-direction: error
-  ARCH-import-boundary: error
-  ARCH-no-circular-deps: error
-  ARCH-max-file-lines: error
-  ARCH-layer-violation: error
-  ARCH-module-boundary: error
-  TQ-no-shallow-assertions: error
-  TQ-return-type-verified: error
-  TQ-schema-conformance: error
-  TQ-error-path-coverage: error
-  TQ-assertion-depth: error
-  TQ-boundary-tested: error
-  TQ-mock-scope: error
-  TQ-test-isolation: error
-  TQ-negative-cases: error
-  TQ-test-naming: error
-  CTR-request-shape: error
-  CTR-response-shape: error
-  CTR-status-code-handling: error
-  CTR-shared-type-sync: error
-  CTR-json-tag-match: error
-  CTR-dual-test: error
-  CTR-strictness-parity: error
-  CTR-manifest-conformance: error
-`
-}
-
 // buildRegistry creates a RuleRegistry with all known rules.
 func buildRegistry() *model.RuleRegistry {
 	r := model.NewRuleRegistry()
@@ -2707,6 +3135,15 @@ func buildRegistry() *model.RuleRegistry {
 	r.Register(&conv.ExportNaming{})
 	r.Register(&conv.TestFileLocation{})
 	r.Register(&conv.RequiredExports{})
+	r.Register(&conv.IndentationConsistency{})
+	r.Register(&conv.QuoteStyle{})
+	r.Register(&conv.NoMagicNumbers{})
+	r.Register(&conv.NoRedundantElse{})
+	r.Register(&conv.NoUnusedPackageVars{})
+	r.Register(&conv.CommentHygiene{})
+	r.Register(&conv.FilenameMatchesType{})
+	r.Register(&conv.NoAbbreviations{})
+	r.Register(&conv.StructFieldAlignment{})
 
 	// ARCH
 	r.Register(&arch.DependencyDirection{})
@@ -2715,6 +3152,22 @@ func buildRegistry() *model.RuleRegistry {
 	r.Register(&arch.MaxFileLines{})
 	r.Register(&arch.LayerViolation{})
 	r.Register(&arch.ModuleBoundary{})
+	r.Register(&arch.PackageNaming{})
+	r.Register(&arch.NoCrossModuleInternalImport{})
+	r.Register(&arch.NoBusinessLogicInHandlers{})
+	r.Register(&arch.MaxImportCount{})
+	r.Register(&arch.NoUpwardImport{})
+	r.Register(&arch.ForbiddenImport{})
+	r.Register(&arch.TestPackagePolicy{})
+	r.Register(&arch.NoDirectDBAccessFromDomain{})
+	r.Register(&arch.NoImportFromEntrypoint{})
+	r.Register(&arch.InterfaceSegregation{})
+	r.Register(&arch.NoSideEffectsInInit{})
+	r.Register(&arch.MaxCyclomaticComplexity{})
+	r.Register(&arch.NoWildcardReexports{})
+	r.Register(&arch.ContextPropagation{})
+	r.Register(&arch.GeneratedImportPolicy{})
+	r.Register(&arch.NoFileCycles{})
 
 	// TQ
 	r.Register(&tq.NoShallowAssertions{})
@@ -2727,6 +3180,21 @@ func buildRegistry() *model.RuleRegistry {
 	r.Register(&tq.TestIsolation{})
 	r.Register(&tq.NegativeCases{})
 	r.Register(&tq.TestNaming{})
+	r.Register(&tq.NoConditionalAssertions{})
+	r.Register(&tq.BoundaryValueCoverage{})
+	r.Register(&tq.NoSharedMutableGlobalsInTests{})
+	r.Register(&tq.AssertionSpecificity{})
+	r.Register(&tq.NoEmptyCatch{})
+	r.Register(&tq.SnapshotStaleness{})
+	r.Register(&tq.NoTestLogicInProduction{})
+	r.Register(&tq.FlakyRetryDetection{})
+	r.Register(&tq.TestCoverageAnnotation{})
+	r.Register(&tq.PaginationBoundaryTested{})
+	r.Register(&tq.TestFileLocation{})
+	r.Register(&tq.NoHardcodedCredentials{})
+	r.Register(&tq.SetupTeardownBalance{})
+	r.Register(&tq.AssertErrorSpecificType{})
+	r.Register(&tq.NoTestInterdependence{})
 
 	// CTR
 	r.Register(&ctr.RequestShape{})
@@ -2737,6 +3205,7 @@ func buildRegistry() *model.RuleRegistry {
 	r.Register(&ctr.DualTest{})
 	r.Register(&ctr.StrictnessParity{})
 	r.Register(&ctr.ManifestConformance{})
+	r.Register(&ctr.RequestRequiredFields{})
 
 	return r
 }
@@ -107,6 +107,7 @@ func TestSplitLintArgs(t *testing.T) {
 		".",
 		"--rule", "CONV-file-header",
 		"pkg",
+		"--files-from", "changed.txt",
 		"--",
 		"literal-arg",
 	})
@@ -114,7 +115,7 @@ func TestSplitLintArgs(t *testing.T) {
 		t.Fatalf("splitLintArgs returned error: %v", err)
 	}
 
-	wantFlags := []string{"--format", "json", "--rule", "CONV-file-header"}
+	wantFlags := []string{"--format", "json", "--rule", "CONV-file-header", "--files-from", "changed.txt"}
 	wantPaths := []string{".", "pkg", "literal-arg"}
 	if !reflect.DeepEqual(flagArgs, wantFlags) {
 		t.Fatalf("flagArgs = %#v, want %#v", flagArgs, wantFlags)
@@ -455,13 +456,14 @@ func TestIsPathWithinRoot(t *testing.T) {
 func TestShouldSkipLintDir(t *testing.T) {
 	t.Parallel()
 
-	if !shouldSkipLintDir("node_modules") {
+	var defaults lintDirSkips
+	if !defaults.skip("node_modules") {
 		t.Fatalf("node_modules should be skipped")
 	}
-	if !shouldSkipLintDir("tests/fixtures/sample") {
+	if !defaults.skip("tests/fixtures/sample") {
 		t.Fatalf("tests/fixtures path should be skipped")
 	}
-	if shouldSkipLintDir("src") {
+	if defaults.skip("src") {
 		t.Fatalf("src should not be skipped")
 	}
 }
@@ -514,7 +516,7 @@ func TestRunLintRulesForFileRecoversFromPanic(t *testing.T) {
 	rules := []model.Rule{
 		fakeRule{id: "PANIC-rule", shouldPanic: true},
 	}
-	out := runLintRulesForFile(file, rules, &model.ProjectContext{}, 0)
+	out := runLintRulesForFile(file, rules, &model.ProjectContext{}, 0, 1, nil)
 	if len(out) != 1 {
 		t.Fatalf("expected 1 panic violation, got %d", len(out))
 	}
@@ -544,7 +546,7 @@ func TestRunLintRulesForFileAppliesSuppressionAndRuleFallback(t *testing.T) {
 			},
 		},
 	}
-	out := runLintRulesForFile(file, rules, &model.ProjectContext{}, 0)
+	out := runLintRulesForFile(file, rules, &model.ProjectContext{}, 0, 1, nil)
 	if len(out) != 1 {
 		t.Fatalf("expected 1 remaining violation, got %d (%+v)", len(out), out)
 	}
@@ -570,8 +572,8 @@ func TestRunLintRulesParallelMatchesSequentialSet(t *testing.T) {
 	}
 	ctx := &model.ProjectContext{}
 
-	seq := runLintRulesSequential(files, rules, ctx, 0)
-	par := runLintRulesParallel(files, rules, ctx, 4)
+	seq := runLintRulesSequential(files, rules, ctx, 0, 1, nil)
+	par := runLintRulesParallel(files, rules, ctx, 4, 1, nil)
 
 	normalize := func(in []model.Violation) []string {
 		out := make([]string, 0, len(in))
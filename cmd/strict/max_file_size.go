@@ -0,0 +1,40 @@
+// max_file_size.go — `--max-file-size`: skip files too large to lint in reasonable time.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// defaultMaxFileSize is the --max-file-size default: 5 MiB, well above hand-written
+// source and below the generated bundles that slip past generated-file detection.
+const defaultMaxFileSize = 5 << 20
+
+// filterFilePathsBySize splits paths into those at most maxSize bytes and those larger.
+// A maxSize of 0 keeps everything. Paths that cannot be stat'ed are kept so that the
+// read error is reported as usual.
+func filterFilePathsBySize(paths []string, maxSize int64) ([]string, []string) {
+	if maxSize <= 0 {
+		return paths, nil
+	}
+	kept := make([]string, 0, len(paths))
+	skipped := make([]string, 0)
+	for _, pathValue := range paths {
+		if info, err := os.Stat(pathValue); err == nil && info.Size() > maxSize {
+			skipped = append(skipped, pathValue)
+			continue
+		}
+		kept = append(kept, pathValue)
+	}
+	return kept, skipped
+}
+
+// warnSkippedLargeFiles tells the user which files --max-file-size left out.
+func warnSkippedLargeFiles(w io.Writer, skipped []string, maxSize int64) {
+	if len(skipped) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Warning: skipped %d file(s) larger than --max-file-size %d bytes: %s\n", len(skipped), maxSize, strings.Join(skipped, ", "))
+}
@@ -0,0 +1,41 @@
+// max_file_size_test.go — Tests for --max-file-size filtering.
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFilterFilePathsBySize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	small := filepath.Join(dir, "small.go")
+	large := filepath.Join(dir, "bundle.js")
+	missing := filepath.Join(dir, "missing.go")
+	if err := os.WriteFile(small, []byte("package a\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(large, bytes.Repeat([]byte("x"), 64), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	kept, skipped := filterFilePathsBySize([]string{small, large, missing}, 32)
+	if !reflect.DeepEqual(kept, []string{small, missing}) || !reflect.DeepEqual(skipped, []string{large}) {
+		t.Fatalf("kept = %v, skipped = %v", kept, skipped)
+	}
+	kept, skipped = filterFilePathsBySize([]string{small, large}, 0)
+	if len(kept) != 2 || len(skipped) != 0 {
+		t.Fatalf("0 should mean unlimited, kept = %v, skipped = %v", kept, skipped)
+	}
+
+	var warning strings.Builder
+	warnSkippedLargeFiles(&warning, []string{"dist/app.js"}, 32)
+	if warning.String() != "Warning: skipped 1 file(s) larger than --max-file-size 32 bytes: dist/app.js\n" {
+		t.Fatalf("warning = %q", warning.String())
+	}
+}
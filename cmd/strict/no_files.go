@@ -0,0 +1,26 @@
+// no_files.go — --fail-on-no-files: fail when filters leave nothing to lint.
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// noFilesExitCode is the --fail-on-no-files status, distinct from the 1 for errors
+// found and the 2 for usage errors, so CI can tell "nothing matched" from "lint failed".
+const noFilesExitCode = 3
+
+// reportNoFiles explains why --fail-on-no-files stopped the run. source is the archive
+// or file list the files came from, or empty for walked paths.
+func reportNoFiles(w io.Writer, paths []string, source string) {
+	from := strings.Join(paths, ", ")
+	switch source {
+	case "":
+	case "-":
+		from = "stdin"
+	default:
+		from = source
+	}
+	fmt.Fprintf(w, "Error: no files to lint in %s after filters (--ext, --changed, --staged, --since, --max-file-size); check the paths and filters, or drop --fail-on-no-files\n", from)
+}
@@ -0,0 +1,22 @@
+// no_files_test.go — Tests for --fail-on-no-files reporting.
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReportNoFiles(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+	reportNoFiles(&out, []string{"src", "lib"}, "")
+	if !strings.HasPrefix(out.String(), "Error: no files to lint in src, lib after filters") {
+		t.Fatalf("message = %q", out.String())
+	}
+	out.Reset()
+	reportNoFiles(&out, []string{"."}, "changed.txt")
+	if !strings.HasPrefix(out.String(), "Error: no files to lint in changed.txt after filters") {
+		t.Fatalf("message = %q", out.String())
+	}
+}
@@ -0,0 +1,116 @@
+// output_dir.go — Per-file report fan-out for lint --output-dir.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// lintReportRenderer renders one report for the linted files, their violations, and the summary.
+type lintReportRenderer func(files []string, violations []model.Violation, summary map[string]interface{}) ([]byte, error)
+
+func reportFileExtension(format string) string {
+	switch format {
+	case "json":
+		return ".json"
+	case "sarif":
+		return ".sarif"
+	case "junit":
+		return ".xml"
+	default:
+		return ".txt"
+	}
+}
+
+// perFileReportPath maps a linted source path to its report path under dir, mirroring
+// the source tree. Absolute paths are re-rooted under dir and `..` segments become `__`
+// so reports can never land outside dir.
+func perFileReportPath(dir string, sourcePath string, format string) string {
+	cleaned := filepath.Clean(sourcePath)
+	cleaned = strings.TrimPrefix(cleaned, filepath.VolumeName(cleaned))
+	segments := strings.Split(filepath.ToSlash(cleaned), "/")
+	kept := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		switch segment {
+		case "", ".":
+			continue
+		case "..":
+			kept = append(kept, "__")
+		default:
+			kept = append(kept, segment)
+		}
+	}
+	return filepath.Join(append([]string{dir}, kept...)...) + reportFileExtension(format)
+}
+
+// writePerFileReports writes one report per linted file (including clean files) and
+// returns the number of reports written.
+func writePerFileReports(dir string, format string, files []*model.UnifiedFileModel, violations []model.Violation, elapsedMs int64, render lintReportRenderer) (int, error) {
+	byFile := map[string][]model.Violation{}
+	for _, v := range violations {
+		byFile[v.FilePath] = append(byFile[v.FilePath], v)
+	}
+	paths := make([]string, 0, len(files))
+	seen := map[string]bool{}
+	for _, file := range files {
+		if !seen[file.Path] {
+			seen[file.Path] = true
+			paths = append(paths, file.Path)
+		}
+	}
+	for p := range byFile {
+		if !seen[p] {
+			seen[p] = true
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		fileViolations := byFile[p]
+		if fileViolations == nil {
+			fileViolations = []model.Violation{}
+		}
+		report, err := render([]string{p}, fileViolations, perFileSummary(fileViolations, elapsedMs))
+		if err != nil {
+			return 0, fmt.Errorf("render report for %s: %w", p, err)
+		}
+		target := perFileReportPath(dir, p, format)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return 0, fmt.Errorf("create output directory for %s: %w", target, err)
+		}
+		if err := os.WriteFile(target, report, 0o644); err != nil {
+			return 0, fmt.Errorf("write output file %s: %w", target, err)
+		}
+	}
+	return len(paths), nil
+}
+
+func perFileSummary(violations []model.Violation, elapsedMs int64) map[string]interface{} {
+	errorCount, warnCount := 0, 0
+	for _, v := range violations {
+		switch strings.ToLower(v.Severity) {
+		case "error":
+			errorCount++
+		case "warn", "warning":
+			warnCount++
+		}
+	}
+	filesWithIssues := 0
+	if len(violations) > 0 {
+		filesWithIssues = 1
+	}
+	return map[string]interface{}{
+		"filesChecked":    1,
+		"filesWithIssues": filesWithIssues,
+		"totalViolations": len(violations),
+		"errors":          errorCount,
+		"warnings":        warnCount,
+		"elapsedMs":       elapsedMs,
+	}
+}
@@ -0,0 +1,74 @@
+// output_dir_test.go — Tests for --output-dir per-file report paths and fan-out.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestPerFileReportPath(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join("out", "reports")
+	cases := []struct {
+		source string
+		format string
+		want   string
+	}{
+		{source: "src/app/user.ts", format: "json", want: filepath.Join(dir, "src", "app", "user.ts.json")},
+		{source: "./main.go", format: "text", want: filepath.Join(dir, "main.go.txt")},
+		{source: "../shared/types.go", format: "sarif", want: filepath.Join(dir, "__", "shared", "types.go.sarif")},
+		{source: "/abs/pkg/a.go", format: "junit", want: filepath.Join(dir, "abs", "pkg", "a.go.xml")},
+	}
+	for _, tc := range cases {
+		if got := perFileReportPath(dir, tc.source, tc.format); got != tc.want {
+			t.Fatalf("perFileReportPath(%q, %q) = %q, want %q", tc.source, tc.format, got, tc.want)
+		}
+	}
+}
+
+func TestWritePerFileReports(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := []*model.UnifiedFileModel{{Path: "pkg/a.go"}, {Path: "pkg/clean.go"}}
+	violations := []model.Violation{
+		{FilePath: "pkg/a.go", RuleID: "RULE-A", Severity: "error"},
+		{FilePath: "pkg/a.go", RuleID: "RULE-B", Severity: "warn"},
+	}
+	render := func(_ []string, vs []model.Violation, summary map[string]interface{}) ([]byte, error) {
+		ids := make([]string, 0, len(vs))
+		for _, v := range vs {
+			ids = append(ids, v.RuleID)
+		}
+		return []byte(fmt.Sprintf("%s errors=%v warnings=%v files=%v\n", strings.Join(ids, ","), summary["errors"], summary["warnings"], summary["filesChecked"])), nil
+	}
+
+	written, err := writePerFileReports(dir, "text", files, violations, 5, render)
+	if err != nil {
+		t.Fatalf("writePerFileReports() error = %v", err)
+	}
+	if written != 2 {
+		t.Fatalf("written = %d, want 2", written)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "pkg", "a.go.txt"))
+	if err != nil {
+		t.Fatalf("read a.go report: %v", err)
+	}
+	if got := string(data); got != "RULE-A,RULE-B errors=1 warnings=1 files=1\n" {
+		t.Fatalf("a.go report = %q", got)
+	}
+	data, err = os.ReadFile(filepath.Join(dir, "pkg", "clean.go.txt"))
+	if err != nil {
+		t.Fatalf("read clean.go report: %v", err)
+	}
+	if got := string(data); got != " errors=0 warnings=0 files=1\n" {
+		t.Fatalf("clean.go report = %q", got)
+	}
+}
@@ -0,0 +1,104 @@
+// output_template.go — `--output-template` and `--summary-template`: text output rendered with text/template.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// outputTemplates replaces the text report: violation is executed once per
+// model.Violation and summary once against the summary map. Either may be nil.
+type outputTemplates struct {
+	violation *template.Template
+	summary   *template.Template
+}
+
+// validateOutputTemplateFlags rejects combinations the templates cannot serve. Templates
+// only replace text output, the summary template is an addition to the violation
+// template, and --summary-only needs a summary template to have anything to print.
+func validateOutputTemplateFlags(format string, violationText string, summaryText string, summaryOnly bool) error {
+	if violationText == "" && summaryText == "" {
+		return nil
+	}
+	switch {
+	case format != "text":
+		return fmt.Errorf("--output-template and --summary-template require --format text, not %s", format)
+	case violationText == "":
+		return errors.New("--summary-template requires --output-template")
+	case summaryOnly && summaryText == "":
+		return errors.New("--summary-only with --output-template requires --summary-template")
+	}
+	return nil
+}
+
+// parseOutputTemplates parses both templates and executes them once against sample data,
+// so a misspelled field fails before linting rather than after. It returns nil when
+// neither template is set.
+func parseOutputTemplates(violationText string, summaryText string) (*outputTemplates, error) {
+	if violationText == "" && summaryText == "" {
+		return nil, nil
+	}
+	templates := &outputTemplates{}
+	var err error
+	if templates.violation, err = parseOutputTemplate("output-template", violationText, model.Violation{Context: &model.ViolationContext{}}); err != nil {
+		return nil, err
+	}
+	sampleSummary := map[string]interface{}{
+		"filesChecked": 0, "filesWithIssues": 0, "totalViolations": 0, "errors": 0, "warnings": 0, "elapsedMs": int64(0),
+	}
+	if templates.summary, err = parseOutputTemplate("summary-template", summaryText, sampleSummary); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func parseOutputTemplate(name string, text string, sample interface{}) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	if err := tmpl.Execute(io.Discard, sample); err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// render writes one line per violation and then the summary. A newline is added to
+// each rendering that does not already end with one.
+func (t *outputTemplates) render(violations []model.Violation, summary map[string]interface{}, summaryOnly bool) ([]byte, error) {
+	var out bytes.Buffer
+	if t.violation != nil && !summaryOnly {
+		for _, v := range violations {
+			if err := executeOutputTemplate(&out, t.violation, v); err != nil {
+				return nil, fmt.Errorf("render --output-template for %s:%d: %w", v.FilePath, v.StartLine, err)
+			}
+		}
+	}
+	if t.summary != nil {
+		if err := executeOutputTemplate(&out, t.summary, summary); err != nil {
+			return nil, fmt.Errorf("render --summary-template: %w", err)
+		}
+	}
+	return out.Bytes(), nil
+}
+
+func executeOutputTemplate(out *bytes.Buffer, tmpl *template.Template, data interface{}) error {
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return err
+	}
+	out.WriteString(rendered.String())
+	if !strings.HasSuffix(rendered.String(), "\n") {
+		out.WriteByte('\n')
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// output_template_test.go — Tests for --output-template and --summary-template.
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestValidateOutputTemplateFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		format      string
+		violation   string
+		summary     string
+		summaryOnly bool
+		wantErr     string
+	}{
+		{name: "no templates", format: "json"},
+		{name: "violation template", format: "text", violation: "{{.RuleID}}"},
+		{name: "summary only with summary template", format: "text", violation: "{{.RuleID}}", summary: "{{.errors}}", summaryOnly: true},
+		{name: "non-text format", format: "json", violation: "{{.RuleID}}", wantErr: "require --format text"},
+		{name: "summary without violation template", format: "text", summary: "{{.errors}}", wantErr: "requires --output-template"},
+		{name: "summary only without summary template", format: "text", violation: "{{.RuleID}}", summaryOnly: true, wantErr: "requires --summary-template"},
+	}
+	for _, tt := range tests {
+		err := validateOutputTemplateFlags(tt.format, tt.violation, tt.summary, tt.summaryOnly)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Fatalf("%s: error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseOutputTemplatesRejectsInvalidTemplates(t *testing.T) {
+	t.Parallel()
+
+	if templates, err := parseOutputTemplates("", ""); templates != nil || err != nil {
+		t.Fatalf("no templates should parse to nil, got %v, %v", templates, err)
+	}
+	if _, err := parseOutputTemplates("{{.RuleID", ""); err == nil || !strings.Contains(err.Error(), "invalid --output-template") {
+		t.Fatalf("syntax error = %v", err)
+	}
+	if _, err := parseOutputTemplates("{{.File}}:{{.StartLine}}", ""); err == nil || !strings.Contains(err.Error(), "File") {
+		t.Fatalf("unknown field should fail validation, got %v", err)
+	}
+	if _, err := parseOutputTemplates("{{.RuleID}}", "{{.errors | len}}"); err == nil || !strings.Contains(err.Error(), "invalid --summary-template") {
+		t.Fatalf("summary execution error = %v", err)
+	}
+}
+
+func TestOutputTemplatesRender(t *testing.T) {
+	t.Parallel()
+
+	templates, err := parseOutputTemplates(
+		"{{.FilePath}}:{{.StartLine}} {{.RuleID}} {{.Message}}{{with .Context}}{{if .SuggestedFix}} fix={{.SuggestedFix}}{{end}}{{end}}",
+		"total={{.totalViolations}} errors={{.errors}}\n",
+	)
+	if err != nil {
+		t.Fatalf("parseOutputTemplates error = %v", err)
+	}
+	violations := []model.Violation{
+		{RuleID: "CONV-file-header", FilePath: "a.go", StartLine: 1, Message: "missing header"},
+		{RuleID: "TQ-no-shallow-assertions", FilePath: "a_test.go", StartLine: 9, Message: "shallow", Context: &model.ViolationContext{SuggestedFix: "assert the value"}},
+	}
+	summary := map[string]interface{}{"totalViolations": 2, "errors": 2}
+
+	got, err := templates.render(violations, summary, false)
+	if err != nil {
+		t.Fatalf("render error = %v", err)
+	}
+	want := "a.go:1 CONV-file-header missing header\na_test.go:9 TQ-no-shallow-assertions shallow fix=assert the value\ntotal=2 errors=2\n"
+	if string(got) != want {
+		t.Fatalf("render = %q, want %q", got, want)
+	}
+
+	got, err = templates.render(violations, summary, true)
+	if err != nil || string(got) != "total=2 errors=2\n" {
+		t.Fatalf("summary-only render = %q, %v", got, err)
+	}
+}
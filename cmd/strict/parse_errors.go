@@ -0,0 +1,57 @@
+// parse_errors.go — Report unreadable lint inputs as PARSE-error violations instead of aborting.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// parseErrorRuleID is the synthetic rule ID for files that could not be loaded.
+const parseErrorRuleID = "PARSE-error"
+
+// loadUnifiedFile reads one lint input into a UnifiedFileModel.
+func loadUnifiedFile(pathValue string) (*model.UnifiedFileModel, error) {
+	data, err := os.ReadFile(pathValue)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UnifiedFileModel{
+		Path:       filepath.ToSlash(pathValue),
+		Language:   detectLanguage(pathValue),
+		Source:     data,
+		LineCount:  countLines(data),
+		IsTestFile: looksLikeTestFile(pathValue),
+	}, nil
+}
+
+// buildUnifiedFilesTolerant loads every path it can. Each file that fails becomes a
+// PARSE-error violation, so one bad file does not stop the rest of the run.
+func buildUnifiedFilesTolerant(paths []string) ([]*model.UnifiedFileModel, []model.Violation) {
+	files := make([]*model.UnifiedFileModel, 0, len(paths))
+	failures := make([]model.Violation, 0)
+	for _, pathValue := range paths {
+		file, err := loadUnifiedFile(pathValue)
+		if err != nil {
+			failures = append(failures, parseErrorViolation(pathValue, err))
+			continue
+		}
+		files = append(files, file)
+	}
+	return files, failures
+}
+
+func parseErrorViolation(pathValue string, err error) model.Violation {
+	return model.Violation{
+		RuleID:    parseErrorRuleID,
+		Severity:  "error",
+		Message:   fmt.Sprintf("File could not be read: %v", err),
+		FilePath:  filepath.ToSlash(pathValue),
+		StartLine: 1,
+		Context: &model.ViolationContext{
+			SuggestedFix: "Fix the file's permissions or contents, or exclude it from the lint paths.",
+		},
+	}
+}
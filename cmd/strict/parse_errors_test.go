@@ -0,0 +1,39 @@
+// parse_errors_test.go — Tests for PARSE-error reporting of unreadable files.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildUnifiedFilesTolerantReportsUnreadableFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.go")
+	if err := os.WriteFile(good, []byte("package good\n"), 0o644); err != nil {
+		t.Fatalf("write good file: %v", err)
+	}
+	missing := filepath.Join(dir, "missing.go")
+
+	files, failures := buildUnifiedFilesTolerant([]string{missing, good})
+	if len(files) != 1 || files[0].Path != filepath.ToSlash(good) || files[0].Language != "go" {
+		t.Fatalf("files = %+v, want only good.go", files)
+	}
+	if len(failures) != 1 {
+		t.Fatalf("failures = %+v, want one", failures)
+	}
+	v := failures[0]
+	if v.RuleID != parseErrorRuleID || v.Severity != "error" || v.FilePath != filepath.ToSlash(missing) || v.StartLine != 1 {
+		t.Fatalf("unexpected violation: %+v", v)
+	}
+	if !strings.HasPrefix(v.Message, "File could not be read: ") {
+		t.Fatalf("message = %q", v.Message)
+	}
+
+	if _, err := buildUnifiedFiles([]string{missing, good}); err == nil {
+		t.Fatalf("buildUnifiedFiles should stay strict")
+	}
+}
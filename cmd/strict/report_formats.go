@@ -0,0 +1,51 @@
+// report_formats.go — SARIF and JUnit rendering for lint reports.
+package main
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/reporter"
+)
+
+func renderReporter(r reporter.Reporter, violations []model.Violation, summary map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := r.Report(&buf, violations, reporterSummary(summary)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// reporterSummary converts the lint summary map into the reporter package's Summary.
+func reporterSummary(summary map[string]interface{}) reporter.Summary {
+	count := func(key string) int64 {
+		switch v := summary[key].(type) {
+		case int:
+			return int64(v)
+		case int64:
+			return v
+		default:
+			return 0
+		}
+	}
+	partial, _ := summary["partial"].(bool)
+	return reporter.Summary{
+		TotalFiles:      int(count("filesChecked")),
+		FilesWithIssues: int(count("filesWithIssues")),
+		TotalViolations: int(count("totalViolations")),
+		ErrorCount:      int(count("errors")),
+		WarningCount:    int(count("warnings")),
+		Duration:        count("elapsedMs"),
+		Partial:         partial,
+	}
+}
+
+func lintedFilePaths(files []*model.UnifiedFileModel) []string {
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,33 @@
+// report_formats_test.go — Tests for lint summary conversion used by SARIF/JUnit output.
+package main
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestReporterSummary(t *testing.T) {
+	t.Parallel()
+
+	got := reporterSummary(map[string]interface{}{
+		"filesChecked":    3,
+		"filesWithIssues": 1,
+		"totalViolations": 2,
+		"errors":          1,
+		"warnings":        1,
+		"elapsedMs":       int64(42),
+	})
+	if got.TotalFiles != 3 || got.FilesWithIssues != 1 || got.TotalViolations != 2 || got.ErrorCount != 1 || got.WarningCount != 1 || got.Duration != 42 {
+		t.Fatalf("reporterSummary() = %+v", got)
+	}
+}
+
+func TestLintedFilePathsSorted(t *testing.T) {
+	t.Parallel()
+
+	got := lintedFilePaths([]*model.UnifiedFileModel{{Path: "b.go"}, {Path: "a.go"}})
+	if len(got) != 2 || got[0] != "a.go" || got[1] != "b.go" {
+		t.Fatalf("lintedFilePaths() = %v", got)
+	}
+}
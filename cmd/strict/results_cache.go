@@ -0,0 +1,184 @@
+// results_cache.go — --results-cache: reuse per-file rule results for unchanged files.
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+)
+
+// resultsCacheFormatVersion is bumped whenever the entry layout changes.
+const resultsCacheFormatVersion = "1"
+
+// resultsCache stores the violations each file-local rule reported for a file, one
+// entry per file in dir (results/ in the project's cache directory), named by the hash
+// of its path. An entry is only reused while both the file content and the rule set
+// hash are unchanged. Rules that need project context or read other files always run
+// (see cacheable).
+type resultsCache struct {
+	dir         string
+	ruleSetHash string
+
+	mu      sync.Mutex
+	entries map[string]*resultsCacheEntry
+	dirty   map[string]bool
+	hits    int
+	misses  int
+}
+
+type resultsCacheEntry struct {
+	Key   string                       `json:"key"`
+	Rules map[string][]model.Violation `json:"rules"`
+}
+
+func newResultsCache(dir string, ruleSetHash string) *resultsCache {
+	return &resultsCache{
+		dir:         dir,
+		ruleSetHash: ruleSetHash,
+		entries:     map[string]*resultsCacheEntry{},
+		dirty:       map[string]bool{},
+	}
+}
+
+// resultsCacheRuleSetHash fingerprints everything besides file content that can change
+// a rule's output: the stricture version, the loaded config (severity overrides, rule
+// options, excludes), each selected rule's effective config, and the content of every
+// local plugin file.
+func resultsCacheRuleSetHash(rules []model.Rule, cfg *config.Config, pluginPaths []string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "format=%s\nversion=%s\n", resultsCacheFormatVersion, version)
+	if encoded, err := json.Marshal(cfg); err == nil {
+		h.Write(encoded)
+	}
+	for _, rule := range rules {
+		fmt.Fprintf(h, "\nrule=%s", rule.ID())
+		if withCfg, ok := rule.(lintRuleWithConfig); ok {
+			if encoded, err := json.Marshal(withCfg.Config); err == nil {
+				h.Write(encoded)
+			}
+		}
+	}
+	sorted := append([]string(nil), pluginPaths...)
+	sort.Strings(sorted)
+	for _, p := range sorted {
+		fmt.Fprintf(h, "\nplugin=%s\n", p)
+		if data, err := os.ReadFile(p); err == nil {
+			h.Write(data)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// attach returns rules with the cache threaded into every file-local rule.
+func (c *resultsCache) attach(rules []model.Rule) []model.Rule {
+	attached := make([]model.Rule, len(rules))
+	for i, rule := range rules {
+		if withCfg, ok := rule.(lintRuleWithConfig); ok && cacheable(withCfg.Rule) {
+			withCfg.Results = c
+			rule = withCfg
+		}
+		attached[i] = rule
+	}
+	return attached
+}
+
+// cacheable reports whether a rule's violations depend only on the checked file. Rules
+// that need project context see every file, and rules that read fixtures, snapshots,
+// or git history see inputs the content hash does not cover, so both always run.
+func cacheable(rule model.Rule) bool {
+	return !rule.NeedsProjectContext() && !model.ReadsExternalInputs(rule)
+}
+
+// lookup returns the cached violations ruleID reported for file, if any.
+func (c *resultsCache) lookup(file *model.UnifiedFileModel, ruleID string) ([]model.Violation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := c.entryLocked(file)
+	cached, ok := entry.Rules[ruleID]
+	if ok {
+		c.hits++
+		return append([]model.Violation(nil), cached...), true
+	}
+	c.misses++
+	return nil, false
+}
+
+// store records the violations ruleID reported for file.
+func (c *resultsCache) store(file *model.UnifiedFileModel, ruleID string, violations []model.Violation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := c.entryLocked(file)
+	entry.Rules[ruleID] = append(make([]model.Violation, 0, len(violations)), violations...)
+	c.dirty[file.Path] = true
+}
+
+// entryLocked loads the entry for file from disk on first use and discards it when
+// the content or rule set changed. c.mu must be held.
+func (c *resultsCache) entryLocked(file *model.UnifiedFileModel) *resultsCacheEntry {
+	if entry, ok := c.entries[file.Path]; ok {
+		return entry
+	}
+	key := c.entryKey(file)
+	entry := &resultsCacheEntry{Key: key, Rules: map[string][]model.Violation{}}
+	if data, err := os.ReadFile(c.entryPath(file.Path)); err == nil {
+		var stored resultsCacheEntry
+		if json.Unmarshal(data, &stored) == nil && stored.Key == key && stored.Rules != nil {
+			entry = &stored
+		}
+	}
+	c.entries[file.Path] = entry
+	return entry
+}
+
+func (c *resultsCache) entryKey(file *model.UnifiedFileModel) string {
+	sum := sha256.Sum256(file.Source)
+	return hex.EncodeToString(sum[:]) + ":" + c.ruleSetHash
+}
+
+func (c *resultsCache) entryPath(filePath string) string {
+	sum := sha256.Sum256([]byte(filepath.ToSlash(filePath)))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// save writes every entry that gained results during this run.
+func (c *resultsCache) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.dirty) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("create results cache %s: %w", c.dir, err)
+	}
+	paths := make([]string, 0, len(c.dirty))
+	for p := range c.dirty {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		encoded, err := json.Marshal(c.entries[p])
+		if err != nil {
+			return fmt.Errorf("encode results cache entry for %s: %w", p, err)
+		}
+		if err := os.WriteFile(c.entryPath(p), encoded, 0o644); err != nil {
+			return fmt.Errorf("write results cache entry for %s: %w", p, err)
+		}
+	}
+	c.dirty = map[string]bool{}
+	return nil
+}
+
+// stats returns how many (file, rule) checks were served from the cache and how many ran.
+func (c *resultsCache) stats() (hits int, misses int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
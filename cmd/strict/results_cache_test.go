@@ -0,0 +1,107 @@
+// results_cache_test.go — Tests for reusing per-file rule results with --results-cache.
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// countingRule records how often Check runs so tests can tell cached results from fresh ones.
+type countingRule struct {
+	fakeRule
+	calls          *int
+	projectContext bool
+}
+
+func (r countingRule) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, cfg model.RuleConfig) []model.Violation {
+	*r.calls++
+	return r.fakeRule.Check(file, ctx, cfg)
+}
+
+func (r countingRule) NeedsProjectContext() bool { return r.projectContext }
+
+func TestResultsCacheReusesUnchangedFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "results")
+	var localCalls, projectCalls int
+	rules := []model.Rule{
+		lintRuleWithConfig{Rule: countingRule{
+			fakeRule: fakeRule{id: "RULE-local", violations: []model.Violation{{Severity: "error", FilePath: "a.go", StartLine: 2, Message: "local"}}},
+			calls:    &localCalls,
+		}, Config: model.RuleConfig{Severity: "error"}},
+		lintRuleWithConfig{Rule: countingRule{
+			fakeRule:       fakeRule{id: "RULE-project"},
+			calls:          &projectCalls,
+			projectContext: true,
+		}, Config: model.RuleConfig{Severity: "error"}},
+	}
+	file := &model.UnifiedFileModel{Path: "a.go", Source: []byte("package a\nvar x = 1\n")}
+
+	first := newResultsCache(dir, "rules-v1")
+	want := runLintRulesForFile(file, first.attach(rules), &model.ProjectContext{}, 0, 1, nil)
+	if err := first.save(); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	second := newResultsCache(dir, "rules-v1")
+	got := runLintRulesForFile(file, second.attach(rules), &model.ProjectContext{}, 0, 1, nil)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("cached violations differ:\n got %+v\nwant %+v", got, want)
+	}
+	if localCalls != 1 {
+		t.Fatalf("file-local rule ran %d times, want 1 (second run served from cache)", localCalls)
+	}
+	if projectCalls != 2 {
+		t.Fatalf("project-context rule ran %d times, want 2 (never cached)", projectCalls)
+	}
+	if hits, misses := second.stats(); hits != 1 || misses != 0 {
+		t.Fatalf("stats = %d hits, %d misses; want 1, 0", hits, misses)
+	}
+
+	changed := &model.UnifiedFileModel{Path: "a.go", Source: []byte("package a\nvar x = 2\n")}
+	runLintRulesForFile(changed, newResultsCache(dir, "rules-v1").attach(rules), &model.ProjectContext{}, 0, 1, nil)
+	if localCalls != 2 {
+		t.Fatalf("changed content should re-run the rule, calls = %d", localCalls)
+	}
+	runLintRulesForFile(file, newResultsCache(dir, "rules-v2").attach(rules), &model.ProjectContext{}, 0, 1, nil)
+	if localCalls != 3 {
+		t.Fatalf("changed rule set should re-run the rule, calls = %d", localCalls)
+	}
+}
+
+func TestResultsCacheRuleSetHashTracksConfig(t *testing.T) {
+	rules := []model.Rule{lintRuleWithConfig{Rule: fakeRule{id: "RULE-a"}, Config: model.RuleConfig{Severity: "error"}}}
+	base := resultsCacheRuleSetHash(rules, nil, nil)
+	if base != resultsCacheRuleSetHash(rules, nil, nil) {
+		t.Fatal("rule set hash should be deterministic")
+	}
+	changed := []model.Rule{lintRuleWithConfig{Rule: fakeRule{id: "RULE-a"}, Config: model.RuleConfig{Severity: "warn"}}}
+	if base == resultsCacheRuleSetHash(changed, nil, nil) {
+		t.Fatal("rule severity change should change the rule set hash")
+	}
+	withOption := []model.Rule{lintRuleWithConfig{Rule: fakeRule{id: "RULE-a"}, Config: model.RuleConfig{Severity: "error", Options: map[string]interface{}{"max": 3}}}}
+	if base == resultsCacheRuleSetHash(withOption, nil, nil) {
+		t.Fatal("rule option change should change the rule set hash")
+	}
+}
+
+func TestResultsCacheSkipsRulesReadingExternalInputs(t *testing.T) {
+	if cacheable(countingRule{projectContext: true}) {
+		t.Fatal("project-context rules must not be cached")
+	}
+	if !cacheable(fakeRule{id: "RULE-a"}) {
+		t.Fatal("file-local rules should be cached")
+	}
+	registry := buildRegistry()
+	for _, id := range []string{"TQ-snapshot-test-staleness", "TQ-schema-conformance", "CTR-request-required-fields"} {
+		rule, ok := registry.ByID(id)
+		if !ok {
+			t.Fatalf("rule %s not registered", id)
+		}
+		if cacheable(rule) {
+			t.Fatalf("%s reads files beyond the checked one and must not be cached", id)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// rule_categories.go — Plugin registration and category lookups shared by lint and list-rules.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/plugins"
+)
+
+// registerPluginRules loads plugin files referenced by a config and adds their rules to registry.
+func registerPluginRules(registry *model.RuleRegistry, configPath string, pluginPaths []string) error {
+	if len(pluginPaths) == 0 {
+		return nil
+	}
+	pluginRules, err := plugins.Load(resolvePluginPaths(configPath, pluginPaths))
+	if err != nil {
+		return err
+	}
+	for _, r := range pluginRules {
+		registry.Register(r)
+	}
+	return nil
+}
+
+// registryWithConfigPlugins returns the built-in registry plus any plugins listed in the
+// config at configPath. A missing config yields only the built-in rules.
+func registryWithConfigPlugins(configPath string) (*model.RuleRegistry, error) {
+	registry := buildRegistry()
+	resolvedConfigPath := resolveConfigPath(configPath)
+	cfg, err := config.Load(resolvedConfigPath)
+	if err != nil {
+		if errors.Is(err, model.ErrConfigNotFound) {
+			return registry, nil
+		}
+		return nil, fmt.Errorf("invalid config %s: %v", resolvedConfigPath, err)
+	}
+	if err := registerPluginRules(registry, resolvedConfigPath, cfg.Plugins); err != nil {
+		return nil, fmt.Errorf("load plugins: %v", err)
+	}
+	return registry, nil
+}
+
+func registryHasCategory(registry *model.RuleRegistry, category string) bool {
+	return rulesInCategory(registry.All(), category)
+}
+
+func rulesInCategory(rules []model.Rule, category string) bool {
+	for _, r := range rules {
+		if strings.EqualFold(strings.TrimSpace(r.Category()), category) {
+			return true
+		}
+	}
+	return false
+}
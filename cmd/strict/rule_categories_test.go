@@ -0,0 +1,78 @@
+// rule_categories_test.go — Tests for data-driven category ordering and selection.
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+)
+
+type categorizedRule struct {
+	fakeRule
+	category string
+}
+
+func (r categorizedRule) Category() string {
+	return r.category
+}
+
+func registryWithPluginCategories() *model.RuleRegistry {
+	registry := buildRegistry()
+	registry.Register(categorizedRule{fakeRule: fakeRule{id: "SEC-no-eval"}, category: "security"})
+	registry.Register(categorizedRule{fakeRule: fakeRule{id: "A11Y-alt-text"}, category: "a11y"})
+	return registry
+}
+
+func TestRegistryCategoriesOrdersBuiltinsThenPlugins(t *testing.T) {
+	t.Parallel()
+
+	got := registryWithPluginCategories().Categories()
+	want := []string{"tq", "arch", "conv", "ctr", "a11y", "security"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Categories() = %v, want %v", got, want)
+	}
+}
+
+func TestSortedRulesForDisplayPlacesPluginCategoriesLast(t *testing.T) {
+	t.Parallel()
+
+	rules := sortedRulesForDisplay(registryWithPluginCategories())
+	n := len(rules)
+	if rules[n-2].ID() != "A11Y-alt-text" || rules[n-1].ID() != "SEC-no-eval" {
+		t.Fatalf("last rules = %s, %s; want A11Y-alt-text, SEC-no-eval", rules[n-2].ID(), rules[n-1].ID())
+	}
+	if rules[0].Category() != "tq" {
+		t.Fatalf("first rule category = %q, want tq", rules[0].Category())
+	}
+}
+
+func TestResolveLintRulesSelectsPluginCategory(t *testing.T) {
+	t.Parallel()
+
+	registry := registryWithPluginCategories()
+	cfg := &config.Config{Rules: map[string]model.RuleConfig{"CONV-file-header": {Severity: "error"}}}
+
+	selected, err := resolveLintRules(registry, cfg, nil, "security", nil, false)
+	if err != nil {
+		t.Fatalf("resolveLintRules() error = %v", err)
+	}
+	if len(selected) != 1 || selected[0].ID() != "SEC-no-eval" {
+		t.Fatalf("selected = %v, want only SEC-no-eval", selected)
+	}
+
+	selected, err = resolveLintRules(registry, cfg, nil, "conv", nil, false)
+	if err != nil {
+		t.Fatalf("resolveLintRules() error = %v", err)
+	}
+	if len(selected) != 1 || selected[0].ID() != "CONV-file-header" {
+		t.Fatalf("configured category should keep config selection, got %v", selected)
+	}
+
+	_, err = resolveLintRules(registry, cfg, nil, "perf", nil, false)
+	if err == nil || !strings.Contains(err.Error(), "available: tq, arch, conv, ctr, a11y, security") {
+		t.Fatalf("unknown category error = %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+// rule_concurrency.go — Runs the rules for a single file in parallel (--concurrency-rules).
+package main
+
+import (
+	"sync"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/suppression"
+)
+
+// runFileRulesConcurrently checks file with up to limit rules at a time. Each rule
+// writes to its own slot and slots are joined in rule order, so output matches the
+// sequential path. Panic recovery stays per rule via checkLintRule.
+func runFileRulesConcurrently(file *model.UnifiedFileModel, rules []model.Rule, ctx *model.ProjectContext, policy *suppression.Policy, limit int, cfg *config.Config) []model.Violation {
+	slots := make([][]model.Violation, len(rules))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, rawRule := range rules {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, rawRule model.Rule) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			slots[i] = checkLintRule(file, rawRule, ctx, policy, cfg)
+		}(i, rawRule)
+	}
+	wg.Wait()
+
+	violations := make([]model.Violation, 0)
+	for _, slot := range slots {
+		violations = append(violations, slot...)
+	}
+	return violations
+}
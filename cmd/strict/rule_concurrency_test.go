@@ -0,0 +1,52 @@
+// rule_concurrency_test.go — Tests for running a file's rules in parallel.
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestRunLintRulesForFileRuleConcurrencyMatchesSequential(t *testing.T) {
+	t.Parallel()
+
+	file := &model.UnifiedFileModel{Path: "big.go", Source: []byte("// stricture-disable-next-line RULE-c\nx\ny\n")}
+	rules := []model.Rule{
+		fakeRule{id: "RULE-a", violations: []model.Violation{{RuleID: "RULE-a", Severity: "error", FilePath: "big.go", StartLine: 3, Message: "a"}}},
+		fakeRule{id: "RULE-b", shouldPanic: true},
+		fakeRule{id: "RULE-c", violations: []model.Violation{{Severity: "warn", FilePath: "big.go", StartLine: 2, Message: "suppressed"}}},
+		fakeRule{id: "RULE-d", violations: []model.Violation{
+			{Severity: "warn", FilePath: "big.go", StartLine: 1, Message: "d1"},
+			{Severity: "warn", FilePath: "big.go", StartLine: 3, Message: "d2"},
+		}},
+	}
+
+	want := runLintRulesForFile(file, rules, &model.ProjectContext{}, 0, 1, nil)
+	if len(want) != 4 {
+		t.Fatalf("sequential violations = %d, want 4 (%+v)", len(want), want)
+	}
+	for _, limit := range []int{2, 4, 16} {
+		got := runLintRulesForFile(file, rules, &model.ProjectContext{}, 0, limit, nil)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("concurrency-rules=%d:\n got %+v\nwant %+v", limit, got, want)
+		}
+	}
+	if want[1].RuleID != "RULE-b" || want[1].Message != "Rule panicked: boom" {
+		t.Fatalf("panic should be recovered per rule, got %+v", want[1])
+	}
+}
+
+func TestRunLintRulesForFileRuleConcurrencyKeepsMaxViolations(t *testing.T) {
+	t.Parallel()
+
+	file := &model.UnifiedFileModel{Path: "a.go", Source: []byte("package a\n")}
+	rules := []model.Rule{
+		fakeRule{id: "RULE-a", violations: []model.Violation{{RuleID: "RULE-a", Severity: "error", StartLine: 1}}},
+		fakeRule{id: "RULE-b", violations: []model.Violation{{RuleID: "RULE-b", Severity: "error", StartLine: 1}}},
+	}
+	got := runLintRulesForFile(file, rules, &model.ProjectContext{}, 1, 4, nil)
+	if len(got) != 1 || got[0].RuleID != "RULE-a" {
+		t.Fatalf("max-violations should stop after the first rule, got %+v", got)
+	}
+}
@@ -0,0 +1,109 @@
+// rule_options.go — Parses --rule-option overrides and merges them into rule config.
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ruleOptionFlag collects repeated --rule-option values verbatim (no comma splitting,
+// so string values may contain commas).
+type ruleOptionFlag []string
+
+func (f *ruleOptionFlag) String() string {
+	return strings.Join(*f, " ")
+}
+
+func (f *ruleOptionFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// parseRuleOptionOverrides turns `RULE-ID.key.sub=value` specs into per-rule option maps.
+func parseRuleOptionOverrides(specs []string) (map[string]map[string]interface{}, error) {
+	overrides := map[string]map[string]interface{}{}
+	for _, raw := range specs {
+		spec := strings.TrimSpace(raw)
+		eq := strings.Index(spec, "=")
+		if eq <= 0 {
+			return nil, fmt.Errorf("invalid --rule-option %q: expected RULE-ID.key=value", raw)
+		}
+		target, value := strings.TrimSpace(spec[:eq]), strings.TrimSpace(spec[eq+1:])
+		dot := strings.Index(target, ".")
+		if dot <= 0 || dot == len(target)-1 {
+			return nil, fmt.Errorf("invalid --rule-option %q: expected RULE-ID.key=value", raw)
+		}
+		ruleID, keyPath := target[:dot], strings.Split(target[dot+1:], ".")
+		for _, key := range keyPath {
+			if strings.TrimSpace(key) == "" {
+				return nil, fmt.Errorf("invalid --rule-option %q: empty key segment", raw)
+			}
+		}
+
+		options := overrides[ruleID]
+		if options == nil {
+			options = map[string]interface{}{}
+			overrides[ruleID] = options
+		}
+		if err := setNestedOption(options, keyPath, parseRuleOptionValue(value)); err != nil {
+			return nil, fmt.Errorf("invalid --rule-option %q: %v", raw, err)
+		}
+	}
+	return overrides, nil
+}
+
+func setNestedOption(options map[string]interface{}, keyPath []string, value interface{}) error {
+	current := options
+	for _, key := range keyPath[:len(keyPath)-1] {
+		next, exists := current[key]
+		if !exists {
+			child := map[string]interface{}{}
+			current[key] = child
+			current = child
+			continue
+		}
+		child, ok := next.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("key %q is already set to a non-map value", key)
+		}
+		current = child
+	}
+	current[keyPath[len(keyPath)-1]] = value
+	return nil
+}
+
+// parseRuleOptionValue infers bool and integer values; everything else stays a string.
+func parseRuleOptionValue(raw string) interface{} {
+	switch strings.ToLower(raw) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if n, err := strconv.Atoi(raw); err == nil {
+		return n
+	}
+	if len(raw) >= 2 && (raw[0] == '"' || raw[0] == '\'') && raw[len(raw)-1] == raw[0] {
+		return raw[1 : len(raw)-1]
+	}
+	return raw
+}
+
+// mergeRuleOptions returns a copy of base with overrides applied; nested maps merge key by key.
+func mergeRuleOptions(base map[string]interface{}, overrides map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(overrides))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overrides {
+		overrideMap, overrideIsMap := value.(map[string]interface{})
+		baseMap, baseIsMap := merged[key].(map[string]interface{})
+		if overrideIsMap && baseIsMap {
+			merged[key] = mergeRuleOptions(baseMap, overrideMap)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
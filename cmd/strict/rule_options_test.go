@@ -0,0 +1,72 @@
+// rule_options_test.go — Tests for --rule-option parsing and merging.
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRuleOptionOverrides(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseRuleOptionOverrides([]string{
+		"CONV-file-naming.style=snake_case",
+		"ARCH-max-file-lines.max=500",
+		"TQ-test-has-no-conditional-assertions.flagLoops=false",
+		"CONV-required-exports.patterns.src/*.ts=a,b",
+		"CONV-file-header.pattern='// {filename}'",
+	})
+	if err != nil {
+		t.Fatalf("parseRuleOptionOverrides() error = %v", err)
+	}
+
+	want := map[string]map[string]interface{}{
+		"CONV-file-naming":                      {"style": "snake_case"},
+		"ARCH-max-file-lines":                   {"max": 500},
+		"TQ-test-has-no-conditional-assertions": {"flagLoops": false},
+		"CONV-required-exports": {
+			"patterns": map[string]interface{}{"src/*": map[string]interface{}{"ts": "a,b"}},
+		},
+		"CONV-file-header": {"pattern": "// {filename}"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseRuleOptionOverrides() = %#v, want %#v", got, want)
+	}
+}
+
+func TestParseRuleOptionOverridesRejectsMalformedSpecs(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{"CONV-file-naming", "CONV-file-naming=snake", ".style=x", "CONV-file-naming.=x", "CONV-file-naming.a..b=x", "=x"} {
+		if _, err := parseRuleOptionOverrides([]string{spec}); err == nil || !strings.Contains(err.Error(), "--rule-option") {
+			t.Fatalf("spec %q: error = %v, want --rule-option error", spec, err)
+		}
+	}
+	if _, err := parseRuleOptionOverrides([]string{"R.a=1", "R.a.b=2"}); err == nil {
+		t.Fatalf("expected conflict error when nesting under a scalar option")
+	}
+}
+
+func TestMergeRuleOptionsDoesNotMutateBase(t *testing.T) {
+	t.Parallel()
+
+	base := map[string]interface{}{
+		"style":  "kebab-case",
+		"limits": map[string]interface{}{"max": 800, "warn": 600},
+	}
+	merged := mergeRuleOptions(base, map[string]interface{}{
+		"limits": map[string]interface{}{"max": 500},
+	})
+
+	want := map[string]interface{}{
+		"style":  "kebab-case",
+		"limits": map[string]interface{}{"max": 500, "warn": 600},
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Fatalf("mergeRuleOptions() = %#v, want %#v", merged, want)
+	}
+	if base["limits"].(map[string]interface{})["max"] != 800 {
+		t.Fatalf("base options were mutated: %#v", base)
+	}
+}
@@ -0,0 +1,90 @@
+// rule_stability_test.go — Tests for experimental and deprecated rule handling.
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+type stabilityRule struct {
+	fakeRule
+	stability string
+}
+
+func (r stabilityRule) Stability() string {
+	return r.stability
+}
+
+func stabilityRegistry() *model.RuleRegistry {
+	registry := model.NewRuleRegistry()
+	registry.Register(fakeRule{id: "TEST-stable"})
+	registry.Register(stabilityRule{fakeRule: fakeRule{id: "TEST-new"}, stability: model.StabilityExperimental})
+	registry.Register(stabilityRule{fakeRule: fakeRule{id: "TEST-old"}, stability: model.StabilityDeprecated})
+	return registry
+}
+
+func selectedIDs(rules []model.Rule) string {
+	ids := make([]string, 0, len(rules))
+	for _, r := range rules {
+		ids = append(ids, r.ID())
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestResolveLintRulesNoExperimental(t *testing.T) {
+	t.Parallel()
+
+	registry := stabilityRegistry()
+	selected, err := resolveLintRules(registry, nil, nil, "", nil, false)
+	if err != nil {
+		t.Fatalf("resolveLintRules() error = %v", err)
+	}
+	if got := selectedIDs(selected); got != "TEST-stable,TEST-new,TEST-old" {
+		t.Fatalf("selected = %s, want every rule", got)
+	}
+
+	selected, err = resolveLintRules(registry, nil, nil, "", nil, true)
+	if err != nil {
+		t.Fatalf("resolveLintRules() error = %v", err)
+	}
+	if got := selectedIDs(selected); got != "TEST-stable,TEST-old" {
+		t.Fatalf("selected = %s, want experimental rules skipped", got)
+	}
+
+	selected, err = resolveLintRules(registry, nil, []string{"TEST-new"}, "", nil, true)
+	if err != nil {
+		t.Fatalf("resolveLintRules() error = %v", err)
+	}
+	if got := selectedIDs(selected); got != "TEST-new" {
+		t.Fatalf("selected = %s, want the rule requested with --rule", got)
+	}
+}
+
+func TestWarnDeprecatedRules(t *testing.T) {
+	t.Parallel()
+
+	selected, err := resolveLintRules(stabilityRegistry(), nil, nil, "", nil, false)
+	if err != nil {
+		t.Fatalf("resolveLintRules() error = %v", err)
+	}
+	var out bytes.Buffer
+	warnDeprecatedRules(&out, selected)
+	want := "Warning: rule TEST-old is deprecated and may be removed in a future release\n"
+	if out.String() != want {
+		t.Fatalf("warnings = %q, want %q", out.String(), want)
+	}
+}
+
+func TestRuleStabilityDefaultsToStable(t *testing.T) {
+	t.Parallel()
+
+	if got := model.RuleStability(fakeRule{id: "TEST-stable"}); got != model.StabilityStable {
+		t.Fatalf("RuleStability() = %q, want stable", got)
+	}
+	if got := model.RuleStability(stabilityRule{stability: " Experimental "}); got != model.StabilityExperimental {
+		t.Fatalf("RuleStability() = %q, want normalized experimental", got)
+	}
+}
@@ -0,0 +1,60 @@
+// rules_from.go — Reads the rule selection from a shared list of rule IDs (--rules-from).
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// validateRulesFromFlags rejects --category next to --rules-from: the list already
+// names every rule to run, and narrowing it by category would silently drop entries.
+func validateRulesFromFlags(rulesFrom string, category string) error {
+	if strings.TrimSpace(rulesFrom) != "" && strings.TrimSpace(category) != "" {
+		return errors.New("--rules-from cannot be combined with --category")
+	}
+	return nil
+}
+
+// readRulesFromList returns the rule IDs listed in pathValue, one per line, in order and
+// without duplicates. `#` starts a comment, on its own line or after an ID. Every ID
+// must be registered; an unknown one is reported with its line number.
+func readRulesFromList(pathValue string, registry *model.RuleRegistry) ([]string, error) {
+	f, err := os.Open(pathValue)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ids := make([]string, 0)
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		id := scanner.Text()
+		if i := strings.IndexByte(id, '#'); i >= 0 {
+			id = id[:i]
+		}
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		if _, ok := registry.ByID(id); !ok {
+			return nil, fmt.Errorf("%s:%d: unknown rule %q", pathValue, lineNo, id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read %s: %w", pathValue, err)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%s lists no rules", pathValue)
+	}
+	return ids, nil
+}
@@ -0,0 +1,61 @@
+// rules_from_test.go — Tests for reading the rule selection with --rules-from.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadRulesFromList(t *testing.T) {
+	t.Parallel()
+
+	registry := buildRegistry()
+	dir := t.TempDir()
+	listPath := filepath.Join(dir, "rules.txt")
+	list := "# Curated review set\nCONV-file-header\n\n  TQ-no-shallow-assertions  # keep\r\nCONV-file-header\nARCH-no-circular-deps\n"
+	if err := os.WriteFile(listPath, []byte(list), 0o644); err != nil {
+		t.Fatalf("write list: %v", err)
+	}
+	got, err := readRulesFromList(listPath, registry)
+	if err != nil {
+		t.Fatalf("readRulesFromList() error = %v", err)
+	}
+	want := []string{"CONV-file-header", "TQ-no-shallow-assertions", "ARCH-no-circular-deps"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("readRulesFromList() = %v, want %v", got, want)
+	}
+
+	if err := os.WriteFile(listPath, []byte("CONV-file-header\nCONV-no-such-rule\n"), 0o644); err != nil {
+		t.Fatalf("write list: %v", err)
+	}
+	if _, err := readRulesFromList(listPath, registry); err == nil || !strings.Contains(err.Error(), `rules.txt:2: unknown rule "CONV-no-such-rule"`) {
+		t.Fatalf("unknown rule error = %v", err)
+	}
+
+	if err := os.WriteFile(listPath, []byte("# nothing yet\n"), 0o644); err != nil {
+		t.Fatalf("write list: %v", err)
+	}
+	if _, err := readRulesFromList(listPath, registry); err == nil || !strings.Contains(err.Error(), "lists no rules") {
+		t.Fatalf("empty list error = %v", err)
+	}
+	if _, err := readRulesFromList(filepath.Join(dir, "missing.txt"), registry); err == nil {
+		t.Fatal("missing list should fail")
+	}
+}
+
+func TestValidateRulesFromFlags(t *testing.T) {
+	t.Parallel()
+
+	if err := validateRulesFromFlags("rules.txt", "tq"); err == nil || err.Error() != "--rules-from cannot be combined with --category" {
+		t.Fatalf("validateRulesFromFlags(rules.txt, tq) = %v", err)
+	}
+	if err := validateRulesFromFlags("rules.txt", ""); err != nil {
+		t.Fatalf("validateRulesFromFlags(rules.txt, \"\") = %v", err)
+	}
+	if err := validateRulesFromFlags("", "tq"); err != nil {
+		t.Fatalf("validateRulesFromFlags(\"\", tq) = %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+// sarif_suppressions.go — `--sarif-include-suppressed`: keep baselined findings in SARIF output.
+package main
+
+import (
+	"sort"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// suppressedForReport returns the baseline-suppressed violations to emit alongside a
+// report covering reportFiles, applying the same severity floor as active findings.
+func suppressedForReport(suppressed []model.Violation, reportFiles []string, minSeverity string) []model.Violation {
+	inReport := make(map[string]bool, len(reportFiles))
+	for _, p := range reportFiles {
+		inReport[p] = true
+	}
+	out := make([]model.Violation, 0, len(suppressed))
+	for _, v := range filterViolationsBySeverity(suppressed, minSeverity) {
+		if inReport[v.FilePath] {
+			out = append(out, v)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].FilePath != out[j].FilePath {
+			return out[i].FilePath < out[j].FilePath
+		}
+		if out[i].StartLine != out[j].StartLine {
+			return out[i].StartLine < out[j].StartLine
+		}
+		return out[i].RuleID < out[j].RuleID
+	})
+	return out
+}
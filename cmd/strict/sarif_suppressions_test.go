@@ -0,0 +1,47 @@
+// sarif_suppressions_test.go — Tests for including baselined findings in SARIF output.
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestApplyBaselineRecordsSuppressedViolations(t *testing.T) {
+	t.Parallel()
+
+	pathValue := filepath.Join(t.TempDir(), "baseline.json")
+	if err := writeBaselineFile(pathValue, []baselineEntry{{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "old"}}); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	violations := []model.Violation{
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "old"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 2, Message: "new"},
+	}
+	state, err := applyBaseline(pathValue, &violations, baselineOptions{})
+	if err != nil {
+		t.Fatalf("applyBaseline() error = %v", err)
+	}
+	if len(violations) != 1 || len(state.SuppressedViolations) != 1 || state.SuppressedViolations[0].Message != "old" {
+		t.Fatalf("active=%+v suppressed=%+v", violations, state.SuppressedViolations)
+	}
+}
+
+func TestSuppressedForReportFiltersAndSorts(t *testing.T) {
+	t.Parallel()
+
+	suppressed := []model.Violation{
+		{RuleID: "RULE-B", Severity: "error", FilePath: "b.go", StartLine: 3},
+		{RuleID: "RULE-A", Severity: "warn", FilePath: "a.go", StartLine: 9},
+		{RuleID: "RULE-A", Severity: "error", FilePath: "a.go", StartLine: 2},
+		{RuleID: "RULE-A", Severity: "error", FilePath: "other.go", StartLine: 1},
+	}
+	got := suppressedForReport(suppressed, []string{"a.go", "b.go"}, "")
+	if len(got) != 3 || got[0].StartLine != 2 || got[1].StartLine != 9 || got[2].FilePath != "b.go" {
+		t.Fatalf("suppressedForReport() = %+v", got)
+	}
+	if got := suppressedForReport(suppressed, []string{"a.go", "b.go"}, "error"); len(got) != 2 {
+		t.Fatalf("severity floor should drop warnings, got %+v", got)
+	}
+}
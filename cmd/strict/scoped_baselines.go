@@ -0,0 +1,158 @@
+// scoped_baselines.go — Routes violations to --baseline files scoped to a rule or category.
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var baselineScopePattern = regexp.MustCompile(`^[A-Za-z][\w-]*$`)
+
+// baselineSpec is one --baseline value: a file for every rule, or with SCOPE=path a
+// file for one rule ID or category.
+type baselineSpec struct {
+	Scope    string
+	Rule     string
+	Category string
+	Path     string
+}
+
+func (s baselineSpec) String() string {
+	if s.Scope == "" {
+		return s.Path
+	}
+	return s.Scope + "=" + s.Path
+}
+
+// parseBaselineSpecs reads --baseline values. A scope must name a registered rule or
+// category, each scope may appear once, and at most one baseline may be unscoped.
+func parseBaselineSpecs(values []string, registry *model.RuleRegistry) ([]baselineSpec, error) {
+	specs := make([]baselineSpec, 0, len(values))
+	seen := map[string]bool{}
+	for _, raw := range values {
+		spec := baselineSpec{Path: strings.TrimSpace(raw)}
+		if scope, pathValue, ok := strings.Cut(spec.Path, "="); ok && baselineScopePattern.MatchString(strings.TrimSpace(scope)) {
+			spec.Scope = strings.TrimSpace(scope)
+			spec.Path = strings.TrimSpace(pathValue)
+			if rule, ok := registry.ByID(spec.Scope); ok {
+				spec.Rule = rule.ID()
+			} else if registryHasCategory(registry, strings.ToLower(spec.Scope)) {
+				spec.Category = strings.ToLower(spec.Scope)
+			} else {
+				return nil, fmt.Errorf("--baseline scope %q is not a rule ID or category (categories: %s)", spec.Scope, strings.Join(registry.Categories(), ", "))
+			}
+		}
+		if spec.Path == "" {
+			return nil, fmt.Errorf("--baseline %q has no file path", raw)
+		}
+		key := spec.Rule + "|" + spec.Category
+		if seen[key] {
+			if spec.Scope == "" {
+				return nil, fmt.Errorf("only one --baseline may be unscoped; scope the others with RULE=path or CATEGORY=path")
+			}
+			return nil, fmt.Errorf("--baseline scope %q is given more than once", spec.Scope)
+		}
+		seen[key] = true
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// routeBaseline returns the index of the baseline a violation belongs to: the one scoped
+// to its rule, else the one scoped to its category, else the unscoped one. It returns -1
+// when none match; such violations are reported as if no baseline were configured.
+func routeBaseline(specs []baselineSpec, categories map[string]string, v model.Violation) int {
+	ruleID := strings.TrimSpace(v.RuleID)
+	byCategory, unscoped := -1, -1
+	for i, spec := range specs {
+		switch {
+		case spec.Rule != "" && spec.Rule == ruleID:
+			return i
+		case spec.Category != "" && spec.Category == categories[ruleID]:
+			byCategory = i
+		case spec.Rule == "" && spec.Category == "":
+			unscoped = i
+		}
+	}
+	if byCategory >= 0 {
+		return byCategory
+	}
+	return unscoped
+}
+
+// applyScopedBaselines routes each violation to its baseline and applies every baseline
+// to its share with applyBaseline, so a missing scoped file is bootstrapped from only the
+// violations routed to it. Violations that match no baseline are kept as they are.
+func applyScopedBaselines(specs []baselineSpec, categories map[string]string, violations *[]model.Violation, options baselineOptions) ([]baselineState, error) {
+	if violations == nil {
+		return nil, fmt.Errorf("internal baseline error: violations pointer is nil")
+	}
+	routed := make([][]model.Violation, len(specs))
+	kept := make([]model.Violation, 0, len(*violations))
+	for _, v := range *violations {
+		if i := routeBaseline(specs, categories, v); i >= 0 {
+			routed[i] = append(routed[i], v)
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	states := make([]baselineState, 0, len(specs))
+	for i, spec := range specs {
+		share := routed[i]
+		state, err := applyBaseline(spec.Path, &share, options)
+		if err != nil {
+			return nil, err
+		}
+		kept = append(kept, share...)
+		states = append(states, state)
+	}
+	*violations = kept
+	return states, nil
+}
+
+// mergeBaselineStates combines per-file baseline states into the totals reported in the
+// summary, JSON, and SARIF output. Paths are joined in --baseline order.
+func mergeBaselineStates(states []baselineState) baselineState {
+	if len(states) == 1 {
+		return states[0]
+	}
+	merged := baselineState{}
+	paths := make([]string, 0, len(states))
+	for _, state := range states {
+		if !state.Enabled {
+			continue
+		}
+		merged.Enabled = true
+		paths = append(paths, state.Path)
+		merged.EntryCount += state.EntryCount
+		merged.Suppressed += state.Suppressed
+		merged.Bootstrapped = merged.Bootstrapped || state.Bootstrapped
+		merged.Pruned += state.Pruned
+		merged.Entries = append(merged.Entries, state.Entries...)
+		merged.Added = append(merged.Added, state.Added...)
+		merged.Resolved = append(merged.Resolved, state.Resolved...)
+		merged.SuppressedViolations = append(merged.SuppressedViolations, state.SuppressedViolations...)
+	}
+	merged.Path = strings.Join(paths, ", ")
+	if merged.Added == nil {
+		merged.Added = []model.Violation{}
+	}
+	if merged.Resolved == nil {
+		merged.Resolved = []baselineEntry{}
+	}
+	sortBaselineEntries(merged.Resolved)
+	return merged
+}
+
+// ruleCategories maps each registered rule ID to its lower-cased category.
+func ruleCategories(registry *model.RuleRegistry) map[string]string {
+	categories := map[string]string{}
+	for _, rule := range registry.All() {
+		categories[rule.ID()] = strings.ToLower(strings.TrimSpace(rule.Category()))
+	}
+	return categories
+}
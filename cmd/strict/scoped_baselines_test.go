@@ -0,0 +1,121 @@
+// scoped_baselines_test.go — Tests for --baseline files scoped to a rule or category.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestParseBaselineSpecs(t *testing.T) {
+	t.Parallel()
+
+	registry := buildRegistry()
+	specs, err := parseBaselineSpecs([]string{"legacy.json", "CONV=conv.json", "CTR-json-tag-match=tags.json"}, registry)
+	if err != nil {
+		t.Fatalf("parseBaselineSpecs() error = %v", err)
+	}
+	if len(specs) != 3 {
+		t.Fatalf("specs = %+v", specs)
+	}
+	if specs[0].Scope != "" || specs[0].Path != "legacy.json" {
+		t.Fatalf("unscoped spec = %+v", specs[0])
+	}
+	if specs[1].Category != "conv" || specs[1].Path != "conv.json" {
+		t.Fatalf("category spec = %+v", specs[1])
+	}
+	if specs[2].Rule != "CTR-json-tag-match" || specs[2].Category != "" {
+		t.Fatalf("rule spec = %+v", specs[2])
+	}
+	if specs, err := parseBaselineSpecs([]string{"out/a=b.json"}, registry); err != nil || specs[0].Scope != "" || specs[0].Path != "out/a=b.json" {
+		t.Fatalf("a path containing = should stay a path, got %+v, %v", specs, err)
+	}
+
+	for _, tc := range []struct {
+		values []string
+		want   string
+	}{
+		{[]string{"NOPE=x.json"}, `--baseline scope "NOPE" is not a rule ID or category`},
+		{[]string{"a.json", "b.json"}, "only one --baseline may be unscoped"},
+		{[]string{"conv=a.json", "CONV=b.json"}, `--baseline scope "CONV" is given more than once`},
+		{[]string{"CONV="}, "has no file path"},
+	} {
+		if _, err := parseBaselineSpecs(tc.values, registry); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("parseBaselineSpecs(%v) error = %v, want %q", tc.values, err, tc.want)
+		}
+	}
+}
+
+func TestRouteBaselinePrefersRuleThenCategoryThenUnscoped(t *testing.T) {
+	t.Parallel()
+
+	specs := []baselineSpec{
+		{Path: "all.json"},
+		{Scope: "conv", Category: "conv", Path: "conv.json"},
+		{Scope: "CONV-file-naming", Rule: "CONV-file-naming", Path: "naming.json"},
+	}
+	categories := map[string]string{"CONV-file-naming": "conv", "CONV-file-header": "conv", "CTR-dual-test": "ctr"}
+	for ruleID, want := range map[string]int{"CONV-file-naming": 2, "CONV-file-header": 1, "CTR-dual-test": 0} {
+		if got := routeBaseline(specs, categories, model.Violation{RuleID: ruleID}); got != want {
+			t.Fatalf("routeBaseline(%s) = %d, want %d", ruleID, got, want)
+		}
+	}
+	if got := routeBaseline(specs[1:], categories, model.Violation{RuleID: "CTR-dual-test"}); got != -1 {
+		t.Fatalf("routeBaseline without an unscoped baseline = %d, want -1", got)
+	}
+}
+
+func TestApplyScopedBaselines(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	convPath := filepath.Join(dir, "conv.json")
+	if err := writeBaselineFile(convPath, []baselineEntry{
+		{RuleID: "CONV-file-header", FilePath: "a.go", StartLine: 1, Message: "legacy header"},
+		{RuleID: "CONV-file-header", FilePath: "b.go", StartLine: 1, Message: "fixed since"},
+	}); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	tagsPath := filepath.Join(dir, "tags.json")
+
+	specs := []baselineSpec{
+		{Scope: "CONV", Category: "conv", Path: convPath},
+		{Scope: "CTR-json-tag-match", Rule: "CTR-json-tag-match", Path: tagsPath},
+	}
+	categories := map[string]string{"CONV-file-header": "conv", "CTR-json-tag-match": "ctr", "CTR-dual-test": "ctr"}
+	violations := []model.Violation{
+		{RuleID: "CONV-file-header", FilePath: "a.go", StartLine: 1, Message: "legacy header"},
+		{RuleID: "CONV-file-header", FilePath: "c.go", StartLine: 1, Message: "new header"},
+		{RuleID: "CTR-json-tag-match", FilePath: "d.go", StartLine: 3, Message: "tag drift"},
+		{RuleID: "CTR-dual-test", FilePath: "e.go", StartLine: 9, Message: "no consumer test"},
+	}
+
+	states, err := applyScopedBaselines(specs, categories, &violations, baselineOptions{BootstrapIfMissing: true})
+	if err != nil {
+		t.Fatalf("applyScopedBaselines() error = %v", err)
+	}
+	got := make([]string, 0, len(violations))
+	for _, v := range violations {
+		got = append(got, v.RuleID+"@"+v.FilePath)
+	}
+	if strings.Join(got, ",") != "CTR-dual-test@e.go,CONV-file-header@c.go" {
+		t.Fatalf("remaining = %v", got)
+	}
+	if len(states) != 2 || states[0].Suppressed != 1 || len(states[0].Resolved) != 1 || !states[1].Bootstrapped || states[1].EntryCount != 1 {
+		t.Fatalf("states = %+v", states)
+	}
+	if _, err := os.Stat(tagsPath); err != nil {
+		t.Fatalf("scoped baseline should be bootstrapped: %v", err)
+	}
+
+	merged := mergeBaselineStates(states)
+	if !merged.Enabled || merged.Suppressed != 2 || merged.EntryCount != 3 || !merged.Bootstrapped {
+		t.Fatalf("merged = %+v", merged)
+	}
+	if merged.Path != convPath+", "+tagsPath {
+		t.Fatalf("merged path = %q", merged.Path)
+	}
+}
@@ -0,0 +1,17 @@
+// severity_map.go — Applies per-path severityMap overrides to lint violations.
+package main
+
+import (
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+)
+
+// remapViolationSeverity rewrites v.Severity through cfg's overrides for v's path.
+// It returns false when the violation was remapped to "off" and should be dropped.
+func remapViolationSeverity(cfg *config.Config, v *model.Violation) bool {
+	if cfg == nil || len(cfg.Overrides) == 0 {
+		return true
+	}
+	v.Severity = cfg.RemapSeverity(v.FilePath, v.RuleID, v.Severity)
+	return v.Severity != "off"
+}
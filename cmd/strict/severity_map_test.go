@@ -0,0 +1,55 @@
+// severity_map_test.go — Tests for per-path severity remapping during lint.
+package main
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestRunLintRulesForFileAppliesSeverityMap(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.LoadFromBytes([]byte(`overrides:
+  - files: ["examples/**"]
+    severityMap:
+      error: warn
+      RULE-b: { warn: off }
+`))
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	rules := []model.Rule{
+		fakeRule{id: "RULE-a", violations: []model.Violation{{RuleID: "RULE-a", Severity: "error", StartLine: 1, Message: "a"}}},
+		fakeRule{id: "RULE-b", violations: []model.Violation{{RuleID: "RULE-b", Severity: "warn", StartLine: 1, Message: "b"}}},
+	}
+
+	example := &model.UnifiedFileModel{Path: "examples/demo.go", Source: []byte("package demo\n")}
+	out := runLintRulesForFile(example, withFilePath(rules, example.Path), &model.ProjectContext{}, 0, 1, cfg)
+	if len(out) != 1 || out[0].RuleID != "RULE-a" || out[0].Severity != "warn" {
+		t.Fatalf("examples/ violations = %+v, want only RULE-a downgraded to warn", out)
+	}
+
+	src := &model.UnifiedFileModel{Path: "src/app.go", Source: []byte("package app\n")}
+	out = runLintRulesSequential([]*model.UnifiedFileModel{src}, withFilePath(rules, src.Path), &model.ProjectContext{}, 0, 1, cfg)
+	if len(out) != 2 || out[0].Severity != "error" || out[1].Severity != "warn" {
+		t.Fatalf("src/ violations = %+v, want severities unchanged", out)
+	}
+}
+
+// withFilePath returns copies of fake rules whose violations report pathValue.
+func withFilePath(rules []model.Rule, pathValue string) []model.Rule {
+	out := make([]model.Rule, 0, len(rules))
+	for _, r := range rules {
+		fr := r.(fakeRule)
+		violations := make([]model.Violation, len(fr.violations))
+		copy(violations, fr.violations)
+		for i := range violations {
+			violations[i].FilePath = pathValue
+		}
+		fr.violations = violations
+		out = append(out, fr)
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+// since_filter.go — Limits lint scope to files modified within a recent duration.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseSinceDuration accepts Go durations (90m, 2h) plus whole days (3d).
+// An empty value disables the filter and returns 0.
+func parseSinceDuration(raw string) (time.Duration, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return 0, nil
+	}
+
+	var window time.Duration
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid --since %q (expected duration like 30m, 2h, or 3d)", raw)
+		}
+		window = time.Duration(n) * 24 * time.Hour
+	} else {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid --since %q (expected duration like 30m, 2h, or 3d)", raw)
+		}
+		window = parsed
+	}
+	if window <= 0 {
+		return 0, fmt.Errorf("invalid --since %q (duration must be > 0)", raw)
+	}
+	return window, nil
+}
+
+// filterFilePathsByModTime keeps files whose modification time is at or after cutoff.
+func filterFilePathsByModTime(paths []string, cutoff time.Time) ([]string, error) {
+	filtered := make([]string, 0, len(paths))
+	for _, pathValue := range paths {
+		info, err := os.Stat(pathValue)
+		if err != nil {
+			return nil, err
+		}
+		if !info.ModTime().Before(cutoff) {
+			filtered = append(filtered, pathValue)
+		}
+	}
+	return filtered, nil
+}
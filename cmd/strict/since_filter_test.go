@@ -0,0 +1,61 @@
+// since_filter_test.go — Tests for --since duration parsing and mtime filtering.
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseSinceDuration(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]time.Duration{
+		"":    0,
+		"2h":  2 * time.Hour,
+		"90m": 90 * time.Minute,
+		"3d":  72 * time.Hour,
+	}
+	for raw, want := range cases {
+		got, err := parseSinceDuration(raw)
+		if err != nil {
+			t.Fatalf("parseSinceDuration(%q) error = %v", raw, err)
+		}
+		if got != want {
+			t.Fatalf("parseSinceDuration(%q) = %v, want %v", raw, got, want)
+		}
+	}
+
+	for _, raw := range []string{"soon", "2x", "d", "-1h", "0s"} {
+		if _, err := parseSinceDuration(raw); err == nil {
+			t.Fatalf("parseSinceDuration(%q) should fail", raw)
+		}
+	}
+}
+
+func TestFilterFilePathsByModTime(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	fresh := filepath.Join(dir, "fresh.go")
+	stale := filepath.Join(dir, "stale.go")
+	for _, p := range []string{fresh, stale} {
+		if err := os.WriteFile(p, []byte("package main\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", p, err)
+		}
+	}
+	old := time.Now().Add(-48 * time.Hour)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	got, err := filterFilePathsByModTime([]string{fresh, stale}, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("filterFilePathsByModTime() error = %v", err)
+	}
+	if want := []string{fresh}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("filterFilePathsByModTime() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+// summary_only.go — `--summary-only`: print aggregate counts without the violation list.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/stricture/stricture/internal/reporter"
+)
+
+// summaryOnlyFormats lists the formats --summary-only can reduce; SARIF and JUnit have
+// no summary-only shape.
+var summaryOnlyFormats = map[string]bool{"text": true, "compact": true, "json": true}
+
+// formatSummaryLine renders the one-line text summary shared by full and summary-only output.
+func formatSummaryLine(summary map[string]interface{}) string {
+	line := fmt.Sprintf("Summary: files=%d issues=%d violations=%d errors=%d warnings=%d elapsedMs=%d",
+		summary["filesChecked"], summary["filesWithIssues"], summary["totalViolations"], summary["errors"], summary["warnings"], summary["elapsedMs"])
+	if partial, _ := summary["partial"].(bool); partial {
+		line += fmt.Sprintf(" partial=true skipped=%d", summary["filesSkipped"])
+	}
+	return line + "\n"
+}
+
+// renderSummaryOnly returns the Summary line for text and compact output, or a JSON
+// document holding only the version, optional title, and summary object.
+func renderSummaryOnly(format string, title string, summary map[string]interface{}) ([]byte, error) {
+	if format != "json" {
+		return []byte(formatSummaryLine(summary)), nil
+	}
+	payload := map[string]interface{}{
+		"version": "1",
+		"summary": summary,
+	}
+	if title != reporter.DefaultTitle {
+		payload["title"] = title
+	}
+	encoded, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(encoded, '\n'), nil
+}
@@ -0,0 +1,36 @@
+// summary_only_test.go — Tests for summary-only output.
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRenderSummaryOnly(t *testing.T) {
+	t.Parallel()
+
+	summary := map[string]interface{}{
+		"filesChecked":    3,
+		"filesWithIssues": 1,
+		"totalViolations": 2,
+		"errors":          1,
+		"warnings":        1,
+		"elapsedMs":       int64(7),
+	}
+	text, err := renderSummaryOnly("compact", "stricture", summary)
+	if err != nil || string(text) != "Summary: files=3 issues=1 violations=2 errors=1 warnings=1 elapsedMs=7\n" {
+		t.Fatalf("text = %q, err = %v", text, err)
+	}
+
+	encoded, err := renderSummaryOnly("json", "backend-lint", summary)
+	if err != nil {
+		t.Fatalf("renderSummaryOnly(json) error = %v", err)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(encoded, &payload); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(payload) != 3 || payload["version"] != "1" || payload["title"] != "backend-lint" || payload["summary"] == nil {
+		t.Fatalf("payload = %v, want version, title, and summary only", payload)
+	}
+}
@@ -2,21 +2,24 @@ package main
 
 import (
 	"errors"
-	"log"
 	"net/http"
+	"os"
 
 	"github.com/stricture/stricture/internal/server"
 )
 
 func main() {
 	cfg := server.LoadConfigFromEnv()
-	app, err := server.New(cfg)
+	logger := server.NewLogger(cfg.LogFormat, os.Stderr)
+	app, err := server.New(cfg, logger)
 	if err != nil {
-		log.Fatalf("stricture-server init failed: %v", err)
+		logger.Error("stricture-server init failed", "error", err)
+		os.Exit(1)
 	}
 
-	log.Printf("stricture-server listening on %s (data_dir=%s)", cfg.Addr, cfg.DataDir)
+	logger.Info("stricture-server listening", "addr", cfg.Addr, "data_dir", cfg.DataDir, "storage_driver", cfg.StorageDriver, "auth_mode", cfg.AuthMode)
 	if err := app.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-		log.Fatalf("stricture-server exited with error: %v", err)
+		logger.Error("stricture-server exited with error", "error", err)
+		os.Exit(1)
 	}
 }
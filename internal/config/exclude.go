@@ -0,0 +1,64 @@
+// exclude.go - Per-rule `exclude` globs.
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ExcludeOption is the rule option listing globs of files the rule does not run on.
+const ExcludeOption = "exclude"
+
+// PathGlobs matches slash-separated paths against globs in the syntax of override `files`.
+type PathGlobs struct {
+	patterns []*regexp.Regexp
+}
+
+// CompilePathGlobs compiles globs, skipping blank entries.
+func CompilePathGlobs(globs []string) PathGlobs {
+	compiled := PathGlobs{}
+	for _, glob := range globs {
+		if strings.TrimSpace(glob) == "" {
+			continue
+		}
+		compiled.patterns = append(compiled.patterns, compileOverrideGlob(glob))
+	}
+	return compiled
+}
+
+// Matches reports whether pathValue matches one of the globs. No globs match nothing.
+func (g PathGlobs) Matches(pathValue string) bool {
+	pathValue = strings.TrimPrefix(filepath.ToSlash(pathValue), "./")
+	for _, re := range g.patterns {
+		if re.MatchString(pathValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleExclude returns the `exclude` globs in a rule's options, given as one glob or a list.
+func RuleExclude(options map[string]interface{}) ([]string, error) {
+	switch value := options[ExcludeOption].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{value}, nil
+	case []string:
+		return value, nil
+	case []interface{}:
+		globs := make([]string, 0, len(value))
+		for _, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s entries must be strings", ExcludeOption)
+			}
+			globs = append(globs, s)
+		}
+		return globs, nil
+	default:
+		return nil, fmt.Errorf("%s must be a glob or list of globs", ExcludeOption)
+	}
+}
@@ -0,0 +1,59 @@
+// exclude_test.go - Tests for per-rule exclude globs.
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestLoadFromBytes_ParsesRuleExclude(t *testing.T) {
+	cfg, err := LoadFromBytes([]byte(`rules:
+  CONV-file-naming: [error, { style: kebab-case, exclude: ["legacy/**"] }]
+  CONV-file-header:
+    severity: warn
+    exclude: "**/*.gen.ts"
+    options: { pattern: "// {filename}" }
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	naming, err := RuleExclude(cfg.Rules["CONV-file-naming"].Options)
+	if err != nil || len(naming) != 1 || naming[0] != "legacy/**" {
+		t.Fatalf("CONV-file-naming exclude = %v, %v", naming, err)
+	}
+	header, err := RuleExclude(cfg.Rules["CONV-file-header"].Options)
+	if err != nil || len(header) != 1 || header[0] != "**/*.gen.ts" {
+		t.Fatalf("CONV-file-header exclude should survive an options block, got %v, %v", header, err)
+	}
+
+	globs := CompilePathGlobs(naming)
+	for path, want := range map[string]bool{
+		"legacy/a.go":        true,
+		"./legacy/deep/b.go": true,
+		"src/legacy.go":      false,
+	} {
+		if got := globs.Matches(path); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", path, got, want)
+		}
+	}
+	if (PathGlobs{}).Matches("legacy/a.go") {
+		t.Fatal("empty PathGlobs should match nothing")
+	}
+}
+
+func TestLoadFromBytes_RejectsInvalidRuleExclude(t *testing.T) {
+	cases := map[string]string{
+		"number":        "rules:\n  CONV-file-naming: [error, { exclude: 3 }]\n",
+		"list of nums":  "rules:\n  CONV-file-naming: { severity: error, exclude: [1, 2] }\n",
+		"nested object": "rules:\n  CONV-file-naming: { severity: error, exclude: { a: b } }\n",
+	}
+	for name, data := range cases {
+		_, err := LoadFromBytes([]byte(data))
+		if !errors.Is(err, model.ErrConfigInvalid) {
+			t.Errorf("%s: expected ErrConfigInvalid, got %v", name, err)
+		}
+	}
+}
@@ -14,9 +14,10 @@ import (
 
 // Config is the normalized representation of .stricture.yml.
 type Config struct {
-	Version string
-	Rules   map[string]model.RuleConfig
-	Plugins []string
+	Version   string
+	Rules     map[string]model.RuleConfig
+	Plugins   []string
+	Overrides []Override
 }
 
 // Default returns an empty configuration with default schema version.
@@ -47,9 +48,10 @@ func LoadFromBytes(data []byte) (*Config, error) {
 	}
 
 	var raw struct {
-		Version string                 `yaml:"version"`
-		Rules   map[string]interface{} `yaml:"rules"`
-		Plugins []string               `yaml:"plugins"`
+		Version   string                 `yaml:"version"`
+		Rules     map[string]interface{} `yaml:"rules"`
+		Plugins   []string               `yaml:"plugins"`
+		Overrides []interface{}          `yaml:"overrides"`
 	}
 	if err := yaml.Unmarshal(data, &raw); err != nil {
 		return nil, fmt.Errorf("%w: %v", model.ErrConfigInvalid, err)
@@ -62,6 +64,9 @@ func LoadFromBytes(data []byte) (*Config, error) {
 
 	for ruleID, value := range raw.Rules {
 		ruleCfg, err := parseRuleConfig(value)
+		if err == nil {
+			_, err = RuleExclude(ruleCfg.Options)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("%w: rule %s: %v", model.ErrConfigInvalid, ruleID, err)
 		}
@@ -69,6 +74,18 @@ func LoadFromBytes(data []byte) (*Config, error) {
 	}
 	cfg.Plugins = append(cfg.Plugins, raw.Plugins...)
 
+	for i, value := range raw.Overrides {
+		entry, ok := normalizeValue(value).(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: overrides[%d]: must be a map", model.ErrConfigInvalid, i)
+		}
+		override, err := parseOverride(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w: overrides[%d]: %v", model.ErrConfigInvalid, i, err)
+		}
+		cfg.Overrides = append(cfg.Overrides, override)
+	}
+
 	return cfg, nil
 }
 
@@ -129,6 +146,12 @@ func parseMapRuleConfig(value map[string]interface{}) (model.RuleConfig, error)
 
 	if rawOptions, ok := value["options"]; ok {
 		ruleCfg.Options = normalizeToStringMap(rawOptions)
+		// exclude is read by the runner, not the rule, so it may sit beside severity.
+		if exclude, ok := options[ExcludeOption]; ok {
+			if _, set := ruleCfg.Options[ExcludeOption]; !set {
+				ruleCfg.Options[ExcludeOption] = exclude
+			}
+		}
 	}
 
 	if ruleCfg.Options == nil {
@@ -0,0 +1,172 @@
+// overrides.go - Per-path overrides and severity remapping.
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// allRulesKey is the severityMap key used for mappings that apply to every rule.
+const allRulesKey = "*"
+
+// Override applies settings to files matching any of Files.
+type Override struct {
+	Files []string
+	// SeverityMap maps a rule ID (or "*" for every rule) to a from->to severity remap.
+	SeverityMap map[string]map[string]string
+
+	patterns []*regexp.Regexp
+}
+
+// Matches reports whether a slash-separated path matches one of the override's globs.
+func (o Override) Matches(pathValue string) bool {
+	pathValue = strings.TrimPrefix(filepath.ToSlash(pathValue), "./")
+	for _, re := range o.patterns {
+		if re.MatchString(pathValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemapSeverity returns the severity a violation of ruleID at pathValue should carry.
+// Later overrides take precedence, and a rule-specific mapping beats a "*" mapping
+// within the same override. Severities with no matching mapping are returned as-is.
+func (c *Config) RemapSeverity(pathValue string, ruleID string, severity string) string {
+	if c == nil || len(c.Overrides) == 0 {
+		return severity
+	}
+	from := strings.ToLower(strings.TrimSpace(severity))
+	if from == "warning" {
+		from = "warn"
+	}
+	for i := len(c.Overrides) - 1; i >= 0; i-- {
+		o := c.Overrides[i]
+		if len(o.SeverityMap) == 0 || !o.Matches(pathValue) {
+			continue
+		}
+		if to, ok := o.SeverityMap[ruleID][from]; ok {
+			return to
+		}
+		if to, ok := o.SeverityMap[allRulesKey][from]; ok {
+			return to
+		}
+	}
+	return severity
+}
+
+// parseOverride normalizes one `overrides` entry. severityMap accepts severity keys
+// that apply to every rule (`error: warn`) and rule-ID keys with their own map
+// (`CONV-file-header: { warn: off }`).
+func parseOverride(raw map[string]interface{}) (Override, error) {
+	override := Override{SeverityMap: map[string]map[string]string{}}
+
+	switch files := raw["files"].(type) {
+	case string:
+		override.Files = []string{files}
+	case []interface{}:
+		for _, item := range files {
+			s, ok := item.(string)
+			if !ok {
+				return Override{}, fmt.Errorf("files entries must be strings")
+			}
+			override.Files = append(override.Files, s)
+		}
+	case nil:
+	default:
+		return Override{}, fmt.Errorf("files must be a string or list of strings")
+	}
+	for _, glob := range override.Files {
+		if strings.TrimSpace(glob) == "" {
+			continue
+		}
+		override.patterns = append(override.patterns, compileOverrideGlob(glob))
+	}
+	if len(override.patterns) == 0 {
+		return Override{}, fmt.Errorf("files must list at least one glob")
+	}
+
+	rawMap := raw["severityMap"]
+	if rawMap == nil {
+		return override, nil
+	}
+	entries, ok := rawMap.(map[string]interface{})
+	if !ok {
+		return Override{}, fmt.Errorf("severityMap must be a map")
+	}
+	for key, value := range entries {
+		if nested, ok := value.(map[string]interface{}); ok {
+			remap, err := parseSeverityRemap(nested)
+			if err != nil {
+				return Override{}, fmt.Errorf("severityMap %s: %v", key, err)
+			}
+			override.SeverityMap[key] = remap
+			continue
+		}
+		from, err := normalizeSeverity(key)
+		if err != nil || from == "off" {
+			return Override{}, fmt.Errorf("severityMap key %q must be error, warn, or a rule ID with a nested map", key)
+		}
+		to, ok := value.(string)
+		if !ok {
+			return Override{}, fmt.Errorf("severityMap %s must map to a severity string", key)
+		}
+		normalized, err := normalizeSeverity(to)
+		if err != nil {
+			return Override{}, fmt.Errorf("severityMap %s: %v", key, err)
+		}
+		if override.SeverityMap[allRulesKey] == nil {
+			override.SeverityMap[allRulesKey] = map[string]string{}
+		}
+		override.SeverityMap[allRulesKey][from] = normalized
+	}
+	return override, nil
+}
+
+func parseSeverityRemap(raw map[string]interface{}) (map[string]string, error) {
+	remap := make(map[string]string, len(raw))
+	for fromRaw, toRaw := range raw {
+		from, err := normalizeSeverity(fromRaw)
+		if err != nil || from == "off" {
+			return nil, fmt.Errorf("cannot remap from %q (valid: error|warn)", fromRaw)
+		}
+		toString, ok := toRaw.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must map to a severity string", fromRaw)
+		}
+		to, err := normalizeSeverity(toString)
+		if err != nil {
+			return nil, err
+		}
+		remap[from] = to
+	}
+	return remap, nil
+}
+
+// compileOverrideGlob converts a path glob to a regexp where `**` spans directories
+// and a trailing `/**` also matches files directly inside the directory.
+func compileOverrideGlob(pattern string) *regexp.Regexp {
+	p := strings.TrimPrefix(filepath.ToSlash(strings.TrimSpace(pattern)), "./")
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
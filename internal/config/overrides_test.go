@@ -0,0 +1,61 @@
+// overrides_test.go - Tests for per-path overrides.
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestLoadFromBytes_ParsesSeverityMapOverrides(t *testing.T) {
+	cfg, err := LoadFromBytes([]byte(`overrides:
+  - files: ["examples/**", "docs/*.ts"]
+    severityMap:
+      error: warn
+  - files: examples/legacy/**
+    severityMap:
+      CONV-file-header: { warn: off, error: off }
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Overrides) != 2 {
+		t.Fatalf("overrides len = %d, want 2", len(cfg.Overrides))
+	}
+
+	tests := []struct {
+		path     string
+		ruleID   string
+		severity string
+		want     string
+	}{
+		{"examples/a/b.go", "ARCH-max-file-lines", "error", "warn"},
+		{"./examples/x.go", "ARCH-max-file-lines", "warn", "warn"},
+		{"docs/guide.ts", "TQ-mock-scope", "error", "warn"},
+		{"docs/nested/guide.ts", "TQ-mock-scope", "error", "error"},
+		{"src/app.go", "ARCH-max-file-lines", "error", "error"},
+		{"examples/legacy/old.go", "CONV-file-header", "error", "off"},
+		{"examples/legacy/old.go", "ARCH-max-file-lines", "error", "warn"},
+	}
+	for _, tt := range tests {
+		if got := cfg.RemapSeverity(tt.path, tt.ruleID, tt.severity); got != tt.want {
+			t.Errorf("RemapSeverity(%q, %q, %q) = %q, want %q", tt.path, tt.ruleID, tt.severity, got, tt.want)
+		}
+	}
+}
+
+func TestLoadFromBytes_RejectsInvalidOverrides(t *testing.T) {
+	cases := map[string]string{
+		"missing files":   "overrides:\n  - severityMap: { error: warn }\n",
+		"bad target":      "overrides:\n  - files: [\"a/**\"]\n    severityMap: { error: critical }\n",
+		"remap from off":  "overrides:\n  - files: [\"a/**\"]\n    severityMap: { off: error }\n",
+		"not a map entry": "overrides:\n  - examples/**\n",
+	}
+	for name, data := range cases {
+		_, err := LoadFromBytes([]byte(data))
+		if !errors.Is(err, model.ErrConfigInvalid) {
+			t.Errorf("%s: expected ErrConfigInvalid, got %v", name, err)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
 )
 
 var (
@@ -33,43 +34,100 @@ type Operation struct {
 
 // Plan builds a list of file operations for fixable violations.
 func Plan(violations []model.Violation) ([]Operation, error) {
+	ops, _, err := plan(violations, true)
+	if err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// MarkFixable sets Fixable on each violation Plan produces an operation for. It plans
+// once over the whole list; a violation whose fix cannot be planned, for example
+// because its file is unreadable, is left unfixable instead of failing the rest.
+func MarkFixable(violations []model.Violation) {
+	_, fixed, _ := plan(violations, false)
+	for i := range violations {
+		violations[i].Fixable = fixed[planKey(violations[i])]
+	}
+}
+
+// planKey identifies the fix a violation contributes to; violations sharing a key are
+// fixed by the same operation.
+func planKey(v model.Violation) string {
+	key := v.RuleID + "|" + v.FilePath
+	if v.RuleID == "CONV-struct-field-alignment" {
+		// Each violation names one struct; every struct gets its own reorder.
+		key += "|" + alignedStructName(v)
+	}
+	if v.RuleID == "CONV-error-format" {
+		// Only wrap-verb findings are fixable; a message-format finding earlier in the
+		// file must not hide them.
+		key += "|" + violationKind(v)
+	}
+	return key
+}
+
+// plan returns the operations for violations and the plan keys that produced one. With
+// stopOnError unset, a violation whose fix fails to plan is skipped.
+func plan(violations []model.Violation, stopOnError bool) ([]Operation, map[string]bool, error) {
 	ops := make([]Operation, 0)
 	seen := map[string]bool{}
+	fixed := map[string]bool{}
+	edits := map[string]int{}
+	pendingEdits := map[string][]byte{}
 
 	for _, v := range violations {
 		if unsupportedRuleIDsForFixing[v.RuleID] {
 			continue
 		}
-		key := v.RuleID + "|" + v.FilePath
+		key := planKey(v)
 		if seen[key] {
 			continue
 		}
 		seen[key] = true
 
+		var (
+			op  Operation
+			ok  bool
+			err error
+		)
 		switch v.RuleID {
 		case "CONV-file-header":
-			op, ok, err := planFileHeaderFix(v)
-			if err != nil {
-				return nil, err
-			}
-			if ok {
-				ops = append(ops, op)
-			}
+			op, ok, err = planFileHeaderFix(v, pendingEdits)
+		case "CONV-no-tabs-or-spaces-mismatch":
+			op, ok, err = planIndentationFix(v, pendingEdits)
+		case "CONV-consistent-quote-style":
+			op, ok, err = planQuoteStyleFix(v, pendingEdits)
+		case "CONV-no-redundant-else-after-return":
+			op, ok, err = planRedundantElseFix(v, pendingEdits)
+		case "CONV-struct-field-alignment":
+			op, ok, err = planStructAlignmentFix(v, pendingEdits)
+		case "CONV-error-format":
+			op, ok, err = planWrapVerbFix(v, pendingEdits)
 		case "CONV-file-naming":
-			op, ok := planFileNamingFix(v)
-			if ok {
-				ops = append(ops, op)
-			}
+			op, ok = planFileNamingFix(v)
 		case "CONV-test-file-location":
-			op, ok := planTestLocationFix(v)
-			if ok {
-				ops = append(ops, op)
+			op, ok = planTestLocationFix(v)
+		}
+		if err != nil {
+			if stopOnError {
+				return nil, nil, err
 			}
+			continue
+		}
+		if !ok {
+			continue
+		}
+		fixed[key] = true
+		if op.Kind == "rename" {
+			ops = append(ops, op)
+		} else {
+			ops = appendEdit(ops, edits, pendingEdits, op)
 		}
 	}
 
 	ops = adjustHeaderFixesForRenames(ops)
-	return ops, nil
+	return ops, fixed, nil
 }
 
 func adjustHeaderFixesForRenames(ops []Operation) []Operation {
@@ -118,10 +176,40 @@ func rewriteHeaderFilename(content []byte, newBase string) []byte {
 	return []byte(updatedFirst + text[firstLineEnd:])
 }
 
-func planFileHeaderFix(v model.Violation) (Operation, bool, error) {
-	data, err := os.ReadFile(v.FilePath)
+// appendEdit folds a new edit into an earlier edit of the same file so that
+// several rules can fix one file without overwriting each other.
+func appendEdit(ops []Operation, edits map[string]int, pending map[string][]byte, op Operation) []Operation {
+	key := filepath.Clean(op.Path)
+	pending[key] = op.Content
+	if idx, ok := edits[key]; ok {
+		ops[idx].Content = op.Content
+		ops[idx].Description += "; " + op.Description
+		if op.RuleID == "CONV-file-header" {
+			// Renames rewrite header edits by rule ID; keep the merged edit discoverable.
+			ops[idx].RuleID = op.RuleID
+		}
+		return ops
+	}
+	edits[key] = len(ops)
+	return append(ops, op)
+}
+
+// readForEdit returns the file content including edits planned so far.
+func readForEdit(pathValue string, pending map[string][]byte) ([]byte, error) {
+	if data, ok := pending[filepath.Clean(pathValue)]; ok {
+		return data, nil
+	}
+	data, err := os.ReadFile(pathValue)
 	if err != nil {
-		return Operation{}, false, fmt.Errorf("read %s: %w", v.FilePath, err)
+		return nil, fmt.Errorf("read %s: %w", pathValue, err)
+	}
+	return data, nil
+}
+
+func planFileHeaderFix(v model.Violation, pending map[string][]byte) (Operation, bool, error) {
+	data, err := readForEdit(v.FilePath, pending)
+	if err != nil {
+		return Operation{}, false, err
 	}
 
 	filename := filepath.Base(v.FilePath)
@@ -141,6 +229,165 @@ func planFileHeaderFix(v model.Violation) (Operation, bool, error) {
 	}, true, nil
 }
 
+func planIndentationFix(v model.Violation, pending map[string][]byte) (Operation, bool, error) {
+	indent, width := "", 0
+	if v.Context != nil && v.Context.Metadata != nil {
+		indent, _ = v.Context.Metadata["indent"].(string)
+		switch w := v.Context.Metadata["width"].(type) {
+		case int:
+			width = w
+		case float64:
+			width = int(w)
+		}
+	}
+	if indent == "" {
+		return Operation{}, false, nil
+	}
+
+	data, err := readForEdit(v.FilePath, pending)
+	if err != nil {
+		return Operation{}, false, err
+	}
+	normalized := rewrite.NormalizeIndentation(data, languageForPath(v.FilePath), indent, width)
+	if string(normalized) == string(data) {
+		return Operation{}, false, nil
+	}
+
+	return Operation{
+		RuleID:      v.RuleID,
+		Kind:        "edit",
+		Path:        v.FilePath,
+		Description: fmt.Sprintf("Normalize indentation to %s in %s", indent, filepath.ToSlash(v.FilePath)),
+		Content:     normalized,
+	}, true, nil
+}
+
+func planQuoteStyleFix(v model.Violation, pending map[string][]byte) (Operation, bool, error) {
+	quote := ""
+	if v.Context != nil && v.Context.Metadata != nil {
+		quote, _ = v.Context.Metadata["quote"].(string)
+	}
+	if quote == "" {
+		return Operation{}, false, nil
+	}
+
+	data, err := readForEdit(v.FilePath, pending)
+	if err != nil {
+		return Operation{}, false, err
+	}
+	normalized := rewrite.NormalizeQuotes(data, v.FilePath, quote)
+	if string(normalized) == string(data) {
+		return Operation{}, false, nil
+	}
+
+	return Operation{
+		RuleID:      v.RuleID,
+		Kind:        "edit",
+		Path:        v.FilePath,
+		Description: fmt.Sprintf("Normalize string quotes to %s in %s", quote, filepath.ToSlash(v.FilePath)),
+		Content:     normalized,
+	}, true, nil
+}
+
+func planRedundantElseFix(v model.Violation, pending map[string][]byte) (Operation, bool, error) {
+	data, err := readForEdit(v.FilePath, pending)
+	if err != nil {
+		return Operation{}, false, err
+	}
+	flattened := rewrite.FlattenRedundantElse(data)
+	if string(flattened) == string(data) {
+		return Operation{}, false, nil
+	}
+
+	return Operation{
+		RuleID:      v.RuleID,
+		Kind:        "edit",
+		Path:        v.FilePath,
+		Description: fmt.Sprintf("Flatten redundant else blocks in %s", filepath.ToSlash(v.FilePath)),
+		Content:     flattened,
+	}, true, nil
+}
+
+func planStructAlignmentFix(v model.Violation, pending map[string][]byte) (Operation, bool, error) {
+	data, err := readForEdit(v.FilePath, pending)
+	if err != nil {
+		return Operation{}, false, err
+	}
+	name := alignedStructName(v)
+	if name == "" {
+		return Operation{}, false, nil
+	}
+	aligned := rewrite.AlignStructFields(data, name)
+	if string(aligned) == string(data) {
+		return Operation{}, false, nil
+	}
+
+	return Operation{
+		RuleID:      v.RuleID,
+		Kind:        "edit",
+		Path:        v.FilePath,
+		Description: fmt.Sprintf("Reorder the fields of struct %s in %s to remove padding", name, filepath.ToSlash(v.FilePath)),
+		Content:     aligned,
+	}, true, nil
+}
+
+func planWrapVerbFix(v model.Violation, pending map[string][]byte) (Operation, bool, error) {
+	if violationKind(v) != "wrap-verb" {
+		return Operation{}, false, nil
+	}
+	data, err := readForEdit(v.FilePath, pending)
+	if err != nil {
+		return Operation{}, false, err
+	}
+	wrapped := rewrite.UseWrapVerb(data)
+	if string(wrapped) == string(data) {
+		return Operation{}, false, nil
+	}
+
+	return Operation{
+		RuleID:      v.RuleID,
+		Kind:        "edit",
+		Path:        v.FilePath,
+		Description: fmt.Sprintf("Wrap errors passed to fmt.Errorf with %%w in %s", filepath.ToSlash(v.FilePath)),
+		Content:     wrapped,
+	}, true, nil
+}
+
+// violationKind is the `kind` a rule with several checks records in metadata.
+func violationKind(v model.Violation) string {
+	if v.Context == nil || v.Context.Metadata == nil {
+		return ""
+	}
+	kind, _ := v.Context.Metadata["kind"].(string)
+	return kind
+}
+
+// alignedStructName is the struct a CONV-struct-field-alignment violation is about.
+func alignedStructName(v model.Violation) string {
+	if v.Context == nil || v.Context.Metadata == nil {
+		return ""
+	}
+	name, _ := v.Context.Metadata["struct"].(string)
+	return name
+}
+
+func languageForPath(pathValue string) string {
+	switch strings.ToLower(filepath.Ext(pathValue)) {
+	case ".go":
+		return "go"
+	case ".ts", ".tsx":
+		return "typescript"
+	case ".js", ".jsx":
+		return "javascript"
+	case ".py":
+		return "python"
+	case ".java":
+		return "java"
+	default:
+		return ""
+	}
+}
+
 func planFileNamingFix(v model.Violation) (Operation, bool) {
 	match := renameSuggestionPattern.FindStringSubmatch(v.Message)
 	if len(match) < 2 {
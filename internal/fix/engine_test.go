@@ -119,6 +119,41 @@ func TestPlanSkipsUnsupportedAndDuplicateViolations(t *testing.T) {
 	}
 }
 
+func TestMarkFixable(t *testing.T) {
+	tmp := t.TempDir()
+	missing := filepath.Join(tmp, "user-service.ts")
+	present := filepath.Join(tmp, "order-service.ts")
+	if err := os.WriteFile(missing, []byte("export const value = 1;\n"), 0o644); err != nil {
+		t.Fatalf("write missing: %v", err)
+	}
+	if err := os.WriteFile(present, []byte("// order-service.ts — handles orders\nexport const value = 1;\n"), 0o644); err != nil {
+		t.Fatalf("write present: %v", err)
+	}
+
+	cases := []struct {
+		v    model.Violation
+		want bool
+	}{
+		{model.Violation{RuleID: "CONV-file-header", FilePath: missing}, true},
+		{model.Violation{RuleID: "CONV-file-header", FilePath: missing, StartLine: 2}, true},
+		{model.Violation{RuleID: "CONV-file-header", FilePath: present}, false},
+		{model.Violation{RuleID: "CONV-file-header", FilePath: filepath.Join(tmp, "gone.ts")}, false},
+		{model.Violation{RuleID: "TQ-mock-scope", FilePath: missing}, false},
+		{model.Violation{RuleID: "TQ-no-shallow-assertions", FilePath: missing}, false},
+	}
+	// One call covers every case: an unreadable file must not hide the others.
+	violations := make([]model.Violation, len(cases))
+	for i, tc := range cases {
+		violations[i] = tc.v
+	}
+	MarkFixable(violations)
+	for i, tc := range cases {
+		if got := violations[i].Fixable; got != tc.want {
+			t.Fatalf("Fixable(%s %s) = %v, want %v", tc.v.RuleID, filepath.Base(tc.v.FilePath), got, tc.want)
+		}
+	}
+}
+
 func TestPlanHeaderFixNoOpWhenAlreadyPresent(t *testing.T) {
 	tmp := t.TempDir()
 	target := filepath.Join(tmp, "user-service.ts")
@@ -282,3 +317,162 @@ func TestFirstNonEmptyLine(t *testing.T) {
 		t.Fatalf("firstNonEmptyLine = %q, want empty", got)
 	}
 }
+
+func TestPlanIndentationFixMergesWithHeaderFix(t *testing.T) {
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, "service.go")
+	if err := os.WriteFile(target, []byte("package service\n\nfunc Run() {\n    call()\n}\n"), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	violations := []model.Violation{
+		{
+			RuleID:   "CONV-no-tabs-or-spaces-mismatch",
+			FilePath: target,
+			Message:  "Line 4 indents with spaces, expected tabs (1 line(s) affected)",
+			Context: &model.ViolationContext{
+				Metadata: map[string]interface{}{"indent": "tabs", "width": 4},
+			},
+		},
+		{
+			RuleID:   "CONV-file-header",
+			FilePath: target,
+			Message:  "File missing header comment",
+		},
+	}
+
+	ops, err := Plan(violations)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("ops len = %d, want 1 merged edit", len(ops))
+	}
+	if err := Apply(ops); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	after, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("read target: %v", err)
+	}
+	want := "// service.go — TODO: describe purpose\npackage service\n\nfunc Run() {\n\tcall()\n}\n"
+	if string(after) != want {
+		t.Fatalf("content after apply = %q, want %q", string(after), want)
+	}
+}
+
+func TestPlanIndentationFixSkipsWithoutMetadata(t *testing.T) {
+	ops, err := Plan([]model.Violation{{
+		RuleID:   "CONV-no-tabs-or-spaces-mismatch",
+		FilePath: filepath.Join(t.TempDir(), "missing.go"),
+	}})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("ops len = %d, want 0", len(ops))
+	}
+}
+
+func TestPlanQuoteStyleFixRewritesLiterals(t *testing.T) {
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, "greet.ts")
+	source := "const a = \"hi\";\nconst b = \"it's\";\nconst c = `x ${\"y\"}`;\n"
+	if err := os.WriteFile(target, []byte(source), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	ops, err := Plan([]model.Violation{{
+		RuleID:   "CONV-consistent-quote-style",
+		FilePath: target,
+		Context:  &model.ViolationContext{Metadata: map[string]interface{}{"quote": "single"}},
+	}})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("ops len = %d, want 1", len(ops))
+	}
+	want := "const a = 'hi';\nconst b = \"it's\";\nconst c = `x ${\"y\"}`;\n"
+	if string(ops[0].Content) != want {
+		t.Fatalf("content = %q, want %q", string(ops[0].Content), want)
+	}
+}
+
+func TestPlanRedundantElseFixFlattensElse(t *testing.T) {
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, "handler.go")
+	source := "package handler\n\nfunc Handle(err error) string {\n\tif err != nil {\n\t\treturn \"failed\"\n\t} else {\n\t\t// happy path\n\t\treturn \"ok\"\n\t}\n}\n"
+	if err := os.WriteFile(target, []byte(source), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	ops, err := Plan([]model.Violation{{RuleID: "CONV-no-redundant-else-after-return", FilePath: target, StartLine: 6}})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("ops len = %d, want 1", len(ops))
+	}
+	want := "package handler\n\nfunc Handle(err error) string {\n\tif err != nil {\n\t\treturn \"failed\"\n\t}\n\t// happy path\n\treturn \"ok\"\n}\n"
+	if string(ops[0].Content) != want {
+		t.Fatalf("content = %q, want %q", string(ops[0].Content), want)
+	}
+}
+
+func TestPlanStructAlignmentFixReordersEachStruct(t *testing.T) {
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, "cache.go")
+	source := "package cache\n\ntype Entry struct {\n\tActive bool\n\tID     int64\n\tHot    bool\n}\n\ntype Stats struct {\n\tOK    bool\n\tHits  int64\n\tStale bool\n}\n"
+	if err := os.WriteFile(target, []byte(source), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	violation := func(name string, line int) model.Violation {
+		return model.Violation{RuleID: "CONV-struct-field-alignment", FilePath: target, StartLine: line, Context: &model.ViolationContext{Metadata: map[string]interface{}{"struct": name}}}
+	}
+	ops, err := Plan([]model.Violation{violation("Entry", 3), violation("Stats", 9)})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("ops len = %d, want 1", len(ops))
+	}
+	want := "package cache\n\ntype Entry struct {\n\tID     int64\n\tActive bool\n\tHot    bool\n}\n\ntype Stats struct {\n\tHits  int64\n\tOK    bool\n\tStale bool\n}\n"
+	if string(ops[0].Content) != want {
+		t.Fatalf("content = %q, want %q", string(ops[0].Content), want)
+	}
+	unnamed := []model.Violation{{RuleID: "CONV-struct-field-alignment", FilePath: target, StartLine: 3}}
+	if MarkFixable(unnamed); unnamed[0].Fixable {
+		t.Fatal("a violation without a struct name should not be fixable")
+	}
+}
+
+func TestPlanWrapVerbFixSkipsMessageFormatFindings(t *testing.T) {
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, "store.go")
+	source := "package store\n\nimport \"fmt\"\n\nfunc load(err error) error {\n\treturn fmt.Errorf(\"Load: read config: %v (%d)\", err, 3)\n}\n"
+	if err := os.WriteFile(target, []byte(source), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	format := model.Violation{RuleID: "CONV-error-format", FilePath: target, StartLine: 6}
+	wrap := model.Violation{RuleID: "CONV-error-format", FilePath: target, StartLine: 6, Context: &model.ViolationContext{Metadata: map[string]interface{}{"kind": "wrap-verb"}}}
+	marked := []model.Violation{format, wrap}
+	MarkFixable(marked)
+	if marked[0].Fixable || !marked[1].Fixable {
+		t.Fatalf("fixable = %v, %v; want only the wrap-verb finding fixable", marked[0].Fixable, marked[1].Fixable)
+	}
+	ops, err := Plan([]model.Violation{format, wrap})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("ops len = %d, want 1", len(ops))
+	}
+	want := "package store\n\nimport \"fmt\"\n\nfunc load(err error) error {\n\treturn fmt.Errorf(\"Load: read config: %w (%d)\", err, 3)\n}\n"
+	if string(ops[0].Content) != want {
+		t.Fatalf("content = %q, want %q", string(ops[0].Content), want)
+	}
+}
@@ -4,23 +4,169 @@ package manifest
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 	"gopkg.in/yaml.v3"
 )
 
-// Contract describes a declared contract entry in .stricture-manifest.yml.
+// Contract describes a declared contract entry in .stricture-manifest.yml. Version
+// scopes every endpoint of the contract to one API version (for example "v2"); when
+// it is empty an endpoint's version comes from its path.
 type Contract struct {
-	ID       string `yaml:"id"`
-	Endpoint string `yaml:"endpoint"`
+	ID        string     `yaml:"id"`
+	Version   string     `yaml:"version"`
+	Endpoint  string     `yaml:"endpoint"`
+	Method    string     `yaml:"method"`
+	Endpoints []Endpoint `yaml:"endpoints"`
+}
+
+// Endpoint describes one HTTP endpoint of a contract and its payload shapes.
+type Endpoint struct {
+	Path     string `yaml:"path"`
 	Method   string `yaml:"method"`
+	Request  *Shape `yaml:"request"`
+	Response *Shape `yaml:"response"`
+}
+
+// Shape names a payload type and declares its fields.
+type Shape struct {
+	Type   string           `yaml:"type"`
+	Fields map[string]Field `yaml:"fields"`
+}
+
+// Field is a declared payload field. Only the attributes Stricture checks are decoded.
+// Values lists the allowed values of an `enum` field.
+type Field struct {
+	Type     string   `yaml:"type"`
+	Required bool     `yaml:"required"`
+	Values   []string `yaml:"values"`
+}
+
+// RequiredFields returns the sorted names of the fields marked required.
+func (s Shape) RequiredFields() []string {
+	names := make([]string, 0, len(s.Fields))
+	for name, field := range s.Fields {
+		if field.Required {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RequestShapes returns every typed request shape declared across the manifest's endpoints.
+func (m Manifest) RequestShapes() []Shape {
+	shapes := make([]Shape, 0)
+	for _, c := range m.Contracts {
+		for _, e := range c.Endpoints {
+			if e.Request != nil && strings.TrimSpace(e.Request.Type) != "" {
+				shapes = append(shapes, *e.Request)
+			}
+		}
+	}
+	return shapes
+}
+
+// VersionedShape is a request or response shape with the API version it belongs to.
+// Version is empty for unversioned endpoints.
+type VersionedShape struct {
+	Shape
+	Version string
+}
+
+// VersionedRequestShapes returns every typed request shape with its API version: the
+// contract's version, else the version segment of the endpoint path (`/api/v2/orders`).
+func (m Manifest) VersionedRequestShapes() []VersionedShape {
+	return m.versionedShapes(func(e Endpoint) *Shape { return e.Request })
+}
+
+// VersionedResponseShapes returns every typed response shape with its API version,
+// resolved the same way as for requests.
+func (m Manifest) VersionedResponseShapes() []VersionedShape {
+	return m.versionedShapes(func(e Endpoint) *Shape { return e.Response })
+}
+
+func (m Manifest) versionedShapes(pick func(Endpoint) *Shape) []VersionedShape {
+	shapes := make([]VersionedShape, 0)
+	for _, c := range m.Contracts {
+		for _, e := range c.Endpoints {
+			shape := pick(e)
+			if shape == nil || strings.TrimSpace(shape.Type) == "" {
+				continue
+			}
+			version := NormalizeVersion(c.Version)
+			if version == "" {
+				version = PathVersion(e.Path)
+			}
+			shapes = append(shapes, VersionedShape{Shape: *shape, Version: version})
+		}
+	}
+	return shapes
+}
+
+var pathVersionPattern = regexp.MustCompile(`(?i)(?:^|/)(v\d+)(?:/|$)`)
+
+// PathVersion returns the first version segment of a URL or file path, lower-cased
+// ("v1" for `/api/v1/items` or `internal/api/v1/items.go`), or "" when there is none.
+func PathVersion(p string) string {
+	if m := pathVersionPattern.FindStringSubmatch(strings.ReplaceAll(p, "\\", "/")); m != nil {
+		return strings.ToLower(m[1])
+	}
+	return ""
+}
+
+// NormalizeVersion lower-cases a declared version and adds the "v" prefix when the
+// manifest writes a bare number ("2" becomes "v2").
+func NormalizeVersion(raw string) string {
+	version := strings.ToLower(strings.TrimSpace(raw))
+	if version != "" && version[0] >= '0' && version[0] <= '9' {
+		version = "v" + version
+	}
+	return version
+}
+
+// Shapes returns every typed request and response shape declared across the manifest's endpoints.
+func (m Manifest) Shapes() []Shape {
+	shapes := make([]Shape, 0)
+	for _, c := range m.Contracts {
+		for _, e := range c.Endpoints {
+			for _, shape := range []*Shape{e.Request, e.Response} {
+				if shape != nil && strings.TrimSpace(shape.Type) != "" {
+					shapes = append(shapes, *shape)
+				}
+			}
+		}
+	}
+	return shapes
+}
+
+// TypeMapping declares how a Go type may be represented in TypeScript. Entries are
+// incompatible unless Compatible is set; a Go type with compatible entries maps only
+// to the TypeScript types those entries list.
+type TypeMapping struct {
+	Name       string `yaml:"name"`
+	Go         string `yaml:"go"`
+	TypeScript string `yaml:"typescript"`
+	Compatible bool   `yaml:"compatible"`
+	Reason     string `yaml:"reason"`
+}
+
+// Label returns the mapping's name, or "go <type> <-> typescript <type>" when unnamed.
+func (t TypeMapping) Label() string {
+	if name := strings.TrimSpace(t.Name); name != "" {
+		return name
+	}
+	return fmt.Sprintf("go %s <-> typescript %s", t.Go, t.TypeScript)
 }
 
 // Manifest is the top-level manifest declaration.
 type Manifest struct {
-	ManifestVersion string     `yaml:"manifest_version"`
-	Contracts       []Contract `yaml:"contracts"`
+	ManifestVersion string        `yaml:"manifest_version"`
+	Contracts       []Contract    `yaml:"contracts"`
+	TypeMappings    []TypeMapping `yaml:"type_mappings"`
 }
 
 // Parse parses and validates manifest bytes.
@@ -60,5 +206,10 @@ func Validate(m Manifest) error {
 			return fmt.Errorf("validate manifest: %w", model.ErrManifestInvalid)
 		}
 	}
+	for _, t := range m.TypeMappings {
+		if strings.TrimSpace(t.Go) == "" || strings.TrimSpace(t.TypeScript) == "" {
+			return fmt.Errorf("validate manifest: type mapping needs go and typescript: %w", model.ErrManifestInvalid)
+		}
+	}
 	return nil
 }
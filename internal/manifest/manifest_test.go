@@ -78,3 +78,165 @@ func TestValidateManifestBranches(t *testing.T) {
 		t.Fatalf("validate valid manifest returned error: %v", err)
 	}
 }
+
+func TestParseEndpointRequestShapes(t *testing.T) {
+	data := []byte(`manifest_version: "1.0"
+contracts:
+  - id: user-api
+    endpoints:
+      - path: /api/users
+        method: POST
+        request:
+          type: CreateUserRequest
+          fields:
+            name:  { type: string, maxLength: 255, required: true }
+            email: { type: string, format: email, required: true }
+            nickname: { type: string }
+      - path: /api/users/:id
+        method: GET
+        response:
+          type: User
+`)
+	m, err := Parse(data)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	shapes := m.RequestShapes()
+	if len(shapes) != 1 || shapes[0].Type != "CreateUserRequest" {
+		t.Fatalf("unexpected request shapes: %+v", shapes)
+	}
+	if got := shapes[0].RequiredFields(); len(got) != 2 || got[0] != "email" || got[1] != "name" {
+		t.Fatalf("required fields = %v, want [email name]", got)
+	}
+}
+
+func TestParseTypeMappings(t *testing.T) {
+	data := []byte(`manifest_version: v1
+contracts:
+  - id: orders.v1
+    endpoints:
+      - path: /orders
+        method: POST
+        request:
+          type: CreateOrderRequest
+        response:
+          type: Order
+type_mappings:
+  - name: int64-as-string
+    go: int64
+    typescript: string
+    compatible: true
+  - go: int64
+    typescript: number
+    reason: JavaScript numbers lose precision above 2^53
+`)
+	m, err := Parse(data)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if len(m.TypeMappings) != 2 || !m.TypeMappings[0].Compatible || m.TypeMappings[1].Compatible {
+		t.Fatalf("unexpected type mappings: %+v", m.TypeMappings)
+	}
+	if got := m.TypeMappings[0].Label(); got != "int64-as-string" {
+		t.Fatalf("named label = %q", got)
+	}
+	if got := m.TypeMappings[1].Label(); got != "go int64 <-> typescript number" {
+		t.Fatalf("unnamed label = %q", got)
+	}
+	if shapes := m.Shapes(); len(shapes) != 2 || shapes[0].Type != "CreateOrderRequest" || shapes[1].Type != "Order" {
+		t.Fatalf("shapes = %+v", shapes)
+	}
+
+	_, err = Parse([]byte("manifest_version: v1\ncontracts:\n  - id: orders.v1\ntype_mappings:\n  - go: int64\n"))
+	if !errors.Is(err, model.ErrManifestInvalid) {
+		t.Fatalf("incomplete mapping error = %v, want ErrManifestInvalid", err)
+	}
+}
+
+func TestVersionedRequestShapes(t *testing.T) {
+	data := []byte(`manifest_version: v1
+contracts:
+  - id: items.v1
+    endpoints:
+      - path: /api/v1/items
+        method: POST
+        request: { type: CreateItemRequest }
+  - id: items.v2
+    version: 2
+    endpoints:
+      - path: /items
+        method: POST
+        request: { type: CreateItemRequest }
+      - path: /items/:id
+        method: GET
+  - id: health
+    endpoints:
+      - path: /health
+        method: POST
+        request: { type: Ping }
+`)
+	m, err := Parse(data)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	got := m.VersionedRequestShapes()
+	want := []struct{ typ, version string }{{"CreateItemRequest", "v1"}, {"CreateItemRequest", "v2"}, {"Ping", ""}}
+	if len(got) != len(want) {
+		t.Fatalf("shapes = %+v, want %d", got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Type != w.typ || got[i].Version != w.version {
+			t.Fatalf("shape %d = %s %q, want %s %q", i, got[i].Type, got[i].Version, w.typ, w.version)
+		}
+	}
+}
+
+func TestVersionedResponseShapesDecodeEnumValues(t *testing.T) {
+	data := []byte(`manifest_version: v1
+contracts:
+  - id: orders.v2
+    version: v2
+    endpoints:
+      - path: /orders/:id
+        method: GET
+        response:
+          type: Order
+          fields:
+            status:   { type: enum, values: ["pending", "shipped"] }
+            priority: { type: enum, values: [1, 2, 3] }
+`)
+	m, err := Parse(data)
+	if err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	got := m.VersionedResponseShapes()
+	if len(got) != 1 || got[0].Type != "Order" || got[0].Version != "v2" {
+		t.Fatalf("shapes = %+v", got)
+	}
+	if values := got[0].Fields["status"].Values; len(values) != 2 || values[0] != "pending" || values[1] != "shipped" {
+		t.Fatalf("status values = %v", values)
+	}
+	if values := got[0].Fields["priority"].Values; len(values) != 3 || values[2] != "3" {
+		t.Fatalf("numeric values should decode as strings, got %v", values)
+	}
+	if len(m.VersionedRequestShapes()) != 0 {
+		t.Fatalf("response shapes must not be reported as request shapes")
+	}
+}
+
+func TestPathVersion(t *testing.T) {
+	cases := map[string]string{
+		"/api/v1/items":              "v1",
+		"/V2/orders":                 "v2",
+		"internal/api/v3/handler.go": "v3",
+		`internal\api\v4\handler.go`: "v4",
+		"/api/items/v1beta":          "",
+		"internal/dev1/handler.go":   "",
+		"":                           "",
+	}
+	for input, want := range cases {
+		if got := PathVersion(input); got != want {
+			t.Fatalf("PathVersion(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -1,12 +1,17 @@
 // rule.go — Rule interface, RuleConfig, and RuleRegistry.
 package model
 
+import (
+	"sort"
+	"strings"
+)
+
 // Rule defines the interface all lint rules must implement.
 type Rule interface {
 	// ID returns the unique rule identifier (e.g., "CONV-file-naming").
 	ID() string
 
-	// Category returns the rule category (CONV, ARCH, TQ, CTR).
+	// Category returns the rule category (CONV, ARCH, TQ, CTR, or a plugin-defined one).
 	Category() string
 
 	// Description returns a human-readable description of the rule.
@@ -26,12 +31,74 @@ type Rule interface {
 	Why() string
 }
 
+// RuleExample is a bad/good code pair that illustrates a rule. Language is the
+// example's language ("go", "typescript", ...) or "text" for prose descriptions.
+type RuleExample struct {
+	Language string `json:"language"`
+	Bad      string `json:"bad"`
+	Good     string `json:"good"`
+}
+
+// ExampleProvider is implemented by rules that ship code examples. It is optional
+// so plugins and older rules keep satisfying Rule.
+type ExampleProvider interface {
+	Examples() []RuleExample
+}
+
+// RuleExamples returns the rule's examples, or nil when it provides none.
+func RuleExamples(rule Rule) []RuleExample {
+	if provider, ok := rule.(ExampleProvider); ok {
+		return provider.Examples()
+	}
+	return nil
+}
+
+// ExternalInputReader is implemented by rules whose result also depends on files other
+// than the one being checked (fixtures, snapshots, git history). The lint results cache
+// never reuses their violations. It is optional.
+type ExternalInputReader interface {
+	ReadsExternalInputs() bool
+}
+
+// ReadsExternalInputs reports whether rule declares that it reads files beyond the one
+// being checked.
+func ReadsExternalInputs(rule Rule) bool {
+	reader, ok := rule.(ExternalInputReader)
+	return ok && reader.ReadsExternalInputs()
+}
+
+// Rule stability levels. Rules that do not declare one are stable.
+const (
+	StabilityStable       = "stable"
+	StabilityExperimental = "experimental"
+	StabilityDeprecated   = "deprecated"
+)
+
+// StabilityReporter is implemented by rules that are not (or no longer) stable. It is
+// optional.
+type StabilityReporter interface {
+	Stability() string
+}
+
+// RuleStability returns the rule's declared stability, or StabilityStable.
+func RuleStability(rule Rule) string {
+	if reporter, ok := rule.(StabilityReporter); ok {
+		if stability := strings.ToLower(strings.TrimSpace(reporter.Stability())); stability != "" {
+			return stability
+		}
+	}
+	return StabilityStable
+}
+
 // RuleConfig holds configuration for a specific rule instance.
 type RuleConfig struct {
 	Severity string
 	Options  map[string]interface{}
 }
 
+// builtinCategoryOrder is the display order of the categories that ship with stricture.
+var builtinCategoryOrder = []string{"tq", "arch", "conv", "ctr"}
+
 // RuleRegistry holds all registered rules.
 type RuleRegistry struct {
 	rules []Rule
@@ -72,3 +139,26 @@ func (r *RuleRegistry) ByCategory(category string) []Rule {
 	}
 	return result
 }
+
+// Categories returns the distinct lower-cased categories of registered rules:
+// built-in categories first in their fixed order, then plugin categories alphabetically.
+func (r *RuleRegistry) Categories() []string {
+	present := map[string]bool{}
+	for _, rule := range r.rules {
+		present[strings.ToLower(strings.TrimSpace(rule.Category()))] = true
+	}
+
+	categories := make([]string, 0, len(present))
+	for _, category := range builtinCategoryOrder {
+		if present[category] {
+			categories = append(categories, category)
+			delete(present, category)
+		}
+	}
+	extra := make([]string, 0, len(present))
+	for category := range present {
+		extra = append(extra, category)
+	}
+	sort.Strings(extra)
+	return append(categories, extra...)
+}
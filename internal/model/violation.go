@@ -2,6 +2,7 @@
 package model
 
 // Violation represents a rule violation.
+// Columns are 1-based; rules that only know the line leave them zero.
 type Violation struct {
 	RuleID      string
 	Severity    string
@@ -9,9 +10,22 @@ type Violation struct {
 	FilePath    string
 	StartLine   int
 	EndLine     int
-	StartColumn int
-	EndColumn   int
+	StartColumn int `json:",omitempty"`
+	EndColumn   int `json:",omitempty"`
 	Context     *ViolationContext
+	// Snippet is only set when the CLI is asked for surrounding source (--diff-context).
+	Snippet *ViolationSnippet `json:",omitempty"`
+	// Fixable is set by the CLI for JSON, SARIF, and templated output: whether
+	// `strict fix` has an operation for this violation.
+	Fixable bool
+}
+
+// ViolationSnippet is a run of source lines around a violation. StartLine and EndLine
+// are the 1-based numbers of the first and last entries in Lines.
+type ViolationSnippet struct {
+	StartLine int
+	EndLine   int
+	Lines     []string
 }
 
 // ViolationContext provides additional context for a violation.
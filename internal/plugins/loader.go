@@ -14,7 +14,8 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// Load loads custom rules from plugin paths.
+// Load loads custom rules from plugin paths. https URLs are fetched, checksum-verified,
+// and cached before loading (see fetchRemotePlugin).
 func Load(paths []string) ([]model.Rule, error) {
 	loaded := make([]model.Rule, 0)
 	seen := map[string]bool{}
@@ -24,6 +25,13 @@ func Load(paths []string) ([]model.Rule, error) {
 		if pathValue == "" {
 			continue
 		}
+		if isRemotePluginPath(pathValue) {
+			local, err := fetchRemotePlugin(pathValue)
+			if err != nil {
+				return nil, err
+			}
+			pathValue = local
+		}
 
 		ext := strings.ToLower(filepath.Ext(pathValue))
 		var rules []model.Rule
@@ -66,6 +74,13 @@ type yamlRule struct {
 	Why         string        `yaml:"why"`
 	Match       yamlMatch     `yaml:"match"`
 	Check       yamlCheckSpec `yaml:"check"`
+	Examples    []yamlExample `yaml:"examples"`
+}
+
+type yamlExample struct {
+	Language string `yaml:"language"`
+	Bad      string `yaml:"bad"`
+	Good     string `yaml:"good"`
 }
 
 type yamlMatch struct {
@@ -126,6 +141,7 @@ type yamlLoadedRule struct {
 	excludePathRegex    []*regexp.Regexp
 	pattern             *regexp.Regexp
 	message             string
+	examples            []model.RuleExample
 }
 
 func newYAMLRule(raw yamlRule) (*yamlLoadedRule, error) {
@@ -188,6 +204,14 @@ func newYAMLRule(raw yamlRule) (*yamlLoadedRule, error) {
 		why = "Custom policy from plugin configuration."
 	}
 
+	examples := make([]model.RuleExample, 0, len(raw.Examples))
+	for _, ex := range raw.Examples {
+		if strings.TrimSpace(ex.Bad) == "" && strings.TrimSpace(ex.Good) == "" {
+			continue
+		}
+		examples = append(examples, model.RuleExample{Language: strings.TrimSpace(ex.Language), Bad: ex.Bad, Good: ex.Good})
+	}
+
 	return &yamlLoadedRule{
 		id:               id,
 		category:         category,
@@ -199,6 +223,7 @@ func newYAMLRule(raw yamlRule) (*yamlLoadedRule, error) {
 		excludePathRegex: exclude,
 		pattern:          pattern,
 		message:          message,
+		examples:         examples,
 	}, nil
 }
 
@@ -208,6 +233,9 @@ func (r *yamlLoadedRule) Description() string       { return r.description }
 func (r *yamlLoadedRule) DefaultSeverity() string   { return r.severity }
 func (r *yamlLoadedRule) NeedsProjectContext() bool { return r.needsProjectContext }
 func (r *yamlLoadedRule) Why() string               { return r.why }
+func (r *yamlLoadedRule) Examples() []model.RuleExample {
+	return r.examples
+}
 
 func (r *yamlLoadedRule) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	if file == nil {
@@ -360,12 +388,14 @@ func (r *goPluginRule) Check(file *model.UnifiedFileModel, ctx *model.ProjectCon
 			severity = config.Severity
 		}
 		converted = append(converted, model.Violation{
-			RuleID:    ruleID,
-			Severity:  severity,
-			Message:   v.Message,
-			FilePath:  file.Path,
-			StartLine: v.StartLine,
-			EndLine:   v.EndLine,
+			RuleID:      ruleID,
+			Severity:    severity,
+			Message:     v.Message,
+			FilePath:    file.Path,
+			StartLine:   v.StartLine,
+			EndLine:     v.EndLine,
+			StartColumn: v.StartColumn,
+			EndColumn:   v.EndColumn,
 			Context: &model.ViolationContext{
 				SuggestedFix: v.SuggestedFix,
 			},
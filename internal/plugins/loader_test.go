@@ -282,6 +282,24 @@ func TestYAMLRuleDefaultsAndCheckFilters(t *testing.T) {
 	}
 }
 
+func TestYAMLRuleExamples(t *testing.T) {
+	rule, err := newYAMLRule(yamlRule{
+		ID:    "CUSTOM-examples",
+		Check: yamlCheckSpec{MustNotContain: yamlMustNotContain{Pattern: "TODO"}},
+		Examples: []yamlExample{
+			{Language: " go ", Bad: "// TODO: fix", Good: "// See issue 12."},
+			{Language: "go"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("newYAMLRule returned error: %v", err)
+	}
+	examples := model.RuleExamples(rule)
+	if len(examples) != 1 || examples[0].Language != "go" || examples[0].Bad != "// TODO: fix" {
+		t.Fatalf("unexpected examples: %+v", examples)
+	}
+}
+
 func TestGoPluginRuleMetadataAndCheck(t *testing.T) {
 	capturedFileCount := -1
 	rule := &goPluginRule{
@@ -304,7 +322,7 @@ func TestGoPluginRuleMetadataAndCheck(t *testing.T) {
 					t.Fatalf("unexpected options: %#v", options)
 				}
 				return []plugapi.Violation{
-					{Message: "primary", StartLine: 2, SuggestedFix: "replace me"},
+					{Message: "primary", StartLine: 2, StartColumn: 7, EndColumn: 12, SuggestedFix: "replace me"},
 					{RuleID: "CUSTOM-alt", Severity: "off", Message: "secondary", StartLine: 3, EndLine: 4},
 				}
 			},
@@ -357,6 +375,9 @@ func TestGoPluginRuleMetadataAndCheck(t *testing.T) {
 	if violations[0].Severity != "warn" {
 		t.Fatalf("severity fallback = %q, want warn", violations[0].Severity)
 	}
+	if violations[0].StartColumn != 7 || violations[0].EndColumn != 12 {
+		t.Fatalf("columns not preserved: %d-%d", violations[0].StartColumn, violations[0].EndColumn)
+	}
 	if violations[0].Context == nil || violations[0].Context.SuggestedFix != "replace me" {
 		t.Fatalf("missing suggested fix context: %#v", violations[0].Context)
 	}
@@ -0,0 +1,147 @@
+// remote.go — Fetch, verify, and cache YAML plugins referenced by https URL.
+package plugins
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxRemotePluginBytes caps remote plugin and checksum downloads.
+const maxRemotePluginBytes = 4 << 20
+
+var (
+	// RemoteCacheDir is where verified remote plugins are stored, keyed by checksum.
+	RemoteCacheDir = filepath.Join(".stricture-cache", "plugins")
+
+	remoteHTTPClient = &http.Client{Timeout: 30 * time.Second, CheckRedirect: httpsOnlyRedirect}
+)
+
+// httpsOnlyRedirect refuses redirects away from https, which would otherwise let the
+// plugin and its sidecar checksum both arrive over plain http.
+func httpsOnlyRedirect(req *http.Request, via []*http.Request) error {
+	if !strings.EqualFold(req.URL.Scheme, "https") {
+		return fmt.Errorf("refusing redirect to non-https URL %s", req.URL.Redacted())
+	}
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after %d redirects", len(via))
+	}
+	return nil
+}
+
+func isRemotePluginPath(pathValue string) bool {
+	return strings.Contains(pathValue, "://")
+}
+
+// fetchRemotePlugin returns a local path for a remote YAML plugin. The expected
+// SHA-256 comes from a `sha256` query parameter, or else from a `<url>.sha256`
+// sidecar; downloads that are not https or do not match are rejected. Verified
+// files are cached under RemoteCacheDir/<sha256>/ and reused without refetching.
+func fetchRemotePlugin(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid plugin URL %s: %w", rawURL, err)
+	}
+	if !strings.EqualFold(u.Scheme, "https") {
+		return "", fmt.Errorf("remote plugin %s must use https", rawURL)
+	}
+	name := path.Base(u.Path)
+	switch strings.ToLower(path.Ext(name)) {
+	case ".yml", ".yaml":
+	default:
+		return "", fmt.Errorf("remote plugin %s must be a .yml or .yaml file", rawURL)
+	}
+
+	query := u.Query()
+	expected := strings.ToLower(strings.TrimSpace(query.Get("sha256")))
+	query.Del("sha256")
+	u.RawQuery = query.Encode()
+	fetchURL := u.String()
+
+	if expected == "" {
+		sidecar := *u
+		sidecar.Path += ".sha256"
+		sidecar.RawPath = ""
+		body, err := downloadRemote(sidecar.String())
+		if err != nil {
+			return "", fmt.Errorf("remote plugin %s has no sha256 query parameter and its checksum sidecar is unavailable: %w", rawURL, err)
+		}
+		if fields := strings.Fields(string(body)); len(fields) > 0 {
+			expected = strings.ToLower(fields[0])
+		}
+	}
+	if decoded, err := hex.DecodeString(expected); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("remote plugin %s has an invalid sha256 checksum %q", rawURL, expected)
+	}
+
+	cached := filepath.Join(RemoteCacheDir, expected, name)
+	if data, err := os.ReadFile(cached); err == nil && sha256Hex(data) == expected {
+		return cached, nil
+	}
+
+	data, err := downloadRemote(fetchURL)
+	if err != nil {
+		return "", fmt.Errorf("fetch remote plugin %s: %w", rawURL, err)
+	}
+	if got := sha256Hex(data); got != expected {
+		return "", fmt.Errorf("remote plugin %s checksum mismatch: got sha256 %s, want %s", rawURL, got, expected)
+	}
+	if err := writeFileAtomic(cached, data); err != nil {
+		return "", fmt.Errorf("cache remote plugin %s: %w", rawURL, err)
+	}
+	return cached, nil
+}
+
+func downloadRemote(rawURL string) ([]byte, error) {
+	resp, err := remoteHTTPClient.Get(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.Request != nil && !strings.EqualFold(resp.Request.URL.Scheme, "https") {
+		return nil, fmt.Errorf("GET %s: served over %s, not https", rawURL, resp.Request.URL.Scheme)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", rawURL, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemotePluginBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRemotePluginBytes {
+		return nil, fmt.Errorf("GET %s: response exceeds %d bytes", rawURL, maxRemotePluginBytes)
+	}
+	return data, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func writeFileAtomic(target string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
@@ -0,0 +1,153 @@
+// remote_test.go — Tests for fetching and caching https plugins.
+package plugins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const remotePluginYAML = `rules:
+  - id: REMOTE-no-todo
+    severity: warn
+    check:
+      must_not_contain:
+        pattern: "TODO"
+`
+
+// serveRemotePlugins starts a TLS server for the test and points the loader's
+// client and cache at it. It returns the server URL and a request counter.
+func serveRemotePlugins(t *testing.T, files map[string]string) (string, *int32) {
+	t.Helper()
+	var hits int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		body, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	prevClient, prevDir := remoteHTTPClient, RemoteCacheDir
+	client := *server.Client()
+	client.CheckRedirect = prevClient.CheckRedirect
+	remoteHTTPClient = &client
+	RemoteCacheDir = filepath.Join(t.TempDir(), "plugins")
+	t.Cleanup(func() { remoteHTTPClient, RemoteCacheDir = prevClient, prevDir })
+	return server.URL, &hits
+}
+
+func TestLoadRemotePluginWithQueryChecksum(t *testing.T) {
+	base, hits := serveRemotePlugins(t, map[string]string{"/packs/rules.yml": remotePluginYAML})
+	sum := sha256Hex([]byte(remotePluginYAML))
+	pluginURL := base + "/packs/rules.yml?sha256=" + sum
+
+	rules, err := Load([]string{pluginURL})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(rules) != 1 || rules[0].ID() != "REMOTE-no-todo" {
+		t.Fatalf("unexpected rules: %+v", rules)
+	}
+	if _, err := os.Stat(filepath.Join(RemoteCacheDir, sum, "rules.yml")); err != nil {
+		t.Fatalf("expected cached plugin: %v", err)
+	}
+
+	if _, err := Load([]string{pluginURL}); err != nil {
+		t.Fatalf("second Load returned error: %v", err)
+	}
+	if got := atomic.LoadInt32(hits); got != 1 {
+		t.Fatalf("server hits = %d, want 1 (second load should use the cache)", got)
+	}
+}
+
+func TestLoadRemotePluginWithSidecarChecksum(t *testing.T) {
+	base, _ := serveRemotePlugins(t, map[string]string{
+		"/rules.yaml":        remotePluginYAML,
+		"/rules.yaml.sha256": sha256Hex([]byte(remotePluginYAML)) + "  rules.yaml\n",
+	})
+
+	rules, err := Load([]string{base + "/rules.yaml"})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("rules len = %d, want 1", len(rules))
+	}
+}
+
+func TestLoadRemotePluginRejectsUnverified(t *testing.T) {
+	base, _ := serveRemotePlugins(t, map[string]string{"/rules.yml": remotePluginYAML})
+	wrong := strings.Repeat("0", 64)
+
+	cases := []struct {
+		name    string
+		url     string
+		wantErr string
+	}{
+		{name: "plain http", url: "http://example.com/rules.yml?sha256=" + wrong, wantErr: "must use https"},
+		{name: "no checksum", url: base + "/rules.yml", wantErr: "checksum sidecar is unavailable"},
+		{name: "mismatch", url: base + "/rules.yml?sha256=" + wrong, wantErr: "checksum mismatch"},
+		{name: "malformed checksum", url: base + "/rules.yml?sha256=abc", wantErr: "invalid sha256"},
+		{name: "not yaml", url: base + "/rules.so?sha256=" + wrong, wantErr: "must be a .yml or .yaml file"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Load([]string{tc.url})
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("Load(%s) error = %v, want %q", tc.url, err, tc.wantErr)
+			}
+		})
+	}
+	entries, _ := os.ReadDir(RemoteCacheDir)
+	if len(entries) != 0 {
+		t.Fatalf("rejected downloads must not be cached, found %d entries", len(entries))
+	}
+}
+
+func TestLoadRemotePluginRejectsRedirectToHTTP(t *testing.T) {
+	var plainHits int32
+	plain := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&plainHits, 1)
+		if strings.HasSuffix(r.URL.Path, ".sha256") {
+			_, _ = w.Write([]byte(sha256Hex([]byte(remotePluginYAML))))
+			return
+		}
+		_, _ = w.Write([]byte(remotePluginYAML))
+	}))
+	t.Cleanup(plain.Close)
+	secure := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, plain.URL+r.URL.Path, http.StatusFound)
+	}))
+	t.Cleanup(secure.Close)
+
+	prevClient, prevDir := remoteHTTPClient, RemoteCacheDir
+	RemoteCacheDir = filepath.Join(t.TempDir(), "plugins")
+	t.Cleanup(func() { remoteHTTPClient, RemoteCacheDir = prevClient, prevDir })
+
+	client := *secure.Client()
+	client.CheckRedirect = prevClient.CheckRedirect
+	remoteHTTPClient = &client
+	if _, err := Load([]string{secure.URL + "/rules.yml"}); err == nil || !strings.Contains(err.Error(), "refusing redirect to non-https URL") {
+		t.Fatalf("Load error = %v, want redirect refusal", err)
+	}
+	if got := atomic.LoadInt32(&plainHits); got != 0 {
+		t.Fatalf("plain http server hits = %d, want 0", got)
+	}
+
+	// A client that follows any redirect is still caught by the final-URL check.
+	remoteHTTPClient = secure.Client()
+	if _, err := Load([]string{secure.URL + "/rules.yml"}); err == nil || !strings.Contains(err.Error(), "not https") {
+		t.Fatalf("Load error = %v, want non-https response rejection", err)
+	}
+	if entries, _ := os.ReadDir(RemoteCacheDir); len(entries) != 0 {
+		t.Fatalf("downloads over http must not be cached, found %d entries", len(entries))
+	}
+}
@@ -0,0 +1,116 @@
+// junit.go — JUnit XML reporter.
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// JUnit writes one <testsuite> named Title with a <testcase> per checked file;
+// each violation becomes a <failure> of its file's test case.
+type JUnit struct {
+	Title string
+	Files []string
+}
+
+func (r *JUnit) Format() string { return "junit" }
+
+func (r *JUnit) Report(w io.Writer, violations []model.Violation, summary Summary) error {
+	title := strings.TrimSpace(r.Title)
+	if title == "" {
+		title = DefaultTitle
+	}
+
+	byFile := map[string][]model.Violation{}
+	for _, v := range violations {
+		byFile[v.FilePath] = append(byFile[v.FilePath], v)
+	}
+	paths := make([]string, 0, len(r.Files)+len(byFile))
+	seen := map[string]bool{}
+	for _, p := range r.Files {
+		if !seen[p] {
+			seen[p] = true
+			paths = append(paths, p)
+		}
+	}
+	for p := range byFile {
+		if !seen[p] {
+			seen[p] = true
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	suite := junitSuite{Name: title, Time: junitSeconds(summary.Duration)}
+	for _, p := range paths {
+		tc := junitCase{Name: filepath.ToSlash(p), ClassName: title}
+		for _, v := range byFile[p] {
+			location := fmt.Sprintf("%s:%d", filepath.ToSlash(v.FilePath), v.StartLine)
+			if v.StartColumn > 0 {
+				location = fmt.Sprintf("%s:%d", location, v.StartColumn)
+			}
+			tc.Failures = append(tc.Failures, junitFailure{
+				Message: v.Message,
+				Type:    v.RuleID,
+				Body:    fmt.Sprintf("%s: %s %s: %s", location, strings.ToUpper(v.Severity), v.RuleID, v.Message),
+			})
+		}
+		if len(tc.Failures) > 0 {
+			suite.Failures++
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	suite.Tests = len(suite.Cases)
+
+	doc := junitSuites{Name: title, Tests: suite.Tests, Failures: suite.Failures, Time: suite.Time, Suites: []junitSuite{suite}}
+	encoded, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	_, err = w.Write(append(encoded, '\n'))
+	return err
+}
+
+func junitSeconds(ms int64) string {
+	return fmt.Sprintf("%.3f", float64(ms)/1000)
+}
+
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Time     string       `xml:"time,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	Failures  []junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
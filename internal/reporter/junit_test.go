@@ -0,0 +1,44 @@
+// junit_test.go — Tests for the JUnit reporter.
+package reporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestJUnitReport(t *testing.T) {
+	r := &JUnit{Title: "backend-lint", Files: []string{"pkg/clean.go", "pkg/a.go"}}
+	violations := []model.Violation{
+		{RuleID: "RULE-A", Severity: "error", Message: "bad thing", FilePath: "pkg/a.go", StartLine: 3, StartColumn: 5},
+		{RuleID: "RULE-B", Severity: "warn", Message: "meh", FilePath: "pkg/a.go", StartLine: 9},
+	}
+	var buf bytes.Buffer
+	if err := r.Report(&buf, violations, Summary{Duration: 1500}); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "<?xml") {
+		t.Fatalf("missing XML header: %q", buf.String())
+	}
+
+	var doc junitSuites
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JUnit XML: %v\n%s", err, buf.String())
+	}
+	if doc.Name != "backend-lint" || len(doc.Suites) != 1 || doc.Suites[0].Name != "backend-lint" {
+		t.Fatalf("title not applied: %+v", doc)
+	}
+	suite := doc.Suites[0]
+	if suite.Tests != 2 || suite.Failures != 1 || suite.Time != "1.500" {
+		t.Fatalf("unexpected suite counts: %+v", suite)
+	}
+	if suite.Cases[0].Name != "pkg/a.go" || len(suite.Cases[0].Failures) != 2 || len(suite.Cases[1].Failures) != 0 {
+		t.Fatalf("unexpected test cases: %+v", suite.Cases)
+	}
+	if got := suite.Cases[0].Failures[0].Body; got != "pkg/a.go:3:5: ERROR RULE-A: bad thing" {
+		t.Fatalf("failure body = %q", got)
+	}
+}
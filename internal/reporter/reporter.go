@@ -1,18 +1,26 @@
 // reporter.go — Reporter interface and Summary type.
 package reporter
 
-import "github.com/stricture/stricture/internal/model"
+import (
+	"io"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// DefaultTitle names the tool and suite in reports when no --report-title is given.
+const DefaultTitle = "stricture"
 
 // Reporter defines the interface for output formatters.
 type Reporter interface {
 	// Format returns the format name (e.g., "text", "json", "sarif").
 	Format() string
 
-	// Report outputs violations in the reporter's format.
-	Report(violations []model.Violation, summary Summary) error
+	// Report formats violations and writes them to w.
+	Report(w io.Writer, violations []model.Violation, summary Summary) error
 }
 
-// Summary holds aggregate statistics about a lint run.
+// Summary holds aggregate statistics about a lint run. Partial marks a run that was
+// interrupted before every file was checked.
 type Summary struct {
 	TotalFiles      int
 	FilesWithIssues int
@@ -20,4 +28,5 @@ type Summary struct {
 	ErrorCount      int
 	WarningCount    int
 	Duration        int64
+	Partial         bool
 }
@@ -0,0 +1,224 @@
+// sarif.go — SARIF 2.1.0 reporter.
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+// SARIF writes a single-run SARIF 2.1.0 log. Title becomes tool.driver.name and
+// the run's "title" property; Rules populate tool.driver.rules. Suppressed violations
+// (baseline matches) follow the active results, each with an external suppression
+// carrying SuppressedJustification and baselineState "unchanged". Every result has a
+// stricture/v1 partialFingerprints entry (see sarifFingerprints) computed from Files,
+// keyed by violation FilePath.
+type SARIF struct {
+	Title                   string
+	Version                 string
+	Rules                   []model.Rule
+	Suppressed              []model.Violation
+	SuppressedJustification string
+	Files                   map[string]*model.UnifiedFileModel
+}
+
+func (r *SARIF) Format() string { return "sarif" }
+
+func (r *SARIF) Report(w io.Writer, violations []model.Violation, summary Summary) error {
+	title := strings.TrimSpace(r.Title)
+	if title == "" {
+		title = DefaultTitle
+	}
+
+	all := append(append([]model.Violation(nil), violations...), r.Suppressed...)
+	rules, index := sarifRules(r.Rules, all)
+	fingerprints := sarifFingerprints(all, r.Files)
+	results := make([]sarifResult, 0, len(all))
+	for i, v := range violations {
+		results = append(results, sarifResultFor(v, index, fingerprints[i]))
+	}
+	for i, v := range r.Suppressed {
+		result := sarifResultFor(v, index, fingerprints[len(violations)+i])
+		result.Suppressions = []sarifSuppression{{Kind: "external", Justification: r.SuppressedJustification}}
+		result.BaselineState = "unchanged"
+		results = append(results, result)
+	}
+
+	properties := map[string]interface{}{
+		"title":           title,
+		"filesChecked":    summary.TotalFiles,
+		"filesWithIssues": summary.FilesWithIssues,
+		"errors":          summary.ErrorCount,
+		"warnings":        summary.WarningCount,
+		"elapsedMs":       summary.Duration,
+	}
+	if summary.Partial {
+		properties["partial"] = true
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool:       sarifTool{Driver: sarifDriver{Name: title, Version: r.Version, Rules: rules}},
+			Results:    results,
+			Properties: properties,
+		}},
+	}
+	encoded, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(encoded, '\n'))
+	return err
+}
+
+func sarifResultFor(v model.Violation, index map[string]int, fingerprint string) sarifResult {
+	region := sarifRegion{StartLine: v.StartLine, StartColumn: v.StartColumn, EndLine: v.EndLine, EndColumn: v.EndColumn}
+	if region.StartLine < 1 {
+		region.StartLine = 1
+	}
+	result := sarifResult{
+		RuleID:    v.RuleID,
+		RuleIndex: index[v.RuleID],
+		Level:     sarifLevel(v.Severity),
+		Message:   sarifMessage{Text: v.Message},
+		Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(v.FilePath)},
+			Region:           region,
+		}}},
+		PartialFingerprints: map[string]string{sarifFingerprintKey: fingerprint},
+	}
+	result.Properties = map[string]interface{}{"fixable": v.Fixable}
+	if v.Context != nil && v.Context.SuggestedFix != "" {
+		result.Properties["suggestedFix"] = v.Context.SuggestedFix
+	}
+	return result
+}
+
+// sarifRules lists the run's rules sorted by ID, adding bare entries for rule IDs that
+// only appear on violations (plugins may report under alternate IDs).
+func sarifRules(selected []model.Rule, violations []model.Violation) ([]sarifRule, map[string]int) {
+	byID := map[string]sarifRule{}
+	for _, rule := range selected {
+		byID[rule.ID()] = sarifRule{
+			ID:                   rule.ID(),
+			ShortDescription:     sarifMessage{Text: rule.Description()},
+			FullDescription:      &sarifMessage{Text: rule.Why()},
+			DefaultConfiguration: &sarifConfiguration{Level: sarifLevel(rule.DefaultSeverity())},
+			Properties:           map[string]interface{}{"category": rule.Category()},
+		}
+	}
+	for _, v := range violations {
+		if _, ok := byID[v.RuleID]; !ok {
+			byID[v.RuleID] = sarifRule{ID: v.RuleID, ShortDescription: sarifMessage{Text: v.RuleID}}
+		}
+	}
+	ids := make([]string, 0, len(byID))
+	for id := range byID {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	rules := make([]sarifRule, 0, len(ids))
+	index := make(map[string]int, len(ids))
+	for i, id := range ids {
+		rules = append(rules, byID[id])
+		index[id] = i
+	}
+	return rules, index
+}
+
+func sarifLevel(severity string) string {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "error":
+		return "error"
+	case "warn", "warning":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool              `json:"tool"`
+	Results    []sarifResult          `json:"results"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string      `json:"name"`
+	Version string      `json:"version,omitempty"`
+	Rules   []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string                 `json:"id"`
+	ShortDescription     sarifMessage           `json:"shortDescription"`
+	FullDescription      *sarifMessage          `json:"fullDescription,omitempty"`
+	DefaultConfiguration *sarifConfiguration    `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string                 `json:"ruleId"`
+	RuleIndex           int                    `json:"ruleIndex"`
+	Level               string                 `json:"level"`
+	Message             sarifMessage           `json:"message"`
+	Locations           []sarifLocation        `json:"locations"`
+	PartialFingerprints map[string]string      `json:"partialFingerprints,omitempty"`
+	Suppressions        []sarifSuppression     `json:"suppressions,omitempty"`
+	BaselineState       string                 `json:"baselineState,omitempty"`
+	Properties          map[string]interface{} `json:"properties,omitempty"`
+}
+
+// sarifSuppression marks a result as suppressed. Baseline matches use kind "external",
+// SARIF's kind for suppressions recorded outside the source file.
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
@@ -0,0 +1,100 @@
+// sarif_fingerprint.go — Line-independent partialFingerprints for SARIF results.
+package reporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// sarifFingerprintKey names the partialFingerprints entry. The version suffix changes
+// whenever the algorithm below does, so old and new values are never compared.
+const sarifFingerprintKey = "stricture/v1"
+
+// sarifFingerprintMaxLines caps how many flagged lines feed the code part of a
+// fingerprint, so a violation spanning a whole function is not invalidated by any edit
+// inside it.
+const sarifFingerprintMaxLines = 5
+
+var (
+	fingerprintLineRefPattern = regexp.MustCompile(`(?i)\b(lines?|L)\s*\d+(?:\s*-\s*\d+)?\b`)
+	fingerprintPosRefPattern  = regexp.MustCompile(`:\d+(?::\d+)?\b`)
+)
+
+// sarifFingerprints computes the stricture/v1 partial fingerprint of each result, in
+// order. The fingerprint is `<sha256 hex>:<occurrence>`, where the hash covers, each
+// followed by a newline:
+//
+//  1. the rule ID;
+//  2. the message with whitespace runs collapsed to one space and line references
+//     ("line 12", "lines 3-4", "L12", ":12:5") replaced with "line #" and ":#";
+//  3. the flagged source lines (StartLine to EndLine, at most five) with leading and
+//     trailing whitespace trimmed, inner whitespace collapsed, and blank lines dropped.
+//
+// The file path and line numbers are left out, so a finding keeps its fingerprint when
+// code above it moves; code scanning already matches fingerprints within a file.
+// Occurrence numbers the results in one file that share a hash, from 1, in result
+// order. Files missing from files hash an empty code part.
+func sarifFingerprints(violations []model.Violation, files map[string]*model.UnifiedFileModel) []string {
+	lines := map[string][]string{}
+	seen := map[string]int{}
+	out := make([]string, 0, len(violations))
+	for _, v := range violations {
+		fileLines, ok := lines[v.FilePath]
+		if !ok {
+			if file := files[v.FilePath]; file != nil {
+				fileLines = fingerprintSourceLines(file.Source)
+			}
+			lines[v.FilePath] = fileLines
+		}
+		sum := sha256.Sum256([]byte(strings.TrimSpace(v.RuleID) + "\n" + normalizeFingerprintMessage(v.Message) + "\n" + fingerprintCode(fileLines, v.StartLine, v.EndLine) + "\n"))
+		hash := hex.EncodeToString(sum[:])
+		seen[v.FilePath+"\x00"+hash]++
+		out = append(out, fmt.Sprintf("%s:%d", hash, seen[v.FilePath+"\x00"+hash]))
+	}
+	return out
+}
+
+func normalizeFingerprintMessage(message string) string {
+	message = strings.Join(strings.Fields(message), " ")
+	message = fingerprintLineRefPattern.ReplaceAllString(message, "line #")
+	return fingerprintPosRefPattern.ReplaceAllString(message, ":#")
+}
+
+// fingerprintCode joins the normalized non-blank lines start..end (end defaults to
+// start) with newlines.
+func fingerprintCode(lines []string, start int, end int) string {
+	if start < 1 {
+		start = 1
+	}
+	if start > len(lines) {
+		return ""
+	}
+	if end < start {
+		end = start
+	}
+	if end > len(lines) {
+		end = len(lines)
+	}
+	if end-start+1 > sarifFingerprintMaxLines {
+		end = start + sarifFingerprintMaxLines - 1
+	}
+	code := make([]string, 0, end-start+1)
+	for _, line := range lines[start-1 : end] {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			code = append(code, line)
+		}
+	}
+	return strings.Join(code, "\n")
+}
+
+func fingerprintSourceLines(source []byte) []string {
+	if len(source) == 0 {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(string(source), "\n"), "\n")
+}
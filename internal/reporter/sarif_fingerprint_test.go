@@ -0,0 +1,77 @@
+// sarif_fingerprint_test.go — Tests for SARIF partialFingerprints.
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestSARIFFingerprintsIgnoreLineShifts(t *testing.T) {
+	before := map[string]*model.UnifiedFileModel{
+		"pkg/a.go": {Path: "pkg/a.go", Source: []byte("package a\n\nfunc Charge() {\n\tpanic(\"x\")\n}\n")},
+	}
+	after := map[string]*model.UnifiedFileModel{
+		"pkg/a.go": {Path: "pkg/a.go", Source: []byte("package a\n\nimport \"fmt\"\n\n// Charge bills.\nfunc Charge() {\n      panic(\"x\")\n}\n")},
+	}
+	old := sarifFingerprints([]model.Violation{{RuleID: "RULE-A", FilePath: "pkg/a.go", StartLine: 4, Message: "panic at line 4"}}, before)
+	moved := sarifFingerprints([]model.Violation{{RuleID: "RULE-A", FilePath: "pkg/a.go", StartLine: 7, Message: "panic at  line 7"}}, after)
+	if old[0] != moved[0] {
+		t.Fatalf("fingerprint changed when the finding moved: %s != %s", old[0], moved[0])
+	}
+	if !strings.HasSuffix(old[0], ":1") || len(old[0]) != 64+2 {
+		t.Fatalf("fingerprint = %q, want <sha256 hex>:1", old[0])
+	}
+
+	otherRule := sarifFingerprints([]model.Violation{{RuleID: "RULE-B", FilePath: "pkg/a.go", StartLine: 4, Message: "panic at line 4"}}, before)
+	otherCode := sarifFingerprints([]model.Violation{{RuleID: "RULE-A", FilePath: "pkg/a.go", StartLine: 3, Message: "panic at line 4"}}, before)
+	if otherRule[0] == old[0] || otherCode[0] == old[0] {
+		t.Fatalf("rule and flagged code should change the fingerprint: %s %s %s", old[0], otherRule[0], otherCode[0])
+	}
+}
+
+func TestSARIFFingerprintsNumberRepeatedFindings(t *testing.T) {
+	files := map[string]*model.UnifiedFileModel{
+		"a.go": {Path: "a.go", Source: []byte("x := 86400\nx := 86400\n")},
+		"b.go": {Path: "b.go", Source: []byte("x := 86400\n")},
+	}
+	got := sarifFingerprints([]model.Violation{
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "magic"},
+		{RuleID: "RULE-A", FilePath: "a.go", StartLine: 2, Message: "magic"},
+		{RuleID: "RULE-A", FilePath: "b.go", StartLine: 1, Message: "magic"},
+	}, files)
+	hash := strings.TrimSuffix(got[0], ":1")
+	if got[1] != hash+":2" || got[2] != hash+":1" {
+		t.Fatalf("fingerprints = %v, want occurrences counted per file", got)
+	}
+}
+
+func TestSARIFReportIncludesPartialFingerprints(t *testing.T) {
+	r := &SARIF{
+		Files:      map[string]*model.UnifiedFileModel{"a.go": {Path: "a.go", Source: []byte("package a\n")}},
+		Suppressed: []model.Violation{{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "old"}},
+	}
+	var buf bytes.Buffer
+	if err := r.Report(&buf, []model.Violation{{RuleID: "RULE-A", FilePath: "a.go", StartLine: 1, Message: "new"}, {RuleID: "RULE-A", FilePath: "gone.go", StartLine: 9, Message: "new"}}, Summary{}); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v", err)
+	}
+	results := log.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("results = %+v", results)
+	}
+	for i, result := range results {
+		if result.PartialFingerprints[sarifFingerprintKey] == "" {
+			t.Fatalf("result %d has no %s fingerprint: %+v", i, sarifFingerprintKey, result)
+		}
+	}
+	if results[0].PartialFingerprints[sarifFingerprintKey] == results[2].PartialFingerprints[sarifFingerprintKey] {
+		t.Fatalf("different messages should not share a fingerprint")
+	}
+}
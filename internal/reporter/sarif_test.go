@@ -0,0 +1,109 @@
+// sarif_test.go — Tests for the SARIF reporter.
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+type stubRule struct{ id, severity string }
+
+func (r stubRule) ID() string                { return r.id }
+func (r stubRule) Category() string          { return "conv" }
+func (r stubRule) Description() string       { return "stub " + r.id }
+func (r stubRule) Why() string               { return "because" }
+func (r stubRule) DefaultSeverity() string   { return r.severity }
+func (r stubRule) NeedsProjectContext() bool { return false }
+func (r stubRule) Check(*model.UnifiedFileModel, *model.ProjectContext, model.RuleConfig) []model.Violation {
+	return nil
+}
+
+func TestSARIFReport(t *testing.T) {
+	r := &SARIF{Title: "backend-lint", Version: "1.2.3", Rules: []model.Rule{stubRule{"RULE-B", "warn"}, stubRule{"RULE-A", "error"}}}
+	violations := []model.Violation{
+		{RuleID: "RULE-B", Severity: "warn", Message: "b", FilePath: "pkg/b.go", StartLine: 4, StartColumn: 2, Fixable: true},
+		{RuleID: "PLUGIN-X", Severity: "error", Message: "x", FilePath: "pkg/x.go"},
+	}
+	var buf bytes.Buffer
+	if err := r.Report(&buf, violations, Summary{TotalFiles: 2, ErrorCount: 1, WarningCount: 1}); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v\n%s", err, buf.String())
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected log header: %+v", log)
+	}
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "backend-lint" || run.Tool.Driver.Version != "1.2.3" || run.Properties["title"] != "backend-lint" {
+		t.Fatalf("title not applied: driver=%+v properties=%+v", run.Tool.Driver, run.Properties)
+	}
+	ids := make([]string, 0, len(run.Tool.Driver.Rules))
+	for _, rule := range run.Tool.Driver.Rules {
+		ids = append(ids, rule.ID)
+	}
+	if len(ids) != 3 || ids[0] != "PLUGIN-X" || ids[1] != "RULE-A" || ids[2] != "RULE-B" {
+		t.Fatalf("driver rules = %v", ids)
+	}
+	first := run.Results[0]
+	region := first.Locations[0].PhysicalLocation.Region
+	if first.RuleIndex != 2 || first.Level != "warning" || region.StartLine != 4 || region.StartColumn != 2 {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	if second := run.Results[1]; second.Level != "error" || second.Locations[0].PhysicalLocation.Region.StartLine != 1 {
+		t.Fatalf("unexpected second result: %+v", second)
+	}
+	if first.Properties["fixable"] != true || run.Results[1].Properties["fixable"] != false {
+		t.Fatalf("fixable properties = %v, %v", first.Properties, run.Results[1].Properties)
+	}
+}
+
+func TestSARIFReportDefaultTitle(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (&SARIF{}).Report(&buf, nil, Summary{}); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v", err)
+	}
+	if got := log.Runs[0].Tool.Driver.Name; got != DefaultTitle {
+		t.Fatalf("driver name = %q, want %q", got, DefaultTitle)
+	}
+	if log.Runs[0].Results == nil {
+		t.Fatalf("results should be an empty array, not null")
+	}
+}
+
+func TestSARIFReportSuppressedResults(t *testing.T) {
+	r := &SARIF{
+		Suppressed:              []model.Violation{{RuleID: "RULE-OLD", Severity: "error", Message: "old", FilePath: "a.go", StartLine: 3}},
+		SuppressedJustification: "Matched an entry in baseline .stricture-baseline.json",
+	}
+	violations := []model.Violation{{RuleID: "RULE-A", Severity: "error", Message: "new", FilePath: "a.go", StartLine: 1}}
+	var buf bytes.Buffer
+	if err := r.Report(&buf, violations, Summary{}); err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v", err)
+	}
+	run := log.Runs[0]
+	if len(run.Results) != 2 || len(run.Results[0].Suppressions) != 0 || run.Results[0].BaselineState != "" {
+		t.Fatalf("active result should come first without suppressions: %+v", run.Results)
+	}
+	suppressed := run.Results[1]
+	if len(suppressed.Suppressions) != 1 || suppressed.Suppressions[0].Kind != "external" ||
+		suppressed.Suppressions[0].Justification != r.SuppressedJustification || suppressed.BaselineState != "unchanged" {
+		t.Fatalf("unexpected suppressed result: %+v", suppressed)
+	}
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[suppressed.RuleIndex].ID != "RULE-OLD" {
+		t.Fatalf("suppressed rule IDs should be listed in driver rules: %+v", run.Tool.Driver.Rules)
+	}
+}
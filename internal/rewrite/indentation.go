@@ -0,0 +1,94 @@
+// indentation.go — Rewrite leading whitespace to one indentation style.
+package rewrite
+
+import (
+	"strings"
+)
+
+// Indentation styles accepted by NormalizeIndentation, and the tab width used when
+// none is given.
+const (
+	IndentTabs   = "tabs"
+	IndentSpaces = "spaces"
+
+	DefaultIndentWidth = 4
+)
+
+// NormalizeIndentation rewrites leading whitespace to the given style, leaving
+// multi-line string literals untouched. It backs the CONV-no-tabs-or-spaces-mismatch fix.
+func NormalizeIndentation(source []byte, language string, indent string, width int) []byte {
+	if width <= 0 {
+		width = DefaultIndentWidth
+	}
+	if indent != IndentTabs {
+		indent = IndentSpaces
+	}
+
+	lines := strings.Split(string(source), "\n")
+	ForEachIndentedLine(source, language, func(lineNo int, leading string, rest string) {
+		columns := indentColumns(leading, width)
+		replacement := strings.Repeat(" ", columns)
+		if indent == IndentTabs {
+			replacement = strings.Repeat("\t", columns/width) + strings.Repeat(" ", columns%width)
+		}
+		lines[lineNo-1] = replacement + rest
+	})
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// ForEachIndentedLine calls visit for every non-blank line outside multi-line string literals.
+func ForEachIndentedLine(source []byte, language string, visit func(lineNo int, leading string, rest string)) {
+	delimiters := multilineStringDelimiters(language)
+	open := ""
+	for idx, line := range strings.Split(string(source), "\n") {
+		inString := open != ""
+		for _, delim := range delimiters {
+			if open != "" && open != delim {
+				continue
+			}
+			if strings.Count(line, delim)%2 == 1 {
+				if open == "" {
+					open = delim
+				} else {
+					open = ""
+				}
+			}
+		}
+		if inString {
+			continue
+		}
+
+		rest := strings.TrimLeft(line, " \t")
+		if strings.TrimSpace(rest) == "" {
+			continue
+		}
+		visit(idx+1, line[:len(line)-len(rest)], rest)
+	}
+}
+
+// multilineStringDelimiters lists the delimiters of the language's multi-line string
+// literals, whose lines are not indentation.
+func multilineStringDelimiters(language string) []string {
+	switch strings.ToLower(strings.TrimSpace(language)) {
+	case "go", "golang", "typescript", "tsx", "javascript", "jsx":
+		return []string{"`"}
+	case "python":
+		return []string{`"""`, `'''`}
+	case "java":
+		return []string{`"""`}
+	default:
+		return nil
+	}
+}
+
+func indentColumns(leading string, width int) int {
+	columns := 0
+	for _, ch := range leading {
+		if ch == '\t' {
+			columns += width - columns%width
+			continue
+		}
+		columns++
+	}
+	return columns
+}
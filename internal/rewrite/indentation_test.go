@@ -0,0 +1,51 @@
+// indentation_test.go — Tests for indentation rewriting.
+package rewrite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeIndentation(t *testing.T) {
+	tests := []struct {
+		name   string
+		lang   string
+		indent string
+		width  int
+		source string
+		want   string
+	}{
+		{
+			name:   "spaces to tabs",
+			lang:   "go",
+			indent: "tabs",
+			width:  4,
+			source: "func A() {\n    call()\n        nested()\n}\n",
+			want:   "func A() {\n\tcall()\n\t\tnested()\n}\n",
+		},
+		{
+			name:   "tabs to spaces",
+			lang:   "typescript",
+			indent: "spaces",
+			width:  2,
+			source: "function a() {\n\tcall();\n}\n",
+			want:   "function a() {\n  call();\n}\n",
+		},
+		{
+			name:   "raw strings untouched",
+			lang:   "go",
+			indent: "tabs",
+			width:  4,
+			source: "const s = `\n    keep\n`\n",
+			want:   "const s = `\n    keep\n`\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NormalizeIndentation([]byte(tt.source), tt.lang, tt.indent, tt.width)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
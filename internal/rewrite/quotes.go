@@ -0,0 +1,255 @@
+// quotes.go — Scan JS/TS string literals and switch their quote style.
+package rewrite
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Quote styles accepted by NormalizeQuotes.
+const (
+	QuoteSingle = "single"
+	QuoteDouble = "double"
+)
+
+// JSRegexPreceders are the characters after which a '/' starts a regex literal rather than a division.
+const JSRegexPreceders = "(,=:[!&|?{};+-*%<>~^"
+
+// JSRegexKeywords are the keywords after which a '/' starts a regex literal.
+var JSRegexKeywords = map[string]bool{"return": true, "typeof": true, "case": true, "in": true, "of": true, "delete": true, "void": true, "throw": true, "new": true}
+
+// NormalizeQuotes rewrites every string literal that can switch to the given quote
+// without adding escapes. It backs the CONV-consistent-quote-style fix.
+func NormalizeQuotes(source []byte, pathValue string, quote string) []byte {
+	if quote != QuoteDouble {
+		quote = QuoteSingle
+	}
+	target := QuoteChar(quote)
+
+	var out strings.Builder
+	last := 0
+	for _, lit := range ScanJSStringLiterals(source, IsJSXPath(pathValue)) {
+		if !QuoteNeedsSwap(source, lit, quote) {
+			continue
+		}
+		out.Write(source[last:lit.Start])
+		out.WriteString(SwapQuotes(source[lit.Start:lit.End], target))
+		last = lit.End
+	}
+	out.Write(source[last:])
+	return []byte(out.String())
+}
+
+// JSStringLiteral is a single- or double-quoted literal found by ScanJSStringLiterals.
+type JSStringLiteral struct {
+	Start  int // offset of the opening quote
+	End    int // offset just past the closing quote
+	Quote  byte
+	Line   int
+	Column int
+}
+
+// QuoteChar returns the quote character of a quote style.
+func QuoteChar(quote string) byte {
+	if quote == QuoteDouble {
+		return '"'
+	}
+	return '\''
+}
+
+// IsJSXPath reports whether pathValue is a .tsx or .jsx file.
+func IsJSXPath(pathValue string) bool {
+	switch strings.ToLower(filepath.Ext(pathValue)) {
+	case ".tsx", ".jsx":
+		return true
+	}
+	return false
+}
+
+// QuoteNeedsSwap reports whether lit uses the wrong quote and its body does not
+// contain the preferred quote, so swapping would not require new escapes.
+func QuoteNeedsSwap(source []byte, lit JSStringLiteral, quote string) bool {
+	target := QuoteChar(quote)
+	if lit.Quote == target {
+		return false
+	}
+	return !strings.ContainsRune(string(source[lit.Start+1:lit.End-1]), rune(target))
+}
+
+// SwapQuotes re-delimits a literal with target, dropping escapes on the old quote.
+func SwapQuotes(literal []byte, target byte) string {
+	original := literal[0]
+	body := literal[1 : len(literal)-1]
+
+	var out strings.Builder
+	out.WriteByte(target)
+	for i := 0; i < len(body); i++ {
+		if body[i] == '\\' && i+1 < len(body) {
+			if body[i+1] == original {
+				out.WriteByte(original)
+			} else {
+				out.WriteByte(body[i])
+				out.WriteByte(body[i+1])
+			}
+			i++
+			continue
+		}
+		out.WriteByte(body[i])
+	}
+	out.WriteByte(target)
+	return out.String()
+}
+
+// ScanJSStringLiterals returns single- and double-quoted literals outside comments,
+// template literals, and regex literals. In JSX files, attribute values (`attr="x"`)
+// are skipped because JSX conventionally uses double quotes there.
+func ScanJSStringLiterals(source []byte, jsx bool) []JSStringLiteral {
+	literals := make([]JSStringLiteral, 0)
+	n := len(source)
+	line, lineStart := 1, 0
+	var prevSig byte
+	prevWord := ""
+
+	advanceLines := func(from int, to int) {
+		for k := from; k < to && k < n; k++ {
+			if source[k] == '\n' {
+				line++
+				lineStart = k + 1
+			}
+		}
+	}
+
+	for i := 0; i < n; {
+		c := source[i]
+		switch {
+		case c == '\n':
+			line++
+			lineStart = i + 1
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '/' && i+1 < n && source[i+1] == '/':
+			for i < n && source[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < n && source[i+1] == '*':
+			end := strings.Index(string(source[i+2:]), "*/")
+			stop := n
+			if end >= 0 {
+				stop = i + 2 + end + 2
+			}
+			advanceLines(i, stop)
+			i = stop
+		case c == '/' && (prevSig == 0 || strings.IndexByte(JSRegexPreceders, prevSig) >= 0 || JSRegexKeywords[prevWord]):
+			i = SkipJSRegex(source, i)
+			prevSig, prevWord = '/', ""
+		case c == '`':
+			stop := SkipJSTemplate(source, i)
+			advanceLines(i, stop)
+			i = stop
+			prevSig, prevWord = '`', ""
+		case c == '\'' || c == '"':
+			end, ok := JSStringEnd(source, i)
+			if ok && !(jsx && isJSXAttributeValue(source, i)) {
+				literals = append(literals, JSStringLiteral{Start: i, End: end, Quote: c, Line: line, Column: i - lineStart + 1})
+			}
+			if !ok {
+				// Unterminated on this line (often an apostrophe in JSX text); resume after it.
+				end = i + 1
+			}
+			i = end
+			prevSig, prevWord = c, ""
+		case IsJSIdentChar(c):
+			start := i
+			for i < n && IsJSIdentChar(source[i]) {
+				i++
+			}
+			prevSig, prevWord = 'a', string(source[start:i])
+		default:
+			prevSig, prevWord = c, ""
+			if c == ')' || c == ']' {
+				prevSig = 'a'
+			}
+			i++
+		}
+	}
+	return literals
+}
+
+// JSStringEnd returns the offset just past the closing quote, or false if the
+// literal is not closed before the end of the line.
+func JSStringEnd(source []byte, start int) (int, bool) {
+	quote := source[start]
+	for i := start + 1; i < len(source); i++ {
+		switch source[i] {
+		case '\\':
+			i++
+		case '\n':
+			return i, false
+		case quote:
+			return i + 1, true
+		}
+	}
+	return len(source), false
+}
+
+// SkipJSRegex returns the offset just past a regex literal starting at start, or
+// start+1 when the slash turns out to be an operator.
+func SkipJSRegex(source []byte, start int) int {
+	inClass := false
+	for i := start + 1; i < len(source); i++ {
+		switch source[i] {
+		case '\\':
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '\n':
+			// Not a regex after all; treat the slash as an operator.
+			return start + 1
+		case '/':
+			if !inClass {
+				return i + 1
+			}
+		}
+	}
+	return start + 1
+}
+
+// SkipJSTemplate returns the offset just past a template literal, following
+// nested `${...}` expressions by brace depth.
+func SkipJSTemplate(source []byte, start int) int {
+	depth := 0
+	for i := start + 1; i < len(source); i++ {
+		c := source[i]
+		if depth > 0 {
+			switch c {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+			continue
+		}
+		switch {
+		case c == '\\':
+			i++
+		case c == '$' && i+1 < len(source) && source[i+1] == '{':
+			depth = 1
+			i++
+		case c == '`':
+			return i + 1
+		}
+	}
+	return len(source)
+}
+
+func isJSXAttributeValue(source []byte, quoteAt int) bool {
+	return quoteAt >= 2 && source[quoteAt-1] == '=' && IsJSIdentChar(source[quoteAt-2])
+}
+
+// IsJSIdentChar reports whether c can appear in a JS identifier.
+func IsJSIdentChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,17 @@
+// quotes_test.go — Tests for quote style rewriting.
+package rewrite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeQuotes(t *testing.T) {
+	source := "const a = \"x\";\nconst b = 'y \\' z';\nconst c = \"say \\\"hi\\\"\";\n"
+	got := NormalizeQuotes([]byte(source), "a.ts", "single")
+	assert.Equal(t, "const a = 'x';\nconst b = 'y \\' z';\nconst c = 'say \"hi\"';\n", string(got))
+
+	back := NormalizeQuotes([]byte("const a = 'x';\n"), "a.ts", "double")
+	assert.Equal(t, "const a = \"x\";\n", string(back))
+}
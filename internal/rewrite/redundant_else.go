@@ -0,0 +1,161 @@
+// redundant_else.go — Find and flatten Go else blocks after a terminating if.
+package rewrite
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// FlattenRedundantElse removes redundant else blocks and outdents their bodies. Else
+// blocks whose removal would change variable scope are left alone. The result is
+// gofmt-formatted; the source is returned unchanged if nothing was flattened. It backs the
+// CONV-no-redundant-else-after-return fix.
+func FlattenRedundantElse(source []byte) []byte {
+	out := source
+	rewritten := false
+	// Nested candidates overlap, so apply non-overlapping edits per pass.
+	for pass := 0; pass < 16; pass++ {
+		_, elses := ScanRedundantElses(out)
+		sort.Slice(elses, func(i, j int) bool { return elses[i].Start > elses[j].Start })
+		next := append([]byte(nil), out...)
+		editedFrom := len(out) + 1
+		changed := false
+		for _, e := range elses {
+			if !e.Flattenable || e.End > editedFrom {
+				continue
+			}
+			next = append(append(append([]byte(nil), next[:e.Start]...), outdentBlock(out[e.BodyStart:e.BodyEnd])...), next[e.End:]...)
+			editedFrom = e.Start
+			changed = true
+		}
+		if !changed {
+			break
+		}
+		out = next
+		rewritten = true
+	}
+	if !rewritten {
+		return source
+	}
+	if formatted, err := format.Source(out); err == nil {
+		return formatted
+	}
+	return source
+}
+
+// RedundantElse is an else block following an if block that ends in a terminating
+// statement. Flattenable is false when outdenting it would change variable scope.
+type RedundantElse struct {
+	ElsePos     token.Pos
+	Terminator  string
+	Flattenable bool
+	// Byte offsets: Start is just after the if body's closing brace, End just after the
+	// else's closing brace, and BodyStart/BodyEnd bound the else block's contents.
+	Start, End         int
+	BodyStart, BodyEnd int
+}
+
+// ScanRedundantElses returns the redundant else blocks of a Go source, outermost first.
+// Else-if chains are skipped. Unparseable source yields nothing.
+func ScanRedundantElses(source []byte) (*token.FileSet, []RedundantElse) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return fset, nil
+	}
+	elseIfs := map[*ast.IfStmt]bool{}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		if stmt, ok := n.(*ast.IfStmt); ok {
+			if nested, ok := stmt.Else.(*ast.IfStmt); ok {
+				elseIfs[nested] = true
+			}
+		}
+		return true
+	})
+
+	found := make([]RedundantElse, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.IfStmt)
+		if !ok || elseIfs[stmt] {
+			return true
+		}
+		elseBlock, ok := stmt.Else.(*ast.BlockStmt)
+		if !ok || len(stmt.Body.List) == 0 {
+			return true
+		}
+		terminator := goTerminator(stmt.Body.List[len(stmt.Body.List)-1])
+		if terminator == "" {
+			return true
+		}
+		start := fset.Position(stmt.Body.Rbrace).Offset + 1
+		elseOffset := start + bytes.Index(source[start:], []byte("else"))
+		found = append(found, RedundantElse{
+			ElsePos:     fset.File(stmt.Pos()).Pos(elseOffset),
+			Terminator:  terminator,
+			Flattenable: stmt.Init == nil && !blockDeclaresNames(elseBlock),
+			Start:       start,
+			End:         fset.Position(elseBlock.Rbrace).Offset + 1,
+			BodyStart:   fset.Position(elseBlock.Lbrace).Offset + 1,
+			BodyEnd:     fset.Position(elseBlock.Rbrace).Offset,
+		})
+		return true
+	})
+	return fset, found
+}
+
+// goTerminator names the statement kind when stmt unconditionally leaves the block.
+func goTerminator(stmt ast.Stmt) string {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return "return"
+	case *ast.BranchStmt:
+		if s.Tok != token.FALLTHROUGH {
+			return s.Tok.String()
+		}
+	case *ast.ExprStmt:
+		if call, ok := s.X.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "panic" {
+				return "panic"
+			}
+		}
+	}
+	return ""
+}
+
+// blockDeclaresNames reports whether the block declares variables, constants, types,
+// or labels at its top level, which would leak into the enclosing scope if outdented.
+func blockDeclaresNames(block *ast.BlockStmt) bool {
+	for _, stmt := range block.List {
+		switch s := stmt.(type) {
+		case *ast.DeclStmt:
+			return true
+		case *ast.AssignStmt:
+			if s.Tok == token.DEFINE {
+				return true
+			}
+		case *ast.LabeledStmt:
+			return true
+		}
+	}
+	return false
+}
+
+// outdentBlock turns the inside of `{ ... }` into statements one tab shallower,
+// preceded by a newline so they follow the if block's closing brace.
+func outdentBlock(body []byte) []byte {
+	text := strings.TrimRight(strings.TrimLeft(string(body), " \t"), " \t\n")
+	text = strings.TrimPrefix(text, "\n")
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "\t")
+	}
+	return []byte("\n" + strings.Join(lines, "\n"))
+}
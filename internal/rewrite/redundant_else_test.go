@@ -0,0 +1,20 @@
+// redundant_else_test.go — Tests for redundant else flattening.
+package rewrite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenRedundantElse(t *testing.T) {
+	source := "package a\n\nfunc A(a, b bool) int {\n\tif a {\n\t\treturn 1\n\t} else {\n\t\tif b {\n\t\t\treturn 2\n\t\t} else {\n\t\t\tprintln(\"neither\")\n\t\t}\n\t}\n\treturn 0\n}\n"
+	want := "package a\n\nfunc A(a, b bool) int {\n\tif a {\n\t\treturn 1\n\t}\n\tif b {\n\t\treturn 2\n\t}\n\tprintln(\"neither\")\n\treturn 0\n}\n"
+	assert.Equal(t, want, string(FlattenRedundantElse([]byte(source))))
+
+	scoped := "package a\n\nfunc A(ok bool) int {\n\tif !ok {\n\t\treturn 0\n\t} else {\n\t\tv := 1\n\t\treturn v\n\t}\n}\n"
+	assert.Equal(t, scoped, string(FlattenRedundantElse([]byte(scoped))), "else blocks with declarations keep their scope")
+
+	unformatted := "package a\nfunc  A() {}\n"
+	assert.Equal(t, unformatted, string(FlattenRedundantElse([]byte(unformatted))), "files without candidates are not reformatted")
+}
@@ -0,0 +1,296 @@
+// struct_alignment.go — Compute Go struct layouts and reorder fields to minimise padding.
+package rewrite
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+)
+
+// fieldLayout is the size and alignment of a type on 64-bit platforms (amd64, arm64).
+type fieldLayout struct {
+	Size  int64
+	Align int64
+}
+
+// builtinLayouts are the predeclared types.
+var builtinLayouts = map[string]fieldLayout{
+	"bool": {1, 1}, "int8": {1, 1}, "uint8": {1, 1}, "byte": {1, 1},
+	"int16": {2, 2}, "uint16": {2, 2},
+	"int32": {4, 4}, "uint32": {4, 4}, "rune": {4, 4}, "float32": {4, 4},
+	"int": {8, 8}, "uint": {8, 8}, "int64": {8, 8}, "uint64": {8, 8}, "uintptr": {8, 8}, "float64": {8, 8},
+	"complex64": {8, 4}, "complex128": {16, 8},
+	"string": {16, 8}, "error": {16, 8}, "any": {16, 8},
+}
+
+// qualifiedLayouts are standard library types common in structs. Fields of any other
+// imported type have an unknown layout, and their struct is skipped.
+var qualifiedLayouts = map[string]fieldLayout{
+	"time.Time": {24, 8}, "time.Duration": {8, 8},
+	"context.Context": {16, 8}, "unsafe.Pointer": {8, 8},
+	"sync.Mutex": {8, 4}, "sync.RWMutex": {24, 4}, "sync.Once": {12, 4},
+	"atomic.Bool": {4, 4}, "atomic.Int32": {4, 4}, "atomic.Uint32": {4, 4},
+	"atomic.Int64": {8, 8}, "atomic.Uint64": {8, 8}, "atomic.Value": {16, 8},
+	"json.RawMessage": {24, 8},
+}
+
+// AlignStructFields reorders the fields of the top-level struct type name by
+// decreasing alignment, keeping each field's doc and line comments. The result is
+// gofmt-formatted; the source is returned unchanged when there is no such struct, its
+// layout is unknown, it is already optimal, or a free-floating comment inside it would
+// lose its place. It backs the CONV-struct-field-alignment fix.
+func AlignStructFields(source []byte, name string) []byte {
+	fset, structs := ScanStructAlignments(source)
+	for _, s := range structs {
+		if s.Name != name || s.OptimalSize >= s.Size {
+			continue
+		}
+		fields := s.Type.Fields
+		attached := map[*ast.CommentGroup]bool{}
+		spans := make([][2]int, 0, len(fields.List))
+		for _, f := range fields.List {
+			start, end := f.Pos(), f.End()
+			if f.Doc != nil {
+				start = f.Doc.Pos()
+				attached[f.Doc] = true
+			}
+			if f.Comment != nil {
+				end = f.Comment.End()
+				attached[f.Comment] = true
+			}
+			spans = append(spans, [2]int{fset.Position(start).Offset, fset.Position(end).Offset})
+		}
+		for _, group := range s.Comments {
+			if group.Pos() > fields.Opening && group.End() < fields.Closing && !attached[group] {
+				return source
+			}
+		}
+
+		var body bytes.Buffer
+		body.WriteString("\n")
+		for _, i := range s.OptimalIndex {
+			body.Write(source[spans[i][0]:spans[i][1]])
+			body.WriteString("\n")
+		}
+		opening, closing := fset.Position(fields.Opening).Offset+1, fset.Position(fields.Closing).Offset
+		out := append(append(append([]byte(nil), source[:opening]...), body.Bytes()...), source[closing:]...)
+		if formatted, err := format.Source(out); err == nil {
+			return formatted
+		}
+		return source
+	}
+	return source
+}
+
+// StructAlignment is a struct type whose layout is known: its size as declared and
+// ordered by decreasing alignment.
+type StructAlignment struct {
+	Name         string
+	Pos          token.Pos
+	Type         *ast.StructType
+	Comments     []*ast.CommentGroup
+	Size         int64
+	OptimalSize  int64
+	OptimalIndex []int    // indices into Type.Fields.List, in optimal order
+	Order        []string // field names in optimal order
+}
+
+// ScanStructAlignments returns the top-level struct types of a Go source with a known
+// layout and at least two fields, in source order.
+func ScanStructAlignments(source []byte) (*token.FileSet, []StructAlignment) {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return fset, nil
+	}
+	locals := map[string]*ast.TypeSpec{}
+	for _, decl := range parsed.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			for _, spec := range gen.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.TypeParams == nil {
+					locals[ts.Name.Name] = ts
+				}
+			}
+		}
+	}
+
+	out := make([]StructAlignment, 0)
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || ts.TypeParams != nil {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || st.Fields == nil || len(st.Fields.List) < 2 {
+				continue
+			}
+			layouts := make([]fieldLayout, 0, len(st.Fields.List))
+			counts := make([]int64, 0, len(st.Fields.List))
+			known := true
+			for _, f := range st.Fields.List {
+				layout, ok := typeLayout(f.Type, locals, 0)
+				if !ok {
+					known = false
+					break
+				}
+				layouts = append(layouts, layout)
+				counts = append(counts, int64(max(len(f.Names), 1)))
+			}
+			if !known {
+				continue
+			}
+
+			order := make([]int, len(layouts))
+			for i := range order {
+				order[i] = i
+			}
+			sort.SliceStable(order, func(a, b int) bool {
+				la, lb := layouts[order[a]], layouts[order[b]]
+				if (la.Size == 0) != (lb.Size == 0) {
+					return la.Size == 0
+				}
+				if la.Align != lb.Align {
+					return la.Align > lb.Align
+				}
+				return la.Size > lb.Size
+			})
+			names := make([]string, 0, len(layouts))
+			for _, i := range order {
+				names = append(names, fieldNames(st.Fields.List[i])...)
+			}
+			out = append(out, StructAlignment{
+				Name:         ts.Name.Name,
+				Pos:          ts.Pos(),
+				Type:         st,
+				Comments:     parsed.Comments,
+				Size:         structSize(layouts, counts, nil),
+				OptimalSize:  structSize(layouts, counts, order),
+				OptimalIndex: order,
+				Order:        names,
+			})
+		}
+	}
+	return fset, out
+}
+
+// typeLayout computes the layout of a type expression, resolving named types declared
+// in the same file through locals.
+func typeLayout(expr ast.Expr, locals map[string]*ast.TypeSpec, depth int) (fieldLayout, bool) {
+	if depth > 8 {
+		return fieldLayout{}, false
+	}
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if layout, ok := builtinLayouts[t.Name]; ok {
+			return layout, true
+		}
+		if spec, ok := locals[t.Name]; ok {
+			return typeLayout(spec.Type, locals, depth+1)
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok {
+			layout, ok := qualifiedLayouts[pkg.Name+"."+t.Sel.Name]
+			return layout, ok
+		}
+	case *ast.ParenExpr:
+		return typeLayout(t.X, locals, depth+1)
+	case *ast.StarExpr, *ast.MapType, *ast.ChanType, *ast.FuncType:
+		return fieldLayout{8, 8}, true
+	case *ast.InterfaceType:
+		return fieldLayout{16, 8}, true
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return fieldLayout{24, 8}, true
+		}
+		lit, ok := t.Len.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return fieldLayout{}, false
+		}
+		n, err := strconv.ParseInt(lit.Value, 0, 64)
+		elem, ok := typeLayout(t.Elt, locals, depth+1)
+		if err != nil || !ok {
+			return fieldLayout{}, false
+		}
+		return fieldLayout{n * elem.Size, elem.Align}, true
+	case *ast.StructType:
+		layouts := make([]fieldLayout, 0, len(t.Fields.List))
+		counts := make([]int64, 0, len(t.Fields.List))
+		for _, f := range t.Fields.List {
+			layout, ok := typeLayout(f.Type, locals, depth+1)
+			if !ok {
+				return fieldLayout{}, false
+			}
+			layouts = append(layouts, layout)
+			counts = append(counts, int64(max(len(f.Names), 1)))
+		}
+		align := int64(1)
+		for _, l := range layouts {
+			align = max(align, l.Align)
+		}
+		return fieldLayout{structSize(layouts, counts, nil), align}, true
+	}
+	return fieldLayout{}, false
+}
+
+// structSize lays out fields in order (or source order when order is nil), each
+// declaration holding counts[i] fields, and returns the padded size. As in gc, a
+// trailing zero-size field gets a byte so its address stays inside the struct.
+func structSize(layouts []fieldLayout, counts []int64, order []int) int64 {
+	if order == nil {
+		order = make([]int, len(layouts))
+		for i := range order {
+			order[i] = i
+		}
+	}
+	offset, align := int64(0), int64(1)
+	for _, i := range order {
+		l := layouts[i]
+		align = max(align, l.Align)
+		for n := int64(0); n < counts[i]; n++ {
+			offset = alignUp(offset, l.Align) + l.Size
+		}
+	}
+	if len(order) > 0 && layouts[order[len(order)-1]].Size == 0 && offset > 0 {
+		offset++
+	}
+	return alignUp(offset, align)
+}
+
+func alignUp(offset int64, align int64) int64 {
+	if align <= 1 {
+		return offset
+	}
+	return (offset + align - 1) / align * align
+}
+
+// fieldNames returns the names a field declaration introduces; an embedded field is
+// named by its type.
+func fieldNames(f *ast.Field) []string {
+	if len(f.Names) > 0 {
+		names := make([]string, 0, len(f.Names))
+		for _, n := range f.Names {
+			names = append(names, n.Name)
+		}
+		return names
+	}
+	typ := f.Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	switch t := typ.(type) {
+	case *ast.Ident:
+		return []string{t.Name}
+	case *ast.SelectorExpr:
+		return []string{t.Sel.Name}
+	}
+	return []string{"_"}
+}
@@ -0,0 +1,19 @@
+// struct_alignment_test.go — Tests for struct field reordering.
+package rewrite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlignStructFields(t *testing.T) {
+	source := "package a\n\n// Entry is cached.\ntype Entry struct {\n\t// Active marks live entries.\n\tActive bool\n\tID     int64 // primary key\n\tHot    bool\n}\n\ntype Other struct {\n\tA bool\n\tB int64\n\tC bool\n}\n"
+	want := "package a\n\n// Entry is cached.\ntype Entry struct {\n\tID int64 // primary key\n\t// Active marks live entries.\n\tActive bool\n\tHot    bool\n}\n\ntype Other struct {\n\tA bool\n\tB int64\n\tC bool\n}\n"
+	assert.Equal(t, want, string(AlignStructFields([]byte(source), "Entry")))
+
+	floating := "package a\n\ntype Entry struct {\n\tActive bool\n\n\t// Identity.\n\n\tID  int64\n\tHot bool\n}\n"
+	assert.Equal(t, floating, string(AlignStructFields([]byte(floating), "Entry")), "free-floating comments keep the struct as is")
+
+	assert.Equal(t, source, string(AlignStructFields([]byte(source), "Missing")))
+}
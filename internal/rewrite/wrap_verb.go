@@ -0,0 +1,148 @@
+// wrap_verb.go — Find fmt.Errorf calls that format errors with %v and rewrite them to %w.
+package rewrite
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// UnwrappedErrorfCall is a fmt.Errorf call formatting error arguments with %v: the call's
+// position, the arguments, and the byte offsets of their `v` verbs in the source.
+type UnwrappedErrorfCall struct {
+	Line    int
+	Column  int
+	Args    []string
+	Offsets []int
+}
+
+// GoUnwrappedErrorfCalls finds fmt.Errorf calls with a literal format that pass an error
+// to a %v verb. An argument is an error when it is named err or ends in Err or Error, or
+// is a call to an Err method such as ctx.Err(). Formats with explicit argument indexes
+// are skipped. Unparseable source yields nothing.
+func GoUnwrappedErrorfCalls(source []byte) []UnwrappedErrorfCall {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	calls := make([]UnwrappedErrorfCall, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Errorf" {
+			return true
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "fmt" {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		verbs, ok := formatVerbs(lit.Value)
+		if !ok {
+			return true
+		}
+		found := UnwrappedErrorfCall{}
+		for i, verb := range verbs {
+			if verb.Verb != 'v' || !verb.Plain || i+1 >= len(call.Args) {
+				continue
+			}
+			if name, isErr := goErrorExpr(call.Args[i+1]); isErr {
+				found.Args = append(found.Args, name)
+				found.Offsets = append(found.Offsets, fset.Position(lit.Pos()).Offset+verb.Offset)
+			}
+		}
+		if len(found.Args) > 0 {
+			pos := fset.Position(call.Pos())
+			found.Line, found.Column = pos.Line, pos.Column
+			calls = append(calls, found)
+		}
+		return true
+	})
+	return calls
+}
+
+// formatVerb is one argument-consuming verb of a format literal: its letter, whether it
+// has no flags, width, or precision, and the offset of the letter in the literal as written.
+type formatVerb struct {
+	Verb   byte
+	Plain  bool
+	Offset int
+}
+
+// formatVerbs lists the verbs of a quoted format literal in argument order. A `*` width
+// or precision consumes an argument and is listed with verb '*'. It reports false for
+// explicit argument indexes, which it does not map.
+func formatVerbs(literal string) ([]formatVerb, bool) {
+	verbs := make([]formatVerb, 0)
+	for i := 0; i < len(literal); i++ {
+		if literal[i] != '%' {
+			continue
+		}
+		i++
+		start := i
+		for i < len(literal) && strings.IndexByte("+-# 0", literal[i]) >= 0 {
+			i++
+		}
+		for i < len(literal) && (literal[i] == '.' || literal[i] == '*' || literal[i] == '[' || (literal[i] >= '0' && literal[i] <= '9')) {
+			switch literal[i] {
+			case '[':
+				return nil, false
+			case '*':
+				verbs = append(verbs, formatVerb{Verb: '*', Offset: i})
+			}
+			i++
+		}
+		if i >= len(literal) || literal[i] == '%' {
+			continue
+		}
+		verbs = append(verbs, formatVerb{Verb: literal[i], Plain: i == start, Offset: i})
+	}
+	return verbs, true
+}
+
+// goErrorExpr reports whether expr looks like an error value, and how it is written.
+func goErrorExpr(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name, e.Name == "err" || strings.HasSuffix(e.Name, "Err") || strings.HasSuffix(e.Name, "Error")
+	case *ast.SelectorExpr:
+		name, ok := goErrorExpr(e.Sel)
+		if root, isIdent := e.X.(*ast.Ident); isIdent {
+			name = root.Name + "." + name
+		}
+		return name, ok
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Err" && len(e.Args) == 0 {
+			if root, isIdent := sel.X.(*ast.Ident); isIdent {
+				return root.Name + ".Err()", true
+			}
+			return "Err()", true
+		}
+	}
+	return "", false
+}
+
+// UseWrapVerb rewrites %v to %w wherever fmt.Errorf formats an error with it, leaving the
+// rest of the source untouched. It backs the CONV-error-format fix; the source is
+// returned unchanged if it does not parse or has nothing to rewrite.
+func UseWrapVerb(source []byte) []byte {
+	calls := GoUnwrappedErrorfCalls(source)
+	if len(calls) == 0 {
+		return source
+	}
+	out := append([]byte(nil), source...)
+	for _, call := range calls {
+		for _, offset := range call.Offsets {
+			out[offset] = 'w'
+		}
+	}
+	return out
+}
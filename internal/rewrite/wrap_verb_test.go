@@ -0,0 +1,17 @@
+// wrap_verb_test.go — Tests for %w rewriting.
+package rewrite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseWrapVerb(t *testing.T) {
+	source := "package store\n\nfunc f(err error, n int) error {\n\treturn fmt.Errorf(\"Load: %d%% done: %v\", n, err)\n}\n"
+	want := "package store\n\nfunc f(err error, n int) error {\n\treturn fmt.Errorf(\"Load: %d%% done: %w\", n, err)\n}\n"
+	assert.Equal(t, want, string(UseWrapVerb([]byte(source))))
+
+	indexed := "package store\n\nfunc f(err error) error {\n\treturn fmt.Errorf(\"Load: %[1]v\", err)\n}\n"
+	assert.Equal(t, indexed, string(UseWrapVerb([]byte(indexed))), "explicit argument indexes are left alone")
+}
@@ -0,0 +1,374 @@
+// context_propagation.go — ARCH-context-propagation: Require Go I/O functions to accept and pass on context.Context.
+package arch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// ioPackageFuncs maps standard-library import paths to the package functions in them
+// that reach the network. Other functions in these packages (http.Error, net.ParseIP)
+// do no I/O. A name ending in `*` is a prefix.
+var ioPackageFuncs = map[string][]string{
+	"net":      {"Dial*", "Listen*", "Lookup*", "Resolve*"},
+	"net/http": {"Get", "Head", "Post", "PostForm", "NewRequest*"},
+	"net/rpc":  {"Dial*"},
+	"net/smtp": {"Dial", "SendMail"},
+}
+
+// defaultIOImports are import globs for database drivers and network client SDKs. Any
+// call into them from a function body counts as I/O.
+var defaultIOImports = []string{
+	"database/sql",
+	"github.com/jmoiron/sqlx",
+	"github.com/jackc/pgx/**",
+	"github.com/lib/pq",
+	"gorm.io/**",
+	"go.mongodb.org/**",
+	"github.com/redis/go-redis/**",
+	"google.golang.org/grpc",
+	"github.com/aws/aws-sdk-go-v2/**",
+	"github.com/aws/aws-sdk-go/**",
+	"github.com/stripe/stripe-go/**",
+	"cloud.google.com/go/**",
+}
+
+// contextlessPackageFuncs maps package functions that cannot be cancelled to the
+// context-aware call that replaces them.
+var contextlessPackageFuncs = map[string]map[string]string{
+	"net/http": {
+		"Get":        "http.NewRequestWithContext and Client.Do",
+		"Head":       "http.NewRequestWithContext and Client.Do",
+		"Post":       "http.NewRequestWithContext and Client.Do",
+		"PostForm":   "http.NewRequestWithContext and Client.Do",
+		"NewRequest": "http.NewRequestWithContext",
+	},
+	"net": {
+		"Dial":        "Dialer.DialContext",
+		"DialTimeout": "Dialer.DialContext",
+	},
+}
+
+// contextlessMethods maps methods that cannot be cancelled to their context-aware
+// variant. They are looked up only in files that import the package, since the names
+// alone (Query, Get) are too common to mean a database call.
+var contextlessMethods = map[string]map[string]string{
+	"database/sql": {
+		"Query": "QueryContext", "QueryRow": "QueryRowContext", "Exec": "ExecContext",
+		"Prepare": "PrepareContext", "Begin": "BeginTx", "Ping": "PingContext",
+	},
+	"github.com/jmoiron/sqlx": {
+		"Query": "QueryContext", "QueryRow": "QueryRowContext", "Exec": "ExecContext",
+		"Prepare": "PrepareContext", "Begin": "BeginTx", "Ping": "PingContext",
+		"Queryx": "QueryxContext", "QueryRowx": "QueryRowxContext", "Get": "GetContext",
+		"Select": "SelectContext", "NamedExec": "NamedExecContext", "MustExec": "MustExecContext",
+		"Beginx": "BeginTxx", "Preparex": "PreparexContext",
+	},
+}
+
+// ContextPropagation flags exported Go functions that perform network or database
+// calls without taking `ctx context.Context` as their first parameter, and exported
+// functions that call a context-less variant (db.Query, http.Get) when a
+// context-aware one exists. HTTP handlers take their context from *http.Request.
+// Functions listed in `allow` are exempt.
+type ContextPropagation struct{}
+
+func (r *ContextPropagation) ID() string       { return "ARCH-context-propagation" }
+func (r *ContextPropagation) Category() string { return "arch" }
+func (r *ContextPropagation) Description() string {
+	return "Require Go functions doing network or database I/O to accept and propagate context.Context"
+}
+func (r *ContextPropagation) Why() string {
+	return "I/O that does not take a context cannot be cancelled or bounded by the caller's deadline, so a slow dependency holds goroutines and connections after the request that needed them is gone."
+}
+func (r *ContextPropagation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "func (s *Store) FindUser(id string) (*User, error) {\n\trow := s.db.QueryRow(\"SELECT name FROM users WHERE id = $1\", id)\n\t...\n}",
+		Good:     "func (s *Store) FindUser(ctx context.Context, id string) (*User, error) {\n\trow := s.db.QueryRowContext(ctx, \"SELECT name FROM users WHERE id = $1\", id)\n\t...\n}",
+	}}
+}
+func (r *ContextPropagation) DefaultSeverity() string   { return "error" }
+func (r *ContextPropagation) NeedsProjectContext() bool { return false }
+
+func (r *ContextPropagation) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Exported function FetchInvoice performs I/O via net/http but does not take ctx context.Context as its first parameter",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add ctx context.Context as the first parameter of FetchInvoice and pass it to its I/O calls.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile || !strings.EqualFold(file.Language, "go") || len(file.Source) == 0 {
+		return nil
+	}
+	ioImports := defaultIOImports
+	if _, ok := config.Options["ioImports"]; ok {
+		ioImports = stringSliceOption(config.Options, "ioImports")
+	}
+	allow := stringSliceOption(config.Options, "allow")
+
+	violations := make([]model.Violation, 0)
+	for _, fn := range goIOFunctions(file.Source, ioImports) {
+		if contextFunctionAllowed(allow, fn) {
+			continue
+		}
+		reasons := make([]string, 0, 2)
+		fixes := make([]string, 0, 2)
+		if !fn.TakesContext {
+			reasons = append(reasons, fmt.Sprintf("performs I/O via %s but does not take ctx context.Context as its first parameter", strings.Join(fn.Imports, ", ")))
+			fixes = append(fixes, fmt.Sprintf("Add ctx context.Context as the first parameter of %s and pass it to its I/O calls.", fn.Name))
+		}
+		if len(fn.Calls) > 0 {
+			reasons = append(reasons, fmt.Sprintf("calls %s instead of %s", strings.Join(fn.Calls, ", "), strings.Join(fn.Variants, ", ")))
+			fixes = append(fixes, fmt.Sprintf("Call %s with ctx.", strings.Join(fn.Variants, ", ")))
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Exported function %s %s", fn.Name, strings.Join(reasons, ", and ")),
+			FilePath:  file.Path,
+			StartLine: fn.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: strings.Join(fixes, " "),
+				Metadata: map[string]interface{}{
+					"function": fn.Name,
+					"imports":  fn.Imports,
+					"calls":    fn.Calls,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// ioFunction is an exported function that does I/O. Name is `Func` or `Recv.Method`;
+// Calls lists context-less calls as the source spells them and Variants the distinct
+// context-aware calls that replace them.
+type ioFunction struct {
+	Name         string
+	Line         int
+	TakesContext bool
+	Imports      []string
+	Calls        []string
+	Variants     []string
+}
+
+// goIOFunctions returns the exported functions and methods in source that call into an
+// I/O package or call a context-less variant, in source order.
+func goIOFunctions(source []byte, ioImports []string) []ioFunction {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	imports := map[string]string{}
+	for _, spec := range parsed.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		local := path.Base(importPath)
+		if spec.Name != nil {
+			local = spec.Name.Name
+		} else if strings.HasPrefix(local, "v") && strings.Trim(local[1:], "0123456789") == "" && strings.Contains(importPath, "/") {
+			local = path.Base(path.Dir(importPath))
+		}
+		imports[local] = importPath
+	}
+	methodVariants := map[string]string{}
+	methodImports := map[string]string{}
+	importPaths := make([]string, 0, len(imports))
+	for _, importPath := range imports {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+	for _, importPath := range importPaths {
+		for method, variant := range contextlessMethods[importPath] {
+			methodVariants[method] = variant
+			methodImports[method] = importPath
+		}
+	}
+
+	found := make([]ioFunction, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !fn.Name.IsExported() {
+			continue
+		}
+		used := map[string]bool{}
+		calls := make([]string, 0)
+		variants := make([]string, 0)
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			name := sel.Sel.Name
+			if pkg, ok := sel.X.(*ast.Ident); ok {
+				if importPath, ok := imports[pkg.Name]; ok {
+					if ioPackageFunc(importPath, name) || matchesAnyImportGlob(ioImports, importPath) {
+						used[importPath] = true
+					}
+					if variant, ok := contextlessPackageFuncs[importPath][name]; ok {
+						calls = append(calls, pkg.Name+"."+name)
+						variants = appendUnique(variants, variant)
+					}
+					return true
+				}
+			}
+			if variant, ok := methodVariants[name]; ok {
+				used[methodImports[name]] = true
+				calls = append(calls, receiverText(sel.X)+"."+name)
+				variants = appendUnique(variants, variant)
+			}
+			return true
+		})
+		if len(used) == 0 && len(calls) == 0 {
+			continue
+		}
+		importList := make([]string, 0, len(used))
+		for importPath := range used {
+			importList = append(importList, importPath)
+		}
+		sort.Strings(importList)
+
+		name := fn.Name.Name
+		if recv := receiverTypeName(fn); recv != "" {
+			name = recv + "." + name
+		}
+		found = append(found, ioFunction{
+			Name:         name,
+			Line:         fset.Position(fn.Pos()).Line,
+			TakesContext: takesContext(fn, imports),
+			Imports:      importList,
+			Calls:        calls,
+			Variants:     variants,
+		})
+	}
+	return found
+}
+
+func ioPackageFunc(importPath string, name string) bool {
+	for _, pattern := range ioPackageFuncs[importPath] {
+		if pattern == name || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(name, strings.TrimSuffix(pattern, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// takesContext reports whether fn's first parameter is a context.Context, or whether
+// any parameter is an *http.Request, whose Context() carries the request's deadline.
+func takesContext(fn *ast.FuncDecl, imports map[string]string) bool {
+	if fn.Type.Params == nil || len(fn.Type.Params.List) == 0 {
+		return false
+	}
+	for i, field := range fn.Type.Params.List {
+		typ := field.Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		sel, ok := typ.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch {
+		case i == 0 && imports[pkg.Name] == "context" && sel.Sel.Name == "Context":
+			return true
+		case imports[pkg.Name] == "net/http" && sel.Sel.Name == "Request" && typ != field.Type:
+			return true
+		}
+	}
+	return false
+}
+
+// receiverTypeName returns the bare type name of a method's receiver, without pointer
+// or type parameters, or "" for a plain function.
+func receiverTypeName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	switch t := typ.(type) {
+	case *ast.IndexExpr:
+		typ = t.X
+	case *ast.IndexListExpr:
+		typ = t.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// receiverText spells a call receiver the way the source does (s.db), or "x" when it
+// is not a plain identifier chain.
+func receiverText(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return receiverText(e.X) + "." + e.Sel.Name
+	}
+	return "x"
+}
+
+// contextFunctionAllowed matches an allow entry against the function as `Func` or
+// `Recv.Method`, exactly or as a path.Match glob.
+func contextFunctionAllowed(allow []string, fn ioFunction) bool {
+	for _, pattern := range allow {
+		if pattern == fn.Name {
+			return true
+		}
+		if matched, err := path.Match(pattern, fn.Name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
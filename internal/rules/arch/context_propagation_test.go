@@ -0,0 +1,109 @@
+// context_propagation_test.go — Tests for ARCH-context-propagation.
+package arch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestContextPropagation(t *testing.T) {
+	assertRuleContract(t, &ContextPropagation{})
+}
+
+func TestContextPropagationFlagsIOWithoutContext(t *testing.T) {
+	source := `package billing
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/stripe/stripe-go/v76/charge"
+)
+
+type Store struct{ db *sql.DB }
+
+func FetchInvoice(id string) (*http.Response, error) {
+	return http.Get("https://billing.example.com/invoices/" + id)
+}
+
+func (s *Store) FindUser(ctx context.Context, id string) error {
+	return s.db.QueryRow("SELECT 1 WHERE id = $1", id).Err()
+}
+
+func (s *Store) Save(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE users SET seen = now() WHERE id = $1", id)
+	return err
+}
+
+func Charge(amount int64) error {
+	_, err := charge.New(nil)
+	return err
+}
+
+func Proxy(w http.ResponseWriter, r *http.Request) {
+	req, _ := http.NewRequestWithContext(r.Context(), http.MethodGet, "https://upstream", nil)
+	http.DefaultClient.Do(req)
+	http.Error(w, "ok", http.StatusOK)
+}
+
+func StatusText(code int) string {
+	return http.StatusText(code)
+}
+
+func refresh(id string) error {
+	_, err := http.Get(id)
+	return err
+}
+`
+	file := &model.UnifiedFileModel{Path: "internal/billing/billing.go", Language: "go", Source: []byte(source)}
+	got := (&ContextPropagation{}).Check(file, nil, model.RuleConfig{})
+	want := []struct {
+		line    int
+		message string
+	}{
+		{13, "Exported function FetchInvoice performs I/O via net/http but does not take ctx context.Context as its first parameter, and calls http.Get instead of http.NewRequestWithContext and Client.Do"},
+		{17, "Exported function Store.FindUser calls s.db.QueryRow instead of QueryRowContext"},
+		{26, "Exported function Charge performs I/O via github.com/stripe/stripe-go/v76/charge but does not take ctx context.Context as its first parameter"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("violations = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].StartLine != w.line || got[i].Message != w.message {
+			t.Fatalf("violation %d = line %d %q, want line %d %q", i, got[i].StartLine, got[i].Message, w.line, w.message)
+		}
+	}
+	if got[1].Context.Metadata["function"] != "Store.FindUser" || !reflect.DeepEqual(got[1].Context.Metadata["calls"], []string{"s.db.QueryRow"}) {
+		t.Fatalf("metadata = %+v", got[1].Context.Metadata)
+	}
+
+	allowed := model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{"Fetch*", "Store.FindUser"}}}
+	got = (&ContextPropagation{}).Check(file, nil, allowed)
+	if len(got) != 1 || got[0].StartLine != 26 {
+		t.Fatalf("allow should exempt listed functions, got %+v", got)
+	}
+}
+
+func TestContextPropagationIgnoresNonIOAndOtherFiles(t *testing.T) {
+	queryBuilder := &model.UnifiedFileModel{
+		Path:     "internal/search/query.go",
+		Language: "go",
+		Source:   []byte("package search\n\ntype Index struct{}\n\nfunc (i *Index) Query(q string) []string { return nil }\n\nfunc Run(i *Index) []string {\n\treturn i.Query(\"x\")\n}\n"),
+	}
+	if got := (&ContextPropagation{}).Check(queryBuilder, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("Query without a database import is not a database call, got %+v", got)
+	}
+
+	testFile := &model.UnifiedFileModel{
+		Path:       "internal/billing/billing_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte("package billing\n\nimport \"net/http\"\n\nfunc Fetch() { http.Get(\"x\") }\n"),
+	}
+	if got := (&ContextPropagation{}).Check(testFile, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("test files are skipped, got %+v", got)
+	}
+}
@@ -2,6 +2,7 @@
 package arch
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
@@ -18,31 +19,72 @@ func (r *DependencyDirection) Description() string {
 func (r *DependencyDirection) Why() string {
 	return "Directional dependencies keep higher-level policies independent of low-level details."
 }
+func (r *DependencyDirection) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "import { dbClient } from '../ui/components' // UI importing from DB layer",
+		Good:     "import { dbClient } from '../data/client' // domain importing from data layer",
+	}}
+}
 func (r *DependencyDirection) DefaultSeverity() string   { return "error" }
 func (r *DependencyDirection) NeedsProjectContext() bool { return false }
 
 func (r *DependencyDirection) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Import from infra to domain violates dependency flow, allowed direction: domain -> application -> infra"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Import from infra to domain violates dependency flow, allowed direction: domain -> application -> infra"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Move the dependency behind an interface so imports follow the allowed layer direction.",
+				},
+			},
+		}
+	}
+
+	layers := parseLayers(config.Options)
+	if file == nil || len(layers) < 2 {
+		return nil
+	}
+	from := layerIndexForFile(layers, file.Path)
+	if from < 0 {
+		return nil
+	}
+	allowed := strings.Join(layerNames(layers), " -> ")
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		to := layerIndexForPaths(layers, importCandidatePaths(file.Path, ref.Path, file.Language))
+		// Layers are ordered top-down: a layer may import itself and layers after it.
+		if to < 0 || to >= from {
+			continue
+		}
+		fromLayer, toLayer := layers[from].Name, layers[to].Name
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Import from %s to %s violates dependency flow, allowed direction: %s", fromLayer, toLayer, allowed),
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Move the dependency behind an interface so imports follow the allowed layer direction.",
+				SuggestedFix: fmt.Sprintf("Layer %s must not depend on %s; invert the dependency with an interface owned by %s.", fromLayer, toLayer, fromLayer),
+				Metadata: map[string]interface{}{
+					"import":    ref.Path,
+					"fromLayer": fromLayer,
+					"toLayer":   toLayer,
+				},
 			},
-		},
+		})
 	}
+	return violations
 }
@@ -1,8 +1,114 @@
 // dependency_direction_test.go — Tests for ARCH-dependency-direction.
 package arch
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestDependencyDirection(t *testing.T) {
 	assertRuleContract(t, &DependencyDirection{})
 }
+
+func TestDependencyDirectionInlineLayers(t *testing.T) {
+	shortLayers := map[string]interface{}{"layers": []interface{}{"handler", "service", "repository", "model"}}
+	globLayers := map[string]interface{}{"layers": []interface{}{
+		map[string]interface{}{"name": "routes", "patterns": []interface{}{"src/routes/**"}},
+		"src/services/**",
+		map[string]interface{}{"name": "models", "patterns": []interface{}{"src/models/**"}},
+	}}
+
+	tests := []struct {
+		name    string
+		file    *model.UnifiedFileModel
+		options map[string]interface{}
+		want    []string
+	}{
+		{
+			name: "lower layer importing a higher one",
+			file: &model.UnifiedFileModel{Path: "internal/service/orders.go", Language: "go", Source: []byte(
+				"package service\n\nimport (\n\t\"fmt\"\n\t\"example.com/app/internal/handler\"\n\t\"example.com/app/internal/repository\"\n)\n")},
+			options: shortLayers,
+			want:    []string{"Import from service to handler violates dependency flow, allowed direction: handler -> service -> repository -> model"},
+		},
+		{
+			name: "higher layer importing lower and same layers",
+			file: &model.UnifiedFileModel{Path: "internal/handler/orders.go", Language: "go", Source: []byte(
+				"package handler\n\nimport (\n\t\"example.com/app/internal/handler/middleware\"\n\t\"example.com/app/internal/service\"\n\t\"example.com/app/internal/model\"\n)\n")},
+			options: shortLayers,
+		},
+		{
+			name: "relative TS import resolved against glob layers",
+			file: &model.UnifiedFileModel{Path: "src/models/user.ts", Language: "typescript", Source: []byte(
+				"import { format } from '../services/format';\nimport { z } from 'zod';\n")},
+			options: globLayers,
+			want:    []string{"Import from models to services"},
+		},
+		{
+			name: "bottom-up direction reverses the order",
+			file: &model.UnifiedFileModel{Path: "internal/handler/orders.go", Language: "go", Source: []byte(
+				"package handler\n\nimport \"example.com/app/internal/service\"\n")},
+			options: map[string]interface{}{"layers": []interface{}{"handler", "service"}, "direction": "bottom-up"},
+			want:    []string{"Import from handler to service violates dependency flow, allowed direction: service -> handler"},
+		},
+		{
+			name: "plural directories and a []string option",
+			file: &model.UnifiedFileModel{Path: "internal\\services\\orders.go", Language: "go", Source: []byte(
+				"package services\n\nimport \"example.com/app/internal/handlers\"\n")},
+			options: map[string]interface{}{"layers": []string{"handler", "service"}},
+			want:    []string{"Import from service to handler"},
+		},
+		{
+			name: "file outside every layer",
+			file: &model.UnifiedFileModel{Path: "cmd/app/main.go", Language: "go", Source: []byte(
+				"package main\n\nimport \"example.com/app/internal/handler\"\n")},
+			options: shortLayers,
+		},
+		{
+			name: "no layers configured",
+			file: &model.UnifiedFileModel{Path: "internal/service/orders.go", Language: "go", Source: []byte(
+				"package service\n\nimport \"example.com/app/internal/handler\"\n")},
+		},
+	}
+
+	rule := &DependencyDirection{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rule.Check(tt.file, nil, model.RuleConfig{Options: tt.options})
+			if len(got) != len(tt.want) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(got[i].Message, want) {
+					t.Errorf("message %q does not contain %q", got[i].Message, want)
+				}
+				meta := got[i].Context.Metadata
+				if meta["fromLayer"] == "" || meta["toLayer"] == "" || meta["import"] == "" {
+					t.Errorf("metadata should name both layers and the import: %+v", meta)
+				}
+			}
+		})
+	}
+}
+
+func TestLayerRulesClassifyFilesAlike(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "src/services/orders.ts", Language: "typescript", Source: []byte(
+		"import { routes } from '../handlers/routes';\n")}
+	for _, options := range []map[string]interface{}{
+		{"layers": []string{"handler", "service"}},
+		{"layers": []interface{}{"src/handlers/**", "src/services/**"}},
+		{"layers": []interface{}{"service", "handler"}, "direction": "bottom-up"},
+	} {
+		config := model.RuleConfig{Options: options}
+		direction := (&DependencyDirection{}).Check(file, nil, config)
+		layer := (&LayerViolation{}).Check(file, nil, config)
+		if len(direction) != 1 || len(layer) != 1 {
+			t.Fatalf("options %v: dependency-direction found %d, layer-violation found %d, want 1 each", options, len(direction), len(layer))
+		}
+		if direction[0].Context.Metadata["toLayer"] != layer[0].Context.Metadata["toLayer"] {
+			t.Fatalf("options %v: layers differ: %v vs %v", options, direction[0].Context.Metadata, layer[0].Context.Metadata)
+		}
+	}
+}
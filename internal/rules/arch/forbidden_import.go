@@ -0,0 +1,154 @@
+// forbidden_import.go — ARCH-forbidden-import: Disallow denylisted import paths.
+package arch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// ForbiddenImport flags imports that match a configured `deny` pattern, naming the
+// suggested replacement when the entry has one.
+type ForbiddenImport struct{}
+
+func (r *ForbiddenImport) ID() string          { return "ARCH-forbidden-import" }
+func (r *ForbiddenImport) Category() string    { return "arch" }
+func (r *ForbiddenImport) Description() string { return "Disallow imports of denylisted packages" }
+func (r *ForbiddenImport) Why() string {
+	return "Deprecated or banned packages keep spreading through copy-paste unless every new import is checked."
+}
+func (r *ForbiddenImport) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "import \"github.com/pkg/errors\" // deny: github.com/pkg/errors",
+		Good:     "import \"errors\" // wrap with fmt.Errorf(\"...: %w\", err)",
+	}}
+}
+func (r *ForbiddenImport) DefaultSeverity() string   { return "error" }
+func (r *ForbiddenImport) NeedsProjectContext() bool { return false }
+
+func (r *ForbiddenImport) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Import \"io/ioutil\" is forbidden; use os and io instead",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Replace the import with os and io.",
+				},
+			},
+		}
+	}
+
+	if file == nil || (file.IsTestFile && excludeTestsOption(config.Options)) {
+		return nil
+	}
+	deny := forbiddenImportEntries(config.Options)
+	if len(deny) == 0 {
+		return nil
+	}
+	allow := stringSliceOption(config.Options, "allow")
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		entry, ok := matchForbiddenImport(deny, ref.Path, file.IsTestFile)
+		if !ok || importMatchesAny(allow, ref.Path) {
+			continue
+		}
+		message := fmt.Sprintf("Import %q is forbidden by pattern %q", ref.Path, entry.Pattern)
+		fix := "Remove the import or use an approved alternative."
+		if entry.Replacement != "" {
+			message = fmt.Sprintf("Import %q is forbidden; use %s instead", ref.Path, entry.Replacement)
+			fix = fmt.Sprintf("Replace the import with %s.", entry.Replacement)
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     message,
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fix,
+				Metadata: map[string]interface{}{
+					"import":      ref.Path,
+					"pattern":     entry.Pattern,
+					"replacement": entry.Replacement,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// forbiddenImportEntry is one entry of the `deny` option.
+type forbiddenImportEntry struct {
+	Pattern      string
+	Replacement  string
+	AllowInTests bool
+}
+
+// forbiddenImportEntries reads `deny`. An entry is either an import path glob or a map
+// with `pattern`, an optional `replacement`, and an optional `allowInTests`.
+func forbiddenImportEntries(options map[string]interface{}) []forbiddenImportEntry {
+	raw, _ := options["deny"].([]interface{})
+	entries := make([]forbiddenImportEntry, 0, len(raw))
+	for _, item := range raw {
+		switch v := item.(type) {
+		case string:
+			if pattern := strings.TrimSpace(v); pattern != "" {
+				entries = append(entries, forbiddenImportEntry{Pattern: pattern})
+			}
+		case map[string]interface{}:
+			pattern, _ := v["pattern"].(string)
+			replacement, _ := v["replacement"].(string)
+			allowInTests, _ := v["allowInTests"].(bool)
+			if strings.TrimSpace(pattern) == "" {
+				continue
+			}
+			entries = append(entries, forbiddenImportEntry{
+				Pattern:      strings.TrimSpace(pattern),
+				Replacement:  strings.TrimSpace(replacement),
+				AllowInTests: allowInTests,
+			})
+		}
+	}
+	if len(entries) == 0 {
+		for _, pattern := range stringSliceOption(options, "deny") {
+			entries = append(entries, forbiddenImportEntry{Pattern: pattern})
+		}
+	}
+	return entries
+}
+
+// matchForbiddenImport returns the first deny entry matching importPath. Entries with
+// allowInTests do not apply to test files.
+func matchForbiddenImport(entries []forbiddenImportEntry, importPath string, isTest bool) (forbiddenImportEntry, bool) {
+	for _, entry := range entries {
+		if isTest && entry.AllowInTests {
+			continue
+		}
+		if matchPathGlob(entry.Pattern, importPath) {
+			return entry, true
+		}
+	}
+	return forbiddenImportEntry{}, false
+}
+
+func importMatchesAny(patterns []string, importPath string) bool {
+	for _, pattern := range patterns {
+		if matchPathGlob(pattern, importPath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+// forbidden_import_test.go — Tests for ARCH-forbidden-import.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestForbiddenImport(t *testing.T) {
+	assertRuleContract(t, &ForbiddenImport{})
+}
+
+func TestForbiddenImportDenyList(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "svc/svc.go",
+		Language: "go",
+		Source:   []byte("package svc\n\nimport (\n\t\"io/ioutil\"\n\t\"github.com/pkg/errors\"\n\t\"github.com/acme/legacy/db\"\n\t\"github.com/acme/legacy/compat\"\n\t\"os\"\n)\n"),
+	}
+	config := model.RuleConfig{Options: map[string]interface{}{
+		"deny": []interface{}{
+			map[string]interface{}{"pattern": "io/ioutil", "replacement": "os and io"},
+			"github.com/pkg/errors",
+			"github.com/acme/legacy/**",
+		},
+		"allow": []interface{}{"github.com/acme/legacy/compat"},
+	}}
+
+	got := (&ForbiddenImport{}).Check(file, nil, config)
+	if len(got) != 3 {
+		t.Fatalf("violations = %d, want 3: %+v", len(got), got)
+	}
+	want := []struct {
+		line    int
+		message string
+	}{
+		{4, `Import "io/ioutil" is forbidden; use os and io instead`},
+		{5, `Import "github.com/pkg/errors" is forbidden by pattern "github.com/pkg/errors"`},
+		{6, `Import "github.com/acme/legacy/db" is forbidden by pattern "github.com/acme/legacy/**"`},
+	}
+	for i, w := range want {
+		if got[i].StartLine != w.line || got[i].Message != w.message {
+			t.Fatalf("violation %d = line %d %q, want line %d %q", i, got[i].StartLine, got[i].Message, w.line, w.message)
+		}
+	}
+	if got[0].Context.Metadata["replacement"] != "os and io" || got[0].StartColumn != 2 {
+		t.Fatalf("unexpected first violation: %+v %+v", got[0], got[0].Context.Metadata)
+	}
+
+	if got := (&ForbiddenImport{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("no deny list should mean no violations, got %+v", got)
+	}
+}
+
+func TestForbiddenImportTestExceptions(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:       "web/app.test.ts",
+		Language:   "typescript",
+		IsTestFile: true,
+		Source:     []byte("import moment from 'moment';\nimport sinon from 'sinon';\n"),
+	}
+	rule := &ForbiddenImport{}
+	deny := []interface{}{
+		map[string]interface{}{"pattern": "moment", "replacement": "date-fns"},
+		map[string]interface{}{"pattern": "sinon", "allowInTests": true},
+	}
+
+	got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"deny": deny}})
+	if len(got) != 1 || got[0].StartLine != 1 {
+		t.Fatalf("allowInTests should exempt sinon in tests only, got %+v", got)
+	}
+	exclude := model.RuleConfig{Options: map[string]interface{}{"deny": deny, "excludeTests": true}}
+	if got := rule.Check(file, nil, exclude); len(got) != 0 {
+		t.Fatalf("excludeTests should skip test files, got %+v", got)
+	}
+}
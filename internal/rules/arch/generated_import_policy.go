@@ -0,0 +1,300 @@
+// generated_import_policy.go — ARCH-no-business-import-in-generated: Keep a clean boundary around generated code.
+package arch
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultGeneratedGlobs are the paths treated as generated when `generated` is unset.
+var defaultGeneratedGlobs = []string{
+	"**/*.pb.go",
+	"**/*.pb.ts",
+	"**/*_pb2.py",
+	"**/*_pb2_grpc.py",
+	"**/*.generated.*",
+	"**/gen/**",
+	"**/generated/**",
+	"**/__generated__/**",
+}
+
+// defaultBusinessGlobs are the hand-written paths generated code must not import when
+// `business` is unset.
+var defaultBusinessGlobs = []string{
+	"**/domain/**",
+	"**/service/**",
+	"**/services/**",
+	"**/usecase/**",
+	"**/usecases/**",
+	"**/business/**",
+}
+
+// generatedHeaderPattern recognises the Go `Code generated ... DO NOT EDIT.` header and
+// the `@generated` marker other generators write.
+var generatedHeaderPattern = regexp.MustCompile(`(?m)^\s*(?://|#|/?\*+)\s*(?:Code generated .* DO NOT EDIT\.|@generated\b)`)
+
+const generatedHeaderReason = "has a generated-code header"
+
+// GeneratedImportPolicy flags import edges that cross the boundary around generated code
+// the wrong way: a hand-written file importing generated code, unless the importer or
+// the import matches an `allow` glob, and a generated file importing hand-written code
+// that matches a `business` glob.
+//
+// Code is generated when its path matches a `generated` glob or, with project context,
+// it carries a generated-code header. A Go package is generated when every non-test
+// file in its directory is. Go imports are only considered within the importing file's
+// module; TypeScript, JavaScript, and Python imports when they are relative or name a
+// project file. Tests may import generated code.
+type GeneratedImportPolicy struct{}
+
+func (r *GeneratedImportPolicy) ID() string       { return "ARCH-no-business-import-in-generated" }
+func (r *GeneratedImportPolicy) Category() string { return "arch" }
+func (r *GeneratedImportPolicy) Description() string {
+	return "Restrict imports across the boundary between generated and hand-written code"
+}
+func (r *GeneratedImportPolicy) Why() string {
+	return "Generated code is rewritten on every run: hand-written code spread across it breaks on each regeneration, and generated code that reaches into business logic ties the generator's output to one implementation."
+}
+func (r *GeneratedImportPolicy) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// src/services/billing.ts\nimport { InvoiceRequest } from '../gen/billing_pb'",
+		Good:     "// src/adapters/billing.ts (allow: [\"src/adapters/**\"])\nimport { InvoiceRequest } from '../gen/billing_pb'",
+	}}
+}
+func (r *GeneratedImportPolicy) DefaultSeverity() string   { return "error" }
+func (r *GeneratedImportPolicy) NeedsProjectContext() bool { return true }
+
+func (r *GeneratedImportPolicy) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Hand-written src/services/billing.ts imports generated src/gen/billing_pb via \"../gen/billing_pb\" (matches generated glob \"**/gen/**\")",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Import generated code only from an adapter listed in `allow`, and convert to domain types there.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile {
+		return nil
+	}
+	generated := stringSliceOption(config.Options, "generated")
+	if len(generated) == 0 {
+		generated = defaultGeneratedGlobs
+	}
+	business := stringSliceOption(config.Options, "business")
+	if len(business) == 0 {
+		business = defaultBusinessGlobs
+	}
+	allow := stringSliceOption(config.Options, "allow")
+	from := filepath.ToSlash(file.Path)
+	if entrypointGlob(allow, []string{from}) != "" {
+		return nil
+	}
+	index := generatedIndexFor(ctx)
+	importerGenerated := entrypointGlob(generated, []string{from}) != "" || generatedHeaderPattern.Match(file.Source)
+
+	lang := strings.ToLower(file.Language)
+	goPackage, goModulePath := "", ""
+	if lang == "go" {
+		goPackage, goModulePath = goPackageImportPath(file.Path)
+		if goModulePath == "" {
+			return nil
+		}
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		var target, generatedReason string
+		candidates := importCandidatePaths(file.Path, ref.Path, lang)
+		if lang == "go" {
+			if ref.Path == goPackage || !strings.HasPrefix(ref.Path, goModulePath+"/") {
+				continue
+			}
+			target = strings.TrimPrefix(ref.Path, goModulePath+"/")
+			if glob := entrypointGlob(generated, candidates); glob != "" {
+				generatedReason = fmt.Sprintf("matches generated glob %q", glob)
+			} else {
+				generatedReason = goPackageGeneratedReason(file.Path, ref.Path, goModulePath, generated)
+			}
+		} else {
+			if len(candidates) == 0 {
+				continue
+			}
+			target = candidates[0]
+			if !strings.HasPrefix(ref.Path, ".") && !index.hasStem(target) {
+				continue
+			}
+			if glob := entrypointGlob(generated, []string{target}); glob != "" {
+				generatedReason = fmt.Sprintf("matches generated glob %q", glob)
+			} else if index.generatedStem(target) {
+				generatedReason = generatedHeaderReason
+			}
+		}
+		if entrypointGlob(allow, append([]string{target}, candidates...)) != "" {
+			continue
+		}
+
+		var message, reason, direction, fix string
+		switch {
+		case !importerGenerated && generatedReason != "":
+			direction, reason = "handwritten-to-generated", generatedReason
+			message = fmt.Sprintf("Hand-written %s imports generated %s via %q (%s)", from, target, ref.Path, reason)
+			fix = fmt.Sprintf("Import %s only from an adapter listed in `allow`, and convert to domain types there.", target)
+		case importerGenerated && generatedReason == "":
+			glob := entrypointGlob(business, candidates)
+			if glob == "" {
+				continue
+			}
+			direction, reason = "generated-to-business", fmt.Sprintf("matches business glob %q", glob)
+			message = fmt.Sprintf("Generated %s imports hand-written business logic %s via %q (%s)", from, target, ref.Path, reason)
+			fix = fmt.Sprintf("Have hand-written code call into %s instead, or change the generator so its output does not depend on %s.", from, target)
+		default:
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     message,
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fix,
+				Metadata: map[string]interface{}{
+					"import":    ref.Path,
+					"from":      from,
+					"to":        target,
+					"direction": direction,
+					"reason":    reason,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+var (
+	goGeneratedPackageMu    sync.Mutex
+	goGeneratedPackageCache = map[string]string{}
+)
+
+// goPackageGeneratedReason reports why the package importPath, in the module containing
+// filePath, is generated: every non-test Go file in its directory matches a generated
+// glob or has a generated-code header. It returns "" for hand-written packages and for
+// packages it cannot read.
+func goPackageGeneratedReason(filePath string, importPath string, modulePath string, globs []string) string {
+	module, ok := findGoModule(filepath.Dir(filePath))
+	if !ok || module.Path != modulePath {
+		return ""
+	}
+	dir := filepath.Join(module.Root, filepath.FromSlash(strings.TrimPrefix(importPath, modulePath+"/")))
+	key := dir + "\x00" + strings.Join(globs, "\x00")
+
+	goGeneratedPackageMu.Lock()
+	defer goGeneratedPackageMu.Unlock()
+	if reason, ok := goGeneratedPackageCache[key]; ok {
+		return reason
+	}
+	reason := ""
+	if entries, err := os.ReadDir(dir); err == nil {
+		byHeader, byGlob := false, ""
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			if glob := entrypointGlob(globs, []string{filepath.ToSlash(filepath.Join(dir, name))}); glob != "" {
+				byGlob = glob
+				continue
+			}
+			source, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil || !generatedHeaderPattern.Match(source) {
+				byHeader, byGlob = false, ""
+				break
+			}
+			byHeader = true
+		}
+		switch {
+		case byHeader:
+			reason = generatedHeaderReason
+		case byGlob != "":
+			reason = fmt.Sprintf("matches generated glob %q", byGlob)
+		}
+	}
+	goGeneratedPackageCache[key] = reason
+	return reason
+}
+
+// generatedIndex records the project files, extensionless, and which carry a
+// generated-code header.
+type generatedIndex struct {
+	stems     map[string]bool
+	generated map[string]bool
+}
+
+func (idx generatedIndex) hasStem(target string) bool {
+	for _, stem := range []string{target, target + "/index", target + "/__init__"} {
+		if idx.stems[stem] {
+			return true
+		}
+	}
+	return false
+}
+
+func (idx generatedIndex) generatedStem(target string) bool {
+	for _, stem := range []string{target, target + "/index", target + "/__init__"} {
+		if idx.generated[stem] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	generatedIndexMu  sync.Mutex
+	generatedIndexCtx *model.ProjectContext
+	generatedIndexVal generatedIndex
+)
+
+// generatedIndexFor scans ctx once per project context; every file of a run shares it.
+func generatedIndexFor(ctx *model.ProjectContext) generatedIndex {
+	generatedIndexMu.Lock()
+	defer generatedIndexMu.Unlock()
+	if ctx != nil && ctx == generatedIndexCtx {
+		return generatedIndexVal
+	}
+
+	idx := generatedIndex{stems: map[string]bool{}, generated: map[string]bool{}}
+	if ctx == nil {
+		return idx
+	}
+	for p, f := range ctx.Files {
+		slashed := filepath.ToSlash(p)
+		stem := strings.TrimSuffix(slashed, path.Ext(slashed))
+		idx.stems[stem] = true
+		if f != nil && generatedHeaderPattern.Match(f.Source) {
+			idx.generated[stem] = true
+		}
+	}
+	generatedIndexCtx, generatedIndexVal = ctx, idx
+	return idx
+}
@@ -0,0 +1,104 @@
+// generated_import_policy_test.go — Tests for ARCH-no-business-import-in-generated.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestGeneratedImportPolicy(t *testing.T) {
+	assertRuleContract(t, &GeneratedImportPolicy{})
+}
+
+func TestGeneratedImportPolicyFlagsHandWrittenImporters(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "src/services/billing.ts",
+		Language: "typescript",
+		Source:   []byte("import { InvoiceRequest } from '../gen/billing_pb';\nimport { money } from './money';\nimport express from 'express';\n"),
+	}
+	rule := &GeneratedImportPolicy{}
+
+	got := rule.Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	want := `Hand-written src/services/billing.ts imports generated src/gen/billing_pb via "../gen/billing_pb" (matches generated glob "**/gen/**")`
+	if got[0].StartLine != 1 || got[0].Message != want {
+		t.Fatalf("violation = line %d %q", got[0].StartLine, got[0].Message)
+	}
+	if got[0].Context.Metadata["direction"] != "handwritten-to-generated" || got[0].Context.Metadata["to"] != "src/gen/billing_pb" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	for _, allow := range []string{"src/services/**", "src/gen/**"} {
+		config := model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{allow}}}
+		if got := rule.Check(file, nil, config); len(got) != 0 {
+			t.Fatalf("allow %q should exempt the edge, got %+v", allow, got)
+		}
+	}
+
+	test := &model.UnifiedFileModel{Path: "src/services/billing.test.ts", Language: "typescript", IsTestFile: true, Source: file.Source}
+	if got := rule.Check(test, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("tests may import generated code, got %+v", got)
+	}
+}
+
+func TestGeneratedImportPolicyFlagsGeneratedImporters(t *testing.T) {
+	generated := &model.UnifiedFileModel{
+		Path:     "src/api/client.ts",
+		Language: "typescript",
+		Source:   []byte("// @generated by openapi-generator\nimport { applyDiscount } from '../domain/pricing';\nimport { fetchJSON } from '../http/fetch';\n"),
+	}
+	importer := &model.UnifiedFileModel{
+		Path:     "src/jobs/sync.ts",
+		Language: "typescript",
+		Source:   []byte("import { Client } from '../api/client';\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{generated.Path: generated, importer.Path: importer}}
+	rule := &GeneratedImportPolicy{}
+
+	got := rule.Check(generated, ctx, model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 2 || got[0].Context.Metadata["direction"] != "generated-to-business" || got[0].Context.Metadata["reason"] != `matches business glob "**/domain/**"` {
+		t.Fatalf("generated import of business logic should be flagged, got %+v", got)
+	}
+
+	got = rule.Check(importer, ctx, model.RuleConfig{})
+	if len(got) != 1 || got[0].Context.Metadata["reason"] != "has a generated-code header" {
+		t.Fatalf("import of a file with a generated header should be flagged, got %+v", got)
+	}
+	if got := rule.Check(importer, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("headers need project context, got %+v", got)
+	}
+}
+
+func TestGeneratedImportPolicyGoModule(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	writeArchTestFile(t, root, "internal/billingpb/billing.pb.go", "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage billingpb\n")
+	writeArchTestFile(t, root, "internal/billingpb/billing_grpc.pb.go", "package billingpb\n")
+	writeArchTestFile(t, root, "internal/service/service.go", "package service\n")
+	handler := writeArchTestFile(t, root, "internal/handler/handler.go", "package handler\n\nimport (\n\t\"example.com/app/internal/billingpb\"\n\t\"example.com/app/internal/mocks\"\n\t\"example.com/app/internal/service\"\n)\n")
+	mocks := writeArchTestFile(t, root, "internal/mocks/store.go", "// Code generated by MockGen. DO NOT EDIT.\n\npackage mocks\n\nimport \"example.com/app/internal/service\"\n")
+	rule := &GeneratedImportPolicy{}
+
+	got := rule.Check(handler, nil, model.RuleConfig{})
+	if len(got) != 2 {
+		t.Fatalf("violations = %d, want 2: %+v", len(got), got)
+	}
+	if got[0].StartLine != 4 || got[0].Context.Metadata["reason"] != `matches generated glob "**/*.pb.go"` {
+		t.Fatalf("first violation = line %d %+v", got[0].StartLine, got[0].Context.Metadata)
+	}
+	if got[1].StartLine != 5 || got[1].Context.Metadata["reason"] != "has a generated-code header" || got[1].Context.Metadata["to"] != "internal/mocks" {
+		t.Fatalf("second violation = line %d %+v", got[1].StartLine, got[1].Context.Metadata)
+	}
+
+	got = rule.Check(mocks, nil, model.RuleConfig{})
+	if len(got) != 1 || got[0].Context.Metadata["direction"] != "generated-to-business" {
+		t.Fatalf("generated mocks importing the service should be flagged, got %+v", got)
+	}
+	custom := model.RuleConfig{Options: map[string]interface{}{"business": "internal/domain/**"}}
+	if got := rule.Check(mocks, nil, custom); len(got) != 0 {
+		t.Fatalf("business should replace the defaults, got %+v", got)
+	}
+}
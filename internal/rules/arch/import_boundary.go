@@ -18,6 +18,13 @@ func (r *ImportBoundary) Description() string {
 func (r *ImportBoundary) Why() string {
 	return "Module boundaries reduce accidental coupling between teams and deploy units."
 }
+func (r *ImportBoundary) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "import { internal } from '../other-module/src/internal' // accessing internal",
+		Good:     "import { publicAPI } from '../other-module' // using public API",
+	}}
+}
 func (r *ImportBoundary) DefaultSeverity() string   { return "error" }
 func (r *ImportBoundary) NeedsProjectContext() bool { return false }
 
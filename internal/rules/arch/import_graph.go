@@ -0,0 +1,162 @@
+// import_graph.go — Package-level import graph shared by ARCH analysis and `strict graph`.
+package arch
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// ImportGraph is a package import graph. Go packages are named by import path and
+// only imports within their module become edges; TypeScript, JavaScript, and Python
+// packages are directories, linked by relative imports and imports naming a project file.
+type ImportGraph struct {
+	Nodes []string
+	Edges map[string][]string
+}
+
+// BuildImportGraph builds the graph of the non-test files given. Nodes and each
+// node's edges are sorted.
+func BuildImportGraph(files []*model.UnifiedFileModel) ImportGraph {
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, file := range files {
+		if file != nil {
+			ctx.Files[file.Path] = file
+		}
+	}
+	stems := projectFileStems(ctx)
+
+	nodes := map[string]bool{}
+	edges := map[string]map[string]bool{}
+	for _, file := range files {
+		if file == nil || file.IsTestFile {
+			continue
+		}
+		from, targets := fileImportEdges(file, stems)
+		if from == "" {
+			continue
+		}
+		nodes[from] = true
+		for _, to := range targets {
+			if to == from {
+				continue
+			}
+			nodes[to] = true
+			if edges[from] == nil {
+				edges[from] = map[string]bool{}
+			}
+			edges[from][to] = true
+		}
+	}
+
+	g := ImportGraph{Nodes: make([]string, 0, len(nodes)), Edges: map[string][]string{}}
+	for node := range nodes {
+		g.Nodes = append(g.Nodes, node)
+	}
+	sort.Strings(g.Nodes)
+	for from, targets := range edges {
+		list := make([]string, 0, len(targets))
+		for to := range targets {
+			list = append(list, to)
+		}
+		sort.Strings(list)
+		g.Edges[from] = list
+	}
+	return g
+}
+
+// fileImportEdges returns the package file belongs to and the project packages it imports.
+func fileImportEdges(file *model.UnifiedFileModel, stems map[string]bool) (string, []string) {
+	lang := strings.ToLower(file.Language)
+	targets := make([]string, 0)
+	if lang == "go" {
+		from, modulePath := goPackageImportPath(file.Path)
+		if from == "" {
+			return "", nil
+		}
+		for _, ref := range extractImports(file) {
+			if ref.Path == modulePath || strings.HasPrefix(ref.Path, modulePath+"/") {
+				targets = append(targets, ref.Path)
+			}
+		}
+		return from, targets
+	}
+	if lang != "typescript" && lang != "javascript" && lang != "python" {
+		return "", nil
+	}
+
+	from := path.Dir(filepath.ToSlash(file.Path))
+	for _, ref := range extractImports(file) {
+		if strings.HasPrefix(ref.Path, ".") {
+			targets = append(targets, relativeImportPackage(file.Path, ref.Path, lang, stems))
+			continue
+		}
+		// Absolute imports count only when they name a project file or package.
+		candidates := importCandidatePaths(file.Path, ref.Path, lang)
+		if len(candidates) == 0 {
+			continue
+		}
+		target := candidates[0]
+		switch {
+		case stems[target]:
+			targets = append(targets, path.Dir(target))
+		case stems[target+"/index"] || stems[target+"/__init__"]:
+			targets = append(targets, target)
+		}
+	}
+	return from, targets
+}
+
+// Cycles returns the strongly connected components with more than one package, each
+// sorted, in order of their first package.
+func (g ImportGraph) Cycles() [][]string {
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	stack := make([]string, 0)
+	next := 0
+	cycles := make([][]string, 0)
+
+	var visit func(node string)
+	visit = func(node string) {
+		index[node], low[node] = next, next
+		next++
+		stack = append(stack, node)
+		onStack[node] = true
+		for _, to := range g.Edges[node] {
+			if _, seen := index[to]; !seen {
+				visit(to)
+				low[node] = min(low[node], low[to])
+			} else if onStack[to] {
+				low[node] = min(low[node], index[to])
+			}
+		}
+		if low[node] != index[node] {
+			return
+		}
+		component := make([]string, 0)
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == node {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+	for _, node := range g.Nodes {
+		if _, seen := index[node]; !seen {
+			visit(node)
+		}
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
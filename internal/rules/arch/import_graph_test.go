@@ -0,0 +1,58 @@
+// import_graph_test.go — Tests for the package import graph and cycle detection.
+package arch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestBuildImportGraphTypeScript(t *testing.T) {
+	files := []*model.UnifiedFileModel{
+		{Path: "src/api/routes.ts", Language: "typescript", Source: []byte("import { svc } from '../service/users';\nimport express from 'express';\nimport { h } from './helpers';\n")},
+		{Path: "src/api/helpers.ts", Language: "typescript", Source: []byte("export const h = 1;\n")},
+		{Path: "src/service/users.ts", Language: "typescript", Source: []byte("import { repo } from '../repo';\n")},
+		{Path: "src/repo/index.ts", Language: "typescript", Source: []byte("import { routes } from '../api/routes';\n")},
+		{Path: "src/util/log.ts", Language: "typescript", Source: []byte("export const log = console.log;\n")},
+		{Path: "src/util/log.test.ts", Language: "typescript", Source: []byte("import { svc } from '../service/users';\n"), IsTestFile: true},
+	}
+
+	g := BuildImportGraph(files)
+	if want := []string{"src/api", "src/repo", "src/service", "src/util"}; !reflect.DeepEqual(g.Nodes, want) {
+		t.Fatalf("nodes = %v, want %v", g.Nodes, want)
+	}
+	wantEdges := map[string][]string{
+		"src/api":     {"src/service"},
+		"src/service": {"src/repo"},
+		"src/repo":    {"src/api"},
+	}
+	if !reflect.DeepEqual(g.Edges, wantEdges) {
+		t.Fatalf("edges = %v, want %v", g.Edges, wantEdges)
+	}
+	if cycles := g.Cycles(); !reflect.DeepEqual(cycles, [][]string{{"src/api", "src/repo", "src/service"}}) {
+		t.Fatalf("cycles = %v", cycles)
+	}
+}
+
+func TestBuildImportGraphGoModule(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	files := []*model.UnifiedFileModel{
+		writeArchTestFile(t, root, "cmd/app/main.go", "package main\n\nimport (\n\t\"fmt\"\n\t\"example.com/app/internal/service\"\n)\n"),
+		writeArchTestFile(t, root, "internal/service/service.go", "package service\n\nimport \"example.com/app/internal/store\"\n"),
+		writeArchTestFile(t, root, "internal/store/store.go", "package store\n"),
+	}
+
+	g := BuildImportGraph(files)
+	wantNodes := []string{"example.com/app/cmd/app", "example.com/app/internal/service", "example.com/app/internal/store"}
+	if !reflect.DeepEqual(g.Nodes, wantNodes) {
+		t.Fatalf("nodes = %v, want %v", g.Nodes, wantNodes)
+	}
+	if got := g.Edges["example.com/app/cmd/app"]; !reflect.DeepEqual(got, []string{"example.com/app/internal/service"}) {
+		t.Fatalf("external imports should not become edges, got %v", got)
+	}
+	if cycles := g.Cycles(); len(cycles) != 0 {
+		t.Fatalf("acyclic graph reported cycles %v", cycles)
+	}
+}
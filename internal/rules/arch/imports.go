@@ -0,0 +1,187 @@
+// imports.go — Import extraction and path glob matching shared by ARCH rules.
+package arch
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var (
+	jsImportFromPattern = regexp.MustCompile(`(?m)^\s*(?:import|export)\b[^'"]*?\bfrom\s*['"]([^'"]+)['"]`)
+	jsImportBarePattern = regexp.MustCompile(`(?m)^\s*import\s*['"]([^'"]+)['"]`)
+	jsRequirePattern    = regexp.MustCompile(`\b(?:require|import)\s*\(\s*['"]([^'"]+)['"]\s*\)`)
+	pyImportPattern     = regexp.MustCompile(`(?m)^\s*import\s+([\w.]+(?:\s*,\s*[\w.]+)*)`)
+	pyFromPattern       = regexp.MustCompile(`(?m)^\s*from\s+(\.*[\w.]*)\s+import\b`)
+)
+
+// importRef is one import statement: the imported path as written and its 1-based
+// line and column (the column of the path literal).
+type importRef struct {
+	Path   string
+	Line   int
+	Column int
+}
+
+// extractImports returns the imports of a Go, TypeScript/JavaScript, or Python file in source order.
+func extractImports(file *model.UnifiedFileModel) []importRef {
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+	switch strings.ToLower(file.Language) {
+	case "go":
+		return extractGoImports(file.Source)
+	case "typescript", "javascript":
+		return extractPatternImports(file.Source, jsImportFromPattern, jsImportBarePattern, jsRequirePattern)
+	case "python":
+		refs := extractPatternImports(file.Source, pyFromPattern)
+		for _, m := range pyImportPattern.FindAllSubmatchIndex(file.Source, -1) {
+			line, column := sourcePosition(file.Source, m[2])
+			offset := 0
+			for _, name := range strings.Split(string(file.Source[m[2]:m[3]]), ",") {
+				trimmed := strings.TrimSpace(name)
+				refs = append(refs, importRef{Path: trimmed, Line: line, Column: column + offset + strings.Index(name, trimmed)})
+				offset += len(name) + 1
+			}
+		}
+		sortImportRefs(refs)
+		return refs
+	}
+	return nil
+}
+
+func extractGoImports(source []byte) []importRef {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.ImportsOnly)
+	if err != nil {
+		return nil
+	}
+	refs := make([]importRef, 0, len(parsed.Imports))
+	for _, spec := range parsed.Imports {
+		value, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		pos := fset.Position(spec.Path.Pos())
+		refs = append(refs, importRef{Path: value, Line: pos.Line, Column: pos.Column})
+	}
+	return refs
+}
+
+func extractPatternImports(source []byte, patterns ...*regexp.Regexp) []importRef {
+	seen := map[int]bool{}
+	refs := make([]importRef, 0)
+	for _, pattern := range patterns {
+		for _, m := range pattern.FindAllSubmatchIndex(source, -1) {
+			if seen[m[2]] {
+				continue
+			}
+			seen[m[2]] = true
+			line, column := sourcePosition(source, m[2])
+			refs = append(refs, importRef{Path: string(source[m[2]:m[3]]), Line: line, Column: column})
+		}
+	}
+	sortImportRefs(refs)
+	return refs
+}
+
+func sortImportRefs(refs []importRef) {
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Line != refs[j].Line {
+			return refs[i].Line < refs[j].Line
+		}
+		return refs[i].Column < refs[j].Column
+	})
+}
+
+// sourcePosition converts a byte offset into a 1-based line and column.
+func sourcePosition(source []byte, offset int) (int, int) {
+	before := source[:offset]
+	line := 1 + bytes.Count(before, []byte("\n"))
+	return line, offset - (bytes.LastIndexByte(before, '\n') + 1) + 1
+}
+
+// importCandidatePaths returns the slash paths an import may refer to. Relative imports
+// resolve against the importing file's directory; module paths also yield every
+// trailing sub-path so `github.com/acme/app/internal/service` can match `internal/service/**`.
+func importCandidatePaths(filePath string, importPath string, language string) []string {
+	dir := path.Dir(strings.ReplaceAll(filePath, "\\", "/"))
+	if strings.HasPrefix(importPath, ".") {
+		if strings.EqualFold(language, "python") {
+			dots := len(importPath) - len(strings.TrimLeft(importPath, "."))
+			rel := strings.ReplaceAll(strings.TrimLeft(importPath, "."), ".", "/")
+			up := strings.Repeat("../", dots-1)
+			return []string{path.Clean(path.Join(dir, up, rel))}
+		}
+		return []string{path.Clean(path.Join(dir, importPath))}
+	}
+
+	normalized := importPath
+	if strings.EqualFold(language, "python") {
+		normalized = strings.ReplaceAll(importPath, ".", "/")
+	}
+	segments := strings.Split(strings.Trim(normalized, "/"), "/")
+	candidates := make([]string, 0, len(segments))
+	for i := range segments {
+		candidates = append(candidates, strings.Join(segments[i:], "/"))
+	}
+	return candidates
+}
+
+var (
+	globCacheMu sync.Mutex
+	globCache   = map[string]*regexp.Regexp{}
+)
+
+// matchPathGlob matches a slash path against a glob where `**` spans directories
+// and a trailing `/**` also matches the directory itself.
+func matchPathGlob(pattern string, value string) bool {
+	globCacheMu.Lock()
+	re, ok := globCache[pattern]
+	if !ok {
+		re = compilePathGlob(pattern)
+		globCache[pattern] = re
+	}
+	globCacheMu.Unlock()
+	return re != nil && re.MatchString(strings.TrimPrefix(value, "./"))
+}
+
+func compilePathGlob(pattern string) *regexp.Regexp {
+	p := strings.TrimPrefix(strings.TrimSpace(pattern), "./")
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "/**") && i+3 == len(p):
+			b.WriteString("(?:/.*)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+	// Imports usually omit extensions; let a pattern match with or without one.
+	b.WriteString(`(?:\.[A-Za-z0-9]+)?$`)
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil
+	}
+	return re
+}
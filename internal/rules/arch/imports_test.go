@@ -0,0 +1,80 @@
+// imports_test.go — Tests for shared ARCH import extraction and glob matching.
+package arch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestExtractImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		source   string
+		want     []importRef
+	}{
+		{
+			name:     "go block",
+			language: "go",
+			source:   "package a\n\nimport (\n\t\"fmt\"\n\tx \"example.com/x\"\n)\n",
+			want:     []importRef{{Path: "fmt", Line: 4, Column: 2}, {Path: "example.com/x", Line: 5, Column: 4}},
+		},
+		{
+			name:     "typescript forms",
+			language: "typescript",
+			source:   "import { a } from './a';\nimport './side-effect';\nexport * from \"../b\";\nconst c = require('c');\n",
+			want:     []importRef{{Path: "./a", Line: 1, Column: 20}, {Path: "./side-effect", Line: 2, Column: 9}, {Path: "../b", Line: 3, Column: 16}, {Path: "c", Line: 4, Column: 20}},
+		},
+		{
+			name:     "python forms",
+			language: "python",
+			source:   "import os, sys\nfrom .models import User\n",
+			want:     []importRef{{Path: "os", Line: 1, Column: 8}, {Path: "sys", Line: 1, Column: 12}, {Path: ".models", Line: 2, Column: 6}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := extractImports(&model.UnifiedFileModel{Language: tc.language, Source: []byte(tc.source)})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("extractImports() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestImportCandidatePaths(t *testing.T) {
+	if got := importCandidatePaths("src/services/a.ts", "../repos/db", "typescript"); !reflect.DeepEqual(got, []string{"src/repos/db"}) {
+		t.Fatalf("relative candidates = %v", got)
+	}
+	if got := importCandidatePaths("app/web/views.py", "..db", "python"); !reflect.DeepEqual(got, []string{"app/db"}) {
+		t.Fatalf("python relative candidates = %v", got)
+	}
+	want := []string{"example.com/app/internal/store", "app/internal/store", "internal/store", "store"}
+	if got := importCandidatePaths("cmd/main.go", "example.com/app/internal/store", "go"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("module candidates = %v, want %v", got, want)
+	}
+}
+
+func TestMatchPathGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"internal/service/**", "internal/service", true},
+		{"internal/service/**", "internal/service/user.go", true},
+		{"internal/service/**", "internal/services/user.go", false},
+		{"**/handler/**", "cmd/api/handler/users.go", true},
+		{"src/*.ts", "src/index.ts", true},
+		{"src/*.ts", "src/lib/index.ts", false},
+		{"src/lib/db", "src/lib/db", true},
+		{"src/lib/db", "src/lib/db.ts", true},
+	}
+	for _, tc := range cases {
+		if got := matchPathGlob(tc.pattern, tc.value); got != tc.want {
+			t.Fatalf("matchPathGlob(%q, %q) = %t, want %t", tc.pattern, tc.value, got, tc.want)
+		}
+	}
+}
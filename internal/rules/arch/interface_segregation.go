@@ -0,0 +1,245 @@
+// interface_segregation.go — ARCH-interface-segregation: Keep interfaces small and focused.
+package arch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const defaultMaxInterfaceMethods = 5
+
+var (
+	tsInterfacePattern    = regexp.MustCompile(`(?m)^[ \t]*(?:export\s+)?(?:declare\s+)?interface\s+([A-Za-z_$][\w$]*)[^{]*\{`)
+	tsMethodMemberPattern = regexp.MustCompile(`^(?:readonly\s+)?[A-Za-z_$][\w$]*\??\s*(?:<[^>]*>\s*)?\(`)
+	tsFuncPropertyPattern = regexp.MustCompile(`^(?:readonly\s+)?[A-Za-z_$][\w$]*\??\s*:\s*(?:<[^>]*>\s*)?\([^)]*\)\s*=>`)
+	tsCommentPattern      = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+)
+
+// InterfaceSegregation flags interfaces declaring more than `maxMethods` methods. Only
+// methods declared on the interface itself count: embedding a smaller interface is how
+// a wide one should be composed. Go and TypeScript interfaces are checked; TypeScript
+// properties count only when their type is a function.
+type InterfaceSegregation struct{}
+
+func (r *InterfaceSegregation) ID() string       { return "ARCH-interface-segregation" }
+func (r *InterfaceSegregation) Category() string { return "arch" }
+func (r *InterfaceSegregation) Description() string {
+	return "Limit the number of methods an interface declares"
+}
+func (r *InterfaceSegregation) Why() string {
+	return "Every implementation and mock has to provide every method; a fat interface forces callers that need two methods to depend on twelve."
+}
+func (r *InterfaceSegregation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "type Storage interface {\n\tPut(ctx context.Context, key string, body []byte) error\n\tGet(ctx context.Context, key string) ([]byte, error)\n\tList(ctx context.Context, prefix string) ([]string, error)\n\tDelete(ctx context.Context, key string) error\n\tHead(ctx context.Context, key string) (Meta, error)\n\tPresignPut(key string) (string, error)\n\tPresignGet(key string) (string, error)\n}",
+		Good:     "type Storage interface {\n\tPut(ctx context.Context, key string, body []byte) error\n\tGet(ctx context.Context, key string) ([]byte, error)\n\tList(ctx context.Context, prefix string) ([]string, error)\n\tDelete(ctx context.Context, key string) error\n\tHead(ctx context.Context, key string) (Meta, error)\n}\n\ntype Presigner interface {\n\tPresignPut(key string) (string, error)\n\tPresignGet(key string) (string, error)\n}",
+	}}
+}
+func (r *InterfaceSegregation) DefaultSeverity() string   { return "error" }
+func (r *InterfaceSegregation) NeedsProjectContext() bool { return false }
+
+func (r *InterfaceSegregation) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Interface Storage declares 7 methods, exceeds maximum 5",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Split Storage into smaller interfaces that each serve one kind of caller, and embed them where the full set is needed.",
+				},
+			},
+		}
+	}
+
+	if file == nil {
+		return nil
+	}
+	maxMethods := intOption(config.Options, "maxMethods", defaultMaxInterfaceMethods)
+	allow := stringSliceOption(config.Options, "allow")
+
+	violations := make([]model.Violation, 0)
+	for _, iface := range interfaceTypes(file) {
+		count := len(iface.Methods)
+		if count <= maxMethods || interfaceAllowed(allow, iface.Name) {
+			continue
+		}
+		line := iface.StartLine
+		if line <= 0 {
+			line = 1
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Interface %s declares %d methods, exceeds maximum %d", iface.Name, count, maxMethods),
+			FilePath:  file.Path,
+			StartLine: line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Split %s into smaller interfaces that each serve one kind of caller, and embed them where the full set is needed.", iface.Name),
+				Metadata: map[string]interface{}{
+					"interface": iface.Name,
+					"methods":   count,
+					"max":       maxMethods,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// interfaceAllowed matches name against the `allow` list, exactly or as a path.Match glob.
+func interfaceAllowed(allow []string, name string) bool {
+	for _, pattern := range allow {
+		if pattern == name {
+			return true
+		}
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// interfaceTypes returns the interfaces declared in file. Adapters that fill Types are
+// authoritative; otherwise Go and TypeScript sources are scanned.
+func interfaceTypes(file *model.UnifiedFileModel) []model.TypeModel {
+	if len(file.Types) > 0 {
+		out := make([]model.TypeModel, 0)
+		for _, t := range file.Types {
+			if t.Kind == "interface" {
+				out = append(out, t)
+			}
+		}
+		return out
+	}
+	switch strings.ToLower(file.Language) {
+	case "go":
+		return goInterfaceTypes(file)
+	case "typescript":
+		return tsInterfaceTypes(file.Source)
+	}
+	return nil
+}
+
+func goInterfaceTypes(file *model.UnifiedFileModel) []model.TypeModel {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	out := make([]model.TypeModel, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		iface, ok := spec.Type.(*ast.InterfaceType)
+		if !ok {
+			return true
+		}
+		out = append(out, model.TypeModel{
+			Name:      spec.Name.Name,
+			Kind:      "interface",
+			Methods:   goInterfaceMethods(iface),
+			Exported:  spec.Name.IsExported(),
+			StartLine: fset.Position(spec.Pos()).Line,
+			EndLine:   fset.Position(spec.End()).Line,
+		})
+		return true
+	})
+	return out
+}
+
+// goInterfaceMethods returns the names of the methods iface declares itself; embedded
+// interfaces and type-set constraints are left out.
+func goInterfaceMethods(iface *ast.InterfaceType) []string {
+	methods := make([]string, 0)
+	if iface.Methods == nil {
+		return methods
+	}
+	for _, field := range iface.Methods.List {
+		if _, ok := field.Type.(*ast.FuncType); !ok {
+			continue
+		}
+		for _, name := range field.Names {
+			methods = append(methods, name.Name)
+		}
+	}
+	return methods
+}
+
+// tsInterfaceTypes finds `interface Name { ... }` blocks and collects the top-level
+// members that are method signatures or function-typed properties.
+func tsInterfaceTypes(source []byte) []model.TypeModel {
+	// Blank comments out in place so offsets still map to the original lines.
+	text := tsCommentPattern.ReplaceAllStringFunc(string(source), func(c string) string {
+		blank := []byte(c)
+		for i := range blank {
+			if blank[i] != '\n' {
+				blank[i] = ' '
+			}
+		}
+		return string(blank)
+	})
+	out := make([]model.TypeModel, 0)
+	for _, m := range tsInterfacePattern.FindAllStringSubmatchIndex(text, -1) {
+		bodyStart := m[1]
+		methods := make([]string, 0)
+		depth, memberStart, end := 1, bodyStart, len(text)
+		flush := func(i int) {
+			member := strings.TrimSpace(text[memberStart:i])
+			if tsMethodMemberPattern.MatchString(member) || tsFuncPropertyPattern.MatchString(member) {
+				name := strings.TrimPrefix(member, "readonly ")
+				name = strings.TrimSpace(name)
+				if idx := strings.IndexAny(name, "?:(< \t"); idx > 0 {
+					name = name[:idx]
+				}
+				methods = append(methods, name)
+			}
+			memberStart = i + 1
+		}
+		for i := bodyStart; i < len(text); i++ {
+			switch text[i] {
+			case '{', '(', '[':
+				depth++
+			case '}', ')', ']':
+				depth--
+				if depth == 0 {
+					flush(i)
+					end = i + 1
+				}
+			case ';', ',', '\n':
+				if depth == 1 {
+					flush(i)
+				}
+			}
+			if depth == 0 {
+				break
+			}
+		}
+		out = append(out, model.TypeModel{
+			Name:      text[m[2]:m[3]],
+			Kind:      "interface",
+			Methods:   methods,
+			Exported:  strings.Contains(text[m[0]:m[2]], "export"),
+			StartLine: 1 + strings.Count(text[:m[2]], "\n"),
+			EndLine:   1 + strings.Count(text[:end], "\n"),
+		})
+	}
+	return out
+}
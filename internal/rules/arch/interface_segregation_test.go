@@ -0,0 +1,116 @@
+// interface_segregation_test.go — Tests for ARCH-interface-segregation.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestInterfaceSegregation(t *testing.T) {
+	assertRuleContract(t, &InterfaceSegregation{})
+}
+
+func TestInterfaceSegregationFlagsWideGoInterfaces(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "storage/storage.go",
+		Language: "go",
+		Source: []byte(`// storage.go — Storage clients.
+package storage
+
+type Reader interface {
+	Get(key string) ([]byte, error)
+	Head(key string) (int, error)
+}
+
+type Store interface {
+	Reader
+	Put(key string, body []byte) error
+	Delete(key string) error
+	List(prefix string) ([]string, error)
+	Copy(from, to string) error
+}
+
+type Admin interface {
+	Put(key string, body []byte) error
+	Delete(key string) error
+	List(prefix string) ([]string, error)
+	Copy(from, to string) error
+	Get(key string) ([]byte, error)
+	Head(key string) (int, error)
+}
+`),
+	}
+	rule := &InterfaceSegregation{}
+
+	got := rule.Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	if got[0].StartLine != 17 || got[0].Message != "Interface Admin declares 6 methods, exceeds maximum 5" {
+		t.Fatalf("line=%d message=%q", got[0].StartLine, got[0].Message)
+	}
+	if got[0].Context.Metadata["interface"] != "Admin" || got[0].Context.Metadata["methods"] != 6 {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"maxMethods": 4}}); len(got) != 1 {
+		t.Fatalf("embedded Reader should not count toward Store, got %+v", got)
+	}
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{"Adm*"}}}); len(got) != 0 {
+		t.Fatalf("allow should skip Admin, got %+v", got)
+	}
+}
+
+func TestInterfaceSegregationCountsTypeScriptMethods(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "src/api.ts",
+		Language: "typescript",
+		Source: []byte(`// api.ts — API client contract.
+export interface UserDTO {
+  id: string;
+  name: string;
+  email?: string;
+}
+
+export interface ApiClient {
+  getUser(id: string): Promise<UserDTO>;
+  // listUsers(): Promise<UserDTO[]>;
+  saveUser(
+    user: UserDTO,
+  ): Promise<void>;
+  onChange: (user: UserDTO) => void;
+  readonly baseUrl: string;
+  options: { retries: number; timeout(): number };
+}
+`),
+	}
+	rule := &InterfaceSegregation{}
+
+	got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"maxMethods": 2}})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	if got[0].StartLine != 8 || got[0].Context.Metadata["methods"] != 3 {
+		t.Fatalf("line=%d metadata=%+v", got[0].StartLine, got[0].Context.Metadata)
+	}
+	if got := rule.Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("default max 5 should pass, got %+v", got)
+	}
+}
+
+func TestInterfaceSegregationUsesModelTypes(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "svc/svc.go",
+		Language: "go",
+		Source:   []byte("package svc\n"),
+		Types: []model.TypeModel{
+			{Name: "Config", Kind: "struct", StartLine: 3},
+			{Name: "Service", Kind: "interface", Methods: []string{"A", "B", "C"}, StartLine: 7},
+		},
+	}
+	got := (&InterfaceSegregation{}).Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"maxMethods": 2}})
+	if len(got) != 1 || got[0].StartLine != 7 {
+		t.Fatalf("expected Service at line 7, got %+v", got)
+	}
+}
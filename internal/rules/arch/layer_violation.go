@@ -2,6 +2,8 @@
 package arch
 
 import (
+	"fmt"
+	"path"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
@@ -16,20 +18,31 @@ func (r *LayerViolation) Description() string { return "Disallow layer responsib
 func (r *LayerViolation) Why() string {
 	return "Layer purity preserves clear ownership and testability."
 }
+func (r *LayerViolation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "// UI component directly executing SQL queries",
+		Good:     "// UI component calling domain service, service using repository",
+	}}
+}
 func (r *LayerViolation) DefaultSeverity() string   { return "error" }
 func (r *LayerViolation) NeedsProjectContext() bool { return false }
 
 func (r *LayerViolation) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
+	if layers := parseLayers(config.Options); len(layers) > 0 {
+		return r.checkLayerEdges(file, layers, config.Options, severity)
+	}
+
+	triggered, line := shouldTriggerRule(file, r.ID())
+	if !triggered {
+		return nil
+	}
+
 	message := "Service layer directly uses persistence concern 'sql query builder', violates layer responsibility"
 	return []model.Violation{
 		{
@@ -44,3 +57,174 @@ func (r *LayerViolation) Check(file *model.UnifiedFileModel, _ *model.ProjectCon
 		},
 	}
 }
+
+// checkLayerEdges flags imports that point against the configured layer direction,
+// unless the `from->to` edge is listed in allowedEdges.
+func (r *LayerViolation) checkLayerEdges(file *model.UnifiedFileModel, layers []layerSpec, options map[string]interface{}, severity string) []model.Violation {
+	if file == nil {
+		return nil
+	}
+	from := layerIndexForFile(layers, file.Path)
+	if from < 0 {
+		return nil
+	}
+
+	allowed := parseAllowedEdges(stringSliceOption(options, "allowedEdges"))
+	order := layerNames(layers)
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		to := layerIndexForPaths(layers, importCandidatePaths(file.Path, ref.Path, file.Language))
+		// Layers are ordered top-down: a layer may import itself and layers after it.
+		if to < 0 || to >= from {
+			continue
+		}
+		edge := layers[from].Name + "->" + layers[to].Name
+		if allowed[edge] {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("%s layer directly uses %s concern '%s', violates layer responsibility (edge %s is not allowed; layers: %s)", capitalizeLayer(layers[from].Name), layers[to].Name, ref.Path, edge, strings.Join(order, " -> ")),
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Depend on the %s layer through an abstraction owned by %s, or add \"%s\" to allowedEdges if this edge is sanctioned.", layers[to].Name, layers[from].Name, edge),
+				Metadata: map[string]interface{}{
+					"fromLayer": layers[from].Name,
+					"toLayer":   layers[to].Name,
+					"edge":      edge,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// layerSpec is one entry of the ordered `layers` option.
+type layerSpec struct {
+	Name     string
+	Patterns []string
+}
+
+// parseLayers reads the `layers` option and returns it ordered from the top layer down,
+// reversing it when `direction: bottom-up`. An entry is a bare name (matched as a
+// directory name, singular or plural), a path glob (named after its last literal
+// segment), or a {name, patterns} map (`paths` is accepted for `patterns`).
+func parseLayers(options map[string]interface{}) []layerSpec {
+	if options == nil {
+		return nil
+	}
+	raw, ok := options["layers"].([]interface{})
+	if !ok {
+		if names, ok := options["layers"].([]string); ok {
+			for _, name := range names {
+				raw = append(raw, name)
+			}
+		}
+	}
+
+	layers := make([]layerSpec, 0, len(raw))
+	for _, item := range raw {
+		switch v := item.(type) {
+		case string:
+			if layer, ok := layerFromString(v); ok {
+				layers = append(layers, layer)
+			}
+		case map[string]interface{}:
+			name, _ := v["name"].(string)
+			name = strings.TrimSpace(name)
+			patterns := stringSliceOption(v, "patterns")
+			if len(patterns) == 0 {
+				patterns = stringSliceOption(v, "paths")
+			}
+			if name == "" {
+				continue
+			}
+			if len(patterns) == 0 {
+				patterns = defaultLayerPatterns(name)
+			}
+			layers = append(layers, layerSpec{Name: name, Patterns: patterns})
+		}
+	}
+	if direction, _ := options["direction"].(string); strings.EqualFold(strings.TrimSpace(direction), "bottom-up") {
+		for i, j := 0, len(layers)-1; i < j; i, j = i+1, j-1 {
+			layers[i], layers[j] = layers[j], layers[i]
+		}
+	}
+	return layers
+}
+
+func defaultLayerPatterns(name string) []string {
+	return []string{"**/" + name + "/**", "**/" + name + "s/**"}
+}
+
+func layerFromString(value string) (layerSpec, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return layerSpec{}, false
+	}
+	if !strings.ContainsAny(value, "/*?") {
+		return layerSpec{Name: value, Patterns: defaultLayerPatterns(value)}, true
+	}
+	name := ""
+	for _, segment := range strings.Split(value, "/") {
+		if segment != "" && !strings.ContainsAny(segment, "*?") {
+			name = segment
+		}
+	}
+	if name == "" {
+		name = path.Clean(value)
+	}
+	return layerSpec{Name: name, Patterns: []string{value}}, true
+}
+
+func parseAllowedEdges(values []string) map[string]bool {
+	allowed := map[string]bool{}
+	for _, value := range values {
+		parts := strings.SplitN(value, "->", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		from, to := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if from != "" && to != "" {
+			allowed[from+"->"+to] = true
+		}
+	}
+	return allowed
+}
+
+// layerIndexForFile returns the layer the file at filePath belongs to, or -1.
+func layerIndexForFile(layers []layerSpec, filePath string) int {
+	return layerIndexForPaths(layers, importCandidatePaths("", strings.ReplaceAll(filePath, "\\", "/"), ""))
+}
+
+func layerIndexForPaths(layers []layerSpec, candidates []string) int {
+	for i, layer := range layers {
+		for _, pattern := range layer.Patterns {
+			for _, candidate := range candidates {
+				if matchPathGlob(pattern, candidate) {
+					return i
+				}
+			}
+		}
+	}
+	return -1
+}
+
+func layerNames(layers []layerSpec) []string {
+	names := make([]string, 0, len(layers))
+	for _, layer := range layers {
+		names = append(names, layer.Name)
+	}
+	return names
+}
+
+func capitalizeLayer(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
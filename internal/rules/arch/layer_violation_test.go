@@ -1,8 +1,117 @@
 // layer_violation_test.go — Tests for ARCH-layer-violation.
 package arch
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestLayerViolation(t *testing.T) {
 	assertRuleContract(t, &LayerViolation{})
 }
+
+func TestLayerViolationEdges(t *testing.T) {
+	layers := []interface{}{"handler", "service", "repository"}
+	tests := []struct {
+		name      string
+		path      string
+		language  string
+		source    string
+		options   map[string]interface{}
+		wantEdges []string
+	}{
+		{
+			name:     "downward import allowed",
+			path:     "internal/handler/users.go",
+			language: "go",
+			source:   "package handler\n\nimport \"github.com/acme/app/internal/service\"\n",
+		},
+		{
+			name:      "upward go import flagged",
+			path:      "internal/repository/users.go",
+			language:  "go",
+			source:    "package repository\n\nimport (\n\t\"fmt\"\n\t\"github.com/acme/app/internal/handler\"\n)\n",
+			wantEdges: []string{"repository->handler"},
+		},
+		{
+			name:     "allowed edge skips violation",
+			path:     "internal/repository/users.go",
+			language: "go",
+			source:   "package repository\n\nimport \"github.com/acme/app/internal/handler\"\n",
+			options:  map[string]interface{}{"allowedEdges": []interface{}{"repository -> handler"}},
+		},
+		{
+			name:      "relative typescript import flagged",
+			path:      "src/services/orders.ts",
+			language:  "typescript",
+			source:    "import { db } from '../repositories/db';\nimport { route } from '../handlers/orders';\n",
+			wantEdges: []string{"service->handler"},
+		},
+		{
+			name:     "bottom-up direction reverses",
+			path:     "src/handlers/orders.ts",
+			language: "typescript",
+			source:   "import { svc } from '../services/orders';\n",
+			options:  map[string]interface{}{"direction": "bottom-up"},
+			wantEdges: []string{
+				"handler->service",
+			},
+		},
+		{
+			name:     "explicit patterns",
+			path:     "app/web/routes.py",
+			language: "python",
+			source:   "from app.db import session\n",
+			options: map[string]interface{}{"layers": []interface{}{
+				map[string]interface{}{"name": "db", "patterns": []interface{}{"app/db/**"}},
+				map[string]interface{}{"name": "web", "patterns": []interface{}{"app/web/**"}},
+			}},
+			wantEdges: []string{"web->db"},
+		},
+		{
+			name:     "file outside layers ignored",
+			path:     "tools/gen.go",
+			language: "go",
+			source:   "package main\n\nimport \"github.com/acme/app/internal/handler\"\n",
+		},
+	}
+
+	rule := &LayerViolation{}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			options := map[string]interface{}{"layers": layers}
+			for k, v := range tc.options {
+				options[k] = v
+			}
+			file := &model.UnifiedFileModel{Path: tc.path, Language: tc.language, Source: []byte(tc.source)}
+			got := rule.Check(file, nil, model.RuleConfig{Options: options})
+			if len(got) != len(tc.wantEdges) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tc.wantEdges), got)
+			}
+			for i, v := range got {
+				if v.Context.Metadata["edge"] != tc.wantEdges[i] {
+					t.Fatalf("edge = %v, want %s", v.Context.Metadata["edge"], tc.wantEdges[i])
+				}
+				if !strings.Contains(v.Message, tc.wantEdges[i]) {
+					t.Fatalf("message %q should name the edge %s", v.Message, tc.wantEdges[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLayerViolationReportsImportLine(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "internal/repository/users.go",
+		Language: "go",
+		Source:   []byte("package repository\n\nimport (\n\t\"fmt\"\n\t\"github.com/acme/app/internal/service\"\n)\n"),
+	}
+	got := (&LayerViolation{}).Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"layers": []interface{}{"handler", "service", "repository"}}})
+	if len(got) != 1 || got[0].StartLine != 5 {
+		t.Fatalf("violations = %+v, want one at line 5", got)
+	}
+}
@@ -0,0 +1,164 @@
+// max_cyclomatic_complexity.go — ARCH-max-cyclomatic-complexity: Keep function complexity within configured limits.
+package arch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const defaultMaxCyclomaticComplexity = 10
+
+// MaxCyclomaticComplexity flags functions whose cyclomatic complexity exceeds `max`.
+// Go function bodies are parsed and measured with the same counting as
+// ARCH-no-business-logic-in-handlers; other languages are checked when their adapter
+// fills FuncModel.Complexity.
+type MaxCyclomaticComplexity struct{}
+
+func (r *MaxCyclomaticComplexity) ID() string       { return "ARCH-max-cyclomatic-complexity" }
+func (r *MaxCyclomaticComplexity) Category() string { return "arch" }
+func (r *MaxCyclomaticComplexity) Description() string {
+	return "Limit the cyclomatic complexity of each function"
+}
+func (r *MaxCyclomaticComplexity) Why() string {
+	return "Every branch is a path that needs a test; functions with many paths hide untested ones and are where bugs cluster."
+}
+func (r *MaxCyclomaticComplexity) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "func price(o Order) int {\n\tif o.Member && o.Total > 100 || o.Coupon != \"\" {\n\t\t// ... ten more nested branches ...\n\t}\n}",
+		Good:     "func price(o Order) int {\n\treturn o.Total - memberDiscount(o) - couponDiscount(o)\n}",
+	}}
+}
+func (r *MaxCyclomaticComplexity) DefaultSeverity() string   { return "error" }
+func (r *MaxCyclomaticComplexity) NeedsProjectContext() bool { return false }
+
+func (r *MaxCyclomaticComplexity) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Function ProcessOrder has cyclomatic complexity 14, exceeds maximum 10",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Extract branches of ProcessOrder into smaller named functions, or replace condition chains with a lookup table.",
+				},
+			},
+		}
+	}
+
+	if file == nil {
+		return nil
+	}
+	maxComplexity := intOption(config.Options, "max", defaultMaxCyclomaticComplexity)
+
+	violations := make([]model.Violation, 0)
+	for _, fn := range functionComplexities(file) {
+		if fn.Complexity <= maxComplexity {
+			continue
+		}
+		line := fn.StartLine
+		if line <= 0 {
+			line = 1
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Function %s has cyclomatic complexity %d, exceeds maximum %d", fn.Name, fn.Complexity, maxComplexity),
+			FilePath:  file.Path,
+			StartLine: line,
+			EndLine:   fn.EndLine,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Extract branches of %s into smaller named functions, or replace condition chains with a lookup table.", fn.Name),
+				Metadata: map[string]interface{}{
+					"function":   fn.Name,
+					"complexity": fn.Complexity,
+					"max":        maxComplexity,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// functionComplexities returns the file's functions with their complexity. Go sources are
+// parsed; methods are named Receiver.Method. For other languages only functions the
+// adapter measured (Complexity > 0) are returned.
+func functionComplexities(file *model.UnifiedFileModel) []model.FuncModel {
+	if strings.EqualFold(file.Language, "go") {
+		return goFunctionComplexities(file.Source)
+	}
+	out := make([]model.FuncModel, 0)
+	add := func(fn model.FuncModel, owner string) {
+		if fn.Complexity <= 0 {
+			return
+		}
+		if owner != "" && fn.Receiver == "" {
+			fn.Name = owner + "." + fn.Name
+		} else if fn.Receiver != "" {
+			fn.Name = fn.Receiver + "." + fn.Name
+		}
+		out = append(out, fn)
+	}
+	for _, fn := range file.Functions {
+		add(fn, "")
+	}
+	for _, class := range file.Classes {
+		for _, fn := range class.Methods {
+			add(fn, class.Name)
+		}
+	}
+	return out
+}
+
+func goFunctionComplexities(source []byte) []model.FuncModel {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	out := make([]model.FuncModel, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		name := fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			name = goReceiverName(fn.Recv.List[0].Type) + "." + name
+		}
+		out = append(out, model.FuncModel{
+			Name:       name,
+			Complexity: cyclomaticComplexity(fn.Body),
+			StartLine:  fset.Position(fn.Pos()).Line,
+			EndLine:    fset.Position(fn.End()).Line,
+		})
+	}
+	return out
+}
+
+// goReceiverName returns the receiver's type name without pointer or type parameters.
+func goReceiverName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return goReceiverName(t.X)
+	case *ast.IndexExpr:
+		return goReceiverName(t.X)
+	case *ast.IndexListExpr:
+		return goReceiverName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return "?"
+}
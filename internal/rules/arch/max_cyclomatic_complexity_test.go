@@ -0,0 +1,78 @@
+// max_cyclomatic_complexity_test.go — Tests for ARCH-max-cyclomatic-complexity.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestMaxCyclomaticComplexity(t *testing.T) {
+	assertRuleContract(t, &MaxCyclomaticComplexity{})
+}
+
+func TestMaxCyclomaticComplexityMeasuresGoFunctions(t *testing.T) {
+	source := `package orders
+
+func (s *Service[T]) Price(o Order) int {
+	total := o.Total
+	if o.Member && total > 100 {
+		total -= 10
+	}
+	for _, item := range o.Items {
+		switch item.Kind {
+		case "gift":
+			continue
+		case "bulk", "pallet":
+			total -= item.Discount
+		default:
+		}
+	}
+	return total
+}
+
+func simple(a int) int {
+	if a > 0 {
+		return a
+	}
+	return -a
+}
+`
+	file := &model.UnifiedFileModel{Path: "internal/orders/price.go", Language: "go", Source: []byte(source)}
+
+	// Price: 1 + if + && + range + 2 non-default cases = 6.
+	got := (&MaxCyclomaticComplexity{}).Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"max": 5}})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	want := "Function Service.Price has cyclomatic complexity 6, exceeds maximum 5"
+	if got[0].Message != want || got[0].StartLine != 3 || got[0].EndLine != 18 {
+		t.Fatalf("violation = lines %d-%d %q, want lines 3-18 %q", got[0].StartLine, got[0].EndLine, got[0].Message, want)
+	}
+	if got[0].Context.Metadata["complexity"] != 6 || got[0].Context.Metadata["function"] != "Service.Price" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	if got := (&MaxCyclomaticComplexity{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("default maximum 10 should pass, got %+v", got)
+	}
+}
+
+func TestMaxCyclomaticComplexityUsesAdapterComplexity(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "src/orders.ts",
+		Language: "typescript",
+		Functions: []model.FuncModel{
+			{Name: "price", Complexity: 12, StartLine: 4, EndLine: 40},
+			{Name: "unmeasured", StartLine: 42},
+		},
+		Classes: []model.ClassModel{{
+			Name:    "Cart",
+			Methods: []model.FuncModel{{Name: "checkout", Complexity: 11, StartLine: 60}},
+		}},
+	}
+	got := (&MaxCyclomaticComplexity{}).Check(file, nil, model.RuleConfig{})
+	if len(got) != 2 || got[0].Context.Metadata["function"] != "price" || got[1].Context.Metadata["function"] != "Cart.checkout" {
+		t.Fatalf("violations = %+v", got)
+	}
+}
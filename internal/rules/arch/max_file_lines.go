@@ -16,6 +16,13 @@ func (r *MaxFileLines) Description() string { return "Keep file size within conf
 func (r *MaxFileLines) Why() string {
 	return "Oversized files hide responsibilities and increase review risk."
 }
+func (r *MaxFileLines) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "// 1200 lines in single file with multiple responsibilities",
+		Good:     "// 3 files of 400 lines each, one responsibility per file",
+	}}
+}
 func (r *MaxFileLines) DefaultSeverity() string   { return "error" }
 func (r *MaxFileLines) NeedsProjectContext() bool { return false }
 
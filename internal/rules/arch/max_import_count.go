@@ -0,0 +1,119 @@
+// max_import_count.go — ARCH-max-import-count: Limit the number of imports per file.
+package arch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const defaultMaxImportCount = 25
+
+// MaxImportCount implements the ARCH-max-import-count rule.
+type MaxImportCount struct{}
+
+func (r *MaxImportCount) ID() string          { return "ARCH-max-import-count" }
+func (r *MaxImportCount) Category() string    { return "arch" }
+func (r *MaxImportCount) Description() string { return "Limit the number of imports in a single file" }
+func (r *MaxImportCount) Why() string {
+	return "A file that needs dozens of imports usually does too much and couples to too many modules."
+}
+func (r *MaxImportCount) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "// order_service.go imports 38 packages: db, cache, mail, pdf, billing, ...",
+		Good:     "// order_service.go imports 9 packages; mail and pdf moved to notification/",
+	}}
+}
+func (r *MaxImportCount) DefaultSeverity() string   { return "error" }
+func (r *MaxImportCount) NeedsProjectContext() bool { return false }
+
+func (r *MaxImportCount) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "File has 38 imports, exceeds maximum 25",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Split this file so each part depends on fewer modules, or raise `max` for this path.",
+				},
+			},
+		}
+	}
+
+	if file == nil || (file.IsTestFile && excludeTestsOption(config.Options)) {
+		return nil
+	}
+	maxImports := intOption(config.Options, "max", defaultMaxImportCount)
+
+	// Adapters that fill Imports are authoritative; otherwise scan the source.
+	count, firstLine := len(file.Imports), 0
+	if count > 0 {
+		firstLine = file.Imports[0].StartLine
+		for _, imp := range file.Imports {
+			if imp.StartLine > 0 && (firstLine <= 0 || imp.StartLine < firstLine) {
+				firstLine = imp.StartLine
+			}
+		}
+	} else {
+		refs := extractImports(file)
+		count = len(refs)
+		if count > 0 {
+			firstLine = refs[0].Line
+		}
+	}
+	if count <= maxImports {
+		return nil
+	}
+
+	return []model.Violation{
+		{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("File has %d imports, exceeds maximum %d", count, maxImports),
+			FilePath:  file.Path,
+			StartLine: importBlockStart(file.Source, firstLine),
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Split this file so each part needs at most %d imports, or raise `max` for this path.", maxImports),
+				Metadata: map[string]interface{}{
+					"count": count,
+					"max":   maxImports,
+				},
+			},
+		},
+	}
+}
+
+// excludeTestsOption reads the `excludeTests` option (default false).
+func excludeTestsOption(options map[string]interface{}) bool {
+	value, _ := options["excludeTests"].(bool)
+	return value
+}
+
+// importBlockStart walks up from the first import path to the line that opens its
+// statement (`import (`, a multi-line `import {`, or `from x import`).
+func importBlockStart(source []byte, line int) int {
+	if line <= 0 {
+		return 1
+	}
+	lines := strings.Split(string(source), "\n")
+	for i := line - 1; i >= 0 && i < len(lines); i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "import") || strings.HasPrefix(trimmed, "from ") {
+			return i + 1
+		}
+		if i < line-1 && (trimmed == "" || strings.HasSuffix(trimmed, ";") || strings.HasSuffix(trimmed, ")")) {
+			break
+		}
+	}
+	return line
+}
@@ -0,0 +1,66 @@
+// max_import_count_test.go — Tests for ARCH-max-import-count.
+package arch
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestMaxImportCount(t *testing.T) {
+	assertRuleContract(t, &MaxImportCount{})
+}
+
+func TestMaxImportCountReportsImportBlock(t *testing.T) {
+	var src strings.Builder
+	src.WriteString("// svc.go — Service.\npackage svc\n\nimport (\n")
+	for i := 0; i < 4; i++ {
+		fmt.Fprintf(&src, "\t\"example.com/pkg%d\"\n", i)
+	}
+	src.WriteString(")\n")
+	file := &model.UnifiedFileModel{Path: "svc/svc.go", Language: "go", Source: []byte(src.String())}
+	rule := &MaxImportCount{}
+
+	got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"max": 3}})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	if got[0].StartLine != 4 || got[0].Message != "File has 4 imports, exceeds maximum 3" {
+		t.Fatalf("line=%d message=%q", got[0].StartLine, got[0].Message)
+	}
+	if got[0].Context.Metadata["count"] != 4 {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"max": 4}}); len(got) != 0 {
+		t.Fatalf("count at the limit should pass, got %+v", got)
+	}
+	if got := rule.Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("default max 25 should pass, got %+v", got)
+	}
+}
+
+func TestMaxImportCountUsesModelImportsAndExcludesTests(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:       "web/app.test.ts",
+		Language:   "typescript",
+		IsTestFile: true,
+		Source:     []byte("import { a } from './a';\nimport {\n  b,\n} from './b';\n"),
+	}
+	rule := &MaxImportCount{}
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"max": 1}}); len(got) != 1 || got[0].StartLine != 1 {
+		t.Fatalf("expected one violation at line 1, got %+v", got)
+	}
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"max": 1, "excludeTests": true}}); len(got) != 0 {
+		t.Fatalf("excludeTests should skip test files, got %+v", got)
+	}
+
+	file.IsTestFile = false
+	file.Imports = []model.ImportDecl{{Path: "./x", StartLine: 7}, {Path: "./y", StartLine: 8}, {Path: "./z", StartLine: 9}}
+	got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"max": 2}})
+	if len(got) != 1 || !strings.Contains(got[0].Message, "3 imports") {
+		t.Fatalf("model Imports should be counted when present, got %+v", got)
+	}
+}
@@ -2,12 +2,36 @@
 package arch
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
-// ModuleBoundary implements the ARCH-module-boundary rule.
+// publicManifestNames are the files that declare a module's published symbols. The
+// directory holding one is the module.
+var publicManifestNames = []string{"public.yml", "public.yaml"}
+
+var (
+	jsImportClausePattern = regexp.MustCompile(`(?m)^\s*(?:import|export)\s+(?:type\s+)?([^'";]*?)\s*from\s*['"]([^'"]+)['"]`)
+	jsNamespacePattern    = regexp.MustCompile(`^\*\s*as\s+([A-Za-z_$][\w$]*)$`)
+)
+
+// ModuleBoundary implements the ARCH-module-boundary rule. A directory with a public.yml
+// is a module, and the manifest's `symbols` list is its published surface: imports from
+// outside the module may only use those symbols.
 type ModuleBoundary struct{}
 
 func (r *ModuleBoundary) ID() string          { return "ARCH-module-boundary" }
@@ -16,31 +40,266 @@ func (r *ModuleBoundary) Description() string { return "Require access through m
 func (r *ModuleBoundary) Why() string {
 	return "Direct internal imports bypass contract checks and break encapsulation."
 }
+func (r *ModuleBoundary) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// payments/public.yml lists: [charge]\nimport { ledgerWrite } from '../payments/ledger'",
+		Good:     "import { charge } from '../payments'",
+	}}
+}
 func (r *ModuleBoundary) DefaultSeverity() string   { return "error" }
 func (r *ModuleBoundary) NeedsProjectContext() bool { return false }
+func (r *ModuleBoundary) ReadsExternalInputs() bool { return true }
 
 func (r *ModuleBoundary) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Access to payments module must go through public API, not direct import of payments/internal/store"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Access to payments module must go through public API, not direct import of payments/internal/store",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Import the module's exported API package instead of internal paths.",
+				},
+			},
+		}
+	}
+
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+	var uses []symbolUse
+	switch strings.ToLower(file.Language) {
+	case "go":
+		uses = goSymbolUses(file)
+	case "typescript", "javascript":
+		uses = jsSymbolUses(file)
+	default:
+		return nil
+	}
+
+	importerDir := filepath.Dir(file.Path)
+	violations := make([]model.Violation, 0)
+	for _, use := range uses {
+		module, api, ok := owningPublicModule(use.Target, importerDir)
+		if !ok || api.Symbols[use.Symbol] {
+			continue
+		}
+		moduleName := filepath.ToSlash(module)
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("%s is not in the public API of module %s (imported from %q)", use.Symbol, moduleName, use.Import),
+			FilePath:    file.Path,
+			StartLine:   use.Line,
+			StartColumn: use.Column,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Import the module's exported API package instead of internal paths.",
+				SuggestedFix: fmt.Sprintf("Use a symbol listed in %s, or publish %s there if it is meant to be used outside %s.", filepath.ToSlash(api.Path), use.Symbol, moduleName),
+				Metadata: map[string]interface{}{
+					"symbol":   use.Symbol,
+					"module":   moduleName,
+					"import":   use.Import,
+					"manifest": filepath.ToSlash(api.Path),
+				},
 			},
-		},
+		})
+	}
+	return violations
+}
+
+// symbolUse is one symbol a file takes from an import, with the directory (or file,
+// for extensionless TS paths) the import resolves to.
+type symbolUse struct {
+	Symbol string
+	Import string
+	Target string
+	Line   int
+	Column int
+}
+
+// goSymbolUses returns the first use of each package-qualified identifier (`store.Save`)
+// for imports that resolve inside the importing file's Go module.
+func goSymbolUses(file *model.UnifiedFileModel) []symbolUse {
+	module, ok := findGoModule(filepath.Dir(file.Path))
+	if !ok {
+		return nil
+	}
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	type goImport struct {
+		path   string
+		target string
+	}
+	imports := map[string]goImport{}
+	for _, spec := range parsed.Imports {
+		value, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || !strings.HasPrefix(value, module.Path+"/") {
+			continue
+		}
+		name := path.Base(value)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == "_" || name == "." {
+			continue
+		}
+		rel := strings.TrimPrefix(value, module.Path+"/")
+		imports[name] = goImport{path: value, target: filepath.Join(module.Root, filepath.FromSlash(rel))}
+	}
+	if len(imports) == 0 {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	uses := make([]symbolUse, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		imp, ok := imports[pkg.Name]
+		if !ok || seen[imp.path+"."+sel.Sel.Name] {
+			return true
+		}
+		seen[imp.path+"."+sel.Sel.Name] = true
+		pos := fset.Position(sel.Sel.Pos())
+		uses = append(uses, symbolUse{Symbol: sel.Sel.Name, Import: imp.path, Target: imp.target, Line: pos.Line, Column: pos.Column})
+		return true
+	})
+	return uses
+}
+
+// jsSymbolUses returns the named and default bindings of relative imports and re-exports,
+// plus the first `ns.name` use of each namespace import.
+func jsSymbolUses(file *model.UnifiedFileModel) []symbolUse {
+	uses := make([]symbolUse, 0)
+	dir := path.Dir(filepath.ToSlash(file.Path))
+	for _, m := range jsImportClausePattern.FindAllSubmatchIndex(file.Source, -1) {
+		importPath := string(file.Source[m[4]:m[5]])
+		if !strings.HasPrefix(importPath, ".") {
+			continue
+		}
+		target := filepath.FromSlash(path.Clean(path.Join(dir, importPath)))
+		line, column := sourcePosition(file.Source, m[4])
+		add := func(symbol string, line, column int) {
+			uses = append(uses, symbolUse{Symbol: symbol, Import: importPath, Target: target, Line: line, Column: column})
+		}
+
+		clause := strings.TrimSpace(string(file.Source[m[2]:m[3]]))
+		if open := strings.Index(clause, "{"); open >= 0 {
+			if end := strings.Index(clause, "}"); end > open {
+				for _, spec := range strings.Split(clause[open+1:end], ",") {
+					fields := strings.Fields(strings.TrimPrefix(strings.TrimSpace(spec), "type "))
+					if len(fields) > 0 {
+						add(fields[0], line, column)
+					}
+				}
+				clause = clause[:open] + clause[end+1:]
+			}
+		}
+		for _, part := range strings.Split(clause, ",") {
+			part = strings.TrimSpace(part)
+			switch {
+			case part == "" || part == "*":
+			case jsNamespacePattern.MatchString(part):
+				ns := jsNamespacePattern.FindStringSubmatch(part)[1]
+				usePattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(ns) + `\.([A-Za-z_$][\w$]*)`)
+				seen := map[string]bool{}
+				for _, u := range usePattern.FindAllSubmatchIndex(file.Source[m[1]:], -1) {
+					symbol := string(file.Source[m[1]+u[2] : m[1]+u[3]])
+					if !seen[symbol] {
+						seen[symbol] = true
+						useLine, useColumn := sourcePosition(file.Source, m[1]+u[2])
+						add(symbol, useLine, useColumn)
+					}
+				}
+			default:
+				add("default", line, column)
+			}
+		}
+	}
+	sort.SliceStable(uses, func(i, j int) bool {
+		if uses[i].Line != uses[j].Line {
+			return uses[i].Line < uses[j].Line
+		}
+		return uses[i].Column < uses[j].Column
+	})
+	return uses
+}
+
+// publicAPI is a module's public.yml.
+type publicAPI struct {
+	Path    string
+	Symbols map[string]bool
+}
+
+var (
+	publicAPICacheMu sync.Mutex
+	publicAPICache   = map[string]*publicAPI{}
+)
+
+// owningPublicModule walks up from target to the nearest directory with a public
+// manifest, stopping before any directory that also contains the importer: imports
+// within a module are not boundary crossings.
+func owningPublicModule(target string, importerDir string) (string, publicAPI, bool) {
+	importerDir = filepath.Clean(importerDir)
+	for dir := filepath.Clean(target); !containsDir(dir, importerDir); dir = filepath.Dir(dir) {
+		for _, name := range publicManifestNames {
+			manifestPath := filepath.Join(dir, name)
+			if info, err := os.Stat(manifestPath); err != nil || info.IsDir() {
+				continue
+			}
+			api, ok := loadPublicAPI(manifestPath)
+			return dir, api, ok
+		}
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+	return "", publicAPI{}, false
+}
+
+// containsDir reports whether child is dir or lies beneath it.
+func containsDir(dir string, child string) bool {
+	rel, err := filepath.Rel(dir, child)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// loadPublicAPI reads a public manifest. Unreadable or invalid manifests are ignored, so
+// a broken file never makes every import a violation.
+func loadPublicAPI(manifestPath string) (publicAPI, bool) {
+	publicAPICacheMu.Lock()
+	defer publicAPICacheMu.Unlock()
+	cached, ok := publicAPICache[manifestPath]
+	if !ok {
+		var doc struct {
+			Symbols []string `yaml:"symbols"`
+		}
+		if data, err := os.ReadFile(manifestPath); err == nil && yaml.Unmarshal(data, &doc) == nil {
+			cached = &publicAPI{Path: manifestPath, Symbols: map[string]bool{}}
+			for _, symbol := range doc.Symbols {
+				cached.Symbols[strings.TrimSpace(symbol)] = true
+			}
+		}
+		publicAPICache[manifestPath] = cached
+	}
+	if cached == nil {
+		return publicAPI{}, false
 	}
+	return *cached, true
 }
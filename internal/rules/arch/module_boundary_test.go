@@ -1,8 +1,108 @@
 // module_boundary_test.go — Tests for ARCH-module-boundary.
 package arch
 
-import "testing"
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestModuleBoundary(t *testing.T) {
 	assertRuleContract(t, &ModuleBoundary{})
 }
+
+func moduleBoundarySymbols(violations []model.Violation) []string {
+	symbols := make([]string, 0, len(violations))
+	for _, v := range violations {
+		symbols = append(symbols, v.Context.Metadata["symbol"].(string))
+	}
+	return symbols
+}
+
+func TestModuleBoundaryPublicManifestGo(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	writeArchTestFile(t, root, "payments/public.yml", "symbols:\n  - Charge\n  - Refund\n")
+	writeArchTestFile(t, root, "payments/store/store.go", "package store\n\nfunc Save() {}\n")
+	file := writeArchTestFile(t, root, "orders/orders.go", `package orders
+
+import (
+	"example.com/app/payments"
+	pstore "example.com/app/payments/store"
+	"example.com/app/shipping"
+)
+
+func Place() {
+	payments.Charge()
+	payments.Refund()
+	payments.ledgerWrite()
+	pstore.Save()
+	pstore.Save()
+	shipping.Send()
+}
+`)
+
+	got := (&ModuleBoundary{}).Check(file, nil, model.RuleConfig{})
+	if symbols := moduleBoundarySymbols(got); !reflect.DeepEqual(symbols, []string{"ledgerWrite", "Save"}) {
+		t.Fatalf("symbols = %v, want [ledgerWrite Save]", symbols)
+	}
+	v := got[1]
+	if v.StartLine != 13 || v.StartColumn != 9 {
+		t.Fatalf("position = %d:%d, want 13:9", v.StartLine, v.StartColumn)
+	}
+	wantModule := filepath.ToSlash(filepath.Join(root, "payments"))
+	want := "Save is not in the public API of module " + wantModule + ` (imported from "example.com/app/payments/store")`
+	if v.Message != want {
+		t.Fatalf("message = %q, want %q", v.Message, want)
+	}
+	if v.Context.Metadata["module"] != wantModule || v.Context.Metadata["manifest"] != wantModule+"/public.yml" {
+		t.Fatalf("metadata = %+v", v.Context.Metadata)
+	}
+
+	inside := writeArchTestFile(t, root, "payments/charge.go", "package payments\n\nimport \"example.com/app/payments/store\"\n\nfunc Charge() { store.Save() }\n")
+	if got := (&ModuleBoundary{}).Check(inside, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("imports within the module should pass, got %+v", got)
+	}
+}
+
+func TestModuleBoundaryPublicManifestTypeScript(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "src/payments/public.yaml", "symbols: [charge, PaymentError, default]\n")
+	file := writeArchTestFile(t, root, "src/orders/place.ts", `import { charge, type PaymentError } from '../payments';
+import {
+  ledgerWrite as write,
+} from '../payments/ledger';
+import client, { retry } from '../payments/client';
+import * as payments from '../payments';
+export { refund } from '../payments/refund';
+import { format } from '../shared/format';
+import { z } from 'zod';
+
+payments.charge(1);
+payments.audit(write(client));
+`)
+	file.Language = "typescript"
+
+	got := (&ModuleBoundary{}).Check(file, nil, model.RuleConfig{})
+	if symbols := moduleBoundarySymbols(got); !reflect.DeepEqual(symbols, []string{"ledgerWrite", "retry", "refund", "audit"}) {
+		t.Fatalf("symbols = %v, want [ledgerWrite retry refund audit]", symbols)
+	}
+	if got[0].StartLine != 4 || got[3].StartLine != 12 {
+		t.Fatalf("lines = %d, %d, want 4 and 12", got[0].StartLine, got[3].StartLine)
+	}
+	if got[1].Context.Metadata["import"] != "../payments/client" {
+		t.Fatalf("metadata = %+v", got[1].Context.Metadata)
+	}
+}
+
+func TestModuleBoundaryIgnoresInvalidManifest(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "payments/public.yml", "symbols: [unterminated\n")
+	file := writeArchTestFile(t, root, "orders/place.ts", "import { anything } from '../payments/internal';\n")
+	file.Language = "typescript"
+	if got := (&ModuleBoundary{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("an invalid manifest should be ignored, got %+v", got)
+	}
+}
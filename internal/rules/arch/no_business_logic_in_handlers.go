@@ -0,0 +1,308 @@
+// no_business_logic_in_handlers.go — ARCH-no-business-logic-in-handlers: Keep HTTP handlers thin.
+package arch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const (
+	defaultHandlerMaxLines      = 40
+	defaultHandlerMaxComplexity = 10
+)
+
+var (
+	// defaultDataLayerImports are import globs treated as persistence packages.
+	defaultDataLayerImports = []string{
+		"database/sql",
+		"github.com/jmoiron/sqlx",
+		"github.com/jackc/pgx/**",
+		"gorm.io/**",
+		"go.mongodb.org/**",
+		"github.com/redis/go-redis/**",
+		"**/repository",
+		"**/repository/**",
+		"**/db",
+		"**/db/**",
+	}
+
+	// routeRegistrationMethods are router methods whose handler argument follows the pattern
+	// (net/http ServeMux, chi, gorilla/mux, echo, gin).
+	routeRegistrationMethods = map[string]bool{
+		"Handle": true, "HandleFunc": true, "Method": true, "MethodFunc": true,
+		"Get": true, "Post": true, "Put": true, "Patch": true, "Delete": true, "Head": true, "Options": true,
+		"GET": true, "POST": true, "PUT": true, "PATCH": true, "DELETE": true,
+	}
+)
+
+// NoBusinessLogicInHandlers flags HTTP handlers that are too long, too branchy, or
+// reach into data-layer packages directly.
+type NoBusinessLogicInHandlers struct{}
+
+func (r *NoBusinessLogicInHandlers) ID() string       { return "ARCH-no-business-logic-in-handlers" }
+func (r *NoBusinessLogicInHandlers) Category() string { return "arch" }
+func (r *NoBusinessLogicInHandlers) Description() string {
+	return "Keep HTTP handlers thin: limit size and complexity, no direct data-layer access"
+}
+func (r *NoBusinessLogicInHandlers) Why() string {
+	return "Handlers that hold business rules or query storage directly cannot be reused or tested without HTTP."
+}
+func (r *NoBusinessLogicInHandlers) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "func CreateItem(db *sql.DB) http.HandlerFunc {\n\treturn func(w http.ResponseWriter, r *http.Request) {\n\t\tdb.Exec(\"INSERT INTO items ...\")\n\t}\n}",
+		Good:     "func CreateItem(svc *items.Service) http.HandlerFunc {\n\treturn func(w http.ResponseWriter, r *http.Request) {\n\t\titem, err := svc.Create(r.Context(), decode(r))\n\t\trespond(w, item, err)\n\t}\n}",
+	}}
+}
+func (r *NoBusinessLogicInHandlers) DefaultSeverity() string   { return "warn" }
+func (r *NoBusinessLogicInHandlers) NeedsProjectContext() bool { return true }
+
+func (r *NoBusinessLogicInHandlers) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !strings.EqualFold(file.Language, "go") || file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	maxLines := intOption(config.Options, "maxLines", defaultHandlerMaxLines)
+	maxComplexity := intOption(config.Options, "maxComplexity", defaultHandlerMaxComplexity)
+	dataGlobs := stringSliceOption(config.Options, "dataLayerImports")
+	if len(dataGlobs) == 0 {
+		dataGlobs = defaultDataLayerImports
+	}
+	handlerFile := false
+	for _, glob := range stringSliceOption(config.Options, "handlerGlobs") {
+		if matchPathGlob(glob, filepath.ToSlash(file.Path)) {
+			handlerFile = true
+			break
+		}
+	}
+
+	dataImports := map[string]string{}
+	for _, spec := range parsed.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || !matchesAnyImportGlob(dataGlobs, importPath) {
+			continue
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name != "_" && name != "." {
+			dataImports[name] = importPath
+		}
+	}
+	registered := registeredRouteHandlers(file, parsed, ctx)
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		name := fn.Name.Name
+		if !(isHTTPHandlerFunc(fn) || registered[name] || (handlerFile && fn.Recv == nil && ast.IsExported(name))) {
+			continue
+		}
+
+		start, end := fset.Position(fn.Pos()), fset.Position(fn.End())
+		lines := end.Line - start.Line + 1
+		complexity := cyclomaticComplexity(fn.Body)
+		used := dataLayerUses(fn, dataImports)
+
+		reasons := make([]string, 0, 3)
+		if lines > maxLines {
+			reasons = append(reasons, fmt.Sprintf("%d lines (max %d)", lines, maxLines))
+		}
+		if complexity > maxComplexity {
+			reasons = append(reasons, fmt.Sprintf("complexity %d (max %d)", complexity, maxComplexity))
+		}
+		if len(used) > 0 {
+			reasons = append(reasons, fmt.Sprintf("uses data-layer package %s", strings.Join(used, ", ")))
+		}
+		if len(reasons) == 0 {
+			continue
+		}
+
+		nameStart := fset.Position(fn.Name.Pos())
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Handler %s holds business logic: %s", name, strings.Join(reasons, "; ")),
+			FilePath:    file.Path,
+			StartLine:   nameStart.Line,
+			StartColumn: nameStart.Column,
+			EndLine:     end.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Move the logic in %s into a service and keep the handler to decoding, calling the service, and encoding the response.", name),
+				Metadata: map[string]interface{}{
+					"handler":     name,
+					"lines":       lines,
+					"complexity":  complexity,
+					"dataImports": used,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// isHTTPHandlerFunc reports whether fn takes (http.ResponseWriter, *http.Request) or
+// returns an http.Handler/http.HandlerFunc.
+func isHTTPHandlerFunc(fn *ast.FuncDecl) bool {
+	hasWriter, hasRequest := false, false
+	for _, field := range fn.Type.Params.List {
+		switch typeString(field.Type) {
+		case "http.ResponseWriter":
+			hasWriter = true
+		case "*http.Request":
+			hasRequest = true
+		}
+	}
+	if hasWriter && hasRequest {
+		return true
+	}
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			switch typeString(field.Type) {
+			case "http.HandlerFunc", "http.Handler":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	}
+	return ""
+}
+
+// registeredRouteHandlers returns function names passed to route registration calls
+// such as mux.HandleFunc("/x", H) or r.Get("/x", H(store)) in this file or any other
+// Go file of the same package directory.
+func registeredRouteHandlers(file *model.UnifiedFileModel, parsed *ast.File, ctx *model.ProjectContext) map[string]bool {
+	registered := map[string]bool{}
+	collect := func(f *ast.File) {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || !routeRegistrationMethods[sel.Sel.Name] {
+				return true
+			}
+			if lit, ok := call.Args[0].(*ast.BasicLit); !ok || lit.Kind != token.STRING {
+				return true
+			}
+			handler := call.Args[len(call.Args)-1]
+			if inner, ok := handler.(*ast.CallExpr); ok {
+				handler = inner.Fun
+			}
+			if ident, ok := handler.(*ast.Ident); ok {
+				registered[ident.Name] = true
+			}
+			return true
+		})
+	}
+	collect(parsed)
+	if ctx == nil {
+		return registered
+	}
+	dir := filepath.Dir(file.Path)
+	paths := make([]string, 0)
+	for p, other := range ctx.Files {
+		if other != nil && p != file.Path && filepath.Dir(p) == dir && strings.EqualFold(other.Language, "go") && !other.IsTestFile {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		if other, err := parser.ParseFile(token.NewFileSet(), p, ctx.Files[p].Source, parser.SkipObjectResolution); err == nil {
+			collect(other)
+		}
+	}
+	return registered
+}
+
+// cyclomaticComplexity counts decision points: branches, loops, non-default cases,
+// and short-circuit operators, plus one for the function itself.
+func cyclomaticComplexity(body ast.Node) int {
+	complexity := 1
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if x.List != nil {
+				complexity++
+			}
+		case *ast.CommClause:
+			if x.Comm != nil {
+				complexity++
+			}
+		case *ast.BinaryExpr:
+			if x.Op == token.LAND || x.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
+// dataLayerUses returns the sorted import paths of data-layer packages referenced in
+// node, including parameter types such as *sql.DB.
+func dataLayerUses(body ast.Node, dataImports map[string]string) []string {
+	seen := map[string]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if importPath, ok := dataImports[ident.Name]; ok {
+					seen[importPath] = true
+				}
+			}
+		}
+		return true
+	})
+	used := make([]string, 0, len(seen))
+	for importPath := range seen {
+		used = append(used, importPath)
+	}
+	sort.Strings(used)
+	return used
+}
+
+func matchesAnyImportGlob(globs []string, importPath string) bool {
+	for _, glob := range globs {
+		if matchPathGlob(glob, importPath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+// no_business_logic_in_handlers_test.go — Tests for ARCH-no-business-logic-in-handlers.
+package arch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const thinHandlersSource = `package api
+
+import (
+	"database/sql"
+	"net/http"
+)
+
+func GetItems(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		respondJSON(w, store.List())
+	}
+}
+
+func Health(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func CreateItem(db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = db.Exec("INSERT INTO items DEFAULT VALUES")
+	}
+}
+
+func Archive(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Query().Get("mode") {
+	case "soft":
+	case "hard":
+	case "purge":
+	}
+}
+
+func respondJSON(w http.ResponseWriter, v interface{}) {
+	_ = sql.ErrNoRows
+}
+`
+
+func TestNoBusinessLogicInHandlersMetadata(t *testing.T) {
+	rule := &NoBusinessLogicInHandlers{}
+	if rule.ID() != "ARCH-no-business-logic-in-handlers" || rule.Category() != "arch" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestNoBusinessLogicInHandlers(t *testing.T) {
+	rule := &NoBusinessLogicInHandlers{}
+	file := &model.UnifiedFileModel{Path: "api/handlers.go", Language: "go", Source: []byte(thinHandlersSource)}
+
+	violations := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"maxComplexity": 3}})
+	got := map[string]string{}
+	for _, v := range violations {
+		got[v.Context.Metadata["handler"].(string)] = v.Message
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected CreateItem and Archive to be flagged, got %+v", got)
+	}
+	if !strings.Contains(got["CreateItem"], "uses data-layer package database/sql") {
+		t.Fatalf("unexpected CreateItem message: %q", got["CreateItem"])
+	}
+	if !strings.Contains(got["Archive"], "complexity 4 (max 3)") {
+		t.Fatalf("unexpected Archive message: %q", got["Archive"])
+	}
+	if _, ok := got["respondJSON"]; ok {
+		t.Fatalf("helpers that are not handlers must not be flagged")
+	}
+}
+
+func TestNoBusinessLogicInHandlersLineLimit(t *testing.T) {
+	rule := &NoBusinessLogicInHandlers{}
+	file := &model.UnifiedFileModel{Path: "api/handlers.go", Language: "go", Source: []byte(thinHandlersSource)}
+
+	violations := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"maxLines": 4, "maxComplexity": 50, "dataLayerImports": []interface{}{"example.com/none"}}})
+	names := make([]string, 0, len(violations))
+	for _, v := range violations {
+		names = append(names, v.Context.Metadata["handler"].(string))
+	}
+	if strings.Join(names, ",") != "GetItems,Health,CreateItem,Archive" {
+		t.Fatalf("flagged handlers = %v", names)
+	}
+	if violations[0].StartLine != 8 || violations[0].EndLine != 12 {
+		t.Fatalf("GetItems span = %d-%d, want 8-12", violations[0].StartLine, violations[0].EndLine)
+	}
+}
+
+func TestNoBusinessLogicInHandlersRouteRegistration(t *testing.T) {
+	rule := &NoBusinessLogicInHandlers{}
+	handlers := &model.UnifiedFileModel{Path: "app/items.go", Language: "go", Source: []byte(`package main
+
+func ListItems(store *Store) func() {
+	for _, item := range store.items {
+		if item.Hidden && item.Stale {
+			continue
+		}
+	}
+	return nil
+}
+`)}
+	router := &model.UnifiedFileModel{Path: "app/main.go", Language: "go", Source: []byte(`package main
+
+func main() {
+	r := chi.NewRouter()
+	r.Get("/items", ListItems(store))
+}
+`)}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{handlers.Path: handlers, router.Path: router}}
+
+	if got := rule.Check(handlers, ctx, model.RuleConfig{Options: map[string]interface{}{"maxComplexity": 2}}); len(got) != 1 {
+		t.Fatalf("registered handler should be checked, got %+v", got)
+	}
+	if got := rule.Check(handlers, nil, model.RuleConfig{Options: map[string]interface{}{"maxComplexity": 2}}); len(got) != 0 {
+		t.Fatalf("unregistered non-handler function should be ignored, got %+v", got)
+	}
+	globbed := rule.Check(handlers, nil, model.RuleConfig{Options: map[string]interface{}{"maxComplexity": 2, "handlerGlobs": []interface{}{"app/items.go"}}})
+	if len(globbed) != 1 {
+		t.Fatalf("exported functions in handler globs should be checked, got %+v", globbed)
+	}
+}
@@ -16,6 +16,13 @@ func (r *NoCircularDeps) Description() string { return "Disallow circular depend
 func (r *NoCircularDeps) Why() string {
 	return "Dependency cycles make builds brittle and block independent evolution of modules."
 }
+func (r *NoCircularDeps) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "A imports B, B imports C, C imports A",
+		Good:     "A imports B, B imports C, C imports shared-types",
+	}}
+}
 func (r *NoCircularDeps) DefaultSeverity() string   { return "error" }
 func (r *NoCircularDeps) NeedsProjectContext() bool { return false }
 
@@ -0,0 +1,198 @@
+// no_cross_module_internal_import.go — ARCH-no-cross-module-internal-import: Keep Go internal packages inside their module.
+package arch
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// NoCrossModuleInternalImport flags Go imports of another module's internal/ packages.
+type NoCrossModuleInternalImport struct{}
+
+func (r *NoCrossModuleInternalImport) ID() string       { return "ARCH-no-cross-module-internal-import" }
+func (r *NoCrossModuleInternalImport) Category() string { return "arch" }
+func (r *NoCrossModuleInternalImport) Description() string {
+	return "Disallow importing another module's internal packages"
+}
+func (r *NoCrossModuleInternalImport) Why() string {
+	return "internal/ packages are private to their module; importing them across modules couples releases and breaks Go's visibility contract."
+}
+func (r *NoCrossModuleInternalImport) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// module example.com/other\nimport \"example.com/app/internal/store\"",
+		Good:     "// module example.com/other\nimport \"example.com/app/store\"",
+	}}
+}
+func (r *NoCrossModuleInternalImport) DefaultSeverity() string   { return "error" }
+func (r *NoCrossModuleInternalImport) NeedsProjectContext() bool { return true }
+
+func (r *NoCrossModuleInternalImport) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+	importer, ok := findGoModule(filepath.Dir(file.Path))
+	if !ok {
+		return nil
+	}
+	rel, err := filepath.Rel(importer.Root, filepath.Dir(file.Path))
+	if err != nil {
+		return nil
+	}
+	importerPkg := path.Join(importer.Path, filepath.ToSlash(rel))
+	modules := projectGoModules(file, ctx)
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		parent, ok := internalParent(ref.Path)
+		if !ok {
+			continue
+		}
+		owner := owningModule(modules, ref.Path)
+
+		var message string
+		switch {
+		case owner != "" && owner != importer.Path:
+			message = fmt.Sprintf("Import %q reaches into internal package of module %s from module %s", ref.Path, owner, importer.Path)
+		case importerPkg != parent && !strings.HasPrefix(importerPkg, parent+"/"):
+			message = fmt.Sprintf("Import %q is internal to %s and cannot be imported from %s", ref.Path, parent, importerPkg)
+		default:
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     message,
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Depend on an exported package of %s instead, or move the shared code out of internal/.", parent),
+				Metadata: map[string]interface{}{
+					"import":      ref.Path,
+					"ownerModule": owner,
+					"module":      importer.Path,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// internalParent returns the import path that roots an internal/ element, e.g.
+// "example.com/app" for "example.com/app/internal/store". Standard-library
+// internal packages (no parent) are ignored.
+func internalParent(importPath string) (string, bool) {
+	idx := strings.LastIndex("/"+importPath+"/", "/internal/")
+	if idx <= 0 {
+		return "", false
+	}
+	return importPath[:idx-1], true
+}
+
+// owningModule returns the longest known module path that prefixes importPath.
+func owningModule(modules []string, importPath string) string {
+	for _, module := range modules {
+		if importPath == module || strings.HasPrefix(importPath, module+"/") {
+			return module
+		}
+	}
+	return ""
+}
+
+// projectGoModules lists the module paths of every Go file in the run, longest first.
+func projectGoModules(file *model.UnifiedFileModel, ctx *model.ProjectContext) []string {
+	dirs := map[string]bool{filepath.Dir(file.Path): true}
+	if ctx != nil {
+		for p, f := range ctx.Files {
+			if f != nil && strings.EqualFold(f.Language, "go") {
+				dirs[filepath.Dir(p)] = true
+			}
+		}
+	}
+	seen := map[string]bool{}
+	modules := make([]string, 0)
+	for dir := range dirs {
+		if m, ok := findGoModule(dir); ok && !seen[m.Path] {
+			seen[m.Path] = true
+			modules = append(modules, m.Path)
+		}
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		if len(modules[i]) != len(modules[j]) {
+			return len(modules[i]) > len(modules[j])
+		}
+		return modules[i] < modules[j]
+	})
+	return modules
+}
+
+type goModule struct {
+	Root string
+	Path string
+}
+
+var (
+	goModuleCacheMu sync.Mutex
+	goModuleCache   = map[string]*goModule{}
+)
+
+// findGoModule walks up from dir to the nearest go.mod and returns its module path.
+// Lookups are cached per directory.
+func findGoModule(dir string) (goModule, bool) {
+	goModuleCacheMu.Lock()
+	defer goModuleCacheMu.Unlock()
+	return findGoModuleLocked(filepath.Clean(dir))
+}
+
+func findGoModuleLocked(dir string) (goModule, bool) {
+	if cached, ok := goModuleCache[dir]; ok {
+		if cached == nil {
+			return goModule{}, false
+		}
+		return *cached, true
+	}
+	var found *goModule
+	if data, err := os.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+		if modulePath := goModulePath(data); modulePath != "" {
+			found = &goModule{Root: dir, Path: modulePath}
+		}
+	} else if parent := filepath.Dir(dir); parent != dir {
+		if m, ok := findGoModuleLocked(parent); ok {
+			found = &m
+		}
+	}
+	goModuleCache[dir] = found
+	if found == nil {
+		return goModule{}, false
+	}
+	return *found, true
+}
+
+func goModulePath(data []byte) string {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		if rest, ok := strings.CutPrefix(line, "module"); ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t') {
+			return strings.Trim(strings.TrimSpace(rest), `"`)
+		}
+	}
+	return ""
+}
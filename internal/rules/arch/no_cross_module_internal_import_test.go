@@ -0,0 +1,108 @@
+// no_cross_module_internal_import_test.go — Tests for ARCH-no-cross-module-internal-import.
+package arch
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func writeArchTestFile(t *testing.T, root string, name string, body string) *model.UnifiedFileModel {
+	t.Helper()
+	target := filepath.Join(root, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(target, []byte(body), 0o644); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return &model.UnifiedFileModel{Path: target, Language: "go", Source: []byte(body)}
+}
+
+func TestNoCrossModuleInternalImportMetadata(t *testing.T) {
+	rule := &NoCrossModuleInternalImport{}
+	if rule.ID() != "ARCH-no-cross-module-internal-import" || rule.Category() != "arch" || rule.DefaultSeverity() != "error" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+	if !rule.NeedsProjectContext() {
+		t.Fatalf("rule should need project context to discover modules")
+	}
+}
+
+func TestNoCrossModuleInternalImport(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	writeArchTestFile(t, root, "tools/go.mod", "// Tooling module.\nmodule example.com/app/tools\n")
+	writeArchTestFile(t, root, "other/go.mod", "module \"example.com/other\"\n")
+
+	store := writeArchTestFile(t, root, "internal/store/store.go", "package store\n")
+	sameModule := writeArchTestFile(t, root, "cmd/app/main.go", "package main\n\nimport (\n\t\"internal/cpu\"\n\t\"example.com/app/internal/store\"\n)\n")
+	nestedInternal := writeArchTestFile(t, root, "pkg/api/api.go", "package api\n\nimport \"example.com/app/internal/store/internal/cache\"\n")
+	subModule := writeArchTestFile(t, root, "tools/gen/gen.go", "package gen\n\nimport \"example.com/app/internal/store\"\n")
+	otherModule := writeArchTestFile(t, root, "other/client/client.go", "package client\n\nimport (\n\t\"fmt\"\n\t\"example.com/app/internal/store\"\n)\n")
+
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, f := range []*model.UnifiedFileModel{store, sameModule, nestedInternal, subModule, otherModule} {
+		ctx.Files[f.Path] = f
+	}
+
+	tests := []struct {
+		name string
+		file *model.UnifiedFileModel
+		want string
+	}{
+		{name: "same module and stdlib internal allowed", file: sameModule},
+		{name: "outside internal parent tree", file: nestedInternal, want: "is internal to example.com/app/internal/store and cannot be imported from example.com/app/pkg/api"},
+		{name: "nested module crosses boundary", file: subModule, want: "internal package of module example.com/app from module example.com/app/tools"},
+		{name: "separate module crosses boundary", file: otherModule, want: "internal package of module example.com/app from module example.com/other"},
+	}
+	rule := &NoCrossModuleInternalImport{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := rule.Check(tc.file, ctx, model.RuleConfig{})
+			if tc.want == "" {
+				if len(violations) != 0 {
+					t.Fatalf("expected no violations, got %+v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 || !strings.Contains(violations[0].Message, tc.want) {
+				t.Fatalf("expected one violation containing %q, got %+v", tc.want, violations)
+			}
+		})
+	}
+
+	violations := rule.Check(otherModule, ctx, model.RuleConfig{})
+	if violations[0].StartLine != 5 || violations[0].StartColumn != 2 {
+		t.Fatalf("unexpected position %d:%d", violations[0].StartLine, violations[0].StartColumn)
+	}
+}
+
+func TestNoCrossModuleInternalImportWithoutModule(t *testing.T) {
+	root := t.TempDir()
+	file := writeArchTestFile(t, root, "loose/main.go", "package main\n\nimport \"example.com/app/internal/store\"\n")
+	if got := (&NoCrossModuleInternalImport{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("files outside any module should be skipped, got %+v", got)
+	}
+}
+
+func TestInternalParent(t *testing.T) {
+	cases := map[string]string{
+		"example.com/app/internal/store":      "example.com/app",
+		"example.com/app/internal":            "example.com/app",
+		"example.com/a/internal/b/internal/c": "example.com/a/internal/b",
+	}
+	for importPath, want := range cases {
+		if got, ok := internalParent(importPath); !ok || got != want {
+			t.Fatalf("internalParent(%q) = %q, %v; want %q", importPath, got, ok, want)
+		}
+	}
+	for _, importPath := range []string{"internal/cpu", "example.com/internals/x", "fmt"} {
+		if _, ok := internalParent(importPath); ok {
+			t.Fatalf("internalParent(%q) should not match", importPath)
+		}
+	}
+}
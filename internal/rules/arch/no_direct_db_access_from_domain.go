@@ -0,0 +1,118 @@
+// no_direct_db_access_from_domain.go — ARCH-no-direct-db-access-from-domain: Keep domain code persistence-ignorant.
+package arch
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultDatabaseImports are import globs for database drivers and ORMs across the
+// supported languages.
+var defaultDatabaseImports = []string{
+	"database/sql",
+	"database/sql/**",
+	"github.com/jmoiron/sqlx",
+	"github.com/jackc/pgx/**",
+	"github.com/lib/pq",
+	"gorm.io/**",
+	"github.com/jinzhu/gorm",
+	"go.mongodb.org/**",
+	"github.com/redis/go-redis/**",
+	"pg",
+	"mysql2",
+	"mongoose",
+	"typeorm",
+	"sequelize",
+	"knex",
+	"@prisma/client",
+	"drizzle-orm",
+	"drizzle-orm/**",
+	"sqlalchemy",
+	"sqlalchemy.*",
+	"psycopg2",
+	"psycopg2.*",
+	"pymongo",
+	"django.db",
+	"django.db.*",
+}
+
+// NoDirectDBAccessFromDomain flags database and ORM imports in files matching the
+// `domain` globs. Domain entities leave persistence to repositories outside the domain.
+type NoDirectDBAccessFromDomain struct{}
+
+func (r *NoDirectDBAccessFromDomain) ID() string       { return "ARCH-no-direct-db-access-from-domain" }
+func (r *NoDirectDBAccessFromDomain) Category() string { return "arch" }
+func (r *NoDirectDBAccessFromDomain) Description() string {
+	return "Disallow database and ORM imports in domain code"
+}
+func (r *NoDirectDBAccessFromDomain) Why() string {
+	return "Domain entities that query storage cannot be tested or reused without a database, and tie business rules to one persistence technology."
+}
+func (r *NoDirectDBAccessFromDomain) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// internal/domain/user.go\nimport \"database/sql\"\n\nfunc (u *User) Save(db *sql.DB) error",
+		Good:     "// internal/domain/user.go\ntype UserRepository interface {\n\tSave(ctx context.Context, u *User) error\n}",
+	}}
+}
+func (r *NoDirectDBAccessFromDomain) DefaultSeverity() string   { return "error" }
+func (r *NoDirectDBAccessFromDomain) NeedsProjectContext() bool { return true }
+
+func (r *NoDirectDBAccessFromDomain) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Domain file imports database package \"database/sql\"",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Declare a repository interface in the domain and implement it with database/sql outside the domain.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile {
+		return nil
+	}
+	domain := stringSliceOption(config.Options, "domain")
+	if len(domain) == 0 || !matchesAnyImportGlob(domain, filepath.ToSlash(file.Path)) {
+		return nil
+	}
+	patterns := stringSliceOption(config.Options, "databaseImports")
+	if len(patterns) == 0 {
+		patterns = defaultDatabaseImports
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		if !matchesAnyImportGlob(patterns, ref.Path) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Domain file imports database package %q", ref.Path),
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Declare a repository interface in the domain and implement it with %s outside the domain.", ref.Path),
+				Metadata: map[string]interface{}{
+					"import": ref.Path,
+				},
+			},
+		})
+	}
+	return violations
+}
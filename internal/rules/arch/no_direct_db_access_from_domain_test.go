@@ -0,0 +1,60 @@
+// no_direct_db_access_from_domain_test.go — Tests for ARCH-no-direct-db-access-from-domain.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoDirectDBAccessFromDomain(t *testing.T) {
+	assertRuleContract(t, &NoDirectDBAccessFromDomain{})
+}
+
+func TestNoDirectDBAccessFromDomainFlagsDatabaseImports(t *testing.T) {
+	source := []byte("package domain\n\nimport (\n\t\"context\"\n\t\"database/sql\"\n\t\"gorm.io/gorm\"\n)\n")
+	config := model.RuleConfig{Options: map[string]interface{}{"domain": []interface{}{"internal/domain/**"}}}
+	rule := &NoDirectDBAccessFromDomain{}
+
+	got := rule.Check(&model.UnifiedFileModel{Path: "internal/domain/user.go", Language: "go", Source: source}, nil, config)
+	if len(got) != 2 {
+		t.Fatalf("violations = %d, want 2: %+v", len(got), got)
+	}
+	if got[0].StartLine != 5 || got[0].Message != `Domain file imports database package "database/sql"` {
+		t.Fatalf("first violation = line %d %q", got[0].StartLine, got[0].Message)
+	}
+	if got[1].StartLine != 6 || got[1].Context.Metadata["import"] != "gorm.io/gorm" {
+		t.Fatalf("second violation = line %d %+v", got[1].StartLine, got[1].Context.Metadata)
+	}
+
+	outside := &model.UnifiedFileModel{Path: "internal/store/user.go", Language: "go", Source: source}
+	if got := rule.Check(outside, nil, config); len(got) != 0 {
+		t.Fatalf("files outside domain should pass, got %+v", got)
+	}
+	if got := rule.Check(&model.UnifiedFileModel{Path: "internal/domain/user.go", Language: "go", Source: source}, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("no domain globs should mean no violations, got %+v", got)
+	}
+}
+
+func TestNoDirectDBAccessFromDomainCustomPatterns(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "src/domain/order.ts",
+		Language: "typescript",
+		Source:   []byte("import { PrismaClient } from '@prisma/client';\nimport { db } from '../infra/db';\n"),
+	}
+	rule := &NoDirectDBAccessFromDomain{}
+
+	got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"domain": "src/domain/**"}})
+	if len(got) != 1 || got[0].StartLine != 1 {
+		t.Fatalf("default patterns should flag @prisma/client only, got %+v", got)
+	}
+
+	custom := model.RuleConfig{Options: map[string]interface{}{
+		"domain":          "src/domain/**",
+		"databaseImports": []interface{}{"**/infra/db"},
+	}}
+	got = rule.Check(file, nil, custom)
+	if len(got) != 1 || got[0].StartLine != 2 {
+		t.Fatalf("databaseImports should replace the defaults, got %+v", got)
+	}
+}
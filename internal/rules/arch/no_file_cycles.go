@@ -0,0 +1,241 @@
+// no_file_cycles.go — ARCH-no-file-cycles: Disallow import cycles between TypeScript/JavaScript files.
+package arch
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// jsTypeOnlyImportPattern matches `import type` and `export type` statements, which the
+// TypeScript compiler erases and so cannot close a runtime cycle.
+var jsTypeOnlyImportPattern = regexp.MustCompile(`(?m)^\s*(?:import|export)\s+type\b[^'"]*?\bfrom\s*['"]([^'"]+)['"]`)
+
+// NoFileCycles implements the ARCH-no-file-cycles rule. ARCH-no-circular-deps works on
+// packages; this rule builds the import graph between the project's non-test
+// TypeScript and JavaScript files, where a cycle between two files of one directory
+// (often through an index.ts barrel) leaves a module half-initialised at runtime.
+//
+// Imports are edges when they are relative or name a project file; an import of a
+// directory resolves to its index file. Type-only imports are skipped unless
+// `includeTypeImports` is set, and files matching an `ignore` glob are left out of the
+// graph. Each group of files importing each other is reported once, on its first file
+// by path, with the shortest chain of imports from that file back to itself.
+type NoFileCycles struct{}
+
+func (r *NoFileCycles) ID() string          { return "ARCH-no-file-cycles" }
+func (r *NoFileCycles) Category() string    { return "arch" }
+func (r *NoFileCycles) Description() string { return "Disallow import cycles between files" }
+func (r *NoFileCycles) Why() string {
+	return "A file cycle, often closed by importing a barrel from inside the directory it re-exports, makes one module run before the other has finished initialising and its imports are undefined."
+}
+func (r *NoFileCycles) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// src/users/service.ts\nimport { UserRepo } from '.'\n// src/users/index.ts\nexport * from './service'\nexport * from './repo'",
+		Good:     "// src/users/service.ts\nimport { UserRepo } from './repo'\n// src/users/index.ts\nexport * from './service'\nexport * from './repo'",
+	}}
+}
+func (r *NoFileCycles) DefaultSeverity() string   { return "error" }
+func (r *NoFileCycles) NeedsProjectContext() bool { return true }
+
+func (r *NoFileCycles) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Import cycle between files: src/users/service.ts -> src/users/index.ts -> src/users/service.ts",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Import from the defining file instead of the barrel, or move what the files share into a file neither imports back.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile || ctx == nil {
+		return nil
+	}
+	lang := strings.ToLower(file.Language)
+	if lang != "typescript" && lang != "javascript" {
+		return nil
+	}
+	ignore := stringSliceOption(config.Options, "ignore")
+	includeTypes, _ := config.Options["includeTypeImports"].(bool)
+	from := filepath.ToSlash(file.Path)
+
+	violations := make([]model.Violation, 0)
+	for _, cycle := range fileCyclesFor(ctx, ignore, includeTypes) {
+		if cycle.chain[0] != from {
+			continue
+		}
+		message := "Import cycle between files: " + strings.Join(cycle.chain, " -> ")
+		if extra := len(cycle.files) - (len(cycle.chain) - 1); extra > 0 {
+			message += fmt.Sprintf(" (%d more file(s) import each other with these)", extra)
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     message,
+			FilePath:    file.Path,
+			StartLine:   cycle.ref.Line,
+			StartColumn: cycle.ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Have %s import %s's dependencies from the files that define them instead of through the cycle, or move what the files share into a file neither imports back.", cycle.chain[0], cycle.chain[1]),
+				Metadata: map[string]interface{}{
+					"import": cycle.ref.Path,
+					"cycle":  cycle.chain,
+					"files":  cycle.files,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// fileCycle is one group of files importing each other: the files, sorted, and the
+// shortest import chain from the first back to itself, starting with the import ref.
+type fileCycle struct {
+	files []string
+	chain []string
+	ref   importRef
+}
+
+var (
+	fileCyclesMu  sync.Mutex
+	fileCyclesCtx *model.ProjectContext
+	fileCyclesKey string
+	fileCyclesVal []fileCycle
+)
+
+// fileCyclesFor builds the file graph once per project context and options; every
+// file of a run shares it.
+func fileCyclesFor(ctx *model.ProjectContext, ignore []string, includeTypes bool) []fileCycle {
+	key := fmt.Sprintf("%v|%s", includeTypes, strings.Join(ignore, "\x00"))
+	fileCyclesMu.Lock()
+	defer fileCyclesMu.Unlock()
+	if ctx == fileCyclesCtx && key == fileCyclesKey {
+		return fileCyclesVal
+	}
+
+	// Stems map an extensionless path to its file, so `./repo` finds `./repo.ts`.
+	stems := map[string]string{}
+	sources := map[string]*model.UnifiedFileModel{}
+	for p, f := range ctx.Files {
+		if f == nil || f.IsTestFile {
+			continue
+		}
+		lang := strings.ToLower(f.Language)
+		slashed := filepath.ToSlash(p)
+		if (lang != "typescript" && lang != "javascript") || entrypointGlob(ignore, []string{slashed}) != "" {
+			continue
+		}
+		sources[slashed] = f
+		stem := strings.TrimSuffix(slashed, path.Ext(slashed))
+		if existing, ok := stems[stem]; !ok || slashed < existing {
+			stems[stem] = slashed
+		}
+	}
+	resolve := func(target string) string {
+		for _, candidate := range []string{target, strings.TrimSuffix(target, path.Ext(target))} {
+			if found, ok := stems[candidate]; ok {
+				return found
+			}
+			if found, ok := stems[candidate+"/index"]; ok {
+				return found
+			}
+		}
+		return ""
+	}
+
+	g := ImportGraph{Nodes: make([]string, 0, len(sources)), Edges: map[string][]string{}}
+	refs := map[[2]string]importRef{}
+	for from, f := range sources {
+		g.Nodes = append(g.Nodes, from)
+		typeOnly := map[[2]int]bool{}
+		if !includeTypes {
+			for _, m := range jsTypeOnlyImportPattern.FindAllSubmatchIndex(f.Source, -1) {
+				line, column := sourcePosition(f.Source, m[2])
+				typeOnly[[2]int{line, column}] = true
+			}
+		}
+		for _, ref := range extractImports(f) {
+			if typeOnly[[2]int{ref.Line, ref.Column}] {
+				continue
+			}
+			candidates := importCandidatePaths(from, ref.Path, f.Language)
+			if len(candidates) == 0 {
+				continue
+			}
+			to := resolve(candidates[0])
+			if to == "" || to == from {
+				continue
+			}
+			edge := [2]string{from, to}
+			if _, seen := refs[edge]; !seen {
+				refs[edge] = ref
+				g.Edges[from] = append(g.Edges[from], to)
+			}
+		}
+	}
+	sort.Strings(g.Nodes)
+	for from := range g.Edges {
+		sort.Strings(g.Edges[from])
+	}
+
+	cycles := make([]fileCycle, 0)
+	for _, files := range g.Cycles() {
+		chain := shortestCycle(g, files)
+		cycles = append(cycles, fileCycle{files: files, chain: chain, ref: refs[[2]string{chain[0], chain[1]}]})
+	}
+	fileCyclesCtx, fileCyclesKey, fileCyclesVal = ctx, key, cycles
+	return cycles
+}
+
+// shortestCycle finds, by breadth-first search within files, the shortest import chain
+// from files[0] back to itself.
+func shortestCycle(g ImportGraph, files []string) []string {
+	inGroup := map[string]bool{}
+	for _, f := range files {
+		inGroup[f] = true
+	}
+	start := files[0]
+	parent := map[string]string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, to := range g.Edges[node] {
+			if to == start {
+				chain := []string{start}
+				for n := node; n != start; n = parent[n] {
+					chain = append(chain, n)
+				}
+				chain = append(chain, start)
+				for i, j := 1, len(chain)-2; i < j; i, j = i+1, j-1 {
+					chain[i], chain[j] = chain[j], chain[i]
+				}
+				return chain
+			}
+			if _, seen := parent[to]; seen || !inGroup[to] {
+				continue
+			}
+			parent[to] = node
+			queue = append(queue, to)
+		}
+	}
+	return []string{start, start}
+}
@@ -0,0 +1,91 @@
+// no_file_cycles_test.go — Tests for ARCH-no-file-cycles.
+package arch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoFileCyclesContract(t *testing.T) {
+	assertRuleContract(t, &NoFileCycles{})
+}
+
+func fileCyclesContext(files ...*model.UnifiedFileModel) *model.ProjectContext {
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, f := range files {
+		ctx.Files[f.Path] = f
+	}
+	return ctx
+}
+
+func TestNoFileCyclesBarrel(t *testing.T) {
+	service := &model.UnifiedFileModel{Path: "src/users/service.ts", Language: "typescript", Source: []byte("import { z } from 'zod';\nimport { UserRepo } from '.';\n")}
+	index := &model.UnifiedFileModel{Path: "src/users/index.ts", Language: "typescript", Source: []byte("export * from './service';\nexport * from './repo';\n")}
+	repo := &model.UnifiedFileModel{Path: "src/users/repo.ts", Language: "typescript", Source: []byte("export class UserRepo {}\n")}
+	test := &model.UnifiedFileModel{Path: "src/users/service.test.ts", Language: "typescript", IsTestFile: true, Source: []byte("import { svc } from '.';\n")}
+	ctx := fileCyclesContext(service, index, repo, test)
+	rule := &NoFileCycles{}
+
+	got := rule.Check(index, ctx, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	v := got[0]
+	if v.Message != "Import cycle between files: src/users/index.ts -> src/users/service.ts -> src/users/index.ts" {
+		t.Fatalf("message = %q", v.Message)
+	}
+	if v.StartLine != 1 || v.StartColumn != 16 || v.Context.Metadata["import"] != "./service" {
+		t.Fatalf("position = %d:%d import %v", v.StartLine, v.StartColumn, v.Context.Metadata["import"])
+	}
+	for _, other := range []*model.UnifiedFileModel{service, repo, test} {
+		if got := rule.Check(other, ctx, model.RuleConfig{}); len(got) != 0 {
+			t.Fatalf("%s: the cycle should be reported once, got %+v", other.Path, got)
+		}
+	}
+}
+
+func TestNoFileCyclesChainAndGroup(t *testing.T) {
+	a := &model.UnifiedFileModel{Path: "lib/a.js", Language: "javascript", Source: []byte("const b = require('./b');\n")}
+	b := &model.UnifiedFileModel{Path: "lib/b.js", Language: "javascript", Source: []byte("import { c } from './c.js';\nimport { d } from './d';\n")}
+	c := &model.UnifiedFileModel{Path: "lib/c.js", Language: "javascript", Source: []byte("import { a } from './a';\n")}
+	d := &model.UnifiedFileModel{Path: "lib/d.js", Language: "javascript", Source: []byte("import { b } from './b';\n")}
+	ctx := fileCyclesContext(a, b, c, d)
+
+	got := (&NoFileCycles{}).Check(a, ctx, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	if want := []string{"lib/a.js", "lib/b.js", "lib/c.js", "lib/a.js"}; !reflect.DeepEqual(got[0].Context.Metadata["cycle"], want) {
+		t.Fatalf("cycle = %v, want %v", got[0].Context.Metadata["cycle"], want)
+	}
+	if want := []string{"lib/a.js", "lib/b.js", "lib/c.js", "lib/d.js"}; !reflect.DeepEqual(got[0].Context.Metadata["files"], want) {
+		t.Fatalf("files = %v, want %v", got[0].Context.Metadata["files"], want)
+	}
+	if got[0].Message != "Import cycle between files: lib/a.js -> lib/b.js -> lib/c.js -> lib/a.js (1 more file(s) import each other with these)" {
+		t.Fatalf("message = %q", got[0].Message)
+	}
+}
+
+func TestNoFileCyclesOptions(t *testing.T) {
+	order := &model.UnifiedFileModel{Path: "src/order.ts", Language: "typescript", Source: []byte("import type { Customer } from './customer';\n")}
+	customer := &model.UnifiedFileModel{Path: "src/customer.ts", Language: "typescript", Source: []byte("import { Order } from './order';\n")}
+	ctx := fileCyclesContext(order, customer)
+	rule := &NoFileCycles{}
+
+	if got := rule.Check(customer, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("type-only imports should not close a cycle, got %+v", got)
+	}
+	withTypes := model.RuleConfig{Options: map[string]interface{}{"includeTypeImports": true}}
+	if got := rule.Check(customer, ctx, withTypes); len(got) != 1 {
+		t.Fatalf("includeTypeImports: violations = %d, want 1", len(got))
+	}
+	ignored := model.RuleConfig{Options: map[string]interface{}{"includeTypeImports": true, "ignore": []interface{}{"src/order.ts"}}}
+	if got := rule.Check(customer, ctx, ignored); len(got) != 0 {
+		t.Fatalf("ignored files should leave the graph, got %+v", got)
+	}
+	if got := rule.Check(customer, nil, withTypes); len(got) != 0 {
+		t.Fatalf("no project context should report nothing, got %+v", got)
+	}
+}
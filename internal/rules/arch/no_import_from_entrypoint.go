@@ -0,0 +1,254 @@
+// no_import_from_entrypoint.go — ARCH-no-import-from-main: Keep entrypoints out of the import graph.
+package arch
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultEntrypointGlobs are the paths treated as entrypoints when `entrypoints` is unset.
+var defaultEntrypointGlobs = []string{
+	"cmd/**",
+	"**/main.ts",
+	"**/main.js",
+	"**/__main__.py",
+}
+
+// entrypointMarkers recognise files that start a process: they serve HTTP or read the
+// command line, so importing them drags that wiring into the importer.
+var entrypointMarkers = map[string]*regexp.Regexp{
+	"go":         regexp.MustCompile(`\bListenAndServe(?:TLS)?\(`),
+	"typescript": regexp.MustCompile(`\bprocess\.argv\b`),
+	"javascript": regexp.MustCompile(`\bprocess\.argv\b`),
+	"python":     regexp.MustCompile(`if\s+__name__\s*==\s*["']__main__["']`),
+}
+
+// NoImportFromEntrypoint flags imports that resolve to an entrypoint: a path matching
+// the `entrypoints` globs or, with project context, a file that calls ListenAndServe,
+// reads process.argv, or guards on `__name__ == "__main__"`.
+//
+// Go imports are only considered within the importing file's module; TypeScript,
+// JavaScript, and Python imports when they are relative or name a project file.
+// Entrypoints may import each other, and tests may import entrypoints.
+type NoImportFromEntrypoint struct{}
+
+func (r *NoImportFromEntrypoint) ID() string       { return "ARCH-no-import-from-main" }
+func (r *NoImportFromEntrypoint) Category() string { return "arch" }
+func (r *NoImportFromEntrypoint) Description() string {
+	return "Disallow importing entrypoint and CLI modules"
+}
+func (r *NoImportFromEntrypoint) Why() string {
+	return "Entrypoints wire configuration, flags, and servers together; importing one as a library runs that wiring in the importer and makes the application impossible to compose differently."
+}
+func (r *NoImportFromEntrypoint) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// src/services/report.ts\nimport { config } from '../main'",
+		Good:     "// src/services/report.ts\nimport { config } from '../config'",
+	}}
+}
+func (r *NoImportFromEntrypoint) DefaultSeverity() string   { return "error" }
+func (r *NoImportFromEntrypoint) NeedsProjectContext() bool { return true }
+
+func (r *NoImportFromEntrypoint) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Import \"../main\" from src/services/report.ts reaches entrypoint src/main (matches entrypoints glob \"**/main.ts\")",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Move what src/services/report.ts needs out of src/main into a module both can import.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile {
+		return nil
+	}
+	globs := stringSliceOption(config.Options, "entrypoints")
+	if len(globs) == 0 {
+		globs = defaultEntrypointGlobs
+	}
+	index := entrypointIndexFor(ctx)
+	from := filepath.ToSlash(file.Path)
+	if entrypointGlob(globs, []string{from}) != "" || index.files[from] {
+		return nil
+	}
+
+	lang := strings.ToLower(file.Language)
+	goPackage, goModulePath := "", ""
+	if lang == "go" {
+		goPackage, goModulePath = goPackageImportPath(file.Path)
+		if goModulePath == "" {
+			return nil
+		}
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		var target, reason string
+		if lang == "go" {
+			if ref.Path == goPackage || !strings.HasPrefix(ref.Path, goModulePath+"/") {
+				continue
+			}
+			target = strings.TrimPrefix(ref.Path, goModulePath+"/")
+			if glob := entrypointGlob(globs, importCandidatePaths(file.Path, ref.Path, lang)); glob != "" {
+				reason = fmt.Sprintf("matches entrypoints glob %q", glob)
+			} else if marker, ok := index.goPackages[ref.Path]; ok {
+				reason = "calls " + marker
+			}
+		} else {
+			candidates := importCandidatePaths(file.Path, ref.Path, lang)
+			if len(candidates) == 0 {
+				continue
+			}
+			target = candidates[0]
+			marker, known := index.stemMarker(target)
+			if !strings.HasPrefix(ref.Path, ".") && !known && !index.stems[target] {
+				continue
+			}
+			if glob := entrypointGlob(globs, []string{target}); glob != "" {
+				reason = fmt.Sprintf("matches entrypoints glob %q", glob)
+			} else if known {
+				reason = "calls " + marker
+			}
+		}
+		if reason == "" {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Import %q from %s reaches entrypoint %s (%s)", ref.Path, from, target, reason),
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Move what %s needs out of %s into a module both can import.", from, target),
+				Metadata: map[string]interface{}{
+					"import": ref.Path,
+					"from":   from,
+					"to":     target,
+					"reason": reason,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// entrypointGlob returns the first glob matching any candidate. Globs are tried with and
+// without their extension, since imports usually omit it.
+func entrypointGlob(globs []string, candidates []string) string {
+	for _, glob := range globs {
+		stem := strings.TrimSuffix(glob, path.Ext(glob))
+		for _, candidate := range candidates {
+			if matchPathGlob(glob, candidate) || (stem != glob && matchPathGlob(stem, candidate)) {
+				return glob
+			}
+		}
+	}
+	return ""
+}
+
+// goPackageImportPath returns the import path of the package containing filePath and
+// the path of its module, or empty strings outside a module.
+func goPackageImportPath(filePath string) (string, string) {
+	module, ok := findGoModule(filepath.Dir(filePath))
+	if !ok {
+		return "", ""
+	}
+	rel, err := filepath.Rel(module.Root, filepath.Dir(filePath))
+	if err != nil {
+		return "", ""
+	}
+	return path.Join(module.Path, filepath.ToSlash(rel)), module.Path
+}
+
+// entrypointIndex records the project files detected as entrypoints by their markers.
+type entrypointIndex struct {
+	files      map[string]bool
+	markers    map[string]string // extensionless path -> marker
+	stems      map[string]bool   // every project file, extensionless
+	goPackages map[string]string // Go import path -> marker
+}
+
+// stemMarker looks up a resolved import as a file, an index module, or a package init.
+func (idx entrypointIndex) stemMarker(target string) (string, bool) {
+	for _, stem := range []string{target, target + "/index", target + "/__init__"} {
+		if marker, ok := idx.markers[stem]; ok {
+			return marker, true
+		}
+	}
+	return "", false
+}
+
+var (
+	entrypointIndexMu  sync.Mutex
+	entrypointIndexCtx *model.ProjectContext
+	entrypointIndexVal entrypointIndex
+)
+
+// entrypointIndexFor scans ctx once per project context; every file of a run shares it.
+func entrypointIndexFor(ctx *model.ProjectContext) entrypointIndex {
+	entrypointIndexMu.Lock()
+	defer entrypointIndexMu.Unlock()
+	if ctx != nil && ctx == entrypointIndexCtx {
+		return entrypointIndexVal
+	}
+
+	idx := entrypointIndex{
+		files:      map[string]bool{},
+		markers:    map[string]string{},
+		stems:      map[string]bool{},
+		goPackages: map[string]string{},
+	}
+	if ctx == nil {
+		return idx
+	}
+	for p, f := range ctx.Files {
+		slashed := filepath.ToSlash(p)
+		stem := strings.TrimSuffix(slashed, path.Ext(slashed))
+		idx.stems[stem] = true
+		if f == nil || f.IsTestFile {
+			continue
+		}
+		lang := strings.ToLower(f.Language)
+		marker := entrypointMarkers[lang]
+		if marker == nil {
+			continue
+		}
+		found := marker.Find(f.Source)
+		if found == nil {
+			continue
+		}
+		name := strings.TrimSuffix(string(found), "(")
+		if lang == "python" {
+			name = `__name__ == "__main__"`
+		}
+		idx.files[slashed] = true
+		idx.markers[stem] = name
+		if lang == "go" {
+			if pkg, _ := goPackageImportPath(p); pkg != "" {
+				idx.goPackages[pkg] = name
+			}
+		}
+	}
+	entrypointIndexCtx, entrypointIndexVal = ctx, idx
+	return idx
+}
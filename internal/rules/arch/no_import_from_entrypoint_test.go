@@ -0,0 +1,92 @@
+// no_import_from_entrypoint_test.go — Tests for ARCH-no-import-from-main.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoImportFromEntrypoint(t *testing.T) {
+	assertRuleContract(t, &NoImportFromEntrypoint{})
+}
+
+func TestNoImportFromEntrypointFlagsEntrypointGlobs(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "src/services/report.ts",
+		Language: "typescript",
+		Source:   []byte("import { config } from '../main';\nimport { format } from './format';\nimport express from 'express';\n"),
+	}
+	rule := &NoImportFromEntrypoint{}
+
+	got := rule.Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	want := `Import "../main" from src/services/report.ts reaches entrypoint src/main (matches entrypoints glob "**/main.ts")`
+	if got[0].StartLine != 1 || got[0].Message != want {
+		t.Fatalf("violation = line %d %q", got[0].StartLine, got[0].Message)
+	}
+	if got[0].Context.Metadata["to"] != "src/main" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	custom := model.RuleConfig{Options: map[string]interface{}{"entrypoints": "src/services/format.ts"}}
+	got = rule.Check(file, nil, custom)
+	if len(got) != 1 || got[0].StartLine != 2 {
+		t.Fatalf("entrypoints should replace the defaults, got %+v", got)
+	}
+}
+
+func TestNoImportFromEntrypointDetectsMarkers(t *testing.T) {
+	server := &model.UnifiedFileModel{
+		Path:     "src/server.ts",
+		Language: "typescript",
+		Source:   []byte("const port = Number(process.argv[2]);\napp.listen(port);\n"),
+	}
+	importer := &model.UnifiedFileModel{
+		Path:     "src/jobs/nightly.ts",
+		Language: "typescript",
+		Source:   []byte("import { app } from '../server';\n"),
+	}
+	cli := &model.UnifiedFileModel{
+		Path:     "src/cli.ts",
+		Language: "typescript",
+		Source:   []byte("import { app } from './server';\nconsole.log(process.argv);\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{
+		server.Path: server, importer.Path: importer, cli.Path: cli,
+	}}
+	rule := &NoImportFromEntrypoint{}
+
+	got := rule.Check(importer, ctx, model.RuleConfig{})
+	if len(got) != 1 || got[0].Context.Metadata["reason"] != "calls process.argv" {
+		t.Fatalf("import of a process.argv file should be flagged, got %+v", got)
+	}
+	if got := rule.Check(cli, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("entrypoints may import each other, got %+v", got)
+	}
+	if got := rule.Check(importer, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("markers need project context, got %+v", got)
+	}
+}
+
+func TestNoImportFromEntrypointGoModule(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "go.mod", "module example.com/app\n\ngo 1.22\n")
+	server := writeArchTestFile(t, root, "internal/server/server.go", "package server\n\nfunc Run() error { return http.ListenAndServe(\":8080\", nil) }\n")
+	importer := writeArchTestFile(t, root, "internal/jobs/jobs.go", "package jobs\n\nimport (\n\t\"example.com/app/cmd/api/flags\"\n\t\"example.com/app/internal/server\"\n\t\"github.com/spf13/cobra/cmd\"\n)\n")
+
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{server.Path: server, importer.Path: importer}}
+
+	got := (&NoImportFromEntrypoint{}).Check(importer, ctx, model.RuleConfig{})
+	if len(got) != 2 {
+		t.Fatalf("violations = %d, want 2: %+v", len(got), got)
+	}
+	if got[0].StartLine != 4 || got[0].Context.Metadata["reason"] != `matches entrypoints glob "cmd/**"` {
+		t.Fatalf("first violation = line %d %+v", got[0].StartLine, got[0].Context.Metadata)
+	}
+	if got[1].StartLine != 5 || got[1].Context.Metadata["reason"] != "calls ListenAndServe" {
+		t.Fatalf("second violation = line %d %+v", got[1].StartLine, got[1].Context.Metadata)
+	}
+}
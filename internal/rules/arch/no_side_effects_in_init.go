@@ -0,0 +1,229 @@
+// no_side_effects_in_init.go — ARCH-no-side-effects-in-init: Keep Go init() functions pure.
+package arch
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// initSideEffects maps an import path to the functions in it that touch the outside
+// world, with the kind of side effect each has. A name ending in `*` is a prefix.
+var initSideEffects = map[string]map[string]string{
+	"net":                    {"Dial*": "network", "Listen*": "network", "Lookup*": "network", "Resolve*": "network"},
+	"net/http":               {"Get": "network", "Head": "network", "Post": "network", "PostForm": "network", "ListenAndServe*": "network", "Serve*": "network", "Handle": "registration", "HandleFunc": "registration"},
+	"net/rpc":                {"Dial*": "network", "Register*": "registration", "HandleHTTP": "registration"},
+	"net/smtp":               {"Dial": "network", "SendMail": "network"},
+	"google.golang.org/grpc": {"Dial*": "network", "NewClient": "network"},
+	"database/sql":           {"Open": "network", "OpenDB": "network", "Register": "registration"},
+	"os": {
+		"Open": "filesystem", "OpenFile": "filesystem", "Create": "filesystem", "CreateTemp": "filesystem",
+		"ReadFile": "filesystem", "WriteFile": "filesystem", "ReadDir": "filesystem", "Mkdir*": "filesystem",
+		"Remove*": "filesystem", "Rename": "filesystem", "Chdir": "filesystem", "Chmod": "filesystem",
+		"Chown": "filesystem", "Symlink": "filesystem", "Link": "filesystem", "Truncate": "filesystem",
+		"Setenv": "process", "Unsetenv": "process", "Clearenv": "process", "Exit": "process",
+	},
+	"io/ioutil":     {"ReadFile": "filesystem", "WriteFile": "filesystem", "ReadDir": "filesystem", "TempFile": "filesystem", "TempDir": "filesystem"},
+	"path/filepath": {"Walk": "filesystem", "WalkDir": "filesystem", "Glob": "filesystem"},
+	"os/exec":       {"Command": "process", "CommandContext": "process"},
+	"os/signal":     {"Notify": "process"},
+	"flag":          {"Parse": "process", "Bool*": "registration", "Int*": "registration", "Uint*": "registration", "String*": "registration", "Float64*": "registration", "Duration*": "registration", "Func": "registration", "Var": "registration"},
+	"expvar":        {"Publish": "registration", "New*": "registration"},
+	"encoding/gob":  {"Register*": "registration"},
+	"image":         {"RegisterFormat": "registration"},
+	"github.com/prometheus/client_golang/prometheus": {"MustRegister": "registration", "Register": "registration"},
+}
+
+// defaultInitAllow are registrations that are the idiomatic reason to have an init():
+// database drivers, gob types, and image decoders registering themselves.
+var defaultInitAllow = []string{
+	"database/sql.Register",
+	"encoding/gob.Register",
+	"encoding/gob.RegisterName",
+	"image.RegisterFormat",
+}
+
+// NoSideEffectsInInit allows Go init() functions but flags calls inside them that reach
+// the network, the filesystem, or the process environment, register global state, or
+// start goroutines. Sanctioned calls are listed in `allow`.
+type NoSideEffectsInInit struct{}
+
+func (r *NoSideEffectsInInit) ID() string       { return "ARCH-no-side-effects-in-init" }
+func (r *NoSideEffectsInInit) Category() string { return "arch" }
+func (r *NoSideEffectsInInit) Description() string {
+	return "Disallow network, filesystem, process, and global registration side effects in Go init()"
+}
+func (r *NoSideEffectsInInit) Why() string {
+	return "init() runs on import, before main and before any test can set up fakes; a side effect there happens to every binary and test that links the package."
+}
+func (r *NoSideEffectsInInit) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "var settings []byte\n\nfunc init() {\n\tsettings, _ = os.ReadFile(\"/etc/app/settings.json\")\n}",
+		Good:     "var defaultTimeouts map[string]time.Duration\n\nfunc init() {\n\tdefaultTimeouts = map[string]time.Duration{\"read\": 5 * time.Second}\n}",
+	}}
+}
+func (r *NoSideEffectsInInit) DefaultSeverity() string   { return "error" }
+func (r *NoSideEffectsInInit) NeedsProjectContext() bool { return false }
+
+func (r *NoSideEffectsInInit) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "init() calls os.ReadFile (filesystem side effect)",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Move the os.ReadFile call into an explicit constructor or setup function that main calls.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile || !strings.EqualFold(file.Language, "go") || len(file.Source) == 0 {
+		return nil
+	}
+	allow := defaultInitAllow
+	if _, ok := config.Options["allow"]; ok {
+		allow = stringSliceOption(config.Options, "allow")
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, effect := range goInitSideEffects(file.Source) {
+		if initCallAllowed(allow, effect) {
+			continue
+		}
+		message := fmt.Sprintf("init() calls %s (%s side effect)", effect.Call, effect.Kind)
+		fix := fmt.Sprintf("Move the %s call into an explicit constructor or setup function that main calls.", effect.Call)
+		if effect.Kind == "goroutine" {
+			message = "init() starts a goroutine"
+			fix = "Start the goroutine from an explicit Start or Run function that main calls and that can be stopped."
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   message,
+			FilePath:  file.Path,
+			StartLine: effect.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fix,
+				Metadata: map[string]interface{}{
+					"call": effect.Call,
+					"kind": effect.Kind,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// initSideEffect is one side-effecting call in an init(). Call is written as
+// `local.Func` the way the source spells it; Qualified uses the import path.
+type initSideEffect struct {
+	Call      string
+	Qualified string
+	Kind      string
+	Line      int
+}
+
+// goInitSideEffects scans every top-level func init() for package-qualified calls listed
+// in initSideEffects and for go statements. Function literals declared in init are
+// scanned too, since init usually calls them right away.
+func goInitSideEffects(source []byte) []initSideEffect {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	imports := map[string]string{}
+	for _, spec := range parsed.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		local := path.Base(importPath)
+		if spec.Name != nil {
+			local = spec.Name.Name
+		}
+		imports[local] = importPath
+	}
+
+	found := make([]initSideEffect, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Name.Name != "init" || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.GoStmt:
+				found = append(found, initSideEffect{Call: "go", Qualified: "go", Kind: "goroutine", Line: fset.Position(node.Pos()).Line})
+			case *ast.CallExpr:
+				sel, ok := node.Fun.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				pkg, ok := sel.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				importPath, ok := imports[pkg.Name]
+				if !ok {
+					return true
+				}
+				kind, ok := initSideEffectKind(importPath, sel.Sel.Name)
+				if !ok {
+					return true
+				}
+				found = append(found, initSideEffect{
+					Call:      pkg.Name + "." + sel.Sel.Name,
+					Qualified: importPath + "." + sel.Sel.Name,
+					Kind:      kind,
+					Line:      fset.Position(node.Pos()).Line,
+				})
+			}
+			return true
+		})
+	}
+	return found
+}
+
+func initSideEffectKind(importPath string, name string) (string, bool) {
+	for pattern, kind := range initSideEffects[importPath] {
+		if pattern == name || (strings.HasSuffix(pattern, "*") && strings.HasPrefix(name, strings.TrimSuffix(pattern, "*"))) {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+// initCallAllowed matches an allow entry against the import-path-qualified call
+// (`database/sql.Register`) or the call as written (`sql.Register`), exactly or as a
+// path.Match glob. `go` allows goroutines.
+func initCallAllowed(allow []string, effect initSideEffect) bool {
+	for _, pattern := range allow {
+		for _, candidate := range []string{effect.Qualified, effect.Call} {
+			if pattern == candidate {
+				return true
+			}
+			if matched, err := path.Match(pattern, candidate); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+// no_side_effects_in_init_test.go — Tests for ARCH-no-side-effects-in-init.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoSideEffectsInInit(t *testing.T) {
+	assertRuleContract(t, &NoSideEffectsInInit{})
+}
+
+func TestNoSideEffectsInInitFlagsSideEffects(t *testing.T) {
+	source := `package config
+
+import (
+	"database/sql"
+	"net/http"
+	fs "os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	settings []byte
+	timeouts map[string]time.Duration
+)
+
+func init() {
+	timeouts = map[string]time.Duration{"read": 5 * time.Second}
+	settings, _ = fs.ReadFile("/etc/app/settings.json")
+	http.HandleFunc("/healthz", health)
+	prometheus.MustRegister(requests)
+	sql.Register("fake", driver{})
+	go refresh()
+}
+
+func Load() ([]byte, error) {
+	return fs.ReadFile("/etc/app/settings.json")
+}
+`
+	file := &model.UnifiedFileModel{Path: "internal/config/config.go", Language: "go", Source: []byte(source)}
+	got := (&NoSideEffectsInInit{}).Check(file, nil, model.RuleConfig{})
+	want := []struct {
+		line    int
+		message string
+	}{
+		{19, "init() calls fs.ReadFile (filesystem side effect)"},
+		{20, "init() calls http.HandleFunc (registration side effect)"},
+		{21, "init() calls prometheus.MustRegister (registration side effect)"},
+		{23, "init() starts a goroutine"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("violations = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].StartLine != w.line || got[i].Message != w.message {
+			t.Fatalf("violation %d = line %d %q, want line %d %q", i, got[i].StartLine, got[i].Message, w.line, w.message)
+		}
+	}
+	if got[0].Context.Metadata["kind"] != "filesystem" || got[0].Context.Metadata["call"] != "fs.ReadFile" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	allowed := model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{"net/http.Handle*", "prometheus.MustRegister", "go"}}}
+	got = (&NoSideEffectsInInit{}).Check(file, nil, allowed)
+	if len(got) != 2 || got[0].StartLine != 19 || got[1].StartLine != 22 {
+		t.Fatalf("allow should replace the defaults and sanction listed calls, got %+v", got)
+	}
+}
+
+func TestNoSideEffectsInInitIgnoresPureInitAndOtherFiles(t *testing.T) {
+	pure := &model.UnifiedFileModel{
+		Path:     "internal/codes/codes.go",
+		Language: "go",
+		Source:   []byte("package codes\n\nimport \"strings\"\n\nvar upper map[string]string\n\nfunc init() {\n\tupper = map[string]string{}\n\tfor _, c := range []string{\"a\"} {\n\t\tupper[c] = strings.ToUpper(c)\n\t}\n}\n"),
+	}
+	if got := (&NoSideEffectsInInit{}).Check(pure, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("pure init should pass, got %+v", got)
+	}
+	method := &model.UnifiedFileModel{
+		Path:     "internal/server/server.go",
+		Language: "go",
+		Source:   []byte("package server\n\nimport \"os\"\n\ntype S struct{}\n\nfunc (S) init() { os.Exit(1) }\n"),
+	}
+	if got := (&NoSideEffectsInInit{}).Check(method, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("methods named init are not package initializers, got %+v", got)
+	}
+}
@@ -0,0 +1,162 @@
+// no_upward_import.go — ARCH-no-upward-import: Disallow imports of an ancestor package.
+package arch
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// NoUpwardImport flags imports whose target package is an ancestor directory of the
+// importing file within the same module or source tree.
+//
+// Go imports are resolved against the nearest go.mod. Relative TypeScript, JavaScript,
+// and Python imports are resolved against the importing file; project context tells a
+// file in the parent directory (`../utils.ts`, part of the parent package) apart from a
+// sibling directory (`../utils/index.ts`, a package of its own).
+type NoUpwardImport struct{}
+
+func (r *NoUpwardImport) ID() string          { return "ARCH-no-upward-import" }
+func (r *NoUpwardImport) Category() string    { return "arch" }
+func (r *NoUpwardImport) Description() string { return "Disallow importing an ancestor package" }
+func (r *NoUpwardImport) Why() string {
+	return "A child module that imports its parent creates a hidden cycle: the parent composes the child, so the child must not depend back on it."
+}
+func (r *NoUpwardImport) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// features/cart/item/Item.ts\nimport { cartStore } from '../store'",
+		Good:     "// features/cart/item/Item.ts\nimport { CartItemProps } from './types'",
+	}}
+}
+func (r *NoUpwardImport) DefaultSeverity() string   { return "error" }
+func (r *NoUpwardImport) NeedsProjectContext() bool { return true }
+
+func (r *NoUpwardImport) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Import \"../store\" from features/cart/item reaches up into ancestor package features/cart",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Pass what features/cart/item needs in from features/cart, or move the shared code into a child package both can import.",
+				},
+			},
+		}
+	}
+
+	if file == nil {
+		return nil
+	}
+	allow := stringSliceOption(config.Options, "allow")
+	var goImporter string
+	var goModulePath string
+	if strings.EqualFold(file.Language, "go") {
+		module, ok := findGoModule(filepath.Dir(file.Path))
+		if !ok {
+			return nil
+		}
+		rel, err := filepath.Rel(module.Root, filepath.Dir(file.Path))
+		if err != nil {
+			return nil
+		}
+		goImporter, goModulePath = path.Join(module.Path, filepath.ToSlash(rel)), module.Path
+	}
+	var stems map[string]bool
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractImports(file) {
+		var from, to string
+		if goImporter != "" {
+			if ref.Path != goModulePath && !strings.HasPrefix(ref.Path, goModulePath+"/") {
+				continue
+			}
+			from, to = goImporter, ref.Path
+		} else {
+			if !strings.HasPrefix(ref.Path, ".") {
+				continue
+			}
+			if stems == nil {
+				stems = projectFileStems(ctx)
+			}
+			from = path.Dir(filepath.ToSlash(file.Path))
+			to = relativeImportPackage(file.Path, ref.Path, file.Language, stems)
+		}
+		if to == from || !strings.HasPrefix(from, to+"/") || upwardImportAllowed(allow, file, ref.Path, to) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Import %q from %s reaches up into ancestor package %s", ref.Path, from, to),
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Pass what %s needs in from %s, or move the shared code into a child package both can import.", from, to),
+				Metadata: map[string]interface{}{
+					"import": ref.Path,
+					"from":   from,
+					"to":     to,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// relativeImportPackage returns the directory of the package a relative import lands
+// in: the parent directory for a file or index module, else the imported directory.
+func relativeImportPackage(filePath string, importPath string, language string, stems map[string]bool) string {
+	candidates := importCandidatePaths(filePath, importPath, language)
+	if len(candidates) == 0 {
+		return ""
+	}
+	resolved := candidates[0]
+	if path.Base(resolved) == "index" || path.Base(resolved) == "__init__" || stems[resolved] {
+		return path.Dir(resolved)
+	}
+	return resolved
+}
+
+// projectFileStems indexes project file paths without their extension, so an import
+// such as `../store` can be recognised as the file `../store.ts`.
+func projectFileStems(ctx *model.ProjectContext) map[string]bool {
+	stems := map[string]bool{}
+	if ctx == nil {
+		return stems
+	}
+	for p := range ctx.Files {
+		slashed := filepath.ToSlash(p)
+		stems[strings.TrimSuffix(slashed, path.Ext(slashed))] = true
+	}
+	return stems
+}
+
+// upwardImportAllowed matches `allow` globs against the import as written, the
+// resolved ancestor package, and the ancestor's trailing sub-paths.
+func upwardImportAllowed(allow []string, file *model.UnifiedFileModel, importPath string, target string) bool {
+	if len(allow) == 0 {
+		return false
+	}
+	candidates := append([]string{importPath}, importCandidatePaths(file.Path, target, "")...)
+	for _, pattern := range allow {
+		for _, candidate := range candidates {
+			if matchPathGlob(pattern, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+// no_upward_import_test.go — Tests for ARCH-no-upward-import.
+package arch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoUpwardImport(t *testing.T) {
+	assertRuleContract(t, &NoUpwardImport{})
+}
+
+func TestNoUpwardImportGo(t *testing.T) {
+	root := t.TempDir()
+	writeArchTestFile(t, root, "go.mod", "module example.com/app\n")
+	child := writeArchTestFile(t, root, "features/cart/item/item.go", "package item\n\nimport (\n\t\"fmt\"\n\t\"example.com/app/features/cart\"\n\t\"example.com/app/features/cart/item/price\"\n\t\"example.com/app/features/catalog\"\n)\n")
+	rootImport := writeArchTestFile(t, root, "features/catalog/catalog.go", "package catalog\n\nimport \"example.com/app\"\n")
+	rule := &NoUpwardImport{}
+
+	got := rule.Check(child, nil, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	v := got[0]
+	if v.StartLine != 5 || v.Message != `Import "example.com/app/features/cart" from example.com/app/features/cart/item reaches up into ancestor package example.com/app/features/cart` {
+		t.Fatalf("line=%d message=%q", v.StartLine, v.Message)
+	}
+	if v.Context.Metadata["from"] != "example.com/app/features/cart/item" || v.Context.Metadata["to"] != "example.com/app/features/cart" {
+		t.Fatalf("metadata = %+v", v.Context.Metadata)
+	}
+
+	if got := rule.Check(rootImport, nil, model.RuleConfig{}); len(got) != 1 {
+		t.Fatalf("importing the module root is an upward import, got %+v", got)
+	}
+	allow := model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{"features/cart"}}}
+	if got := rule.Check(child, nil, allow); len(got) != 0 {
+		t.Fatalf("allow should permit the edge, got %+v", got)
+	}
+}
+
+func TestNoUpwardImportRelative(t *testing.T) {
+	item := &model.UnifiedFileModel{
+		Path:     "web/features/cart/item/Item.ts",
+		Language: "typescript",
+		Source:   []byte("import { store } from '../store';\nimport { fmt } from '../format';\nimport { cart } from '..';\nimport { price } from './price';\nimport { x } from '../../catalog';\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{
+		item.Path:                             item,
+		"web/features/cart/store.ts":          {Path: "web/features/cart/store.ts", Language: "typescript"},
+		"web/features/cart/format/index.ts":   {Path: "web/features/cart/format/index.ts", Language: "typescript"},
+		"web/features/catalog/index.ts":       {Path: "web/features/catalog/index.ts", Language: "typescript"},
+		"web/features/cart/item/price.ts":     {Path: "web/features/cart/item/price.ts", Language: "typescript"},
+		"web/features/cart/item/Item.test.ts": {Path: "web/features/cart/item/Item.test.ts", Language: "typescript", IsTestFile: true},
+	}}
+
+	got := (&NoUpwardImport{}).Check(item, ctx, model.RuleConfig{})
+	// '../store' is a file in the parent package and '..' is the parent itself; the
+	// sibling directory '../format' and the cousin '../../catalog' are fine.
+	if len(got) != 2 || got[0].StartLine != 1 || got[1].StartLine != 3 {
+		t.Fatalf("unexpected violations: %+v", got)
+	}
+	if !strings.Contains(got[0].Message, "reaches up into ancestor package web/features/cart") {
+		t.Fatalf("message = %q", got[0].Message)
+	}
+
+	py := &model.UnifiedFileModel{Path: "app/orders/api/views.py", Language: "python", Source: []byte("from ..models import Order\nfrom .serializers import OrderSerializer\n")}
+	pyCtx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{py.Path: py, "app/orders/models.py": {Path: "app/orders/models.py", Language: "python"}}}
+	if got := (&NoUpwardImport{}).Check(py, pyCtx, model.RuleConfig{}); len(got) != 1 || got[0].Context.Metadata["to"] != "app/orders" {
+		t.Fatalf("python parent-module import should be flagged, got %+v", got)
+	}
+}
@@ -0,0 +1,89 @@
+// no_wildcard_reexports.go — ARCH-no-wildcard-reexports: Require named re-exports instead of `export *`.
+package arch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var jsWildcardReexportPattern = regexp.MustCompile(`(?m)^[ \t]*export\s+(?:type\s+)?\*\s*from\s*['"]([^'"]+)['"]`)
+
+// NoWildcardReexports flags TypeScript and JavaScript `export * from '...'` statements.
+// Namespaced re-exports (`export * as api from`) keep the names apart and are not
+// flagged. Files matching `allow` are intentional barrels.
+type NoWildcardReexports struct{}
+
+func (r *NoWildcardReexports) ID() string       { return "ARCH-no-wildcard-reexports" }
+func (r *NoWildcardReexports) Category() string { return "arch" }
+func (r *NoWildcardReexports) Description() string {
+	return "Disallow `export * from` re-exports in TypeScript and JavaScript"
+}
+func (r *NoWildcardReexports) Why() string {
+	return "`export *` makes a module's public surface whatever its dependencies happen to export, hides collisions, and lets barrels quietly form import cycles."
+}
+func (r *NoWildcardReexports) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "export * from './invoice';",
+		Good:     "export { Invoice, createInvoice } from './invoice';",
+	}}
+}
+func (r *NoWildcardReexports) DefaultSeverity() string   { return "error" }
+func (r *NoWildcardReexports) NeedsProjectContext() bool { return false }
+
+func (r *NoWildcardReexports) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Wildcard re-export from './invoice' exposes everything that module exports",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Replace it with named re-exports: export { Name } from './invoice';",
+				},
+			},
+		}
+	}
+
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+	language := strings.ToLower(file.Language)
+	if language != "typescript" && language != "javascript" {
+		return nil
+	}
+	for _, pattern := range stringSliceOption(config.Options, "allow") {
+		if matchPathGlob(pattern, file.Path) {
+			return nil
+		}
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, ref := range extractPatternImports(file.Source, jsWildcardReexportPattern) {
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Wildcard re-export from '%s' exposes everything that module exports", ref.Path),
+			FilePath:    file.Path,
+			StartLine:   ref.Line,
+			StartColumn: ref.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Replace it with named re-exports: export { Name } from '%s';, or add this file to `allow` if it is an intentional barrel.", ref.Path),
+				Metadata: map[string]interface{}{
+					"source": ref.Path,
+				},
+			},
+		})
+	}
+	return violations
+}
@@ -0,0 +1,55 @@
+// no_wildcard_reexports_test.go — Tests for ARCH-no-wildcard-reexports.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoWildcardReexports(t *testing.T) {
+	assertRuleContract(t, &NoWildcardReexports{})
+}
+
+func TestNoWildcardReexportsFlagsExportStar(t *testing.T) {
+	source := `export * from './invoice';
+export type * from "./types";
+export * as api from './api';
+export { Customer, createCustomer } from './customer';
+import * as util from './util';
+  export * from '../shared/money';
+`
+	file := &model.UnifiedFileModel{Path: "src/billing/index.ts", Language: "typescript", Source: []byte(source)}
+	got := (&NoWildcardReexports{}).Check(file, nil, model.RuleConfig{})
+	want := []struct {
+		line   int
+		source string
+	}{{1, "./invoice"}, {2, "./types"}, {6, "../shared/money"}}
+	if len(got) != len(want) {
+		t.Fatalf("violations = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].StartLine != w.line || got[i].Context.Metadata["source"] != w.source {
+			t.Fatalf("violation %d = line %d %v, want line %d %s", i, got[i].StartLine, got[i].Context.Metadata, w.line, w.source)
+		}
+	}
+	if got[0].Message != "Wildcard re-export from './invoice' exposes everything that module exports" {
+		t.Fatalf("message = %q", got[0].Message)
+	}
+}
+
+func TestNoWildcardReexportsAllowAndLanguages(t *testing.T) {
+	barrel := &model.UnifiedFileModel{Path: "src/sdk/index.ts", Language: "typescript", Source: []byte("export * from './client';\n")}
+	allowed := model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{"src/sdk/index"}}}
+	if got := (&NoWildcardReexports{}).Check(barrel, nil, allowed); len(got) != 0 {
+		t.Fatalf("allowed barrel should pass, got %+v", got)
+	}
+	other := &model.UnifiedFileModel{Path: "src/app/index.js", Language: "javascript", Source: []byte("export * from './routes';\n")}
+	if got := (&NoWildcardReexports{}).Check(other, nil, allowed); len(got) != 1 {
+		t.Fatalf("files outside allow should be flagged, got %+v", got)
+	}
+	python := &model.UnifiedFileModel{Path: "app/__init__.py", Language: "python", Source: []byte("from .models import *\n")}
+	if got := (&NoWildcardReexports{}).Check(python, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("only TypeScript and JavaScript are checked, got %+v", got)
+	}
+}
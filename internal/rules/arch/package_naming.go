@@ -0,0 +1,151 @@
+// package_naming.go — ARCH-package-naming: Enforce short, lowercase, single-word Go package names.
+package arch
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var (
+	goPackageClausePattern = regexp.MustCompile(`(?m)^\s*package\s+([A-Za-z_][A-Za-z0-9_]*)`)
+	goGeneratedPattern     = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+	singularSuffixes       = []string{"ss", "us", "is", "os", "ics"}
+)
+
+// PackageNaming implements the ARCH-package-naming rule.
+type PackageNaming struct{}
+
+func (r *PackageNaming) ID() string       { return "ARCH-package-naming" }
+func (r *PackageNaming) Category() string { return "arch" }
+func (r *PackageNaming) Description() string {
+	return "Enforce short, lowercase, single-word Go package names"
+}
+func (r *PackageNaming) Why() string {
+	return "Package names appear at every call site; mixed case, underscores, plurals, and stutter make them noisy."
+}
+func (r *PackageNaming) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// internal/user/userstore/store.go\npackage user_stores",
+		Good:     "// internal/user/store/store.go\npackage store",
+	}}
+}
+func (r *PackageNaming) DefaultSeverity() string   { return "warn" }
+func (r *PackageNaming) NeedsProjectContext() bool { return false }
+
+func (r *PackageNaming) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !strings.EqualFold(file.Language, "go") || goGeneratedPattern.Match(file.Source) {
+		return nil
+	}
+	m := goPackageClausePattern.FindSubmatch(stripGoLeadingComments(file.Source))
+	if len(m) != 2 {
+		return nil
+	}
+
+	name := string(m[1])
+	if strings.HasSuffix(name, "_test") && strings.HasSuffix(file.Path, "_test.go") {
+		name = strings.TrimSuffix(name, "_test")
+	}
+	if name == "main" || packageNameAllowed(name, stringSliceOption(config.Options, "allow")) {
+		return nil
+	}
+
+	dir := path.Dir(strings.ReplaceAll(file.Path, "\\", "/"))
+	problems := packageNameProblems(name, dir)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	return []model.Violation{
+		{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Package '%s' %s", name, strings.Join(problems, ", ")),
+			FilePath:  file.Path,
+			StartLine: 1,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Rename package '%s' to '%s', or add it to the rule's allow list.", name, suggestPackageName(name, dir)),
+			},
+		},
+	}
+}
+
+func packageNameProblems(name string, dir string) []string {
+	problems := make([]string, 0, 3)
+	if strings.ToLower(name) != name {
+		problems = append(problems, "contains uppercase letters")
+	}
+	if strings.Contains(name, "_") {
+		problems = append(problems, "contains underscores")
+	}
+	if looksPlural(strings.ToLower(name)) {
+		problems = append(problems, "is plural")
+	}
+	if parent := path.Base(path.Dir(dir)); parent != "." && parent != "/" && parent != "" {
+		lower := strings.ToLower(name)
+		if lower == parent || (strings.HasPrefix(lower, parent) && len(lower) > len(parent)) {
+			problems = append(problems, fmt.Sprintf("stutters with parent directory '%s'", parent))
+		}
+	}
+	return problems
+}
+
+func looksPlural(name string) bool {
+	if len(name) <= 3 || !strings.HasSuffix(name, "s") {
+		return false
+	}
+	for _, suffix := range singularSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+func suggestPackageName(name string, dir string) string {
+	suggested := strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	if parent := path.Base(path.Dir(dir)); parent != "" && strings.HasPrefix(suggested, parent) && len(suggested) > len(parent) {
+		suggested = strings.TrimPrefix(suggested, parent)
+	}
+	if looksPlural(suggested) {
+		suggested = strings.TrimSuffix(suggested, "s")
+	}
+	return suggested
+}
+
+func packageNameAllowed(name string, allow []string) bool {
+	for _, pattern := range allow {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// stripGoLeadingComments blanks out block comments so a `package` word inside
+// a doc comment is not mistaken for the package clause.
+func stripGoLeadingComments(source []byte) []byte {
+	text := string(source)
+	for {
+		start := strings.Index(text, "/*")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(text[start+2:], "*/")
+		if end < 0 {
+			text = text[:start]
+			break
+		}
+		text = text[:start] + strings.Repeat(" ", end+4) + text[start+end+4:]
+	}
+	return []byte(text)
+}
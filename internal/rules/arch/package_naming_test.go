@@ -0,0 +1,63 @@
+// package_naming_test.go — Tests for ARCH-package-naming.
+package arch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestPackageNaming(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		source   string
+		options  map[string]interface{}
+		wantPart string
+	}{
+		{name: "clean name", path: "internal/user/store.go", source: "package user\n"},
+		{name: "main package", path: "cmd/app/main.go", source: "package main\n"},
+		{name: "external test package", path: "internal/user/store_test.go", source: "package user_test\n"},
+		{name: "uppercase", path: "internal/user/store.go", source: "package userStore\n", wantPart: "uppercase"},
+		{name: "underscore", path: "internal/user/store.go", source: "package user_store\n", wantPart: "underscores"},
+		{name: "plural", path: "internal/helpers/str.go", source: "package helpers\n", wantPart: "plural"},
+		{name: "singular ending in s", path: "internal/status/s.go", source: "package status\n"},
+		{name: "stutter", path: "internal/user/userstore/store.go", source: "package userstore\n", wantPart: "stutters"},
+		{name: "allow list", path: "internal/api/pb/api.go", source: "package api_pb\n", options: map[string]interface{}{"allow": []interface{}{"*_pb"}}},
+		{name: "generated file", path: "internal/api/api.go", source: "// Code generated by protoc. DO NOT EDIT.\n\npackage api_pb\n"},
+		{name: "block comment before clause", path: "internal/user/doc.go", source: "/*\npackage Bad is documented here\n*/\npackage user\n"},
+	}
+
+	rule := &PackageNaming{}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			file := &model.UnifiedFileModel{Path: tc.path, Language: "go", Source: []byte(tc.source)}
+			got := rule.Check(file, nil, model.RuleConfig{Options: tc.options})
+			if tc.wantPart == "" {
+				if len(got) != 0 {
+					t.Fatalf("violations = %+v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("violations = %d, want 1", len(got))
+			}
+			if got[0].StartLine != 1 || got[0].Severity != "warn" {
+				t.Fatalf("violation = %+v, want line 1 warn", got[0])
+			}
+			if !strings.Contains(got[0].Message, tc.wantPart) {
+				t.Fatalf("message = %q, want it to mention %q", got[0].Message, tc.wantPart)
+			}
+		})
+	}
+}
+
+func TestPackageNamingIgnoresNonGo(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "src/Bad_Pkg.ts", Language: "typescript", Source: []byte("package Bad_Pkg\n")}
+	if got := (&PackageNaming{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("violations = %d, want 0", len(got))
+	}
+}
@@ -26,3 +26,46 @@ func tokenLine(source []byte, token string) (bool, int) {
 	}
 	return true, 1 + strings.Count(text[:idx], "\n")
 }
+
+// stringSliceOption reads a list option given either as a YAML list or a single string.
+func stringSliceOption(options map[string]interface{}, key string) []string {
+	if options == nil {
+		return nil
+	}
+	switch v := options[key].(type) {
+	case string:
+		if s := strings.TrimSpace(v); s != "" {
+			return []string{s}
+		}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// intOption reads a positive integer option, falling back when it is missing or invalid.
+func intOption(options map[string]interface{}, key string, fallback int) int {
+	switch v := options[key].(type) {
+	case int:
+		if v > 0 {
+			return v
+		}
+	case int64:
+		if v > 0 {
+			return int(v)
+		}
+	case float64:
+		if n := int(v); float64(n) == v && n > 0 {
+			return n
+		}
+	}
+	return fallback
+}
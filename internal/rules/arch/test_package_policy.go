@@ -0,0 +1,113 @@
+// test_package_policy.go — ARCH-test-in-same-package-policy: Enforce white-box or black-box Go test packages.
+package arch
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// TestPackagePolicy checks the package clause of Go test files against the configured
+// `policy`: "same" requires `package foo` (white-box), "external" requires
+// `package foo_test` (black-box). Without a policy the rule reports nothing.
+type TestPackagePolicy struct{}
+
+func (r *TestPackagePolicy) ID() string       { return "ARCH-test-in-same-package-policy" }
+func (r *TestPackagePolicy) Category() string { return "arch" }
+func (r *TestPackagePolicy) Description() string {
+	return "Enforce a single Go test package style (same package or _test package)"
+}
+func (r *TestPackagePolicy) Why() string {
+	return "Mixing white-box and black-box test packages makes it unclear whether tests may rely on unexported internals."
+}
+func (r *TestPackagePolicy) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// policy: external\n// orders/service_test.go\npackage orders",
+		Good:     "// policy: external\n// orders/service_test.go\npackage orders_test",
+	}}
+}
+func (r *TestPackagePolicy) DefaultSeverity() string   { return "error" }
+func (r *TestPackagePolicy) NeedsProjectContext() bool { return false }
+
+func (r *TestPackagePolicy) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Test file declares package orders; policy external requires package orders_test",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Change the package clause to orders_test and import the package under test.",
+				},
+			},
+		}
+	}
+
+	if file == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+	policy, _ := config.Options["policy"].(string)
+	policy = strings.ToLower(strings.TrimSpace(policy))
+	if policy != "same" && policy != "external" {
+		return nil
+	}
+	// export_test.go conventionally stays in the package to expose internals to
+	// black-box tests.
+	if policy == "external" && filepath.Base(file.Path) == "export_test.go" {
+		return nil
+	}
+	for _, pattern := range stringSliceOption(config.Options, "allow") {
+		if matchPathGlob(pattern, filepath.ToSlash(file.Path)) {
+			return nil
+		}
+	}
+
+	parsed, err := parser.ParseFile(token.NewFileSet(), file.Path, file.Source, parser.PackageClauseOnly)
+	if err != nil || parsed.Name == nil {
+		return nil
+	}
+	declared := parsed.Name.Name
+	base := strings.TrimSuffix(declared, "_test")
+
+	var expected, fix string
+	switch {
+	case policy == "external" && declared == base:
+		expected = base + "_test"
+		fix = fmt.Sprintf("Change the package clause to %s and import the package under test.", expected)
+	case policy == "same" && declared != base:
+		expected = base
+		fix = fmt.Sprintf("Change the package clause to %s so the test shares its package.", expected)
+	default:
+		return nil
+	}
+
+	return []model.Violation{
+		{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Test file declares package %s; policy %s requires package %s", declared, policy, expected),
+			FilePath:  file.Path,
+			StartLine: 1,
+			Context: &model.ViolationContext{
+				SuggestedFix: fix,
+				Metadata: map[string]interface{}{
+					"policy":   policy,
+					"declared": declared,
+					"expected": expected,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+// test_package_policy_test.go — Tests for ARCH-test-in-same-package-policy.
+package arch
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestTestPackagePolicy(t *testing.T) {
+	assertRuleContract(t, &TestPackagePolicy{})
+}
+
+func TestTestPackagePolicyChecksPackageClause(t *testing.T) {
+	rule := &TestPackagePolicy{}
+	whiteBox := &model.UnifiedFileModel{Path: "orders/service_test.go", Language: "go", IsTestFile: true, Source: []byte("// service_test.go — Tests.\npackage orders\n")}
+	blackBox := &model.UnifiedFileModel{Path: "orders/api_test.go", Language: "go", IsTestFile: true, Source: []byte("package orders_test\n")}
+	external := model.RuleConfig{Options: map[string]interface{}{"policy": "external"}}
+	same := model.RuleConfig{Options: map[string]interface{}{"policy": "same"}}
+
+	got := rule.Check(whiteBox, nil, external)
+	if len(got) != 1 || got[0].StartLine != 1 || got[0].Message != "Test file declares package orders; policy external requires package orders_test" {
+		t.Fatalf("external policy on white-box test = %+v", got)
+	}
+	if got := rule.Check(blackBox, nil, external); len(got) != 0 {
+		t.Fatalf("external policy on black-box test should pass, got %+v", got)
+	}
+
+	got = rule.Check(blackBox, nil, same)
+	if len(got) != 1 || got[0].Context.Metadata["expected"] != "orders" {
+		t.Fatalf("same policy on black-box test = %+v", got)
+	}
+	if got := rule.Check(whiteBox, nil, same); len(got) != 0 {
+		t.Fatalf("same policy on white-box test should pass, got %+v", got)
+	}
+
+	if got := rule.Check(whiteBox, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("no policy should report nothing, got %+v", got)
+	}
+}
+
+func TestTestPackagePolicyExemptions(t *testing.T) {
+	rule := &TestPackagePolicy{}
+	exportTest := &model.UnifiedFileModel{Path: "orders/export_test.go", Language: "go", IsTestFile: true, Source: []byte("package orders\n")}
+	if got := rule.Check(exportTest, nil, model.RuleConfig{Options: map[string]interface{}{"policy": "external"}}); len(got) != 0 {
+		t.Fatalf("export_test.go should be exempt under external policy, got %+v", got)
+	}
+
+	legacy := &model.UnifiedFileModel{Path: "legacy/old_test.go", Language: "go", IsTestFile: true, Source: []byte("package legacy\n")}
+	config := model.RuleConfig{Options: map[string]interface{}{"policy": "external", "allow": []interface{}{"legacy/**"}}}
+	if got := rule.Check(legacy, nil, config); len(got) != 0 {
+		t.Fatalf("allow globs should exempt matching files, got %+v", got)
+	}
+
+	source := &model.UnifiedFileModel{Path: "orders/service.go", Language: "go", Source: []byte("package orders\n")}
+	if got := rule.Check(source, nil, config); len(got) != 0 {
+		t.Fatalf("non-test files should be ignored, got %+v", got)
+	}
+}
@@ -0,0 +1,229 @@
+// comment_hygiene.go — CONV-comment-hygiene: Require an owner or ticket on TODO/FIXME/XXX comments.
+package conv
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
+)
+
+// defaultActionMarkers are the comment keywords that ask someone to come back later.
+var defaultActionMarkers = []string{"TODO", "FIXME", "XXX"}
+
+// defaultAttributionPattern is matched against the text right after the marker and
+// accepts a parenthesized owner or ticket: `TODO(alice):`, `FIXME(JIRA-123)`.
+const defaultAttributionPattern = `^\([^)\s][^)]*\)`
+
+// commentLinePrefix strips comment delimiters and block-comment gutters from the start of
+// a comment line.
+var commentLinePrefix = regexp.MustCompile(`^[\s/*#!]*`)
+
+// CommentHygiene flags action comments (TODO, FIXME, XXX) that do not name an owner or
+// a ticket. A marker only counts at the start of a comment line, so prose that merely
+// mentions "TODO" is left alone.
+type CommentHygiene struct{}
+
+func (r *CommentHygiene) ID() string       { return "CONV-comment-hygiene" }
+func (r *CommentHygiene) Category() string { return "conv" }
+func (r *CommentHygiene) Description() string {
+	return "Require TODO, FIXME, and XXX comments to name an owner or ticket"
+}
+func (r *CommentHygiene) DefaultSeverity() string   { return "warn" }
+func (r *CommentHygiene) NeedsProjectContext() bool { return false }
+func (r *CommentHygiene) Why() string {
+	return "An unattributed TODO has nobody to ask and nothing to track, so it outlives everyone who knew what it meant."
+}
+func (r *CommentHygiene) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// TODO: handle pagination\nfunc (c *Client) ListUsers(ctx context.Context) ([]User, error)",
+		Good:     "// TODO(PLAT-412): handle pagination\nfunc (c *Client) ListUsers(ctx context.Context) ([]User, error)",
+	}}
+}
+
+func (r *CommentHygiene) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+
+	var comments []sourceComment
+	switch normalizeLanguage(file.Language) {
+	case "go":
+		comments = scanGoComments(file.Source)
+	case "typescript", "javascript", "java":
+		comments = scanCStyleComments(file.Source)
+	case "python":
+		comments = scanPythonComments(file.Source)
+	default:
+		return nil
+	}
+
+	markers := toStringSlice(config.Options["markers"])
+	if len(markers) == 0 {
+		markers = defaultActionMarkers
+	}
+	quoted := make([]string, 0, len(markers))
+	for _, m := range markers {
+		quoted = append(quoted, regexp.QuoteMeta(m))
+	}
+	markerPattern := regexp.MustCompile(`^(` + strings.Join(quoted, "|") + `)\b`)
+	attribution := regexp.MustCompile(defaultAttributionPattern)
+	if raw, ok := config.Options["attribution"].(string); ok && strings.TrimSpace(raw) != "" {
+		if re, err := regexp.Compile(raw); err == nil {
+			attribution = re
+		}
+	}
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, c := range comments {
+		for offset, line := range strings.Split(c.Text, "\n") {
+			body := strings.TrimPrefix(line, commentLinePrefix.FindString(line))
+			m := markerPattern.FindStringSubmatch(body)
+			if m == nil || attribution.MatchString(body[len(m[1]):]) {
+				continue
+			}
+			violations = append(violations, model.Violation{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   fmt.Sprintf("%s comment has no owner or ticket reference", m[1]),
+				FilePath:  file.Path,
+				StartLine: c.Line + offset,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Attribute it, for example %s(alice): or %s(PROJ-123):, or resolve it and delete the comment.", m[1], m[1]),
+					Metadata: map[string]interface{}{
+						"marker": m[1],
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// sourceComment is a comment's raw text, delimiters included, and its first line.
+type sourceComment struct {
+	Text string
+	Line int
+}
+
+func scanGoComments(source []byte) []sourceComment {
+	fset := token.NewFileSet()
+	tf := fset.AddFile("", fset.Base(), len(source))
+	var s scanner.Scanner
+	s.Init(tf, source, nil, scanner.ScanComments)
+
+	comments := make([]sourceComment, 0)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.COMMENT {
+			comments = append(comments, sourceComment{Text: lit, Line: fset.Position(pos).Line})
+		}
+	}
+	return comments
+}
+
+// scanCStyleComments returns // and /* */ comments, skipping string and template
+// literals so a "// TODO" inside a string is not a comment.
+func scanCStyleComments(source []byte) []sourceComment {
+	comments := make([]sourceComment, 0)
+	n := len(source)
+	line := 1
+	for i := 0; i < n; {
+		c := source[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == '/' && i+1 < n && source[i+1] == '/':
+			start := i
+			for i < n && source[i] != '\n' {
+				i++
+			}
+			comments = append(comments, sourceComment{Text: string(source[start:i]), Line: line})
+		case c == '/' && i+1 < n && source[i+1] == '*':
+			stop := n
+			if end := strings.Index(string(source[i+2:]), "*/"); end >= 0 {
+				stop = i + 2 + end + 2
+			}
+			text := string(source[i:stop])
+			comments = append(comments, sourceComment{Text: text, Line: line})
+			line += strings.Count(text, "\n")
+			i = stop
+		case c == '`':
+			stop := rewrite.SkipJSTemplate(source, i)
+			line += strings.Count(string(source[i:stop]), "\n")
+			i = stop
+		case c == '\'' || c == '"':
+			i++
+			for i < n && source[i] != c && source[i] != '\n' {
+				if source[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i < n && source[i] == c {
+				i++
+			}
+		default:
+			i++
+		}
+	}
+	return comments
+}
+
+// scanPythonComments returns # comments, skipping single-, double-, and triple-quoted
+// strings.
+func scanPythonComments(source []byte) []sourceComment {
+	comments := make([]sourceComment, 0)
+	text := string(source)
+	n := len(text)
+	line := 1
+	for i := 0; i < n; {
+		c := text[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == '#':
+			start := i
+			for i < n && text[i] != '\n' {
+				i++
+			}
+			comments = append(comments, sourceComment{Text: text[start:i], Line: line})
+		case strings.HasPrefix(text[i:], `"""`) || strings.HasPrefix(text[i:], `'''`):
+			quote := text[i : i+3]
+			stop := n
+			if end := strings.Index(text[i+3:], quote); end >= 0 {
+				stop = i + 3 + end + 3
+			}
+			line += strings.Count(text[i:stop], "\n")
+			i = stop
+		case c == '\'' || c == '"':
+			i++
+			for i < n && text[i] != c && text[i] != '\n' {
+				if text[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i < n && text[i] == c {
+				i++
+			}
+		default:
+			i++
+		}
+	}
+	return comments
+}
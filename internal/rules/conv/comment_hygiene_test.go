@@ -0,0 +1,91 @@
+// comment_hygiene_test.go — Tests for CONV-comment-hygiene rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestCommentHygiene_InterfaceCompliance(t *testing.T) {
+	rule := &CommentHygiene{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-comment-hygiene", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "warn", rule.DefaultSeverity())
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestCommentHygiene_Check(t *testing.T) {
+	rule := &CommentHygiene{}
+
+	tests := []struct {
+		name      string
+		lang      string
+		source    string
+		options   map[string]interface{}
+		wantLines []int
+	}{
+		{
+			name:      "go unattributed markers are flagged",
+			lang:      "go",
+			source:    "package a\n\n// TODO: paginate\n// TODO(alice): retry\n// FIXME(JIRA-123) off by one\nfunc f() {} // XXX hack\n\n/*\n * FIXME handle nil\n */\n",
+			wantLines: []int{3, 6, 9},
+		},
+		{
+			name:   "go markers in strings and mid-sentence are ignored",
+			lang:   "go",
+			source: "package a\n\nconst s = \"// TODO: not a comment\"\n\n// See the TODO list in the README.\nfunc f() {}\n",
+		},
+		{
+			name:      "ts line, block, and template literal handling",
+			lang:      "typescript",
+			source:    "const url = 'http://x'; // TODO wire up\nconst t = `\n// TODO: inside template\n`;\n/** FIXME(bob): later */\n",
+			wantLines: []int{1},
+		},
+		{
+			name:      "python comments outside strings",
+			lang:      "python",
+			source:    "x = \"# TODO: not a comment\"\n\"\"\"\n# TODO: docstring\n\"\"\"\n# TODO: real one\n# TODO(carol): fine\n",
+			wantLines: []int{5},
+		},
+		{
+			name:      "custom markers and attribution pattern",
+			lang:      "go",
+			source:    "package a\n\n// HACK: temporary\n// TODO: PROJ-9 tracked\n// TODO: untracked\n",
+			options:   map[string]interface{}{"markers": []interface{}{"TODO", "HACK"}, "attribution": `^:?\s*[A-Z]+-\d+`},
+			wantLines: []int{3, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "src/file", Language: tt.lang, Source: []byte(tt.source)}
+			got := rule.Check(file, nil, model.RuleConfig{Options: tt.options})
+			lines := make([]int, 0, len(got))
+			for _, v := range got {
+				lines = append(lines, v.StartLine)
+			}
+			if len(tt.wantLines) == 0 {
+				assert.Empty(t, lines)
+				return
+			}
+			assert.Equal(t, tt.wantLines, lines)
+		})
+	}
+}
+
+func TestCommentHygiene_ViolationDetails(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "a.go", Language: "go", Source: []byte("package a\n\n// FIXME: nil map\n")}
+	got := (&CommentHygiene{}).Check(file, nil, model.RuleConfig{Severity: "error"})
+	require.Len(t, got, 1)
+	assert.Equal(t, "FIXME comment has no owner or ticket reference", got[0].Message)
+	assert.Equal(t, "error", got[0].Severity)
+	assert.Equal(t, "FIXME", got[0].Context.Metadata["marker"])
+}
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
 
 	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
 )
 
-// ErrorFormat enforces a consistent error message shape.
+// ErrorFormat enforces a consistent error message shape. In Go it also requires
+// fmt.Errorf to wrap an error argument with %w rather than %v, so the chain stays
+// visible to errors.Is and errors.As; the `requireWrapVerb` option turns that off.
 type ErrorFormat struct{}
 
 func (r *ErrorFormat) ID() string                { return "CONV-error-format" }
@@ -22,6 +26,13 @@ func (r *ErrorFormat) NeedsProjectContext() bool { return false }
 func (r *ErrorFormat) Why() string {
 	return "Consistent error format makes logs searchable and tells users how to recover."
 }
+func (r *ErrorFormat) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "throw new Error('something failed')",
+		Good:     "throw new Error('DATABASE_CONNECT: Connection refused. Verify host and port.')",
+	}}
+}
 
 var (
 	goFmtErrorRe = regexp.MustCompile("fmt\\.Errorf\\(\\s*(\"(?:\\\\.|[^\"\\\\])*\"|`[^`]*`)\\s*(?:,|\\))")
@@ -104,6 +115,13 @@ func (r *ErrorFormat) Check(file *model.UnifiedFileModel, _ *model.ProjectContex
 		}
 	}
 
+	if applyTargets["fmt.Errorf"] && boolOption(config.Options, "requireWrapVerb", true) && isGoFile(file) {
+		for _, call := range rewrite.GoUnwrappedErrorfCalls(file.Source) {
+			violations = append(violations, r.newWrapViolation(file.Path, severity, call))
+		}
+		sort.SliceStable(violations, func(i, j int) bool { return violations[i].StartLine < violations[j].StartLine })
+	}
+
 	return violations
 }
 
@@ -290,6 +308,28 @@ func (r *ErrorFormat) newViolation(filePath string, line int, severity string, m
 	}
 }
 
+func (r *ErrorFormat) newWrapViolation(filePath string, severity string, call rewrite.UnwrappedErrorfCall) model.Violation {
+	return model.Violation{
+		RuleID:      r.ID(),
+		Severity:    severity,
+		Message:     fmt.Sprintf("fmt.Errorf formats error %s with %%v; wrap it with %%w so errors.Is and errors.As can unwrap it", strings.Join(call.Args, ", ")),
+		FilePath:    filePath,
+		StartLine:   call.Line,
+		StartColumn: call.Column,
+		Context: &model.ViolationContext{
+			SuggestedFix: "Replace %v with %w for the error argument, or run `strict fix`.",
+			Metadata: map[string]interface{}{
+				"kind":      "wrap-verb",
+				"arguments": call.Args,
+			},
+		},
+	}
+}
+
+func isGoFile(file *model.UnifiedFileModel) bool {
+	return strings.EqualFold(file.Language, "go") || strings.EqualFold(filepath.Ext(file.Path), ".go")
+}
+
 func suggestedMessage(path string) string {
 	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
 	parts := strings.FieldsFunc(base, func(r rune) bool {
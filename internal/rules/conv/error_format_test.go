@@ -196,3 +196,23 @@ func testPath(language string) string {
 		return "/project/src/example.txt"
 	}
 }
+
+func TestErrorFormat_WrapVerb(t *testing.T) {
+	rule := &ErrorFormat{}
+	source := "package store\n\nfunc load(ctx context.Context, path string) error {\n" +
+		"\tif err := read(path); err != nil {\n\t\treturn fmt.Errorf(\"Load: read %s: %v\", path, err)\n\t}\n" +
+		"\tif readErr := check(); readErr != nil {\n\t\treturn fmt.Errorf(\"Load: check: %w\", readErr)\n\t}\n" +
+		"\treturn fmt.Errorf(\"Load: %v. Retry with a longer deadline (%+v).\", ctx.Err(), opts.lastError)\n}\n"
+	file := &model.UnifiedFileModel{Path: testPath("go"), Language: "go", Source: []byte(source)}
+
+	violations := rule.Check(file, nil, model.RuleConfig{})
+	require.Len(t, violations, 2)
+	assert.Equal(t, 5, violations[0].StartLine)
+	assert.Equal(t, 10, violations[0].StartColumn)
+	assert.Equal(t, "fmt.Errorf formats error err with %v; wrap it with %w so errors.Is and errors.As can unwrap it", violations[0].Message)
+	assert.Equal(t, "wrap-verb", violations[0].Context.Metadata["kind"])
+	assert.Equal(t, []string{"ctx.Err()"}, violations[1].Context.Metadata["arguments"], "%+v is left alone")
+
+	assert.Empty(t, rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"requireWrapVerb": false}}))
+	assert.Empty(t, rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"applyTo": []string{"errors.New"}}}))
+}
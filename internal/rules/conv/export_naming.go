@@ -8,7 +8,17 @@ import (
 	"github.com/stricture/stricture/internal/model"
 )
 
-// ExportNaming enforces naming conventions for exported/public symbols.
+// defaultGoInitialisms are the initialisms Go spells in a single case (`ID`, `URL`),
+// following the list used by golint and staticcheck.
+var defaultGoInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS",
+	"ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH",
+	"TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML",
+	"XMPP", "XSRF", "XSS",
+}
+
+// ExportNaming enforces naming conventions for exported/public symbols. For Go
+// PascalCase exports it also expects known initialisms to be fully upper-cased.
 type ExportNaming struct{}
 
 func (r *ExportNaming) ID() string       { return "CONV-export-naming" }
@@ -21,6 +31,13 @@ func (r *ExportNaming) NeedsProjectContext() bool { return false }
 func (r *ExportNaming) Why() string {
 	return "Inconsistent export names make imports confusing and break IDE autocomplete."
 }
+func (r *ExportNaming) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "export function CREATEUSER() {}",
+		Good:     "export function createUser() {}",
+	}}
+}
 
 func (r *ExportNaming) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	if file == nil {
@@ -36,11 +53,56 @@ func (r *ExportNaming) Check(file *model.UnifiedFileModel, _ *model.ProjectConte
 	symbols := scanExportedSymbols(file)
 	violations := make([]model.Violation, 0)
 
+	var initialisms map[string]bool
+	goTestFile := false
+	if lang := strings.ToLower(strings.TrimSpace(file.Language)); lang == "go" || lang == "golang" {
+		initialisms = resolveGoInitialisms(config.Options)
+		goTestFile = file.IsTestFile || strings.HasSuffix(file.Path, "_test.go")
+	}
+
 	for _, symbol := range symbols {
+		if goTestFile && isGoTestHarnessFunc(symbol) {
+			continue
+		}
 		expected := expectedConventionForKind(symbol.Kind, conventions)
 		if expected == "" {
 			continue
 		}
+		if initialisms != nil && expected == namingPascalCase {
+			suggested, misCased := goInitialismName(symbol.Name, initialisms)
+			if suggested == symbol.Name {
+				continue
+			}
+			if len(misCased) > 0 {
+				violations = append(violations, model.Violation{
+					RuleID:    r.ID(),
+					Severity:  severity,
+					Message:   fmt.Sprintf("Export '%s' mis-cases initialism %s, should be '%s'", symbol.Name, strings.Join(misCased, ", "), suggested),
+					FilePath:  file.Path,
+					StartLine: symbol.Line,
+					Context: &model.ViolationContext{
+						SuggestedFix: fmt.Sprintf("Rename to '%s'; Go spells initialisms in a single case.", suggested),
+						Metadata: map[string]interface{}{
+							"symbol":      symbol.Name,
+							"initialisms": misCased,
+							"suggested":   suggested,
+						},
+					},
+				})
+				continue
+			}
+			violations = append(violations, model.Violation{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   fmt.Sprintf("Export '%s' does not follow convention '%s', should be '%s'", symbol.Name, expected, suggested),
+				FilePath:  file.Path,
+				StartLine: symbol.Line,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Rename to '%s' following %s.", suggested, expected),
+				},
+			})
+			continue
+		}
 		if matchesNameConvention(symbol.Name, expected) {
 			continue
 		}
@@ -61,6 +123,21 @@ func (r *ExportNaming) Check(file *model.UnifiedFileModel, _ *model.ProjectConte
 	return violations
 }
 
+// isGoTestHarnessFunc reports whether symbol is a function `go test` runs by name.
+// Their names follow the testing package (TestParse_EmptyInput, ExampleUser_Name),
+// which TQ-test-naming checks, rather than the export convention.
+func isGoTestHarnessFunc(symbol exportSymbol) bool {
+	if symbol.Kind != "function" {
+		return false
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Example", "Fuzz"} {
+		if strings.HasPrefix(symbol.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func expectedConventionForKind(kind string, conventions map[string]string) string {
 	switch normalizeSymbolKind(kind) {
 	case "function":
@@ -136,3 +213,50 @@ func applyNamingConventionOverrides(conventions map[string]string, values map[st
 		conventions[key] = normalized
 	}
 }
+
+// resolveGoInitialisms reads the `initialisms` option, top-level or under `go:`. A
+// configured list replaces the defaults.
+func resolveGoInitialisms(options map[string]interface{}) map[string]bool {
+	list := toStringSlice(options["initialisms"])
+	if nested, ok := toStringMap(options["go"]); ok {
+		if goList := toStringSlice(nested["initialisms"]); len(goList) > 0 {
+			list = goList
+		}
+	}
+	if len(list) == 0 {
+		list = defaultGoInitialisms
+	}
+	initialisms := make(map[string]bool, len(list))
+	for _, item := range list {
+		initialisms[strings.ToUpper(item)] = true
+	}
+	return initialisms
+}
+
+// goInitialismName rebuilds name in PascalCase with every known initialism upper-cased
+// ("GetUserId" -> "GetUserID") and lists the initialisms name spells differently.
+func goInitialismName(name string, initialisms map[string]bool) (string, []string) {
+	words := splitIntoWords(name)
+	if len(words) == 0 {
+		return name, nil
+	}
+	var out strings.Builder
+	misCased := make([]string, 0)
+	for i := 0; i < len(words); i++ {
+		word := strings.ToUpper(words[i])
+		// Digit splits break initialisms such as UTF8 into "utf" + "8".
+		if i+1 < len(words) && initialisms[word+strings.ToUpper(words[i+1])] {
+			word += strings.ToUpper(words[i+1])
+			i++
+		}
+		if !initialisms[word] {
+			out.WriteString(capitalize(words[i]))
+			continue
+		}
+		out.WriteString(word)
+		if !strings.Contains(name, word) {
+			misCased = append(misCased, word)
+		}
+	}
+	return out.String(), misCased
+}
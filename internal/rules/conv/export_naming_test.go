@@ -91,3 +91,67 @@ func TestExportNaming_PassesWhenConformant(t *testing.T) {
 	violations := rule.Check(file, nil, model.RuleConfig{})
 	assert.Empty(t, violations)
 }
+
+func TestExportNaming_GoInitialismMustBeUpperCase(t *testing.T) {
+	rule := &ExportNaming{}
+	file := &model.UnifiedFileModel{
+		Path:     "internal/service/user.go",
+		Language: "go",
+		Source:   []byte("package service\n\nfunc GetUserId() {}\n\ntype HttpClient struct{}\n"),
+	}
+
+	violations := rule.Check(file, nil, model.RuleConfig{})
+	require.Len(t, violations, 2)
+	assert.Equal(t, 3, violations[0].StartLine)
+	assert.Equal(t, "Export 'GetUserId' mis-cases initialism ID, should be 'GetUserID'", violations[0].Message)
+	assert.Equal(t, "GetUserID", violations[0].Context.Metadata["suggested"])
+	assert.Equal(t, []string{"ID"}, violations[0].Context.Metadata["initialisms"])
+	assert.Equal(t, 5, violations[1].StartLine)
+	assert.Contains(t, violations[1].Message, "'HTTPClient'")
+}
+
+func TestExportNaming_GoInitialismsPassWhenUpperCase(t *testing.T) {
+	rule := &ExportNaming{}
+	file := &model.UnifiedFileModel{
+		Path:     "internal/service/user.go",
+		Language: "go",
+		Source:   []byte("package service\n\nfunc GetUserID() {}\n\ntype HTTPClient struct{}\n\nconst DefaultURL = \"\"\n\nfunc DecodeUTF8() {}\n"),
+	}
+
+	violations := rule.Check(file, nil, model.RuleConfig{})
+	assert.Empty(t, violations)
+}
+
+func TestExportNaming_GoConfiguredInitialisms(t *testing.T) {
+	rule := &ExportNaming{}
+	file := &model.UnifiedFileModel{
+		Path:     "internal/service/user.go",
+		Language: "go",
+		Source:   []byte("package service\n\nfunc LoadGrpcConfig() {}\n\nfunc GetUserId() {}\n"),
+	}
+
+	cfg := model.RuleConfig{Options: map[string]interface{}{
+		"go": map[string]interface{}{"initialisms": []interface{}{"GRPC"}},
+	}}
+	violations := rule.Check(file, nil, cfg)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "Export 'LoadGrpcConfig' mis-cases initialism GRPC, should be 'LoadGRPCConfig'", violations[0].Message)
+}
+
+func TestExportNaming_GoTestFunctionsFollowTestingNames(t *testing.T) {
+	rule := &ExportNaming{}
+	source := "func TestParse_EmptyInput(t *testing.T) {}\nfunc BenchmarkParse_Large(b *testing.B) {}\nfunc ExampleUser_Name() {}\nfunc FuzzParse_Bytes(f *testing.F) {}\nfunc Helper_Setup() {}\n"
+	file := &model.UnifiedFileModel{
+		Path:       "internal/parse/parse_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte(source),
+	}
+
+	violations := rule.Check(file, nil, model.RuleConfig{})
+	require.Len(t, violations, 1)
+	assert.Contains(t, violations[0].Message, "Helper_Setup")
+
+	file.Path, file.IsTestFile = "internal/parse/parse.go", false
+	assert.Len(t, rule.Check(file, nil, model.RuleConfig{}), 5)
+}
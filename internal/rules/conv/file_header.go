@@ -18,6 +18,13 @@ func (r *FileHeader) Description() string { return "Require file header comments
 func (r *FileHeader) Why() string {
 	return "File headers provide quick context about a file's purpose."
 }
+func (r *FileHeader) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// (no header)\nexport function doThing() {}",
+		Good:     "// user-service.ts — User CRUD operations.\nexport function doThing() {}",
+	}}
+}
 func (r *FileHeader) DefaultSeverity() string   { return "error" }
 func (r *FileHeader) NeedsProjectContext() bool { return false }
 
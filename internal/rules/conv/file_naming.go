@@ -62,6 +62,13 @@ func (r *FileNaming) NeedsProjectContext() bool { return false }
 func (r *FileNaming) Why() string {
 	return "Inconsistent naming makes files hard to find and breaks tooling assumptions."
 }
+func (r *FileNaming) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "userController.ts (should be kebab-case)",
+		Good:     "user-controller.ts",
+	}}
+}
 
 // Check evaluates the file name against the configured or auto-detected naming convention.
 func (r *FileNaming) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
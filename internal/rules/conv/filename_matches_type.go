@@ -0,0 +1,120 @@
+// filename_matches_type.go — CONV-filename-matches-primary-type: Name files after their single exported type.
+package conv
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var (
+	tsExportedTypePattern   = regexp.MustCompile(`(?m)^[ \t]*export\s+(?:default\s+)?(?:declare\s+)?(?:abstract\s+)?(?:const\s+)?(class|interface|type|enum)\s+([A-Za-z_$][\w$]*)`)
+	javaPublicTypePattern   = regexp.MustCompile(`(?m)^public\s+(?:(?:abstract|final|sealed|non-sealed|static|strictfp)\s+)*(class|interface|enum|record|@interface)\s+([A-Za-z_$][\w$]*)`)
+	filenameMatchesTypeSkip = map[string]bool{"index": true}
+)
+
+// FilenameMatchesType flags TypeScript, JavaScript, and Java files whose name does not
+// match the one type they export. Names are compared word by word, so `user-service.ts`
+// and `UserService.ts` both match `class UserService`; the casing itself is
+// CONV-file-naming's concern. Files exporting zero or several types are skipped.
+type FilenameMatchesType struct{}
+
+func (r *FilenameMatchesType) ID() string       { return "CONV-filename-matches-primary-type" }
+func (r *FilenameMatchesType) Category() string { return "conv" }
+func (r *FilenameMatchesType) Description() string {
+	return "Name a file after the single type it exports"
+}
+func (r *FilenameMatchesType) DefaultSeverity() string   { return "warn" }
+func (r *FilenameMatchesType) NeedsProjectContext() bool { return false }
+func (r *FilenameMatchesType) Why() string {
+	return "Readers look for a type by its name; a file named for something else hides it and usually means the type was renamed without the file."
+}
+func (r *FilenameMatchesType) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// user-service.ts\nexport class AccountService { ... }",
+		Good:     "// account-service.ts\nexport class AccountService { ... }",
+	}}
+}
+
+func (r *FilenameMatchesType) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || file.IsTestFile {
+		return nil
+	}
+	language := normalizeLanguage(file.Language)
+	if language != "typescript" && language != "javascript" && language != "java" {
+		return nil
+	}
+	baseName := extractBaseName(file.Path)
+	if baseName == "" || filenameMatchesTypeSkip[baseName] || strings.HasSuffix(filepath.Base(file.Path), ".d.ts") {
+		return nil
+	}
+
+	types := exportedTypes(file, language)
+	if len(types) != 1 {
+		return nil
+	}
+	primary := types[0]
+	if strings.Join(splitIntoWords(baseName), "-") == strings.Join(splitIntoWords(primary.Name), "-") {
+		return nil
+	}
+
+	convention := resolveConvention(language, config)
+	expected := rebuildFileName(file.Path, convertToConvention(primary.Name, convention))
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+	line := primary.StartLine
+	if line <= 0 {
+		line = 1
+	}
+	return []model.Violation{{
+		RuleID:    r.ID(),
+		Severity:  severity,
+		Message:   fmt.Sprintf("File name '%s' does not match its exported type %s, should be '%s'", filepath.Base(file.Path), primary.Name, expected),
+		FilePath:  file.Path,
+		StartLine: line,
+		Context: &model.ViolationContext{
+			SuggestedFix: fmt.Sprintf("Rename the file to '%s', or rename %s if the file name is the intended one.", expected, primary.Name),
+			Metadata: map[string]interface{}{
+				"type":     primary.Name,
+				"expected": expected,
+			},
+		},
+	}}
+}
+
+// exportedTypes returns the exported types declared in file. Adapters that fill Types
+// are authoritative; otherwise TypeScript/JavaScript `export` declarations and top-level
+// Java `public` types are scanned.
+func exportedTypes(file *model.UnifiedFileModel, language string) []model.TypeModel {
+	if len(file.Types) > 0 {
+		out := make([]model.TypeModel, 0)
+		for _, t := range file.Types {
+			if t.Exported {
+				out = append(out, t)
+			}
+		}
+		return out
+	}
+
+	pattern := tsExportedTypePattern
+	if language == "java" {
+		pattern = javaPublicTypePattern
+	}
+	source := string(file.Source)
+	out := make([]model.TypeModel, 0)
+	for _, m := range pattern.FindAllStringSubmatchIndex(source, -1) {
+		out = append(out, model.TypeModel{
+			Name:      source[m[4]:m[5]],
+			Kind:      source[m[2]:m[3]],
+			Exported:  true,
+			StartLine: strings.Count(source[:m[4]], "\n") + 1,
+		})
+	}
+	return out
+}
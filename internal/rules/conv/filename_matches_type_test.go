@@ -0,0 +1,73 @@
+// filename_matches_type_test.go — Tests for CONV-filename-matches-primary-type rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestFilenameMatchesType_InterfaceCompliance(t *testing.T) {
+	rule := &FilenameMatchesType{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-filename-matches-primary-type", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "warn", rule.DefaultSeverity())
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestFilenameMatchesType_Check(t *testing.T) {
+	rule := &FilenameMatchesType{}
+
+	tests := []struct {
+		name     string
+		path     string
+		lang     string
+		source   string
+		types    []model.TypeModel
+		wantFlag bool
+	}{
+		{name: "ts mismatch", path: "src/UserService.ts", lang: "typescript", source: "import x from 'y';\n\nexport class Account {}\n", wantFlag: true},
+		{name: "ts kebab match", path: "src/user-service.ts", lang: "typescript", source: "export class UserService {}\n"},
+		{name: "ts pascal match is left to file naming", path: "src/UserService.ts", lang: "typescript", source: "export default class UserService {}\n"},
+		{name: "ts several exported types", path: "src/user-service.ts", lang: "typescript", source: "export class UserService {}\nexport interface Options {}\n"},
+		{name: "ts unexported types", path: "src/user-service.ts", lang: "typescript", source: "class Account {}\nexport function load() {}\n"},
+		{name: "ts index barrel", path: "src/billing/index.ts", lang: "typescript", source: "export class Invoice {}\n"},
+		{name: "ts test file", path: "src/user.test.ts", lang: "typescript", source: "export class Fixture {}\n"},
+		{name: "java mismatch", path: "src/main/java/app/UserService.java", lang: "java", source: "package app;\n\npublic final class AccountService {\n    public static class Nested {}\n}\n", wantFlag: true},
+		{name: "java match with package-private helper", path: "src/main/java/app/UserService.java", lang: "java", source: "package app;\n\npublic class UserService {}\n\nclass Helper {}\n"},
+		{name: "adapter types are authoritative", path: "src/user-service.ts", lang: "typescript", source: "export class UserService {}\n", types: []model.TypeModel{{Name: "Account", Exported: true, StartLine: 1}}, wantFlag: true},
+		{name: "go is out of scope", path: "internal/user/service.go", lang: "go", source: "package user\n\ntype Account struct{}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: tt.path, Language: tt.lang, Source: []byte(tt.source), Types: tt.types, IsTestFile: tt.name == "ts test file"}
+			got := rule.Check(file, nil, model.RuleConfig{})
+			if tt.wantFlag {
+				assert.Len(t, got, 1)
+				return
+			}
+			assert.Empty(t, got)
+		})
+	}
+}
+
+func TestFilenameMatchesType_ViolationDetails(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "src/UserService.ts", Language: "typescript", Source: []byte("import x from 'y';\n\nexport class AccountService {}\n")}
+	got := (&FilenameMatchesType{}).Check(file, nil, model.RuleConfig{})
+	require.Len(t, got, 1)
+	assert.Equal(t, "File name 'UserService.ts' does not match its exported type AccountService, should be 'account-service.ts'", got[0].Message)
+	assert.Equal(t, 3, got[0].StartLine)
+	assert.Equal(t, "AccountService", got[0].Context.Metadata["type"])
+
+	snake := (&FilenameMatchesType{}).Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"style": StyleSnakeCase}})
+	require.Len(t, snake, 1)
+	assert.Equal(t, "account_service.ts", snake[0].Context.Metadata["expected"])
+}
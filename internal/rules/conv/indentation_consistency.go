@@ -0,0 +1,168 @@
+// indentation_consistency.go — CONV-no-tabs-or-spaces-mismatch: Enforce one indentation style per file.
+package conv
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
+)
+
+// IndentationConsistency flags files whose indentation does not match the expected style.
+type IndentationConsistency struct{}
+
+func (r *IndentationConsistency) ID() string       { return "CONV-no-tabs-or-spaces-mismatch" }
+func (r *IndentationConsistency) Category() string { return "conv" }
+func (r *IndentationConsistency) Description() string {
+	return "Require a single indentation style (tabs or spaces) per file"
+}
+func (r *IndentationConsistency) DefaultSeverity() string   { return "error" }
+func (r *IndentationConsistency) NeedsProjectContext() bool { return false }
+func (r *IndentationConsistency) Why() string {
+	return "Mixed indentation renders differently across editors, breaks diffs, and confuses indentation-sensitive parsers."
+}
+func (r *IndentationConsistency) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "func Run() {\n\tcall()\n    other()\n}",
+		Good:     "func Run() {\n\tcall()\n\tother()\n}",
+	}}
+}
+
+func (r *IndentationConsistency) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+
+	expected := resolveIndentStyle(file.Language, config.Options)
+	width := resolveIndentWidth(config.Options)
+
+	firstLine := 0
+	affected := 0
+	found := ""
+	rewrite.ForEachIndentedLine(file.Source, file.Language, func(lineNo int, leading string, rest string) {
+		if kind := indentMismatch(leading, rest, expected, width); kind != "" {
+			if firstLine == 0 {
+				firstLine = lineNo
+				found = kind
+			}
+			affected++
+		}
+	})
+	if firstLine == 0 {
+		return nil
+	}
+
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	return []model.Violation{
+		{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Line %d indents with %s, expected %s (%d line(s) affected)", firstLine, found, expected, affected),
+			FilePath:  file.Path,
+			StartLine: firstLine,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Re-indent the file using %s.", expected),
+				Metadata: map[string]interface{}{
+					"indent": expected,
+					"width":  width,
+				},
+			},
+		},
+	}
+}
+
+// indentMismatch returns the offending whitespace kind, or "" when the line matches expected.
+func indentMismatch(leading string, rest string, expected string, width int) string {
+	if leading == "" {
+		return ""
+	}
+	if expected == rewrite.IndentSpaces {
+		if strings.Contains(leading, "\t") {
+			return rewrite.IndentTabs
+		}
+		return ""
+	}
+
+	if strings.Contains(leading, " \t") {
+		return rewrite.IndentSpaces
+	}
+	trailingSpaces := len(leading) - len(strings.TrimRight(leading, " "))
+	if !strings.Contains(leading, "\t") {
+		// A single space before '*' continues a block comment; anything else is space indentation.
+		if trailingSpaces == 1 && strings.HasPrefix(rest, "*") {
+			return ""
+		}
+		if trailingSpaces >= 2 {
+			return rewrite.IndentSpaces
+		}
+		return ""
+	}
+	if trailingSpaces >= width {
+		return rewrite.IndentSpaces
+	}
+	return ""
+}
+
+func resolveIndentStyle(language string, options map[string]interface{}) string {
+	lang := normalizeLanguage(language)
+	if options != nil {
+		if raw, ok := options["languages"]; ok {
+			if overrides, ok := toStringMap(raw); ok {
+				if style := normalizeIndentStyle(overrides[lang]); style != "" {
+					return style
+				}
+			}
+		}
+	}
+	if lang == "go" {
+		return rewrite.IndentTabs
+	}
+	if options != nil {
+		if style := normalizeIndentStyle(options["indent"]); style != "" {
+			return style
+		}
+	}
+	return rewrite.IndentSpaces
+}
+
+func normalizeIndentStyle(value interface{}) string {
+	s, ok := value.(string)
+	if !ok {
+		return ""
+	}
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "tab", "tabs":
+		return rewrite.IndentTabs
+	case "space", "spaces":
+		return rewrite.IndentSpaces
+	default:
+		return ""
+	}
+}
+
+func resolveIndentWidth(options map[string]interface{}) int {
+	if options == nil {
+		return rewrite.DefaultIndentWidth
+	}
+	switch v := options["width"].(type) {
+	case int:
+		if v > 0 {
+			return v
+		}
+	case int64:
+		if v > 0 {
+			return int(v)
+		}
+	case float64:
+		if n := int(v); float64(n) == v && n > 0 {
+			return n
+		}
+	}
+	return rewrite.DefaultIndentWidth
+}
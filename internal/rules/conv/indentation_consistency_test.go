@@ -0,0 +1,99 @@
+// indentation_consistency_test.go — Tests for CONV-no-tabs-or-spaces-mismatch rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestIndentationConsistency_InterfaceCompliance(t *testing.T) {
+	rule := &IndentationConsistency{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-no-tabs-or-spaces-mismatch", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "error", rule.DefaultSeverity())
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestIndentationConsistency_Check(t *testing.T) {
+	rule := &IndentationConsistency{}
+
+	tests := []struct {
+		name     string
+		lang     string
+		source   string
+		options  map[string]interface{}
+		wantLine int
+	}{
+		{
+			name:   "go tabs pass",
+			lang:   "go",
+			source: "package a\n\nfunc A() {\n\tif ok {\n\t\treturn\n\t}\n}\n",
+		},
+		{
+			name:     "go space indentation flagged",
+			lang:     "go",
+			source:   "package a\n\nfunc A() {\n\tcall()\n    other()\n}\n",
+			wantLine: 5,
+		},
+		{
+			name:   "go alignment spaces after tabs pass",
+			lang:   "go",
+			source: "package a\n\nvar x = call(a,\n\t  b)\n/*\n * block comment\n */\n",
+		},
+		{
+			name:   "go raw string content ignored",
+			lang:   "go",
+			source: "package a\n\nconst s = `\n    indented text\n`\n",
+		},
+		{
+			name:     "typescript tab flagged",
+			lang:     "typescript",
+			source:   "export function a() {\n  call();\n\tother();\n}\n",
+			wantLine: 3,
+		},
+		{
+			name:    "typescript tabs allowed via indent option",
+			lang:    "typescript",
+			source:  "export function a() {\n\tcall();\n}\n",
+			options: map[string]interface{}{"indent": "tabs"},
+		},
+		{
+			name:     "per-language override beats go default",
+			lang:     "go",
+			source:   "package a\n\nfunc A() {\n\tcall()\n}\n",
+			options:  map[string]interface{}{"languages": map[string]interface{}{"go": "spaces"}},
+			wantLine: 4,
+		},
+		{
+			name:   "indent option does not override go default",
+			lang:   "go",
+			source: "package a\n\nfunc A() {\n\tcall()\n}\n",
+			options: map[string]interface{}{
+				"indent": "spaces",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "file", Language: tt.lang, Source: []byte(tt.source)}
+			got := rule.Check(file, nil, model.RuleConfig{Options: tt.options})
+			if tt.wantLine == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.wantLine, got[0].StartLine)
+			require.NotNil(t, got[0].Context)
+			assert.NotEmpty(t, got[0].Context.Metadata["indent"])
+		})
+	}
+}
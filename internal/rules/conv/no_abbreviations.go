@@ -0,0 +1,168 @@
+// no_abbreviations.go — CONV-no-abbreviations: Spell out words in exported names.
+package conv
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultAbbreviations maps common abbreviations, lower-case, to the word they stand for.
+var defaultAbbreviations = map[string]string{
+	"addr": "address", "arg": "argument", "args": "arguments", "attr": "attribute",
+	"btn": "button", "calc": "calculate", "cfg": "config", "cnt": "count",
+	"conn": "connection", "ctx": "context", "dest": "destination", "idx": "index",
+	"img": "image", "mgr": "manager", "msg": "message", "obj": "object",
+	"param": "parameter", "params": "parameters", "pkg": "package", "prev": "previous",
+	"pwd": "password", "qty": "quantity", "recv": "receive", "req": "request",
+	"res": "response", "resp": "response", "srv": "server", "svc": "service",
+	"tmp": "temporary", "txn": "transaction", "usr": "user", "util": "utility",
+	"val": "value",
+}
+
+// defaultAllowedAbbreviations are abbreviations common enough to keep in public names.
+var defaultAllowedAbbreviations = []string{"cfg", "req", "res", "ctx"}
+
+// NoAbbreviations flags exported Go and TypeScript/JavaScript identifiers that contain a
+// known abbreviation as one of their words (`UserMgr`, `parseCfgFile`). The
+// `abbreviations` option adds to or overrides the expansion map; `allow` replaces the
+// default allowlist.
+type NoAbbreviations struct{}
+
+func (r *NoAbbreviations) ID() string       { return "CONV-no-abbreviations" }
+func (r *NoAbbreviations) Category() string { return "conv" }
+func (r *NoAbbreviations) Description() string {
+	return "Spell out abbreviated words in exported names"
+}
+func (r *NoAbbreviations) DefaultSeverity() string   { return "warn" }
+func (r *NoAbbreviations) NeedsProjectContext() bool { return false }
+func (r *NoAbbreviations) Why() string {
+	return "Exported names are read by every caller; abbreviations save the author a few keystrokes and cost each reader a guess."
+}
+func (r *NoAbbreviations) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "type UserMgr struct{}\nfunc NewSvcClient() *Client",
+		Good:     "type UserManager struct{}\nfunc NewServiceClient() *Client",
+	}}
+}
+
+func (r *NoAbbreviations) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || file.IsTestFile {
+		return nil
+	}
+	language := normalizeLanguage(file.Language)
+	if language != "go" && language != "typescript" && language != "javascript" {
+		return nil
+	}
+
+	abbreviations := resolveAbbreviations(config.Options)
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	seen := map[string]bool{}
+	for _, symbol := range scanExportedSymbols(file) {
+		if symbol.Kind == "default" || seen[symbol.Name] {
+			continue
+		}
+		seen[symbol.Name] = true
+		expanded, found := expandAbbreviations(symbol.Name, abbreviations)
+		if len(found) == 0 {
+			continue
+		}
+		pairs := make([]string, 0, len(found))
+		for _, abbr := range found {
+			pairs = append(pairs, fmt.Sprintf("'%s' (%s)", abbr, abbreviations[abbr]))
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Exported %s %s abbreviates %s, should be %s", symbol.Kind, symbol.Name, strings.Join(pairs, ", "), expanded),
+			FilePath:  file.Path,
+			StartLine: symbol.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Rename %s to %s, or add the abbreviation to `allow` if it is standard in this codebase.", symbol.Name, expanded),
+				Metadata: map[string]interface{}{
+					"symbol":        symbol.Name,
+					"abbreviations": found,
+					"suggested":     expanded,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// resolveAbbreviations merges the `abbreviations` option over the defaults and drops the
+// allowed ones. A configured `allow` list replaces the default allowlist.
+func resolveAbbreviations(options map[string]interface{}) map[string]string {
+	abbreviations := make(map[string]string, len(defaultAbbreviations))
+	for abbr, word := range defaultAbbreviations {
+		abbreviations[abbr] = word
+	}
+	if configured, ok := toStringMap(options["abbreviations"]); ok {
+		for abbr, raw := range configured {
+			if word, ok := raw.(string); ok && strings.TrimSpace(word) != "" {
+				abbreviations[strings.ToLower(strings.TrimSpace(abbr))] = strings.ToLower(strings.TrimSpace(word))
+			}
+		}
+	}
+
+	allow := defaultAllowedAbbreviations
+	if _, ok := options["allow"]; ok {
+		allow = toStringSlice(options["allow"])
+	}
+	for _, abbr := range allow {
+		delete(abbreviations, strings.ToLower(abbr))
+	}
+	return abbreviations
+}
+
+// expandAbbreviations replaces each abbreviated word of name with its expansion, keeping
+// the word's casing and the rest of the name as written ("HTTPSvcMgr" ->
+// "HTTPServiceManager"), and returns the abbreviations it replaced in order.
+func expandAbbreviations(name string, abbreviations map[string]string) (string, []string) {
+	lower := strings.ToLower(name)
+	var out strings.Builder
+	found := make([]string, 0)
+	seen := map[string]bool{}
+	cursor := 0
+	for _, word := range splitIntoWords(name) {
+		idx := strings.Index(lower[cursor:], word)
+		if idx < 0 {
+			continue
+		}
+		start, end := cursor+idx, cursor+idx+len(word)
+		out.WriteString(name[cursor:start])
+		cursor = end
+		expansion, ok := abbreviations[word]
+		if !ok {
+			out.WriteString(name[start:end])
+			continue
+		}
+		out.WriteString(matchWordCase(name[start:end], expansion))
+		if !seen[word] {
+			seen[word] = true
+			found = append(found, word)
+		}
+	}
+	out.WriteString(name[cursor:])
+	return out.String(), found
+}
+
+// matchWordCase spells word in the casing of original: all upper, capitalized, or lower.
+func matchWordCase(original string, word string) string {
+	switch {
+	case len(original) > 1 && strings.ToUpper(original) == original:
+		return strings.ToUpper(word)
+	case unicode.IsUpper([]rune(original)[0]):
+		return capitalize(word)
+	default:
+		return word
+	}
+}
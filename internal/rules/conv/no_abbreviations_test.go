@@ -0,0 +1,95 @@
+// no_abbreviations_test.go — Tests for CONV-no-abbreviations.
+package conv
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoAbbreviationsMetadata(t *testing.T) {
+	rule := &NoAbbreviations{}
+	if rule.ID() != "CONV-no-abbreviations" || rule.Category() != "conv" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestNoAbbreviations(t *testing.T) {
+	tests := []struct {
+		name      string
+		language  string
+		source    string
+		options   map[string]interface{}
+		wantNames []string
+	}{
+		{
+			name:      "go exported names",
+			language:  "go",
+			source:    "package users\n\ntype UserMgr struct{}\n\nfunc NewHTTPSvcClient() {}\n\nfunc newMgr() {}\n\nconst MAX_CONN = 4\n\nfunc HandleReq(ctx Context) {}\n",
+			wantNames: []string{"UserManager", "NewHTTPServiceClient", "MAX_CONNECTION"},
+		},
+		{
+			name:      "typescript exports",
+			language:  "typescript",
+			source:    "export function calcTotal() {}\nexport class OrderSvc {}\nexport const defaultCfg = {};\nfunction tmpHelper() {}\n",
+			wantNames: []string{"calculateTotal", "OrderService"},
+		},
+		{
+			name:      "allow replaces the default allowlist",
+			language:  "go",
+			source:    "package users\n\ntype UserMgr struct{}\n\nfunc HandleReq() {}\n",
+			options:   map[string]interface{}{"allow": []interface{}{"mgr"}},
+			wantNames: []string{"HandleRequest"},
+		},
+		{
+			name:      "abbreviations extend and override the map",
+			language:  "go",
+			source:    "package users\n\nfunc UserSvc() {}\n\nfunc UserAcct() {}\n",
+			options:   map[string]interface{}{"abbreviations": map[string]interface{}{"svc": "servicer", "acct": "account"}},
+			wantNames: []string{"UserServicer", "UserAccount"},
+		},
+		{
+			name:     "words that only contain an abbreviation pass",
+			language: "go",
+			source:   "package users\n\nfunc Validate() {}\n\nfunc Reserve() {}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "src/users/users.go", Language: tt.language, Source: []byte(tt.source)}
+			got := (&NoAbbreviations{}).Check(file, nil, model.RuleConfig{Options: tt.options})
+			names := make([]string, 0, len(got))
+			for _, v := range got {
+				names = append(names, v.Context.Metadata["suggested"].(string))
+			}
+			if len(tt.wantNames) == 0 && len(names) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Fatalf("suggested = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestNoAbbreviationsMessage(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "mgr.go", Language: "go", Source: []byte("package users\n\n// UsrMsgMgr routes messages.\ntype UsrMsgMgr struct{}\n")}
+	got := (&NoAbbreviations{}).Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 4 {
+		t.Fatalf("violations = %+v", got)
+	}
+	want := "Exported type UsrMsgMgr abbreviates 'usr' (user), 'msg' (message), 'mgr' (manager), should be UserMessageManager"
+	if got[0].Message != want {
+		t.Fatalf("message = %q, want %q", got[0].Message, want)
+	}
+	if !reflect.DeepEqual(got[0].Context.Metadata["abbreviations"], []string{"usr", "msg", "mgr"}) {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	test := &model.UnifiedFileModel{Path: "mgr_test.go", Language: "go", IsTestFile: true, Source: file.Source}
+	if got := (&NoAbbreviations{}).Check(test, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("test files are skipped, got %+v", got)
+	}
+}
@@ -0,0 +1,375 @@
+// no_magic_numbers.go — CONV-no-magic-numbers: Flag unexplained numeric literals in Go and JS/TS.
+package conv
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
+)
+
+// defaultAllowedNumbers are literals common enough to never need a name.
+var defaultAllowedNumbers = []string{"0", "1", "-1"}
+
+// jsRecentTokens is how many significant JS tokens are kept to recognize `const NAME: Type =`.
+const jsRecentTokens = 8
+
+// NoMagicNumbers flags numeric literals used outside constant declarations.
+type NoMagicNumbers struct{}
+
+func (r *NoMagicNumbers) ID() string       { return "CONV-no-magic-numbers" }
+func (r *NoMagicNumbers) Category() string { return "conv" }
+func (r *NoMagicNumbers) Description() string {
+	return "Disallow unexplained numeric literals outside constant declarations"
+}
+func (r *NoMagicNumbers) DefaultSeverity() string   { return "warn" }
+func (r *NoMagicNumbers) NeedsProjectContext() bool { return false }
+func (r *NoMagicNumbers) Why() string {
+	return "A named constant documents what a number means and keeps every use in sync when it changes."
+}
+func (r *NoMagicNumbers) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "if retries > 5 {\n\treturn errTooMany\n}",
+		Good:     "const maxRetries = 5\n\nif retries > maxRetries {\n\treturn errTooMany\n}",
+	}}
+}
+
+func (r *NoMagicNumbers) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+	if file.IsTestFile && boolOption(config.Options, "ignoreTests", false) {
+		return nil
+	}
+
+	var literals []numericLiteral
+	switch normalizeLanguage(file.Language) {
+	case "go":
+		literals = scanGoNumericLiterals(file.Source)
+	case "typescript", "javascript":
+		literals = scanJSNumericLiterals(file.Source)
+	default:
+		return nil
+	}
+
+	allowed := resolveAllowedNumbers(config.Options)
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, lit := range literals {
+		value, ok := canonicalNumber(lit.Text)
+		if !ok || allowed[value] {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Magic number %s; name it with a constant", lit.Text),
+			FilePath:    file.Path,
+			StartLine:   lit.Line,
+			StartColumn: lit.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Extract %s into a named constant that says what it means.", lit.Text),
+				Metadata: map[string]interface{}{
+					"value": value,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// numericLiteral is one number outside a constant declaration. Text includes a
+// leading minus sign when the literal is negated.
+type numericLiteral struct {
+	Text   string
+	Line   int
+	Column int
+}
+
+// scanGoNumericLiterals walks the Go token stream and returns numeric literals
+// outside `const` declarations (single or grouped).
+func scanGoNumericLiterals(source []byte) []numericLiteral {
+	fset := token.NewFileSet()
+	tf := fset.AddFile("", fset.Base(), len(source))
+	var s scanner.Scanner
+	s.Init(tf, source, nil, 0)
+
+	literals := make([]numericLiteral, 0)
+	inConst, constDepth := false, 0
+	prev, prevPrev := token.ILLEGAL, token.ILLEGAL
+	var prevPos token.Pos
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		switch {
+		case prev == token.CONST && tok == token.LPAREN:
+			constDepth = 1
+		case tok == token.CONST:
+			inConst = true
+		case constDepth > 0 && tok == token.LPAREN:
+			constDepth++
+		case constDepth > 0 && tok == token.RPAREN:
+			constDepth--
+			if constDepth == 0 {
+				inConst = false
+			}
+		case inConst && constDepth == 0 && tok == token.SEMICOLON:
+			inConst = false
+		case !inConst && (tok == token.INT || tok == token.FLOAT || tok == token.IMAG):
+			text, at := lit, pos
+			if prev == token.SUB && !isGoOperandEnd(prevPrev) {
+				text, at = "-"+lit, prevPos
+			}
+			position := fset.Position(at)
+			literals = append(literals, numericLiteral{Text: text, Line: position.Line, Column: position.Column})
+		}
+		prevPrev, prev, prevPos = prev, tok, pos
+	}
+	return literals
+}
+
+func isGoOperandEnd(tok token.Token) bool {
+	switch tok {
+	case token.IDENT, token.INT, token.FLOAT, token.IMAG, token.CHAR, token.STRING, token.RPAREN, token.RBRACK, token.RBRACE:
+		return true
+	}
+	return false
+}
+
+// scanJSNumericLiterals returns numeric literals outside comments, strings, template
+// literals, regexes, and enum bodies. A literal that is the whole initializer of a
+// `const NAME =` (optionally typed) declaration is already named and is skipped.
+func scanJSNumericLiterals(source []byte) []numericLiteral {
+	literals := make([]numericLiteral, 0)
+	n := len(source)
+	line, lineStart := 1, 0
+	var prevSig byte
+	prevWord := ""
+	// recent holds the last few significant tokens; numbers are recorded as "0" and strings as "'".
+	recent := make([]string, 0, jsRecentTokens)
+	enumDepth, braceDepth := 0, 0
+
+	push := func(tok string) {
+		if len(recent) == jsRecentTokens {
+			recent = append(recent[:0], recent[1:]...)
+		}
+		recent = append(recent, tok)
+	}
+	advanceLines := func(from int, to int) {
+		for k := from; k < to && k < n; k++ {
+			if source[k] == '\n' {
+				line++
+				lineStart = k + 1
+			}
+		}
+	}
+
+	for i := 0; i < n; {
+		c := source[i]
+		switch {
+		case c == '\n':
+			line++
+			lineStart = i + 1
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '/' && i+1 < n && source[i+1] == '/':
+			for i < n && source[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < n && source[i+1] == '*':
+			end := strings.Index(string(source[i+2:]), "*/")
+			stop := n
+			if end >= 0 {
+				stop = i + 2 + end + 2
+			}
+			advanceLines(i, stop)
+			i = stop
+		case c == '/' && (prevSig == 0 || strings.IndexByte(rewrite.JSRegexPreceders, prevSig) >= 0 || rewrite.JSRegexKeywords[prevWord]):
+			i = rewrite.SkipJSRegex(source, i)
+			prevSig, prevWord = '/', ""
+			push("/")
+		case c == '`':
+			stop := rewrite.SkipJSTemplate(source, i)
+			advanceLines(i, stop)
+			i = stop
+			prevSig, prevWord = '`', ""
+			push("`")
+		case c == '\'' || c == '"':
+			end, ok := rewrite.JSStringEnd(source, i)
+			if !ok {
+				end = i + 1
+			}
+			i = end
+			prevSig, prevWord = c, ""
+			push("'")
+		case isJSDigit(c) || (c == '.' && i+1 < n && isJSDigit(source[i+1])):
+			start := i
+			i = jsNumberEnd(source, i)
+			text, col := string(source[start:i]), start-lineStart+1
+			negated := prevSig == '-' && len(recent) >= 2 && !isJSOperandEnd(recent[len(recent)-2])
+			if negated {
+				text, col = "-"+text, col-1
+			}
+			if enumDepth == 0 && !isJSNamedConstant(recent, negated, source[i:]) {
+				literals = append(literals, numericLiteral{Text: text, Line: line, Column: col})
+			}
+			prevSig, prevWord = 'a', ""
+			push("0")
+		case rewrite.IsJSIdentChar(c):
+			start := i
+			for i < n && rewrite.IsJSIdentChar(source[i]) {
+				i++
+			}
+			prevSig, prevWord = 'a', string(source[start:i])
+			push(prevWord)
+		default:
+			switch c {
+			case '{':
+				braceDepth++
+				if enumDepth == 0 && len(recent) >= 2 && recent[len(recent)-2] == "enum" {
+					enumDepth = braceDepth
+				}
+			case '}':
+				if braceDepth == enumDepth {
+					enumDepth = 0
+				}
+				braceDepth--
+			}
+			prevSig, prevWord = c, ""
+			if c == ')' || c == ']' {
+				prevSig = 'a'
+			}
+			push(string(c))
+			i++
+		}
+	}
+	return literals
+}
+
+// isJSNamedConstant reports whether the literal just scanned is the entire initializer
+// of `const NAME = <lit>` or `const NAME: Type = <lit>`.
+func isJSNamedConstant(recent []string, negated bool, rest []byte) bool {
+	end := len(recent)
+	if negated {
+		end--
+	}
+	if end < 3 || recent[end-1] != "=" {
+		return false
+	}
+	head := recent[:end-1]
+	if len(head) >= 4 && head[len(head)-2] == ":" {
+		head = head[:len(head)-2]
+	}
+	if len(head) < 2 || head[len(head)-2] != "const" {
+		return false
+	}
+	next := strings.TrimLeft(string(rest), " \t\r")
+	return next == "" || next[0] == ';' || next[0] == '\n' || next[0] == ',' || strings.HasPrefix(next, "//")
+}
+
+func isJSOperandEnd(tok string) bool {
+	if tok == ")" || tok == "]" || tok == "0" || tok == "'" || tok == "`" {
+		return true
+	}
+	return tok != "" && rewrite.IsJSIdentChar(tok[0]) && !rewrite.JSRegexKeywords[tok]
+}
+
+func jsNumberEnd(source []byte, start int) int {
+	i := start
+	hex := i+1 < len(source) && source[i] == '0' && (source[i+1] == 'x' || source[i+1] == 'X')
+	for i < len(source) {
+		c := source[i]
+		switch {
+		case rewrite.IsJSIdentChar(c) || c == '.':
+			i++
+		case (c == '+' || c == '-') && !hex && (source[i-1] == 'e' || source[i-1] == 'E'):
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+func isJSDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// canonicalNumber normalizes a literal so 0x10, 16, and 16.0 compare equal.
+func canonicalNumber(text string) (string, bool) {
+	negative := strings.HasPrefix(text, "-")
+	raw := strings.ReplaceAll(strings.TrimPrefix(text, "-"), "_", "")
+	raw = strings.TrimSuffix(raw, "n")
+	if strings.HasSuffix(raw, "i") {
+		return text, true
+	}
+	var value float64
+	if i, err := strconv.ParseInt(raw, 0, 64); err == nil {
+		value = float64(i)
+	} else if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		value = f
+	} else {
+		return "", false
+	}
+	if negative {
+		value = -value
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64), true
+}
+
+// resolveAllowedNumbers returns the default allowlist plus the `allow` option, which
+// may be a list or a single value (numbers or numeric strings).
+func resolveAllowedNumbers(options map[string]interface{}) map[string]bool {
+	allowed := map[string]bool{}
+	for _, v := range defaultAllowedNumbers {
+		allowed[v] = true
+	}
+	var items []interface{}
+	switch raw := options["allow"].(type) {
+	case []interface{}:
+		items = raw
+	case []string:
+		for _, v := range raw {
+			items = append(items, v)
+		}
+	case nil:
+	default:
+		items = []interface{}{raw}
+	}
+	for _, item := range items {
+		var text string
+		switch v := item.(type) {
+		case string:
+			text = strings.TrimSpace(v)
+		case int:
+			text = strconv.Itoa(v)
+		case float64:
+			text = strconv.FormatFloat(v, 'g', -1, 64)
+		default:
+			continue
+		}
+		if value, ok := canonicalNumber(text); ok {
+			allowed[value] = true
+		}
+	}
+	return allowed
+}
+
+func boolOption(options map[string]interface{}, key string, fallback bool) bool {
+	if value, ok := options[key].(bool); ok {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,117 @@
+// no_magic_numbers_test.go — Tests for CONV-no-magic-numbers rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoMagicNumbers_InterfaceCompliance(t *testing.T) {
+	rule := &NoMagicNumbers{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-no-magic-numbers", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "warn", rule.DefaultSeverity())
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestNoMagicNumbers_Check(t *testing.T) {
+	rule := &NoMagicNumbers{}
+
+	tests := []struct {
+		name       string
+		lang       string
+		source     string
+		test       bool
+		options    map[string]interface{}
+		wantValues []string
+	}{
+		{
+			name:       "go literals outside const are flagged",
+			lang:       "go",
+			source:     "package a\n\nconst limit = 50\n\nconst (\n\tretries = 3\n\twindow  = time.Duration(30) * time.Second\n)\n\nfunc f(x int) int {\n\tif x > 42 {\n\t\treturn -7\n\t}\n\treturn x - 1 + 0 + 0x10\n}\n",
+			wantValues: []string{"42", "-7", "0x10"},
+		},
+		{
+			name:       "go negated operand is subtraction",
+			lang:       "go",
+			source:     "package a\n\nfunc f(x int) int { return x - 2 }\n",
+			wantValues: []string{"2"},
+		},
+		{
+			name:       "ts named constants, enums, strings, and comments are skipped",
+			lang:       "typescript",
+			source:     "const TIMEOUT_MS: number = 5000;\nenum Port { Http = 80, Https = 443 }\n// 99 bottles\nconst label = \"404\";\nconst x = `n=${7}`;\nexport function f(n: number) {\n  return n * 60 + 1;\n}\n",
+			wantValues: []string{"60"},
+		},
+		{
+			name:       "ts expression initializers are still flagged",
+			lang:       "javascript",
+			source:     "const DAY = 24 * 3600;\nlet offset = -3;\nconst ok = x - 1;\n",
+			wantValues: []string{"24", "3600", "-3"},
+		},
+		{
+			name:       "allow option accepts numbers and equivalent spellings",
+			lang:       "go",
+			source:     "package a\n\nvar port = 8080\nvar mask = 0xff\nvar ratio = 2.5\n",
+			options:    map[string]interface{}{"allow": []interface{}{8080, "255", 2.5}},
+			wantValues: []string{},
+		},
+		{
+			name:    "ignoreTests skips test files",
+			lang:    "go",
+			source:  "package a\n\nvar x = 17\n",
+			test:    true,
+			options: map[string]interface{}{"ignoreTests": true},
+		},
+		{
+			name:       "test files are checked by default",
+			lang:       "go",
+			source:     "package a\n\nvar x = 17\n",
+			test:       true,
+			wantValues: []string{"17"},
+		},
+		{
+			name:   "other languages are ignored",
+			lang:   "python",
+			source: "x = 42\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "src/a", Language: tc.lang, Source: []byte(tc.source), IsTestFile: tc.test}
+			violations := rule.Check(file, nil, model.RuleConfig{Options: tc.options})
+
+			got := make([]string, 0, len(violations))
+			for _, v := range violations {
+				require.NotNil(t, v.Context)
+				got = append(got, v.Message[len("Magic number "):len(v.Message)-len("; name it with a constant")])
+			}
+			want := tc.wantValues
+			if want == nil {
+				want = []string{}
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestNoMagicNumbers_Positions(t *testing.T) {
+	rule := &NoMagicNumbers{}
+	file := &model.UnifiedFileModel{Path: "a.ts", Language: "typescript", Source: []byte("let a = 1;\nif (b > 12) { c(-5); }\n")}
+
+	violations := rule.Check(file, nil, model.RuleConfig{})
+	require.Len(t, violations, 2)
+	assert.Equal(t, 2, violations[0].StartLine)
+	assert.Equal(t, 9, violations[0].StartColumn)
+	assert.Equal(t, 17, violations[1].StartColumn)
+	assert.Equal(t, "-5", violations[1].Context.Metadata["value"])
+}
@@ -0,0 +1,73 @@
+// no_redundant_else.go — CONV-no-redundant-else-after-return: Prefer early returns over else in Go.
+package conv
+
+import (
+	"fmt"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
+)
+
+// NoRedundantElse flags Go `if` blocks that end in a return (or other terminating
+// statement) and are followed by an else block that could be outdented.
+//
+// UnifiedFileModel does not carry statement or block structure, so the rule parses
+// the source with go/parser, like the other Go AST-based rules.
+type NoRedundantElse struct{}
+
+func (r *NoRedundantElse) ID() string       { return "CONV-no-redundant-else-after-return" }
+func (r *NoRedundantElse) Category() string { return "conv" }
+func (r *NoRedundantElse) Description() string {
+	return "Disallow else blocks after an if block that always returns"
+}
+func (r *NoRedundantElse) DefaultSeverity() string   { return "warn" }
+func (r *NoRedundantElse) NeedsProjectContext() bool { return false }
+func (r *NoRedundantElse) Why() string {
+	return "An else after a returning if adds nesting without adding meaning; early returns keep the happy path at the left margin."
+}
+func (r *NoRedundantElse) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "if err != nil {\n\treturn err\n} else {\n\tlog.Print(\"ok\")\n}",
+		Good:     "if err != nil {\n\treturn err\n}\nlog.Print(\"ok\")",
+	}}
+}
+
+func (r *NoRedundantElse) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 || normalizeLanguage(file.Language) != "go" {
+		return nil
+	}
+	fset, elses := rewrite.ScanRedundantElses(file.Source)
+	if len(elses) == 0 {
+		return nil
+	}
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0, len(elses))
+	for _, e := range elses {
+		pos := fset.Position(e.ElsePos)
+		fix := "Remove the else and outdent its block."
+		if !e.Flattenable {
+			fix = "Remove the else and outdent its block; move the if's short variable declaration or the else's declarations so their scope is unchanged."
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("if block ends with a %s statement, so the else is redundant", e.Terminator),
+			FilePath:    file.Path,
+			StartLine:   pos.Line,
+			StartColumn: pos.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fix,
+				Metadata: map[string]interface{}{
+					"terminator":  e.Terminator,
+					"flattenable": e.Flattenable,
+				},
+			},
+		})
+	}
+	return violations
+}
@@ -0,0 +1,96 @@
+// no_redundant_else_test.go — Tests for CONV-no-redundant-else-after-return rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoRedundantElse_InterfaceCompliance(t *testing.T) {
+	rule := &NoRedundantElse{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-no-redundant-else-after-return", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "warn", rule.DefaultSeverity())
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestNoRedundantElse_Check(t *testing.T) {
+	rule := &NoRedundantElse{}
+
+	tests := []struct {
+		name        string
+		source      string
+		wantLines   []int
+		terminator  string
+		flattenable bool
+	}{
+		{
+			name:        "else after return",
+			source:      "package a\n\nfunc A(ok bool) int {\n\tif !ok {\n\t\treturn 0\n\t} else {\n\t\treturn 1\n\t}\n}\n",
+			wantLines:   []int{6},
+			terminator:  "return",
+			flattenable: true,
+		},
+		{
+			name:        "else after continue in loop",
+			source:      "package a\n\nfunc A(xs []int) {\n\tfor _, x := range xs {\n\t\tif x < 0 {\n\t\t\tcontinue\n\t\t} else {\n\t\t\tprintln(x)\n\t\t}\n\t}\n}\n",
+			wantLines:   []int{7},
+			terminator:  "continue",
+			flattenable: true,
+		},
+		{
+			name:        "else after panic",
+			source:      "package a\n\nfunc A(ok bool) {\n\tif !ok {\n\t\tpanic(\"bad\")\n\t} else {\n\t\tprintln(1)\n\t}\n}\n",
+			wantLines:   []int{6},
+			terminator:  "panic",
+			flattenable: true,
+		},
+		{
+			name:        "init statement is reported but not flattenable",
+			source:      "package a\n\nfunc A(m map[string]int) int {\n\tif v, ok := m[\"k\"]; !ok {\n\t\treturn 0\n\t} else {\n\t\treturn v\n\t}\n}\n",
+			wantLines:   []int{6},
+			terminator:  "return",
+			flattenable: false,
+		},
+		{
+			name:   "if without terminating statement",
+			source: "package a\n\nfunc A(ok bool) {\n\tif ok {\n\t\tprintln(1)\n\t} else {\n\t\tprintln(2)\n\t}\n}\n",
+		},
+		{
+			name:   "else-if chain is left alone",
+			source: "package a\n\nfunc A(n int) int {\n\tif n < 0 {\n\t\treturn -1\n\t} else if n == 0 {\n\t\treturn 0\n\t} else {\n\t\treturn 1\n\t}\n}\n",
+		},
+		{
+			name:   "unparseable source",
+			source: "package a\n\nfunc A( {\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "a.go", Language: "go", Source: []byte(tc.source)}
+			violations := rule.Check(file, nil, model.RuleConfig{})
+			require.Len(t, violations, len(tc.wantLines))
+			for i, line := range tc.wantLines {
+				v := violations[i]
+				assert.Equal(t, line, v.StartLine)
+				assert.Equal(t, "warn", v.Severity)
+				assert.Contains(t, v.Message, tc.terminator)
+				assert.Equal(t, tc.flattenable, v.Context.Metadata["flattenable"])
+			}
+		})
+	}
+}
+
+func TestNoRedundantElse_IgnoresOtherLanguages(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "a.ts", Language: "typescript", Source: []byte("if (!ok) {\n  return 0;\n} else {\n  return 1;\n}\n")}
+	assert.Empty(t, (&NoRedundantElse{}).Check(file, nil, model.RuleConfig{}))
+}
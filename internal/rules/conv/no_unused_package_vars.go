@@ -0,0 +1,250 @@
+// no_unused_package_vars.go — CONV-no-unused-package-level-vars: Flag dead package-level vars and consts in Go.
+package conv
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// NoUnusedPackageVars flags Go package-level vars and consts that no file of the
+// package references. The compiler rejects unused locals but not package-level ones.
+//
+// References are tracked across the files of one package: the .go files in the same
+// directory with the same package clause, taken from project context and, for files
+// the run did not load (a --changed run, say), from disk. Identifiers are matched by
+// name, so a local that shadows the declaration counts as a use. Exported names may be
+// used by other packages and are skipped unless `checkExported` is set. A const group
+// using iota counts as used when any of its names is.
+type NoUnusedPackageVars struct{}
+
+func (r *NoUnusedPackageVars) ID() string       { return "CONV-no-unused-package-level-vars" }
+func (r *NoUnusedPackageVars) Category() string { return "conv" }
+func (r *NoUnusedPackageVars) Description() string {
+	return "Disallow package-level vars and consts that the package never references"
+}
+func (r *NoUnusedPackageVars) DefaultSeverity() string   { return "warn" }
+func (r *NoUnusedPackageVars) NeedsProjectContext() bool { return true }
+func (r *NoUnusedPackageVars) Why() string {
+	return "Dead package-level declarations survive compilation, mislead readers about what the package depends on, and keep stale values around."
+}
+func (r *NoUnusedPackageVars) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "var legacyTimeout = 30 * time.Second // nothing reads it\n\nfunc Dial() error { return dial(timeout) }",
+		Good:     "func Dial() error { return dial(timeout) }",
+	}}
+}
+
+func (r *NoUnusedPackageVars) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 || normalizeLanguage(file.Language) != "go" {
+		return nil
+	}
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	decls := packageLevelValues(parsed, boolOption(config.Options, "checkExported", false))
+	if len(decls) == 0 {
+		return nil
+	}
+
+	used := map[string]bool{}
+	markIdentUses(parsed, used)
+	siblings, ok := goPackageSiblings(file, parsed.Name.Name, ctx)
+	if !ok {
+		return nil
+	}
+	for _, sibling := range siblings {
+		markIdentUses(sibling, used)
+	}
+
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+	violations := make([]model.Violation, 0)
+	for _, group := range decls {
+		groupUsed := false
+		for _, d := range group {
+			groupUsed = groupUsed || used[d.Ident.Name]
+		}
+		for _, d := range group {
+			if used[d.Ident.Name] || (d.Iota && groupUsed) {
+				continue
+			}
+			pos := fset.Position(d.Ident.Pos())
+			violations = append(violations, model.Violation{
+				RuleID:      r.ID(),
+				Severity:    severity,
+				Message:     fmt.Sprintf("Package-level %s '%s' is never referenced in package %s", d.Kind, d.Ident.Name, parsed.Name.Name),
+				FilePath:    file.Path,
+				StartLine:   pos.Line,
+				StartColumn: pos.Column,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Remove %s, or use it where it was meant to be used.", d.Ident.Name),
+					Metadata: map[string]interface{}{
+						"name":    d.Ident.Name,
+						"kind":    d.Kind,
+						"package": parsed.Name.Name,
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// packageValue is one name declared by a package-level var or const spec.
+type packageValue struct {
+	Ident *ast.Ident
+	Kind  string
+	Iota  bool
+}
+
+// packageLevelValues returns the candidate declarations grouped by GenDecl. Blank
+// names, exported names (unless checkExported), and declarations carrying a `//go:`
+// directive such as go:linkname are left out.
+func packageLevelValues(parsed *ast.File, checkExported bool) [][]packageValue {
+	groups := make([][]packageValue, 0)
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.VAR && gen.Tok != token.CONST) || hasGoDirective(gen.Doc) {
+			continue
+		}
+		usesIota := gen.Tok == token.CONST && gen.Lparen.IsValid() && mentionsIota(gen)
+		group := make([]packageValue, 0)
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if hasGoDirective(vs.Doc) {
+				continue
+			}
+			for _, name := range vs.Names {
+				if name.Name == "_" || (name.IsExported() && !checkExported) {
+					continue
+				}
+				group = append(group, packageValue{Ident: name, Kind: gen.Tok.String(), Iota: usesIota})
+			}
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func hasGoDirective(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		if strings.HasPrefix(c.Text, "//go:") {
+			return true
+		}
+	}
+	return false
+}
+
+func mentionsIota(gen *ast.GenDecl) bool {
+	found := false
+	ast.Inspect(gen, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == "iota" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// markIdentUses records every identifier in parsed that can refer to a package-level
+// value: declaring names and selector fields (`x.Name`) are skipped.
+func markIdentUses(parsed *ast.File, used map[string]bool) {
+	declaring := map[*ast.Ident]bool{}
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok {
+				for _, name := range vs.Names {
+					declaring[name] = true
+				}
+			}
+		}
+	}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.SelectorExpr:
+			ast.Inspect(node.X, func(inner ast.Node) bool {
+				if ident, ok := inner.(*ast.Ident); ok && !declaring[ident] {
+					used[ident.Name] = true
+				}
+				return true
+			})
+			return false
+		case *ast.Ident:
+			if !declaring[node] {
+				used[node.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+// goPackageSiblings parses the other files of file's package: project-context files
+// first, then any remaining .go files in the directory on disk. ok is false when a
+// sibling does not parse, since its references are then unknown.
+func goPackageSiblings(file *model.UnifiedFileModel, pkg string, ctx *model.ProjectContext) ([]*ast.File, bool) {
+	dir := filepath.Clean(filepath.Dir(file.Path))
+	self := filepath.Clean(file.Path)
+	sources := map[string][]byte{}
+	if ctx != nil {
+		for p, f := range ctx.Files {
+			if f != nil && normalizeLanguage(f.Language) == "go" && filepath.Clean(filepath.Dir(p)) == dir {
+				sources[filepath.Clean(p)] = f.Source
+			}
+		}
+	}
+	if entries, err := os.ReadDir(dir); err == nil {
+		for _, entry := range entries {
+			p := filepath.Join(dir, entry.Name())
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+				continue
+			}
+			if _, ok := sources[p]; ok {
+				continue
+			}
+			if data, err := os.ReadFile(p); err == nil {
+				sources[p] = data
+			}
+		}
+	}
+	delete(sources, self)
+
+	paths := make([]string, 0, len(sources))
+	for p := range sources {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	siblings := make([]*ast.File, 0, len(paths))
+	fset := token.NewFileSet()
+	for _, p := range paths {
+		parsed, err := parser.ParseFile(fset, p, sources[p], parser.SkipObjectResolution)
+		if err != nil {
+			return nil, false
+		}
+		if parsed.Name.Name == pkg {
+			siblings = append(siblings, parsed)
+		}
+	}
+	return siblings, true
+}
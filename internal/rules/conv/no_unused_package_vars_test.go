@@ -0,0 +1,83 @@
+// no_unused_package_vars_test.go — Tests for CONV-no-unused-package-level-vars rule.
+package conv
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoUnusedPackageVars_InterfaceCompliance(t *testing.T) {
+	rule := &NoUnusedPackageVars{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-no-unused-package-level-vars", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "warn", rule.DefaultSeverity())
+	assert.True(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestNoUnusedPackageVars_Check(t *testing.T) {
+	dir := t.TempDir()
+	declPath := filepath.Join(dir, "config.go")
+	decl := &model.UnifiedFileModel{Path: declPath, Language: "go", Source: []byte(`package dial
+
+import "time"
+
+var (
+	timeout       = 10 * time.Second
+	legacyTimeout = 30 * time.Second
+	retries       = 3
+)
+
+const maxConns = 8
+
+const (
+	modeA = iota
+	modeB
+)
+
+var _ = 0
+
+var DefaultPort = 443
+
+//go:linkname runtimeNano runtime.nanotime
+var runtimeNano func() int64
+`)}
+	user := &model.UnifiedFileModel{Path: filepath.Join(dir, "dial.go"), Language: "go", Source: []byte(`package dial
+
+func Dial() error { return dial(timeout, modeA) }
+`)}
+	// Read from disk because the run did not load it.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "retry.go"), []byte("package dial\n\nfunc retry() int { return retries }\n"), 0o644))
+	// A different package in the same directory does not count.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dial_ext_test.go"), []byte("package dial_test\n\nvar _ = maxConns\n"), 0o644))
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{decl.Path: decl, user.Path: user}}
+
+	got := (&NoUnusedPackageVars{}).Check(decl, ctx, model.RuleConfig{})
+	require.Len(t, got, 2)
+	assert.Equal(t, 7, got[0].StartLine)
+	assert.Equal(t, "Package-level var 'legacyTimeout' is never referenced in package dial", got[0].Message)
+	assert.Equal(t, 11, got[1].StartLine)
+	assert.Equal(t, "const", got[1].Context.Metadata["kind"])
+
+	exported := (&NoUnusedPackageVars{}).Check(decl, ctx, model.RuleConfig{Options: map[string]interface{}{"checkExported": true}})
+	require.Len(t, exported, 3)
+	assert.Equal(t, "DefaultPort", exported[2].Context.Metadata["name"])
+}
+
+func TestNoUnusedPackageVars_SkipsWhenSiblingDoesNotParse(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.go"), []byte("package dial\n\nfunc broken( {\n"), 0o644))
+	file := &model.UnifiedFileModel{Path: filepath.Join(dir, "config.go"), Language: "go", Source: []byte("package dial\n\nvar unused = 1\n")}
+
+	got := (&NoUnusedPackageVars{}).Check(file, nil, model.RuleConfig{})
+	assert.Empty(t, got)
+}
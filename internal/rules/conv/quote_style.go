@@ -0,0 +1,84 @@
+// quote_style.go — CONV-consistent-quote-style: Enforce one string quote style in JS/TS.
+package conv
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
+)
+
+// QuoteStyle flags JS/TS string literals that do not use the configured quote character.
+type QuoteStyle struct{}
+
+func (r *QuoteStyle) ID() string       { return "CONV-consistent-quote-style" }
+func (r *QuoteStyle) Category() string { return "conv" }
+func (r *QuoteStyle) Description() string {
+	return "Require string literals to use the configured quote style (single or double)"
+}
+func (r *QuoteStyle) DefaultSeverity() string   { return "error" }
+func (r *QuoteStyle) NeedsProjectContext() bool { return false }
+func (r *QuoteStyle) Why() string {
+	return "Mixed quote styles churn diffs and make search-and-replace unreliable."
+}
+func (r *QuoteStyle) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "import { api } from \"./api\";\nconst label = 'ok';",
+		Good:     "import { api } from './api';\nconst label = 'ok';",
+	}}
+}
+
+func (r *QuoteStyle) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+	switch normalizeLanguage(file.Language) {
+	case "typescript", "javascript":
+	default:
+		return nil
+	}
+
+	quote := resolveQuoteStyle(config.Options)
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, lit := range rewrite.ScanJSStringLiterals(file.Source, rewrite.IsJSXPath(file.Path)) {
+		if !rewrite.QuoteNeedsSwap(file.Source, lit, quote) {
+			continue
+		}
+		found := rewrite.QuoteSingle
+		if lit.Quote == '"' {
+			found = rewrite.QuoteDouble
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("String literal uses %s quotes, expected %s quotes", found, quote),
+			FilePath:    file.Path,
+			StartLine:   lit.Line,
+			StartColumn: lit.Column,
+			Context: &model.ViolationContext{
+				Snippet:      string(file.Source[lit.Start:lit.End]),
+				SuggestedFix: fmt.Sprintf("Rewrite as %s.", rewrite.SwapQuotes(file.Source[lit.Start:lit.End], rewrite.QuoteChar(quote))),
+				Metadata: map[string]interface{}{
+					"quote": quote,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+func resolveQuoteStyle(options map[string]interface{}) string {
+	if options != nil {
+		if raw, ok := options["quote"].(string); ok && strings.EqualFold(strings.TrimSpace(raw), rewrite.QuoteDouble) {
+			return rewrite.QuoteDouble
+		}
+	}
+	return rewrite.QuoteSingle
+}
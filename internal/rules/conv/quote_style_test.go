@@ -0,0 +1,112 @@
+// quote_style_test.go — Tests for CONV-consistent-quote-style rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestQuoteStyle_InterfaceCompliance(t *testing.T) {
+	rule := &QuoteStyle{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-consistent-quote-style", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "error", rule.DefaultSeverity())
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestQuoteStyle_Check(t *testing.T) {
+	rule := &QuoteStyle{}
+
+	tests := []struct {
+		name      string
+		path      string
+		lang      string
+		source    string
+		options   map[string]interface{}
+		wantLines []int
+	}{
+		{
+			name:   "single quotes pass by default",
+			path:   "src/a.ts",
+			lang:   "typescript",
+			source: "import x from 'x';\nconst a = 'b';\n",
+		},
+		{
+			name:      "double quotes flagged by default",
+			path:      "src/a.ts",
+			lang:      "typescript",
+			source:    "import x from \"x\";\nconst a = 'b';\nconst c = \"d\";\n",
+			wantLines: []int{1, 3},
+		},
+		{
+			name:      "configured double flags single",
+			path:      "src/a.js",
+			lang:      "javascript",
+			source:    "const a = 'b';\n",
+			options:   map[string]interface{}{"quote": "double"},
+			wantLines: []int{1},
+		},
+		{
+			name:   "string containing preferred quote is left alone",
+			path:   "src/a.ts",
+			lang:   "typescript",
+			source: "const a = \"it's\";\n",
+		},
+		{
+			name:   "comments, templates, and regexes ignored",
+			path:   "src/a.ts",
+			lang:   "typescript",
+			source: "// say \"hi\"\n/* \"block\" */\nconst t = `a \"b\" ${x}`;\nconst r = /\"q\"/g;\n",
+		},
+		{
+			name:      "division is not a regex",
+			path:      "src/a.ts",
+			lang:      "typescript",
+			source:    "const half = total / 2; const s = \"x\"; const q = n / 3;\n",
+			wantLines: []int{1},
+		},
+		{
+			name:   "jsx attributes ignored",
+			path:   "src/Button.tsx",
+			lang:   "typescript",
+			source: "export const B = () => <button type=\"submit\">Don't</button>;\n",
+		},
+		{
+			name:   "non js languages ignored",
+			path:   "main.go",
+			lang:   "go",
+			source: "package main\n\nvar s = \"x\"\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: tc.path, Language: tc.lang, Source: []byte(tc.source)}
+			got := rule.Check(file, nil, model.RuleConfig{Options: tc.options})
+			require.Len(t, got, len(tc.wantLines))
+			for i, v := range got {
+				assert.Equal(t, tc.wantLines[i], v.StartLine)
+				assert.Greater(t, v.StartColumn, 0)
+				require.NotNil(t, v.Context)
+				assert.Equal(t, resolveQuoteStyle(tc.options), v.Context.Metadata["quote"])
+			}
+		})
+	}
+}
+
+func TestQuoteStyle_ReportsColumn(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "a.ts", Language: "typescript", Source: []byte("let a = 1;\nconst b = \"c\";\n")}
+	got := (&QuoteStyle{}).Check(file, nil, model.RuleConfig{})
+	require.Len(t, got, 1)
+	assert.Equal(t, 2, got[0].StartLine)
+	assert.Equal(t, 11, got[0].StartColumn)
+	assert.Equal(t, "Rewrite as 'c'.", got[0].Context.SuggestedFix)
+}
@@ -23,6 +23,13 @@ func (r *RequiredExports) NeedsProjectContext() bool { return false }
 func (r *RequiredExports) Why() string {
 	return "Missing required exports break module contracts and cause integration failures."
 }
+func (r *RequiredExports) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// plugin module missing 'register()' function",
+		Good:     "export function register(app: App) {}",
+	}}
+}
 
 func (r *RequiredExports) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	if file == nil {
@@ -0,0 +1,105 @@
+// struct_field_alignment.go — CONV-struct-field-alignment: Order Go struct fields to minimise padding.
+package conv
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+	"github.com/stricture/stricture/internal/rewrite"
+)
+
+// defaultMinAlignmentSavings is the padding, in bytes, a reorder must save to be
+// reported: one machine word.
+const defaultMinAlignmentSavings = 8
+
+// StructFieldAlignment flags Go structs whose field order wastes at least `minSavings`
+// bytes (default 8) of padding compared with ordering fields by decreasing alignment,
+// and reports that order. Sizes are those of 64-bit platforms, computed from the field
+// types: predeclared types, pointers, slices, maps, arrays, interfaces, structs
+// declared in the same file, and a few standard library types. Structs with a field of
+// any other type are skipped.
+//
+// TypeModel lists field type names but not the underlying types of local named types
+// or field positions, so the rule parses the source with go/parser, like the other Go
+// AST-based rules. `strict fix` reorders the fields.
+type StructFieldAlignment struct{}
+
+func (r *StructFieldAlignment) ID() string       { return "CONV-struct-field-alignment" }
+func (r *StructFieldAlignment) Category() string { return "conv" }
+func (r *StructFieldAlignment) Description() string {
+	return "Order Go struct fields to avoid wasted padding"
+}
+func (r *StructFieldAlignment) DefaultSeverity() string   { return "warn" }
+func (r *StructFieldAlignment) NeedsProjectContext() bool { return false }
+func (r *StructFieldAlignment) Stability() string         { return model.StabilityExperimental }
+func (r *StructFieldAlignment) Why() string {
+	return "Padding between badly ordered fields is paid by every value: in large slices, maps, and caches it adds up to memory and cache misses for nothing."
+}
+func (r *StructFieldAlignment) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "type Entry struct {\n\tActive bool\n\tID     int64\n\tHot    bool\n}",
+		Good:     "type Entry struct {\n\tID     int64\n\tActive bool\n\tHot    bool\n}",
+	}}
+}
+
+func (r *StructFieldAlignment) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 || normalizeLanguage(file.Language) != "go" {
+		return nil
+	}
+	fset, structs := rewrite.ScanStructAlignments(file.Source)
+	if len(structs) == 0 {
+		return nil
+	}
+	minSavings := resolveMinAlignmentSavings(config)
+	severity := config.Severity
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, s := range structs {
+		saved := s.Size - s.OptimalSize
+		if saved < minSavings {
+			continue
+		}
+		pos := fset.Position(s.Pos)
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("struct %s is %d bytes but would be %d with its fields reordered, saving %d bytes of padding", s.Name, s.Size, s.OptimalSize, saved),
+			FilePath:    file.Path,
+			StartLine:   pos.Line,
+			StartColumn: pos.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Order the fields of %s by decreasing alignment: %s.", s.Name, strings.Join(s.Order, ", ")),
+				Metadata: map[string]interface{}{
+					"struct":      s.Name,
+					"size":        s.Size,
+					"optimalSize": s.OptimalSize,
+					"order":       s.Order,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+func resolveMinAlignmentSavings(config model.RuleConfig) int64 {
+	switch v := config.Options["minSavings"].(type) {
+	case int:
+		if v > 0 {
+			return int64(v)
+		}
+	case int64:
+		if v > 0 {
+			return v
+		}
+	case float64:
+		if n := int64(v); float64(n) == v && n > 0 {
+			return n
+		}
+	}
+	return defaultMinAlignmentSavings
+}
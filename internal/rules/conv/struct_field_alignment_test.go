@@ -0,0 +1,93 @@
+// struct_field_alignment_test.go — Tests for CONV-struct-field-alignment rule.
+package conv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestStructFieldAlignment_InterfaceCompliance(t *testing.T) {
+	rule := &StructFieldAlignment{}
+	var _ model.Rule = rule
+
+	assert.Equal(t, "CONV-struct-field-alignment", rule.ID())
+	assert.Equal(t, "conv", rule.Category())
+	assert.Equal(t, "warn", rule.DefaultSeverity())
+	assert.Equal(t, model.StabilityExperimental, model.RuleStability(rule))
+	assert.False(t, rule.NeedsProjectContext())
+	assert.NotEmpty(t, rule.Description())
+	assert.NotEmpty(t, rule.Why())
+}
+
+func TestStructFieldAlignment_Check(t *testing.T) {
+	rule := &StructFieldAlignment{}
+
+	tests := []struct {
+		name    string
+		source  string
+		structs []string
+		sizes   [][2]int64
+	}{
+		{
+			name:    "bools around an int64",
+			source:  "package a\n\ntype Entry struct {\n\tActive bool\n\tID     int64\n\tHot    bool\n}\n",
+			structs: []string{"Entry"},
+			sizes:   [][2]int64{{24, 16}},
+		},
+		{
+			name:    "local named types and grouped names",
+			source:  "package a\n\ntype Flags uint8\n\ntype Point struct{ X, Y int32 }\n\ntype Shape struct {\n\tA, B  Flags\n\tName  string\n\tC     Flags\n\tAt    Point\n\tD     bool\n\tTags  []string\n}\n",
+			structs: []string{"Shape"},
+			sizes:   [][2]int64{{64, 56}},
+		},
+		{
+			name:   "already ordered",
+			source: "package a\n\ntype Entry struct {\n\tID     int64\n\tName   string\n\tActive bool\n\tHot    bool\n}\n",
+		},
+		{
+			name:   "saving below the threshold",
+			source: "package a\n\ntype Small struct {\n\tA bool\n\tB int32\n\tC bool\n}\n",
+		},
+		{
+			name:   "unknown field type",
+			source: "package a\n\nimport \"net/http\"\n\ntype Client struct {\n\tOK   bool\n\tHTTP http.Client\n\tN    int64\n\tDone bool\n}\n",
+		},
+		{
+			name:   "unparseable source",
+			source: "package a\n\ntype Entry struct {\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "a.go", Language: "go", Source: []byte(tc.source)}
+			violations := rule.Check(file, nil, model.RuleConfig{})
+			require.Len(t, violations, len(tc.structs))
+			for i, name := range tc.structs {
+				v := violations[i]
+				assert.Equal(t, name, v.Context.Metadata["struct"])
+				assert.Equal(t, tc.sizes[i][0], v.Context.Metadata["size"])
+				assert.Equal(t, tc.sizes[i][1], v.Context.Metadata["optimalSize"])
+			}
+		})
+	}
+}
+
+func TestStructFieldAlignment_MessageAndOptions(t *testing.T) {
+	source := "package a\n\ntype Entry struct {\n\tActive bool\n\tID     int64\n\tHot    bool\n}\n"
+	file := &model.UnifiedFileModel{Path: "a.go", Language: "go", Source: []byte(source)}
+	rule := &StructFieldAlignment{}
+
+	violations := rule.Check(file, nil, model.RuleConfig{})
+	require.Len(t, violations, 1)
+	assert.Equal(t, 3, violations[0].StartLine)
+	assert.Equal(t, "struct Entry is 24 bytes but would be 16 with its fields reordered, saving 8 bytes of padding", violations[0].Message)
+	assert.Equal(t, []string{"ID", "Active", "Hot"}, violations[0].Context.Metadata["order"])
+
+	assert.Empty(t, rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"minSavings": 16}}))
+	assert.Len(t, rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"minSavings": float64(4)}}), 1)
+}
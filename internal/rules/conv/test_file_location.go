@@ -36,6 +36,13 @@ func (r *TestFileLocation) NeedsProjectContext() bool { return false }
 func (r *TestFileLocation) Why() string {
 	return "Scattered test files make test discovery and coverage analysis unreliable."
 }
+func (r *TestFileLocation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "src/user-service.test.ts (tests in src/)",
+		Good:     "test/user-service.test.ts (tests in test/)",
+	}}
+}
 
 func (r *TestFileLocation) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	if file == nil {
@@ -0,0 +1,218 @@
+// dual_test_lineage.go — CTR-dual-test: Check lineage contract tests across repositories.
+package ctr
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stricture/stricture/internal/lineage"
+	"github.com/stricture/stricture/internal/model"
+)
+
+// lineageContractTestPattern finds contract test IDs written out in an annotation, under
+// the canonical key or its aliases. IDs the parser derives for annotations that omit
+// the key are not declarations and are not checked.
+var lineageContractTestPattern = regexp.MustCompile(`\b(?:contract_test_id|contract_test|test_id)=(?:"([^"]*)"|'([^']*)'|(\S+))`)
+
+var (
+	lineageArtifactCacheMu sync.Mutex
+	lineageArtifactCache   = map[string]lineageArtifactEntry{}
+)
+
+// lineageArtifactEntry is a loaded artifact, or its load error, with the modification
+// time and size of the file it was read from. A file that changes is loaded again.
+type lineageArtifactEntry struct {
+	ModTime  time.Time
+	Size     int64
+	Artifact lineage.Artifact
+	Err      error
+}
+
+// lineageArtifactFailure is an artifact named by `lineageArtifact` that did not load.
+type lineageArtifactFailure struct {
+	Path string
+	Err  error
+}
+
+// lineageViolations flags lineage annotations in file whose explicit contract_test_id
+// is neither referenced by a test file in the project nor declared by another field in
+// the artifacts named by the `lineageArtifact` option. When producer and consumer live
+// in separate repositories, the producer's artifact is where the other side's contract
+// test is declared. Without the option nothing is checked. An artifact that fails to
+// load is reported as a warning on each file with explicit contract test IDs; when none
+// loads, only the warnings are reported.
+func (r *DualTest) lineageViolations(file *model.UnifiedFileModel, ctx *model.ProjectContext, severity string, options map[string]interface{}) []model.Violation {
+	paths := lineageArtifactPaths(options)
+	if len(paths) == 0 {
+		return nil
+	}
+	annotations, _ := lineage.Parse(file.Source)
+	if len(annotations) == 0 {
+		return nil
+	}
+	explicit := map[string]bool{}
+	for _, m := range lineageContractTestPattern.FindAllSubmatch(file.Source, -1) {
+		explicit[string(bytes.Join(m[1:], nil))] = true
+	}
+	firstLine := 0
+	for _, a := range annotations {
+		if id := strings.TrimSpace(a.ContractTestID); id != "" && explicit[id] {
+			firstLine = a.Line
+			break
+		}
+	}
+	if firstLine == 0 {
+		return nil
+	}
+
+	artifacts, failures := loadLineageArtifacts(paths)
+	violations := make([]model.Violation, 0)
+	for _, failure := range failures {
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  "warn",
+			Message:   fmt.Sprintf("Lineage artifact %s could not be loaded, so contract tests declared in other repositories were not checked: %v", failure.Path, failure.Err),
+			FilePath:  file.Path,
+			StartLine: firstLine,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Fix the lineageArtifact path %s or regenerate the artifact.", failure.Path),
+				Metadata: map[string]interface{}{
+					"artifact": failure.Path,
+				},
+			},
+		})
+	}
+	if len(artifacts) == 0 {
+		return violations
+	}
+
+	filePath := filepath.ToSlash(file.Path)
+	for _, a := range annotations {
+		id := strings.TrimSpace(a.ContractTestID)
+		if id == "" || !explicit[id] || lineageTestReferenced(ctx, id) || lineageTestDeclared(artifacts, id, filePath) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Field '%s' (from %s) names contract test %s, but no test file references it and no lineage artifact declares it", a.Field, a.SourceSystem, id),
+			FilePath:  file.Path,
+			StartLine: a.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Add a contract test that references %s, or regenerate the %s lineage artifact so it declares the test.", id, a.SourceSystem),
+				Metadata: map[string]interface{}{
+					"field":          a.Field,
+					"fieldId":        a.FieldID,
+					"contractTestId": id,
+					"producer":       a.SourceSystem,
+					"consumers":      lineageConsumers(artifacts, a.FieldID),
+					"artifacts":      paths,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// lineageTestReferenced reports whether a test file in the project mentions id.
+func lineageTestReferenced(ctx *model.ProjectContext, id string) bool {
+	needle := []byte(id)
+	for _, f := range ctx.Files {
+		if f != nil && f.IsTestFile && bytes.Contains(f.Source, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// lineageTestDeclared reports whether any artifact field outside filePath carries id.
+// Fields from filePath itself are skipped, so an artifact built from this repository
+// does not vouch for the annotation being checked.
+func lineageTestDeclared(artifacts []lineage.Artifact, id string, filePath string) bool {
+	for _, artifact := range artifacts {
+		for _, field := range artifact.Fields {
+			if field.ContractTestID == id && filepath.ToSlash(field.FilePath) != filePath {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lineageConsumers lists, sorted, the files that annotate fieldID in the artifacts:
+// the services that consume the field.
+func lineageConsumers(artifacts []lineage.Artifact, fieldID string) []string {
+	seen := map[string]bool{}
+	for _, artifact := range artifacts {
+		for _, field := range artifact.Fields {
+			if field.FieldID == fieldID && field.FilePath != "" {
+				seen[filepath.ToSlash(field.FilePath)] = true
+			}
+		}
+	}
+	consumers := make([]string, 0, len(seen))
+	for p := range seen {
+		consumers = append(consumers, p)
+	}
+	sort.Strings(consumers)
+	return consumers
+}
+
+// lineageArtifactPaths reads the `lineageArtifact` option, a path or a list of paths.
+func lineageArtifactPaths(options map[string]interface{}) []string {
+	var raw []interface{}
+	switch value := options["lineageArtifact"].(type) {
+	case string:
+		raw = []interface{}{value}
+	case []interface{}:
+		raw = value
+	case []string:
+		for _, v := range value {
+			raw = append(raw, v)
+		}
+	}
+	paths := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok && strings.TrimSpace(s) != "" {
+			paths = append(paths, strings.TrimSpace(s))
+		}
+	}
+	return paths
+}
+
+// loadLineageArtifacts loads each artifact with lineage.LoadArtifact and returns those
+// that loaded and the paths that did not. Results are cached per path until the file's
+// modification time or size changes.
+func loadLineageArtifacts(paths []string) ([]lineage.Artifact, []lineageArtifactFailure) {
+	lineageArtifactCacheMu.Lock()
+	defer lineageArtifactCacheMu.Unlock()
+	artifacts := make([]lineage.Artifact, 0, len(paths))
+	failures := make([]lineageArtifactFailure, 0)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			delete(lineageArtifactCache, path)
+			failures = append(failures, lineageArtifactFailure{Path: path, Err: err})
+			continue
+		}
+		entry, ok := lineageArtifactCache[path]
+		if !ok || !entry.ModTime.Equal(info.ModTime()) || entry.Size != info.Size() {
+			artifact, err := lineage.LoadArtifact(path)
+			entry = lineageArtifactEntry{ModTime: info.ModTime(), Size: info.Size(), Artifact: artifact, Err: err}
+			lineageArtifactCache[path] = entry
+		}
+		if entry.Err != nil {
+			failures = append(failures, lineageArtifactFailure{Path: path, Err: entry.Err})
+			continue
+		}
+		artifacts = append(artifacts, entry.Artifact)
+	}
+	return artifacts, failures
+}
@@ -2,12 +2,25 @@
 package ctr
 
 import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
 // DualTest implements the CTR-dual-test rule.
+//
+// Contract pairs are the same-named types that CTR-shared-type-sync pairs: an exported
+// Go struct and a TypeScript interface or object type. When a test in one language
+// references the contract and no test in the other does, the untested side's
+// declaration is flagged with the test file it is expected to have.
+//
+// With the `lineageArtifact` option, lineage annotations that name a contract test are
+// also checked across repositories; see dual_test_lineage.go.
 type DualTest struct{}
 
 func (r *DualTest) ID() string          { return "CTR-dual-test" }
@@ -16,31 +29,165 @@ func (r *DualTest) Description() string { return "Require mirrored contract scen
 func (r *DualTest) Why() string {
 	return "Contract tests must exist on both producer and consumer sides."
 }
+func (r *DualTest) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "server tests 'empty array' case, client does not",
+		Good:     "both server and client test 'empty array' case",
+	}}
+}
 func (r *DualTest) DefaultSeverity() string   { return "error" }
-func (r *DualTest) NeedsProjectContext() bool { return false }
-
-func (r *DualTest) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *DualTest) NeedsProjectContext() bool { return true }
+func (r *DualTest) ReadsExternalInputs() bool { return true }
 
+func (r *DualTest) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Contract 'UserSearch' has test scenario 'empty_query' on server but not client"
-	return []model.Violation{
-		{
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Contract 'UserSearch' has test scenario 'empty_query' on server but not client",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add mirrored contract scenario coverage on both client and server.",
+				},
+			},
+		}
+	}
+
+	if file == nil || ctx == nil || file.IsTestFile {
+		return nil
+	}
+	violations := r.sharedTypeViolations(file, ctx, severity)
+	return append(violations, r.lineageViolations(file, ctx, severity, config.Options)...)
+}
+
+// sharedTypeViolations flags contract types of file that are tested only on the other
+// side of a Go/TypeScript pair.
+func (r *DualTest) sharedTypeViolations(file *model.UnifiedFileModel, ctx *model.ProjectContext, severity string) []model.Violation {
+	var own []sharedType
+	var otherLanguage string
+	switch {
+	case strings.EqualFold(file.Language, "go"):
+		own, otherLanguage = goSharedTypes(file), "typescript"
+	case strings.EqualFold(file.Language, "typescript"):
+		own, otherLanguage = tsSharedTypes(filepath.ToSlash(file.Path), string(file.Source)), "go"
+	default:
+		return nil
+	}
+	if len(own) == 0 {
+		return nil
+	}
+	others := projectSharedTypes(ctx, otherLanguage)
+
+	violations := make([]model.Violation, 0)
+	for _, contract := range own {
+		counterparts := others[contract.Name]
+		if len(counterparts) == 0 || len(contractTests(ctx, file.Language, contract.Name)) > 0 {
+			continue
+		}
+		tested := contractTests(ctx, otherLanguage, contract.Name)
+		if len(tested) == 0 {
+			continue
+		}
+		ownSide, otherSide := dualTestSideLabel(file.Language), dualTestSideLabel(otherLanguage)
+		expected := expectedDualTestPath(file.Path, file.Language, ctx)
+		violations = append(violations, model.Violation{
 			RuleID:    r.ID(),
 			Severity:  severity,
-			Message:   message,
+			Message:   fmt.Sprintf("Contract '%s' is tested on the %s side (%s) but not the %s side; expected a test at %s", contract.Name, otherSide, tested[0], ownSide, expected),
 			FilePath:  file.Path,
-			StartLine: line,
+			StartLine: contract.Line,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Add mirrored contract scenario coverage on both client and server.",
+				SuggestedFix: fmt.Sprintf("Add %s exercising '%s' with the same scenarios as %s.", expected, contract.Name, tested[0]),
+				Metadata: map[string]interface{}{
+					"contract":     contract.Name,
+					"testedSide":   strings.ToLower(otherLanguage),
+					"missingSide":  strings.ToLower(file.Language),
+					"existingTest": tested[0],
+					"expectedTest": expected,
+					"counterpart":  counterparts[0].Path,
+				},
 			},
-		},
+		})
+	}
+	return violations
+}
+
+// projectSharedTypes indexes contract types declared in non-test project files of
+// the given language by name.
+func projectSharedTypes(ctx *model.ProjectContext, language string) map[string][]sharedType {
+	if strings.EqualFold(language, "typescript") {
+		return projectTSSharedTypes(ctx)
+	}
+	paths := make([]string, 0)
+	for p, f := range ctx.Files {
+		if f != nil && strings.EqualFold(f.Language, language) && !f.IsTestFile {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	index := map[string][]sharedType{}
+	for _, p := range paths {
+		for _, t := range goSharedTypes(ctx.Files[p]) {
+			index[t.Name] = append(index[t.Name], t)
+		}
+	}
+	return index
+}
+
+// contractTests returns the sorted paths of test files in the given language that
+// mention the contract type by name.
+func contractTests(ctx *model.ProjectContext, language string, name string) []string {
+	pattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`)
+	paths := make([]string, 0)
+	for p, f := range ctx.Files {
+		if f != nil && f.IsTestFile && strings.EqualFold(f.Language, language) && pattern.Match(f.Source) {
+			paths = append(paths, filepath.ToSlash(p))
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// expectedDualTestPath names the test file a contract source is expected to have:
+// a sibling `_test.go` for Go, and a sibling `.test.ts` for TypeScript (`.spec.ts`
+// when every TypeScript test in the project uses that suffix).
+func expectedDualTestPath(sourcePath string, language string, ctx *model.ProjectContext) string {
+	slashed := filepath.ToSlash(sourcePath)
+	ext := path.Ext(slashed)
+	stem := strings.TrimSuffix(slashed, ext)
+	if strings.EqualFold(language, "go") {
+		return stem + "_test.go"
+	}
+	marker, specs, tests := ".test", 0, 0
+	for p, f := range ctx.Files {
+		if f == nil || !f.IsTestFile || !strings.EqualFold(f.Language, "typescript") {
+			continue
+		}
+		switch {
+		case strings.Contains(p, ".spec."):
+			specs++
+		case strings.Contains(p, ".test."):
+			tests++
+		}
+	}
+	if specs > 0 && tests == 0 {
+		marker = ".spec"
+	}
+	return stem + marker + ext
+}
+
+func dualTestSideLabel(language string) string {
+	if strings.EqualFold(language, "go") {
+		return "Go"
 	}
+	return "TypeScript"
 }
@@ -1,8 +1,150 @@
 // dual_test_test.go — Tests for CTR-dual-test.
 package ctr
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/lineage"
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestDualTest(t *testing.T) {
 	assertRuleContract(t, &DualTest{})
 }
+
+func dualTestProject(files ...*model.UnifiedFileModel) *model.ProjectContext {
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, f := range files {
+		ctx.Files[f.Path] = f
+	}
+	return ctx
+}
+
+func TestDualTestReportsMissingTypeScriptSide(t *testing.T) {
+	goSource := &model.UnifiedFileModel{Path: "server/models.go", Language: "go", Source: []byte("package server\n\ntype UserProfile struct {\n\tID   string `json:\"id\"`\n\tName string `json:\"name\"`\n}\n")}
+	goTest := &model.UnifiedFileModel{Path: "server/models_test.go", Language: "go", IsTestFile: true, Source: []byte("package server\n\nfunc TestDecode(t *testing.T) { var p UserProfile; _ = p }\n")}
+	tsSource := &model.UnifiedFileModel{Path: "client/contracts.ts", Language: "typescript", Source: []byte("export interface UserProfile {\n  id: string;\n  name: string;\n}\n")}
+	tsOther := &model.UnifiedFileModel{Path: "client/other.spec.ts", Language: "typescript", IsTestFile: true, Source: []byte("it('works', () => {});\n")}
+	ctx := dualTestProject(goSource, goTest, tsSource, tsOther)
+	rule := &DualTest{}
+
+	violations := rule.Check(tsSource, ctx, model.RuleConfig{})
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation on the TypeScript side, got %d: %+v", len(violations), violations)
+	}
+	v := violations[0]
+	want := "Contract 'UserProfile' is tested on the Go side (server/models_test.go) but not the TypeScript side; expected a test at client/contracts.spec.ts"
+	if v.Message != want || v.StartLine != 1 {
+		t.Fatalf("unexpected violation: line %d %q", v.StartLine, v.Message)
+	}
+	if v.Context.Metadata["missingSide"] != "typescript" || v.Context.Metadata["testedSide"] != "go" || v.Context.Metadata["expectedTest"] != "client/contracts.spec.ts" {
+		t.Fatalf("unexpected metadata: %+v", v.Context.Metadata)
+	}
+	if got := rule.Check(goSource, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("expected the tested Go side to pass, got %+v", got)
+	}
+}
+
+func TestDualTestReportsMissingGoSide(t *testing.T) {
+	goSource := &model.UnifiedFileModel{Path: "server/models.go", Language: "go", Source: []byte("package server\n\ntype Order struct {\n\tID string\n}\n")}
+	tsSource := &model.UnifiedFileModel{Path: "client/order.ts", Language: "typescript", Source: []byte("export type Order = {\n  ID: string;\n};\n")}
+	tsTest := &model.UnifiedFileModel{Path: "client/order.test.ts", Language: "typescript", IsTestFile: true, Source: []byte("import { Order } from './order';\n")}
+	ctx := dualTestProject(goSource, tsSource, tsTest)
+
+	violations := (&DualTest{}).Check(goSource, ctx, model.RuleConfig{})
+	if len(violations) != 1 || violations[0].StartLine != 3 || !strings.Contains(violations[0].Message, "expected a test at server/models_test.go") {
+		t.Fatalf("expected the Go side to be flagged at the type, got %+v", violations)
+	}
+}
+
+func TestDualTestIgnoresUnpairedOrUntestedContracts(t *testing.T) {
+	goSource := &model.UnifiedFileModel{Path: "server/models.go", Language: "go", Source: []byte("package server\n\ntype Order struct {\n\tID string\n}\n\ntype Internal struct {\n\tID string\n}\n")}
+	tsSource := &model.UnifiedFileModel{Path: "client/order.ts", Language: "typescript", Source: []byte("export interface Order {\n  ID: string;\n}\n")}
+	goTest := &model.UnifiedFileModel{Path: "server/internal_test.go", Language: "go", IsTestFile: true, Source: []byte("package server\n\nvar _ Internal\n")}
+	ctx := dualTestProject(goSource, tsSource, goTest)
+	rule := &DualTest{}
+
+	// Order has no tests on either side; Internal has no TypeScript counterpart.
+	for _, file := range []*model.UnifiedFileModel{goSource, tsSource} {
+		if got := rule.Check(file, ctx, model.RuleConfig{}); len(got) != 0 {
+			t.Fatalf("%s: expected no violations, got %+v", file.Path, got)
+		}
+	}
+}
+
+func TestDualTestChecksLineageContractTests(t *testing.T) {
+	annotation := `// strict-source field=response.user_id field_id=response_user_id source_system=Identity source_version=v2026.02 contract_test_id=ci://contracts/identity/user-id sources=api:identity.GetUser#response.id@cross_repo?contract_ref=git+https://github.com/acme/identity//openapi.yaml@a1b2`
+	derived := `// strict-source field=response.email field_id=response_email source_system=Identity source_version=v2026.02 sources=api:identity.GetUser#response.email@cross_repo`
+	source := &model.UnifiedFileModel{Path: "gateway/user.go", Language: "go", Source: []byte("package gateway\n\n" + annotation + "\n" + derived + "\nvar UserID string\n")}
+	ctx := dualTestProject(source)
+
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty.json")
+	if err := lineage.WriteArtifact(empty, lineage.Artifact{}); err != nil {
+		t.Fatalf("write artifact: %v", err)
+	}
+	config := model.RuleConfig{Options: map[string]interface{}{"lineageArtifact": empty}}
+
+	if got := (&DualTest{}).Check(source, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("lineage is only checked with lineageArtifact, got %+v", got)
+	}
+	got := (&DualTest{}).Check(source, ctx, config)
+	if len(got) != 1 || got[0].StartLine != 3 {
+		t.Fatalf("expected the explicit contract test to be flagged, got %+v", got)
+	}
+	want := "Field 'response.user_id' (from Identity) names contract test ci://contracts/identity/user-id, but no test file references it and no lineage artifact declares it"
+	if got[0].Message != want || got[0].Context.Metadata["producer"] != "Identity" {
+		t.Fatalf("unexpected violation: %q %+v", got[0].Message, got[0].Context.Metadata)
+	}
+
+	localTest := &model.UnifiedFileModel{Path: "gateway/contract_test.go", Language: "go", IsTestFile: true, Source: []byte("package gateway\n\n// contract: ci://contracts/identity/user-id\n")}
+	if got := (&DualTest{}).Check(source, dualTestProject(source, localTest), config); len(got) != 0 {
+		t.Fatalf("a local test referencing the ID should satisfy the rule, got %+v", got)
+	}
+
+	producer := filepath.Join(dir, "identity.json")
+	if err := lineage.WriteArtifact(producer, lineage.Artifact{Fields: []lineage.Annotation{
+		{FieldID: "user_id", Field: "id", SourceSystem: "users_db", ContractTestID: "ci://contracts/identity/user-id", FilePath: "identity/handlers/user.go"},
+	}}); err != nil {
+		t.Fatalf("write artifact: %v", err)
+	}
+	declared := model.RuleConfig{Options: map[string]interface{}{"lineageArtifact": []interface{}{empty, producer}}}
+	if got := (&DualTest{}).Check(source, ctx, declared); len(got) != 0 {
+		t.Fatalf("a contract test declared by another repository's artifact should satisfy the rule, got %+v", got)
+	}
+}
+
+func TestDualTestReportsLineageArtifactsThatFailToLoad(t *testing.T) {
+	annotation := `// strict-source field=response.user_id field_id=response_user_id source_system=Identity source_version=v2026.02 contract_test_id=ci://contracts/identity/user-id sources=api:identity.GetUser#response.id@cross_repo?contract_ref=git+https://github.com/acme/identity//openapi.yaml@a1b2`
+	source := &model.UnifiedFileModel{Path: "gateway/user.go", Language: "go", Source: []byte("package gateway\n\n" + annotation + "\nvar UserID string\n")}
+	ctx := dualTestProject(source)
+
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "identty.json")
+	got := (&DualTest{}).Check(source, ctx, model.RuleConfig{Options: map[string]interface{}{"lineageArtifact": missing}})
+	if len(got) != 1 || got[0].Severity != "warn" || got[0].StartLine != 3 || got[0].Context.Metadata["artifact"] != missing || !strings.Contains(got[0].Message, missing) {
+		t.Fatalf("expected one warning naming the missing artifact, got %+v", got)
+	}
+
+	artifact := filepath.Join(dir, "identity.json")
+	if err := os.WriteFile(artifact, []byte("{not json"), 0o644); err != nil {
+		t.Fatalf("write artifact: %v", err)
+	}
+	config := model.RuleConfig{Options: map[string]interface{}{"lineageArtifact": artifact}}
+	if got := (&DualTest{}).Check(source, ctx, config); len(got) != 1 || !strings.Contains(got[0].Message, "Lineage artifact "+artifact+" could not be loaded") {
+		t.Fatalf("expected a warning for the unparseable artifact, got %+v", got)
+	}
+
+	// A rewritten artifact is loaded again rather than served from the cache.
+	if err := lineage.WriteArtifact(artifact, lineage.Artifact{Fields: []lineage.Annotation{
+		{FieldID: "user_id", Field: "id", SourceSystem: "users_db", ContractTestID: "ci://contracts/identity/user-id", FilePath: "identity/handlers/user.go"},
+	}}); err != nil {
+		t.Fatalf("write artifact: %v", err)
+	}
+	if got := (&DualTest{}).Check(source, ctx, config); len(got) != 0 {
+		t.Fatalf("the rewritten artifact declares the test, got %+v", got)
+	}
+}
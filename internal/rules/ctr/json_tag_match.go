@@ -2,12 +2,26 @@
 package ctr
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
-// JSONTagMatch implements the CTR-json-tag-match rule.
+var (
+	snakeCaseJSONKey = regexp.MustCompile(`^[a-z0-9]+(?:_[a-z0-9]+)*$`)
+	camelCaseJSONKey = regexp.MustCompile(`^[a-z][a-z0-9]*(?:[A-Z][a-z0-9]*)*$`)
+)
+
+// JSONTagMatch implements the CTR-json-tag-match rule. With the `jsonCase` option set
+// it also flags Go struct JSON tags that do not follow that case style.
 type JSONTagMatch struct{}
 
 func (r *JSONTagMatch) ID() string          { return "CTR-json-tag-match" }
@@ -16,31 +30,159 @@ func (r *JSONTagMatch) Description() string { return "Ensure Go JSON tags match
 func (r *JSONTagMatch) Why() string {
 	return "JSON tag mismatches cause serialization bugs across language boundaries."
 }
+func (r *JSONTagMatch) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "Go: `json:\"user_id\"`, TS: userId",
+		Good:     "Go: `json:\"userId\"`, TS: userId",
+	}}
+}
 func (r *JSONTagMatch) DefaultSeverity() string   { return "error" }
 func (r *JSONTagMatch) NeedsProjectContext() bool { return false }
 
 func (r *JSONTagMatch) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Go struct 'UserDTO' JSON tag 'created_at' does not match TypeScript field 'createdAt'"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Go struct 'UserDTO' JSON tag 'created_at' does not match TypeScript field 'createdAt'"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Align JSON tags and TypeScript field names for wire compatibility.",
+				},
+			},
+		}
+	}
+
+	jsonCase, _ := config.Options["jsonCase"].(string)
+	jsonCase = strings.TrimSpace(jsonCase)
+	if file == nil || !strings.EqualFold(file.Language, "go") || (jsonCase != "snake_case" && jsonCase != "camelCase") {
+		return nil
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, tag := range goJSONTags(file) {
+		if jsonKeyMatchesCase(tag.Key, jsonCase) {
+			continue
+		}
+		suggested := jsonKeyForCase(tag.Key, jsonCase)
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("JSON tag '%s' on %s.%s is not %s; use '%s'", tag.Key, tag.Struct, tag.Field, jsonCase, suggested),
+			FilePath:    file.Path,
+			StartLine:   tag.Line,
+			StartColumn: tag.Column,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Align JSON tags and TypeScript field names for wire compatibility.",
+				SuggestedFix: fmt.Sprintf("Rename the tag to `json:\"%s\"` so every key on the API surface is %s.", suggested, jsonCase),
+				Metadata: map[string]interface{}{
+					"struct":       tag.Struct,
+					"field":        tag.Field,
+					"tag":          tag.Key,
+					"jsonCase":     jsonCase,
+					"suggestedTag": suggested,
+				},
 			},
-		},
+		})
+	}
+	return violations
+}
+
+// goJSONTag is a named JSON key on a Go struct field.
+type goJSONTag struct {
+	Struct string
+	Field  string
+	Key    string
+	Line   int
+	Column int
+}
+
+// goJSONTags lists the JSON tag keys of every named struct type in a Go file, nested
+// anonymous structs included. Omitted (`-`) and empty keys are skipped.
+func goJSONTags(file *model.UnifiedFileModel) []goJSONTag {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	tags := make([]goJSONTag, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		ast.Inspect(spec.Type, func(inner ast.Node) bool {
+			field, ok := inner.(*ast.Field)
+			if !ok || field.Tag == nil || len(field.Names) == 0 {
+				return true
+			}
+			raw, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return true
+			}
+			key := strings.Split(reflect.StructTag(raw).Get("json"), ",")[0]
+			if key == "" || key == "-" {
+				return true
+			}
+			pos := fset.Position(field.Tag.Pos())
+			tags = append(tags, goJSONTag{Struct: spec.Name.Name, Field: field.Names[0].Name, Key: key, Line: pos.Line, Column: pos.Column})
+			return true
+		})
+		return false
+	})
+	return tags
+}
+
+func jsonKeyMatchesCase(key string, jsonCase string) bool {
+	if jsonCase == "camelCase" {
+		return camelCaseJSONKey.MatchString(key)
+	}
+	return snakeCaseJSONKey.MatchString(key)
+}
+
+// jsonKeyForCase rewrites key in jsonCase: "userID" -> "user_id", "created_at" -> "createdAt".
+func jsonKeyForCase(key string, jsonCase string) string {
+	words := jsonKeyWords(key)
+	if jsonCase != "camelCase" {
+		return strings.Join(words, "_")
+	}
+	var out strings.Builder
+	for i, word := range words {
+		if i > 0 && word != "" {
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			word = string(runes)
+		}
+		out.WriteString(word)
+	}
+	return out.String()
+}
+
+// jsonKeyWords splits a key on `_`, `-`, `.`, and case transitions into lowercase
+// words, keeping acronyms together ("HTTPStatus" -> ["http", "status"]).
+func jsonKeyWords(key string) []string {
+	words := make([]string, 0)
+	for _, part := range strings.FieldsFunc(key, func(r rune) bool { return r == '_' || r == '-' || r == '.' }) {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			prev, curr := runes[i-1], runes[i]
+			lowerToUpper := (unicode.IsLower(prev) || unicode.IsDigit(prev)) && unicode.IsUpper(curr)
+			acronymEnd := unicode.IsUpper(prev) && unicode.IsUpper(curr) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if lowerToUpper || acronymEnd {
+				words = append(words, strings.ToLower(string(runes[start:i])))
+				start = i
+			}
+		}
+		words = append(words, strings.ToLower(string(runes[start:])))
 	}
+	return words
 }
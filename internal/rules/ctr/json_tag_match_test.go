@@ -1,8 +1,38 @@
 // json_tag_match_test.go — Tests for CTR-json-tag-match.
 package ctr
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestJSONTagMatch(t *testing.T) {
 	assertRuleContract(t, &JSONTagMatch{})
 }
+
+func TestJSONTagMatchEnforcesJSONCase(t *testing.T) {
+	source := "package models\n\ntype Order struct {\n\tID         string `json:\"id\"`\n\tCustomerID string `json:\"customerID\"`\n\tCreatedAt  string `json:\"created_at,omitempty\"`\n\tSecret     string `json:\"-\"`\n\tMeta       struct {\n\t\tHTTPStatus int `json:\"HTTPStatus\"`\n\t} `json:\"meta\"`\n\tnotes string\n}\n"
+	file := &model.UnifiedFileModel{Path: "server/models/order.go", Language: "go", Source: []byte(source)}
+	rule := &JSONTagMatch{}
+
+	snake := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"jsonCase": "snake_case"}})
+	if len(snake) != 2 {
+		t.Fatalf("snake_case violations = %d, want 2: %+v", len(snake), snake)
+	}
+	if snake[0].StartLine != 5 || snake[0].Message != "JSON tag 'customerID' on Order.CustomerID is not snake_case; use 'customer_id'" {
+		t.Fatalf("first violation = line %d %q", snake[0].StartLine, snake[0].Message)
+	}
+	if snake[1].StartLine != 9 || snake[1].Context.Metadata["suggestedTag"] != "http_status" || snake[1].Context.Metadata["field"] != "HTTPStatus" {
+		t.Fatalf("nested struct violation = line %d %+v", snake[1].StartLine, snake[1].Context.Metadata)
+	}
+
+	camel := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"jsonCase": "camelCase"}})
+	if len(camel) != 2 || camel[0].Context.Metadata["suggestedTag"] != "createdAt" || camel[1].Context.Metadata["suggestedTag"] != "httpStatus" {
+		t.Fatalf("camelCase violations = %+v", camel)
+	}
+
+	if got := rule.Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("without jsonCase no tags should be flagged, got %+v", got)
+	}
+}
@@ -2,12 +2,18 @@
 package ctr
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/stricture/stricture/internal/manifest"
 	"github.com/stricture/stricture/internal/model"
 )
 
 // ManifestConformance implements the CTR-manifest-conformance rule.
+//
+// With a manifest `type_mappings` table it compares the fields of each Go struct named
+// by a manifest shape against the TypeScript type of the same name in the project, and
+// flags pairs such as Go int64 <-> TypeScript number that the table rules out.
 type ManifestConformance struct{}
 
 func (r *ManifestConformance) ID() string       { return "CTR-manifest-conformance" }
@@ -18,31 +24,146 @@ func (r *ManifestConformance) Description() string {
 func (r *ManifestConformance) Why() string {
 	return "Manifest drift erodes trust in declared API and schema ownership."
 }
+func (r *ManifestConformance) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "manifest lists POST /users, code only implements GET /users",
+		Good:     "manifest lists POST /users, code implements POST /users",
+	}}
+}
 func (r *ManifestConformance) DefaultSeverity() string   { return "error" }
-func (r *ManifestConformance) NeedsProjectContext() bool { return false }
-
-func (r *ManifestConformance) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *ManifestConformance) NeedsProjectContext() bool { return true }
 
+func (r *ManifestConformance) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Manifest declares contract 'billing.v2.invoice' but code missing matching handler implementation"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Update manifest or code so declared contracts and implementation match.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Manifest declares contract 'billing.v2.invoice' but code missing matching handler implementation"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Update manifest or code so declared contracts and implementation match.",
+				},
 			},
-		},
+		}
+	}
+
+	// Pairs are reported once, from the Go side.
+	if file == nil || ctx == nil || !strings.EqualFold(file.Language, "go") || file.IsTestFile {
+		return nil
+	}
+	m, ok := loadRuleManifest(config.Options)
+	if !ok || len(m.TypeMappings) == 0 {
+		return nil
+	}
+	declared := map[string]bool{}
+	for _, shape := range m.Shapes() {
+		declared[shape.Type] = true
+	}
+	goTypes := goSharedTypes(file)
+	if len(goTypes) == 0 {
+		return nil
+	}
+	tsTypes := projectTSSharedTypes(ctx)
+
+	violations := make([]model.Violation, 0)
+	for _, goType := range goTypes {
+		if !declared[goType.Name] {
+			continue
+		}
+		for _, tsType := range tsTypes[goType.Name] {
+			tsFields := map[string]string{}
+			for _, name := range tsType.Fields {
+				tsFields[sharedFieldKey(name)] = name
+			}
+			for _, field := range goType.Fields {
+				tsName, ok := tsFields[sharedFieldKey(field)]
+				if !ok {
+					// Missing fields are CTR-shared-type-sync's concern.
+					continue
+				}
+				goKind := strings.TrimPrefix(goType.Types[field], "*")
+				tsKind := normalizeTSFieldType(tsType.Types[tsName])
+				mapping, allowed, failed := checkTypeMapping(m.TypeMappings, goKind, tsKind)
+				if !failed {
+					continue
+				}
+				message := fmt.Sprintf("Field %s.%s is Go %s but TypeScript %s in %s; type mapping %q marks the pair incompatible", goType.Name, field, goKind, tsKind, tsType.Path, mapping.Label())
+				if reason := strings.TrimSpace(mapping.Reason); reason != "" {
+					message += ": " + reason
+				}
+				if allowed != nil {
+					message = fmt.Sprintf("Field %s.%s is Go %s but TypeScript %s in %s; the type mappings only allow %s", goType.Name, field, goKind, tsKind, tsType.Path, strings.Join(allowed, ", "))
+				}
+				metadata := map[string]interface{}{
+					"type":    goType.Name,
+					"field":   field,
+					"goType":  goKind,
+					"tsType":  tsKind,
+					"tsFile":  tsType.Path,
+					"mapping": mapping.Label(),
+				}
+				if allowed != nil {
+					metadata["allowed"] = allowed
+				}
+				violations = append(violations, model.Violation{
+					RuleID:    r.ID(),
+					Severity:  severity,
+					Message:   message,
+					FilePath:  file.Path,
+					StartLine: goType.Lines[field],
+					Context: &model.ViolationContext{
+						SuggestedFix: fmt.Sprintf("Change %s.%s so Go %s and its TypeScript counterpart follow a compatible type mapping, or bump the contract.", goType.Name, field, goKind),
+						Metadata:     metadata,
+					},
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// checkTypeMapping reports whether the Go/TypeScript pair fails the table. An entry
+// naming both types decides directly; otherwise, when the Go type has compatible
+// entries, the TypeScript type must be one of them and allowed lists the choices.
+func checkTypeMapping(mappings []manifest.TypeMapping, goKind string, tsKind string) (manifest.TypeMapping, []string, bool) {
+	var allowed []string
+	var first manifest.TypeMapping
+	for _, mapping := range mappings {
+		if strings.TrimSpace(mapping.Go) != goKind {
+			continue
+		}
+		if strings.TrimSpace(mapping.TypeScript) == tsKind {
+			return mapping, nil, !mapping.Compatible
+		}
+		if mapping.Compatible {
+			if allowed == nil {
+				first = mapping
+			}
+			allowed = append(allowed, strings.TrimSpace(mapping.TypeScript))
+		}
+	}
+	return first, allowed, allowed != nil
+}
+
+// normalizeTSFieldType drops `null` and `undefined` members, so optional and nullable
+// fields compare by the type they carry.
+func normalizeTSFieldType(raw string) string {
+	parts := make([]string, 0)
+	for _, part := range strings.Split(raw, "|") {
+		part = strings.TrimSpace(part)
+		if part == "" || part == "null" || part == "undefined" {
+			continue
+		}
+		parts = append(parts, part)
 	}
+	return strings.Join(parts, " | ")
 }
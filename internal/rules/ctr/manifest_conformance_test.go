@@ -1,8 +1,80 @@
 // manifest_conformance_test.go — Tests for CTR-manifest-conformance.
 package ctr
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const typeMappingManifest = `manifest_version: "1.0"
+contracts:
+  - id: inventory.v1
+    endpoints:
+      - path: /items
+        method: GET
+        response:
+          type: Item
+type_mappings:
+  - name: uint8-number-overflow
+    go: uint8
+    typescript: number
+    reason: number accepts values above 255 and the Go consumer overflows
+  - name: int64-as-string
+    go: int64
+    typescript: string
+    compatible: true
+  - go: string
+    typescript: string
+    compatible: true
+`
 
 func TestManifestConformance(t *testing.T) {
 	assertRuleContract(t, &ManifestConformance{})
 }
+
+func TestManifestConformanceTypeMappings(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "stricture-manifest.yml")
+	if err := os.WriteFile(manifestPath, []byte(typeMappingManifest), 0o644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	goFile := &model.UnifiedFileModel{
+		Path:     "server/item.go",
+		Language: "go",
+		Source: []byte("package server\n\ntype Item struct {\n\tName     string `json:\"name\"`\n\tQuantity uint8  `json:\"quantity\"`\n\tSerial   *int64 `json:\"serial\"`\n}\n\n" +
+			"type Audit struct {\n\tCount uint8 `json:\"count\"`\n}\n"),
+	}
+	tsFile := &model.UnifiedFileModel{
+		Path:     "client/item.ts",
+		Language: "typescript",
+		Source:   []byte("export interface Item {\n  name: string;\n  quantity: number;\n  serial?: number | null;\n}\n\nexport interface Audit {\n  count: number;\n}\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{goFile.Path: goFile, tsFile.Path: tsFile}}
+	config := model.RuleConfig{Options: map[string]interface{}{"manifest": manifestPath}}
+	rule := &ManifestConformance{}
+
+	got := rule.Check(goFile, ctx, config)
+	if len(got) != 2 {
+		t.Fatalf("violations = %d, want 2: %+v", len(got), got)
+	}
+	want := `Field Item.quantity is Go uint8 but TypeScript number in client/item.ts; type mapping "uint8-number-overflow" marks the pair incompatible: number accepts values above 255 and the Go consumer overflows`
+	if got[0].StartLine != 5 || got[0].Message != want {
+		t.Fatalf("first violation = line %d %q", got[0].StartLine, got[0].Message)
+	}
+	want = "Field Item.serial is Go int64 but TypeScript number in client/item.ts; the type mappings only allow string"
+	if got[1].StartLine != 6 || got[1].Message != want {
+		t.Fatalf("second violation = line %d %q", got[1].StartLine, got[1].Message)
+	}
+	if got[1].Context.Metadata["mapping"] != "int64-as-string" || got[1].Context.Metadata["tsType"] != "number" {
+		t.Fatalf("second violation metadata = %+v", got[1].Context.Metadata)
+	}
+
+	if got := rule.Check(tsFile, ctx, config); len(got) != 0 {
+		t.Fatalf("pairs should be reported from the Go side only, got %+v", got)
+	}
+	if got := rule.Check(goFile, ctx, model.RuleConfig{Options: map[string]interface{}{"manifest": filepath.Join(t.TempDir(), "missing.yml")}}); len(got) != 0 {
+		t.Fatalf("no manifest should mean no violations, got %+v", got)
+	}
+}
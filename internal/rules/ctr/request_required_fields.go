@@ -0,0 +1,200 @@
+// request_required_fields.go — CTR-request-required-fields: Ensure manifest-required request fields are validated as required.
+package ctr
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/stricture/stricture/internal/manifest"
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultManifestPaths mirrors the manifest auto-detection used by `strict audit` and `strict trace`.
+var defaultManifestPaths = []string{
+	"stricture-manifest.yml",
+	".stricture-manifest.yml",
+	"stricture-manifest.yaml",
+	".stricture-manifest.yaml",
+}
+
+// RequestRequiredFields implements the CTR-request-required-fields rule.
+type RequestRequiredFields struct{}
+
+func (r *RequestRequiredFields) ID() string       { return "CTR-request-required-fields" }
+func (r *RequestRequiredFields) Category() string { return "ctr" }
+func (r *RequestRequiredFields) Description() string {
+	return "Ensure request structs require the fields the manifest marks required"
+}
+func (r *RequestRequiredFields) Why() string {
+	return "A required field the server treats as optional lets requests through without mandatory data."
+}
+func (r *RequestRequiredFields) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "type CreateOrderRequest struct {\n\tTotalAmount int64 `json:\"total_amount\" validate:\"gt=0\"`\n}",
+		Good:     "type CreateOrderRequest struct {\n\tTotalAmount int64 `json:\"total_amount\" validate:\"required,gt=0\"`\n}",
+	}}
+}
+func (r *RequestRequiredFields) DefaultSeverity() string   { return "error" }
+func (r *RequestRequiredFields) NeedsProjectContext() bool { return false }
+func (r *RequestRequiredFields) ReadsExternalInputs() bool { return true }
+
+func (r *RequestRequiredFields) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !strings.EqualFold(file.Language, "go") || file.IsTestFile {
+		return nil
+	}
+	m, ok := loadRuleManifest(config.Options)
+	if !ok {
+		return nil
+	}
+	shapes := map[string]manifest.Shape{}
+	for _, shape := range m.RequestShapes() {
+		shapes[shape.Type] = shape
+	}
+	if len(shapes) == 0 {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		shape, ok := shapes[spec.Name.Name]
+		if !ok {
+			return true
+		}
+		st, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		fields := goRequestFields(st)
+		for _, name := range shape.RequiredFields() {
+			field, ok := fields[strings.ToLower(name)]
+			if !ok || field.Required {
+				// Missing fields are CTR-request-shape's concern.
+				continue
+			}
+			pos := fset.Position(field.Pos)
+			violations = append(violations, model.Violation{
+				RuleID:      r.ID(),
+				Severity:    severity,
+				Message:     fmt.Sprintf("Manifest requires %s.%s but field %s is optional (%s)", shape.Type, name, field.GoName, field.Reason),
+				FilePath:    file.Path,
+				StartLine:   pos.Line,
+				StartColumn: pos.Column,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Add `validate:\"required\"` to %s.%s, or relax the manifest if the field is truly optional.", spec.Name.Name, field.GoName),
+					Metadata: map[string]interface{}{
+						"type":  shape.Type,
+						"field": name,
+					},
+				},
+			})
+		}
+		return false
+	})
+	return violations
+}
+
+type goRequestField struct {
+	GoName   string
+	Required bool
+	Reason   string
+	Pos      token.Pos
+}
+
+// goRequestFields indexes struct fields by lower-cased wire name (JSON tag, else Go name).
+// A field counts as required only when its validate tag has `required` and no `omitempty`.
+func goRequestFields(st *ast.StructType) map[string]goRequestField {
+	fields := map[string]goRequestField{}
+	for _, f := range st.Fields.List {
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			if raw, err := strconv.Unquote(f.Tag.Value); err == nil {
+				tag = reflect.StructTag(raw)
+			}
+		}
+		jsonName := strings.Split(tag.Get("json"), ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+
+		required, reason := false, "no validate tag"
+		if validate, ok := tag.Lookup("validate"); ok {
+			rules := map[string]bool{}
+			for _, part := range strings.Split(validate, ",") {
+				rules[strings.TrimSpace(part)] = true
+			}
+			switch {
+			case rules["omitempty"]:
+				reason = fmt.Sprintf("validate:%q allows empty values", validate)
+			case rules["required"]:
+				required = true
+			default:
+				reason = fmt.Sprintf("validate:%q has no required", validate)
+			}
+		}
+
+		for _, ident := range f.Names {
+			wire := jsonName
+			if wire == "" {
+				wire = ident.Name
+			}
+			fields[strings.ToLower(wire)] = goRequestField{GoName: ident.Name, Required: required, Reason: reason, Pos: ident.Pos()}
+		}
+	}
+	return fields
+}
+
+var (
+	manifestCacheMu sync.Mutex
+	manifestCache   = map[string]*manifest.Manifest{}
+)
+
+// loadRuleManifest loads the manifest named by the `manifest` option, or the first
+// auto-detected manifest in the working directory. Results are cached per path.
+func loadRuleManifest(options map[string]interface{}) (manifest.Manifest, bool) {
+	candidates := defaultManifestPaths
+	if raw, ok := options["manifest"].(string); ok && strings.TrimSpace(raw) != "" {
+		candidates = []string{strings.TrimSpace(raw)}
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		manifestCacheMu.Lock()
+		cached, ok := manifestCache[path]
+		if !ok {
+			if m, err := manifest.Load(path); err == nil {
+				cached = &m
+			}
+			manifestCache[path] = cached
+		}
+		manifestCacheMu.Unlock()
+		if cached == nil {
+			return manifest.Manifest{}, false
+		}
+		return *cached, true
+	}
+	return manifest.Manifest{}, false
+}
@@ -0,0 +1,93 @@
+// request_required_fields_test.go — Tests for CTR-request-required-fields.
+package ctr
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const requiredFieldsManifest = `manifest_version: "1.0"
+contracts:
+  - id: orders
+    endpoints:
+      - path: /orders
+        method: POST
+        request:
+          type: CreateOrderRequest
+          fields:
+            customer_id:  { type: string, format: uuid, required: true }
+            total_amount: { type: integer, required: true }
+            currency:     { type: string, required: true }
+            coupon:       { type: string, required: true }
+            note:         { type: string }
+`
+
+func writeRequiredFieldsManifest(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stricture-manifest.yml")
+	if err := os.WriteFile(path, []byte(requiredFieldsManifest), 0o644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	return path
+}
+
+func TestRequestRequiredFieldsMetadata(t *testing.T) {
+	rule := &RequestRequiredFields{}
+	if rule.ID() != "CTR-request-required-fields" || rule.Category() != "ctr" || rule.DefaultSeverity() != "error" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestRequestRequiredFields(t *testing.T) {
+	manifestPath := writeRequiredFieldsManifest(t)
+	source := `package orders
+
+type CreateOrderRequest struct {
+	CustomerID  string ` + "`json:\"customer_id\" validate:\"required,uuid4\"`" + `
+	TotalAmount int64  ` + "`json:\"total_amount\" validate:\"gt=0\"`" + `
+	Currency    string ` + "`json:\"currency\"`" + `
+	Coupon      string ` + "`json:\"coupon\" validate:\"omitempty,required\"`" + `
+	Note        string ` + "`json:\"note\"`" + `
+}
+
+type UnrelatedRequest struct {
+	Currency string
+}
+`
+	rule := &RequestRequiredFields{}
+	file := &model.UnifiedFileModel{Path: "orders/request.go", Language: "go", Source: []byte(source)}
+	violations := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"manifest": manifestPath}})
+
+	got := make([]string, 0, len(violations))
+	for _, v := range violations {
+		got = append(got, v.Context.Metadata["field"].(string))
+	}
+	if strings.Join(got, ",") != "coupon,currency,total_amount" {
+		t.Fatalf("flagged fields = %v, want [coupon currency total_amount]: %+v", got, violations)
+	}
+	for _, v := range violations {
+		if v.StartLine < 4 || v.StartLine > 7 || v.StartColumn != 2 {
+			t.Fatalf("unexpected position %d:%d for %s", v.StartLine, v.StartColumn, v.Message)
+		}
+	}
+	if !strings.Contains(violations[1].Message, "CreateOrderRequest.currency") || !strings.Contains(violations[1].Message, "no validate tag") {
+		t.Fatalf("unexpected message: %q", violations[1].Message)
+	}
+}
+
+func TestRequestRequiredFieldsWithoutManifest(t *testing.T) {
+	rule := &RequestRequiredFields{}
+	file := &model.UnifiedFileModel{
+		Path:     "orders/request.go",
+		Language: "go",
+		Source:   []byte("package orders\n\ntype CreateOrderRequest struct {\n\tCurrency string `json:\"currency\"`\n}\n"),
+	}
+	missing := filepath.Join(t.TempDir(), "missing.yml")
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"manifest": missing}}); len(got) != 0 {
+		t.Fatalf("expected no violations without a manifest, got %+v", got)
+	}
+}
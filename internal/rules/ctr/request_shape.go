@@ -2,12 +2,22 @@
 package ctr
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/stricture/stricture/internal/config"
+	"github.com/stricture/stricture/internal/manifest"
 	"github.com/stricture/stricture/internal/model"
 )
 
-// RequestShape implements the CTR-request-shape rule.
+// RequestShape implements the CTR-request-shape rule. For Go, request structs named like
+// a manifest request shape are compared field by field with the shape declared for the
+// file's API version, so a v2 handler is held to the v2 contract and not the v1 one.
 type RequestShape struct{}
 
 func (r *RequestShape) ID() string       { return "CTR-request-shape" }
@@ -18,31 +28,229 @@ func (r *RequestShape) Description() string {
 func (r *RequestShape) Why() string {
 	return "Mismatched request contracts cause immediate runtime failures."
 }
+func (r *RequestShape) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "client sends {id: string}, server expects {userId: number}",
+		Good:     "client sends {userId: number}, server expects {userId: number}",
+	}}
+}
 func (r *RequestShape) DefaultSeverity() string   { return "error" }
 func (r *RequestShape) NeedsProjectContext() bool { return false }
+func (r *RequestShape) ReadsExternalInputs() bool { return true }
 
 func (r *RequestShape) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Client sends UserCreateInput but server expects CreateUserRequest, field mismatch: email,role"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Client sends UserCreateInput but server expects CreateUserRequest, field mismatch: email,role"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Align client request payload fields and types with the server contract.",
+				},
+			},
+		}
+	}
+
+	if file == nil || !strings.EqualFold(file.Language, "go") || file.IsTestFile {
+		return nil
+	}
+	m, ok := loadRuleManifest(config.Options)
+	if !ok {
+		return nil
+	}
+	shapes := map[string][]manifest.VersionedShape{}
+	for _, shape := range m.VersionedRequestShapes() {
+		shapes[shape.Type] = append(shapes[shape.Type], shape)
+	}
+	if len(shapes) == 0 {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	version := requestFileVersion(file.Path, parsed, config.Options)
+	strictExtra, _ := config.Options["strictExtraFields"].(bool)
+
+	violations := make([]model.Violation, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		st, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		shape, ok := shapeForVersion(shapes[spec.Name.Name], version)
+		if !ok || len(shape.Fields) == 0 {
+			return false
+		}
+
+		fields := goRequestFields(st)
+		missing := make([]string, 0)
+		if !hasEmbeddedField(st) {
+			for name := range shape.Fields {
+				if _, ok := fields[strings.ToLower(name)]; !ok {
+					missing = append(missing, name)
+				}
+			}
+		}
+		extra := make([]string, 0)
+		if strictExtra {
+			declared := map[string]bool{}
+			for name := range shape.Fields {
+				declared[strings.ToLower(name)] = true
+			}
+			for wire := range fields {
+				if !declared[wire] {
+					extra = append(extra, wire)
+				}
+			}
+		}
+		if len(missing) == 0 && len(extra) == 0 {
+			return false
+		}
+		sort.Strings(missing)
+		sort.Strings(extra)
+
+		label := "manifest request shape"
+		if shape.Version != "" {
+			label = fmt.Sprintf("manifest %s request shape", shape.Version)
+		}
+		parts := make([]string, 0, 2)
+		if len(missing) > 0 {
+			parts = append(parts, "missing "+strings.Join(missing, ","))
+		}
+		if len(extra) > 0 {
+			parts = append(parts, "extra "+strings.Join(extra, ","))
+		}
+		pos := fset.Position(spec.Name.Pos())
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("%s does not match the %s, field mismatch: %s", spec.Name.Name, label, strings.Join(parts, "; ")),
+			FilePath:    file.Path,
+			StartLine:   pos.Line,
+			StartColumn: pos.Column,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Align client request payload fields and types with the server contract.",
+				SuggestedFix: fmt.Sprintf("Align %s's JSON fields with the %s, or update the manifest.", spec.Name.Name, label),
+				Metadata: map[string]interface{}{
+					"type":    shape.Type,
+					"version": shape.Version,
+					"missing": missing,
+					"extra":   extra,
+				},
 			},
-		},
+		})
+		return false
+	})
+	return violations
+}
+
+// requestFileVersion decides which API version a file serves: the first `versions`
+// option glob matching its path, else a version segment in the path
+// (`internal/api/v2/orders.go`), else the version in the file's route literals
+// (`r.Route("/api/v1", ...)`) when they all agree. It returns "" when none applies.
+// parsed is nil for non-Go files, which skips the route scan.
+func requestFileVersion(filePath string, parsed *ast.File, options map[string]interface{}) string {
+	if raw, ok := options["versions"].(map[string]interface{}); ok {
+		globs := make([]string, 0, len(raw))
+		for glob := range raw {
+			globs = append(globs, glob)
+		}
+		sort.Strings(globs)
+		for _, glob := range globs {
+			version, ok := raw[glob].(string)
+			if !ok {
+				version = fmt.Sprint(raw[glob])
+			}
+			if config.CompilePathGlobs([]string{glob}).Matches(filePath) {
+				return manifest.NormalizeVersion(version)
+			}
+		}
+	}
+	if version := manifest.PathVersion(filePath); version != "" {
+		return version
+	}
+	if parsed == nil {
+		return ""
+	}
+
+	found := ""
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil || !strings.HasPrefix(value, "/") {
+			return true
+		}
+		if version := manifest.PathVersion(value); version != "" {
+			if found != "" && found != version {
+				found = "-"
+				return false
+			}
+			found = version
+		}
+		return true
+	})
+	if found == "-" {
+		return ""
+	}
+	return found
+}
+
+// shapeForVersion picks the shape a struct is checked against. A versioned file uses
+// the shape of its version, or an unversioned one. An unversioned file uses the only
+// shape declared for the type; with several versions to choose from it is not checked.
+func shapeForVersion(candidates []manifest.VersionedShape, version string) (manifest.VersionedShape, bool) {
+	if len(candidates) == 0 {
+		return manifest.VersionedShape{}, false
+	}
+	if version != "" {
+		var fallback *manifest.VersionedShape
+		for i, shape := range candidates {
+			if shape.Version == version {
+				return shape, true
+			}
+			if shape.Version == "" && fallback == nil {
+				fallback = &candidates[i]
+			}
+		}
+		if fallback != nil {
+			return *fallback, true
+		}
+		return manifest.VersionedShape{}, false
+	}
+	versions := map[string]bool{}
+	for _, shape := range candidates {
+		versions[shape.Version] = true
+	}
+	if len(versions) != 1 {
+		return manifest.VersionedShape{}, false
+	}
+	return candidates[0], true
+}
+
+func hasEmbeddedField(st *ast.StructType) bool {
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			return true
+		}
 	}
+	return false
 }
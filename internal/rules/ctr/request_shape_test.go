@@ -1,8 +1,106 @@
 // request_shape_test.go — Tests for CTR-request-shape.
 package ctr
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestRequestShape(t *testing.T) {
 	assertRuleContract(t, &RequestShape{})
 }
+
+const versionedShapeManifest = `manifest_version: "1.0"
+contracts:
+  - id: items.v1
+    endpoints:
+      - path: /api/v1/items
+        method: POST
+        request:
+          type: CreateItemRequest
+          fields:
+            sku:      { type: string, required: true }
+            quantity: { type: integer, required: true }
+  - id: items.v2
+    version: v2
+    endpoints:
+      - path: /items
+        method: POST
+        request:
+          type: CreateItemRequest
+          fields:
+            sku:         { type: string, required: true }
+            quantity:    { type: integer, required: true }
+            warehouse_id: { type: string, required: true }
+`
+
+const createItemV1Source = "package items\n\ntype CreateItemRequest struct {\n\tSKU      string `json:\"sku\"`\n\tQuantity int    `json:\"quantity\"`\n}\n"
+
+func writeVersionedShapeManifest(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stricture-manifest.yml")
+	if err := os.WriteFile(path, []byte(versionedShapeManifest), 0o644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	return path
+}
+
+func TestRequestShapeUsesTheFilesAPIVersion(t *testing.T) {
+	options := map[string]interface{}{"manifest": writeVersionedShapeManifest(t)}
+	rule := &RequestShape{}
+
+	v1 := &model.UnifiedFileModel{Path: "internal/api/v1/items.go", Language: "go", Source: []byte(createItemV1Source)}
+	if got := rule.Check(v1, nil, model.RuleConfig{Options: options}); len(got) != 0 {
+		t.Fatalf("v1 struct matches the v1 shape, got %+v", got)
+	}
+
+	v2 := &model.UnifiedFileModel{Path: "internal/api/v2/items.go", Language: "go", Source: []byte(createItemV1Source)}
+	got := rule.Check(v2, nil, model.RuleConfig{Options: options})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	want := "CreateItemRequest does not match the manifest v2 request shape, field mismatch: missing warehouse_id"
+	if got[0].Message != want || got[0].StartLine != 3 {
+		t.Fatalf("violation = line %d %q, want line 3 %q", got[0].StartLine, got[0].Message, want)
+	}
+	if got[0].Context.Metadata["version"] != "v2" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	// An unversioned path with both versions declared is ambiguous and not checked.
+	plain := &model.UnifiedFileModel{Path: "internal/items/items.go", Language: "go", Source: []byte(createItemV1Source)}
+	if got := rule.Check(plain, nil, model.RuleConfig{Options: options}); len(got) != 0 {
+		t.Fatalf("ambiguous version should not be checked, got %+v", got)
+	}
+}
+
+func TestRequestShapeVersionFromRoutesAndOptions(t *testing.T) {
+	manifestPath := writeVersionedShapeManifest(t)
+	rule := &RequestShape{}
+	routed := &model.UnifiedFileModel{
+		Path:     "internal/items/items.go",
+		Language: "go",
+		Source:   []byte(createItemV1Source + "\nfunc Routes(r Router) {\n\tr.Route(\"/api/v2\", mount)\n}\n"),
+	}
+	if got := rule.Check(routed, nil, model.RuleConfig{Options: map[string]interface{}{"manifest": manifestPath}}); len(got) != 1 {
+		t.Fatalf("route literal /api/v2 should select the v2 shape, got %+v", got)
+	}
+
+	options := map[string]interface{}{
+		"manifest":          manifestPath,
+		"versions":          map[string]interface{}{"internal/items/**": "v1"},
+		"strictExtraFields": true,
+	}
+	extra := &model.UnifiedFileModel{
+		Path:     "internal/items/items.go",
+		Language: "go",
+		Source:   []byte("package items\n\ntype CreateItemRequest struct {\n\tSKU      string `json:\"sku\"`\n\tQuantity int    `json:\"quantity\"`\n\tNote     string `json:\"note\"`\n}\n"),
+	}
+	got := rule.Check(extra, nil, model.RuleConfig{Options: options})
+	if len(got) != 1 || got[0].Message != "CreateItemRequest does not match the manifest v1 request shape, field mismatch: extra note" {
+		t.Fatalf("versions option should select v1 and strictExtraFields report note, got %+v", got)
+	}
+}
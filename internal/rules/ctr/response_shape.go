@@ -2,12 +2,31 @@
 package ctr
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/stricture/stricture/internal/manifest"
 	"github.com/stricture/stricture/internal/model"
 )
 
-// ResponseShape implements the CTR-response-shape rule.
+var (
+	tsUnionAliasPattern = regexp.MustCompile(`^\s*(?:export\s+)?(?:declare\s+)?type\s+([A-Za-z_$][\w$]*)\s*=(.*)$`)
+	tsEnumDeclPattern   = regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:declare\s+)?(?:const\s+)?enum\s+([A-Za-z_$][\w$]*)\s*\{`)
+	tsEnumMemberPattern = regexp.MustCompile(`^\s*(?:[A-Za-z_$][\w$]*|"[^"]*"|'[^']*')\s*=\s*(.+?)\s*$`)
+	tsIdentPattern      = regexp.MustCompile(`^[A-Za-z_$][\w$]*$`)
+)
+
+// ResponseShape implements the CTR-response-shape rule. For Go and TypeScript, response
+// types named like a manifest response shape have their `type: enum` fields checked: the
+// values the code allows (a Go string type's constants, a TypeScript union or enum) must
+// be exactly the manifest's `values`, for the shape of the file's API version.
 type ResponseShape struct{}
 
 func (r *ResponseShape) ID() string       { return "CTR-response-shape" }
@@ -18,31 +37,410 @@ func (r *ResponseShape) Description() string {
 func (r *ResponseShape) Why() string {
 	return "Response drift breaks consumers even when requests still succeed."
 }
+func (r *ResponseShape) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "server returns {count: string}, client expects {count: number}",
+		Good:     "server returns {count: number}, client expects {count: number}",
+	}}
+}
 func (r *ResponseShape) DefaultSeverity() string   { return "error" }
-func (r *ResponseShape) NeedsProjectContext() bool { return false }
-
-func (r *ResponseShape) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *ResponseShape) NeedsProjectContext() bool { return true }
+func (r *ResponseShape) ReadsExternalInputs() bool { return true }
 
+func (r *ResponseShape) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Server returns UserRecord but client expects UserDTO, field mismatch: createdAt,status"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Update server/client models so response fields match exactly.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Server returns UserRecord but client expects UserDTO, field mismatch: createdAt,status"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Update server/client models so response fields match exactly.",
+				},
 			},
-		},
+		}
+	}
+
+	if file == nil || file.IsTestFile {
+		return nil
+	}
+	lang := strings.ToLower(file.Language)
+	if lang != "go" && lang != "typescript" {
+		return nil
+	}
+	m, ok := loadRuleManifest(config.Options)
+	if !ok {
+		return nil
+	}
+	shapes := map[string][]manifest.VersionedShape{}
+	for _, shape := range m.VersionedResponseShapes() {
+		if len(enumFieldNames(shape.Shape)) > 0 {
+			shapes[shape.Type] = append(shapes[shape.Type], shape)
+		}
+	}
+	if len(shapes) == 0 {
+		return nil
+	}
+
+	var declared []sharedType
+	var enums map[string][]string
+	version := ""
+	if lang == "go" {
+		parsed, err := parser.ParseFile(token.NewFileSet(), file.Path, file.Source, parser.SkipObjectResolution)
+		if err != nil {
+			return nil
+		}
+		version = requestFileVersion(file.Path, parsed, config.Options)
+		declared = goSharedTypes(file)
+		enums = goEnumValues(parsed)
+	} else {
+		version = requestFileVersion(file.Path, nil, config.Options)
+		declared = tsSharedTypes(file.Path, string(file.Source))
+		enums = tsEnumValues(string(file.Source))
+	}
+	addProjectEnums(enums, file, ctx)
+
+	violations := make([]model.Violation, 0)
+	for _, t := range declared {
+		shape, ok := shapeForVersion(shapes[t.Name], version)
+		if !ok {
+			continue
+		}
+		label := "manifest response shape"
+		if shape.Version != "" {
+			label = fmt.Sprintf("manifest %s response shape", shape.Version)
+		}
+		for _, name := range enumFieldNames(shape.Shape) {
+			wire := ""
+			for _, field := range t.Fields {
+				if strings.EqualFold(field, name) {
+					wire = field
+					break
+				}
+			}
+			if wire == "" {
+				continue
+			}
+			enumName, implemented, ok := fieldEnumValues(lang, t.Types[wire], enums)
+			if !ok {
+				continue
+			}
+			added, removed := enumValueDelta(implemented, shape.Fields[name].Values)
+			if len(added) == 0 && len(removed) == 0 {
+				continue
+			}
+
+			parts := make([]string, 0, 2)
+			if len(added) > 0 {
+				parts = append(parts, "added "+strings.Join(added, ","))
+			}
+			if len(removed) > 0 {
+				parts = append(parts, "removed "+strings.Join(removed, ","))
+			}
+			subject := t.Name + "." + wire
+			allowed := subject
+			if enumName != "" {
+				subject += " (" + enumName + ")"
+				allowed = enumName
+			}
+			line := t.Lines[wire]
+			if line == 0 {
+				line = tsFieldLine(string(file.Source), t.Line, wire)
+			}
+			violations = append(violations, model.Violation{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   fmt.Sprintf("%s enum values do not match the %s: %s", subject, label, strings.Join(parts, "; ")),
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Make %s allow exactly %s, or update the %s.", allowed, strings.Join(shape.Fields[name].Values, ", "), label),
+					Metadata: map[string]interface{}{
+						"type":    shape.Type,
+						"field":   wire,
+						"enum":    enumName,
+						"version": shape.Version,
+						"added":   added,
+						"removed": removed,
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// enumFieldNames returns the sorted names of a shape's `type: enum` fields that list
+// their values.
+func enumFieldNames(shape manifest.Shape) []string {
+	names := make([]string, 0)
+	for name, field := range shape.Fields {
+		if strings.EqualFold(strings.TrimSpace(field.Type), "enum") && len(field.Values) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fieldEnumValues resolves a field's declared type to the values it allows: a named
+// enum type (returned with its name), or for TypeScript an inline literal union.
+func fieldEnumValues(lang string, fieldType string, enums map[string][]string) (string, []string, bool) {
+	fieldType = strings.TrimSpace(fieldType)
+	if lang == "go" {
+		name := strings.TrimPrefix(fieldType, "*")
+		values, ok := enums[name]
+		return name, values, ok
+	}
+	if values, ok := tsUnionValues(fieldType); ok {
+		return "", values, true
+	}
+	name := ""
+	for _, part := range strings.Split(fieldType, "|") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "" || part == "null" || part == "undefined":
+		case name == "" && tsIdentPattern.MatchString(part):
+			name = part
+		default:
+			return "", nil, false
+		}
+	}
+	values, ok := enums[name]
+	return name, values, ok
+}
+
+// enumValueDelta returns the values the code allows that the manifest does not
+// (added) and the manifest values the code no longer allows (removed), both sorted.
+func enumValueDelta(implemented []string, declared []string) ([]string, []string) {
+	inCode := map[string]bool{}
+	for _, v := range implemented {
+		inCode[v] = true
+	}
+	inManifest := map[string]bool{}
+	for _, v := range declared {
+		inManifest[v] = true
+	}
+	added := make([]string, 0)
+	for v := range inCode {
+		if !inManifest[v] {
+			added = append(added, v)
+		}
+	}
+	removed := make([]string, 0)
+	for v := range inManifest {
+		if !inCode[v] {
+			removed = append(removed, v)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// goEnumValues collects, per named type, the values of the constants declared with
+// that type (`StatusShipped OrderStatus = "shipped"`). A type with any constant whose
+// value is not a literal (iota, expressions) is left out, since its values are unknown.
+func goEnumValues(parsed *ast.File) map[string][]string {
+	enums := map[string][]string{}
+	opaque := map[string]bool{}
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		last := ""
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			if vs.Type == nil && len(vs.Values) == 0 {
+				if last != "" {
+					opaque[last] = true
+				}
+				continue
+			}
+			ident, ok := vs.Type.(*ast.Ident)
+			if !ok {
+				last = ""
+				continue
+			}
+			last = ident.Name
+			for _, value := range vs.Values {
+				lit, ok := value.(*ast.BasicLit)
+				if !ok {
+					opaque[ident.Name] = true
+					continue
+				}
+				text := lit.Value
+				if lit.Kind == token.STRING {
+					unquoted, err := strconv.Unquote(lit.Value)
+					if err != nil {
+						continue
+					}
+					text = unquoted
+				}
+				enums[ident.Name] = append(enums[ident.Name], text)
+			}
+		}
+	}
+	for name := range opaque {
+		delete(enums, name)
+	}
+	return enums
+}
+
+// tsEnumValues collects literal union aliases (`type OrderStatus = "pending" | "shipped"`,
+// also written one `| "value"` per line) and enums whose members all have literal
+// initializers.
+func tsEnumValues(text string) map[string][]string {
+	enums := map[string][]string{}
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines); i++ {
+		m := tsUnionAliasPattern.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		expr := tsLineCommentStrip.ReplaceAllString(m[2], "")
+		for !strings.Contains(expr, ";") && i+1 < len(lines) {
+			trimmed := strings.TrimSpace(expr)
+			next := strings.TrimSpace(lines[i+1])
+			if trimmed != "" && !strings.HasSuffix(trimmed, "|") && !strings.HasPrefix(next, "|") {
+				break
+			}
+			i++
+			expr += " " + tsLineCommentStrip.ReplaceAllString(lines[i], "")
+		}
+		if semi := strings.Index(expr, ";"); semi >= 0 {
+			expr = expr[:semi]
+		}
+		if values, ok := tsUnionValues(expr); ok {
+			enums[m[1]] = values
+		}
+	}
+
+	for _, m := range tsEnumDeclPattern.FindAllStringSubmatchIndex(text, -1) {
+		open := m[1] - 1
+		end := matchTSBrace(text, open)
+		body := tsLineCommentStrip.ReplaceAllString(text[open+1:end-1], "")
+		values := make([]string, 0)
+		literal := true
+		for _, member := range strings.Split(body, ",") {
+			if strings.TrimSpace(member) == "" {
+				continue
+			}
+			mm := tsEnumMemberPattern.FindStringSubmatch(member)
+			if mm == nil {
+				literal = false
+				break
+			}
+			value, ok := tsLiteralValue(mm[1])
+			if !ok {
+				literal = false
+				break
+			}
+			values = append(values, value)
+		}
+		if literal && len(values) > 0 {
+			enums[text[m[2]:m[3]]] = values
+		}
+	}
+	return enums
+}
+
+// tsUnionValues reads a union of string or number literals. null and undefined
+// members are ignored; any other member means the union is not a literal enum.
+func tsUnionValues(expr string) ([]string, bool) {
+	values := make([]string, 0)
+	for _, part := range strings.Split(expr, "|") {
+		part = strings.TrimSpace(part)
+		if part == "" || part == "null" || part == "undefined" {
+			continue
+		}
+		value, ok := tsLiteralValue(part)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+	return values, len(values) > 0
+}
+
+func tsLiteralValue(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) >= 2 && strings.ContainsRune(`"'`+"`", rune(raw[0])) && raw[len(raw)-1] == raw[0] {
+		return raw[1 : len(raw)-1], true
+	}
+	if _, err := strconv.ParseFloat(raw, 64); err == nil {
+		return raw, true
+	}
+	return "", false
+}
+
+// addProjectEnums fills in enum types the file uses but declares elsewhere: in another
+// file of the same Go package, or in any TypeScript project file. A name declared
+// differently in several files is ambiguous and left unresolved.
+func addProjectEnums(enums map[string][]string, file *model.UnifiedFileModel, ctx *model.ProjectContext) {
+	if ctx == nil {
+		return
+	}
+	paths := make([]string, 0)
+	for p, f := range ctx.Files {
+		if f == nil || f.IsTestFile || p == file.Path || !strings.EqualFold(f.Language, file.Language) {
+			continue
+		}
+		if strings.EqualFold(file.Language, "go") && filepath.Dir(filepath.ToSlash(p)) != filepath.Dir(filepath.ToSlash(file.Path)) {
+			continue
+		}
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	found := map[string][]string{}
+	ambiguous := map[string]bool{}
+	for _, p := range paths {
+		var declared map[string][]string
+		if strings.EqualFold(file.Language, "go") {
+			parsed, err := parser.ParseFile(token.NewFileSet(), p, ctx.Files[p].Source, parser.SkipObjectResolution)
+			if err != nil {
+				continue
+			}
+			declared = goEnumValues(parsed)
+		} else {
+			declared = tsEnumValues(string(ctx.Files[p].Source))
+		}
+		for name, values := range declared {
+			if prev, ok := found[name]; ok && strings.Join(prev, "\x00") != strings.Join(values, "\x00") {
+				ambiguous[name] = true
+			}
+			found[name] = values
+		}
+	}
+	for name, values := range found {
+		if _, ok := enums[name]; !ok && !ambiguous[name] {
+			enums[name] = values
+		}
+	}
+}
+
+// tsFieldLine finds the line of a property declared in the type starting at typeLine.
+func tsFieldLine(text string, typeLine int, field string) int {
+	pattern := regexp.MustCompile(`^\s*(?:readonly\s+)?["']?` + regexp.QuoteMeta(field) + `["']?\s*\??\s*:`)
+	lines := strings.Split(text, "\n")
+	for i := typeLine - 1; i >= 0 && i < len(lines); i++ {
+		if pattern.MatchString(lines[i]) {
+			return i + 1
+		}
 	}
+	return typeLine
 }
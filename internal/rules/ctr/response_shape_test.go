@@ -1,8 +1,116 @@
 // response_shape_test.go — Tests for CTR-response-shape.
 package ctr
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestResponseShape(t *testing.T) {
 	assertRuleContract(t, &ResponseShape{})
 }
+
+const orderEnumManifest = `manifest_version: "1.0"
+contracts:
+  - id: orders
+    endpoints:
+      - path: /api/v1/orders/:id
+        method: GET
+        response:
+          type: Order
+          fields:
+            id:     { type: string, required: true }
+            status: { type: enum, values: ["pending", "confirmed", "shipped", "delivered"], required: true }
+`
+
+func writeOrderEnumManifest(t *testing.T) map[string]interface{} {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stricture-manifest.yml")
+	if err := os.WriteFile(path, []byte(orderEnumManifest), 0o644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	return map[string]interface{}{"manifest": path}
+}
+
+func TestResponseShapeFlagsGoEnumDrift(t *testing.T) {
+	source := "package orders\n\ntype OrderStatus string\n\nconst (\n\tStatusPending   OrderStatus = \"pending\"\n\tStatusConfirmed OrderStatus = \"confirmed\"\n\tStatusShipped   OrderStatus = \"shipped\"\n\tStatusRefunded  OrderStatus = \"refunded\"\n)\n\ntype Order struct {\n\tID     string      `json:\"id\"`\n\tStatus OrderStatus `json:\"status\"`\n}\n"
+	file := &model.UnifiedFileModel{Path: "internal/orders/order.go", Language: "go", Source: []byte(source)}
+	got := (&ResponseShape{}).Check(file, nil, model.RuleConfig{Options: writeOrderEnumManifest(t)})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	want := "Order.status (OrderStatus) enum values do not match the manifest v1 response shape: added refunded; removed delivered"
+	if got[0].Message != want || got[0].StartLine != 14 {
+		t.Fatalf("violation = line %d %q, want line 14 %q", got[0].StartLine, got[0].Message, want)
+	}
+	meta := got[0].Context.Metadata
+	if meta["field"] != "status" || meta["enum"] != "OrderStatus" ||
+		!reflect.DeepEqual(meta["added"], []string{"refunded"}) || !reflect.DeepEqual(meta["removed"], []string{"delivered"}) {
+		t.Fatalf("metadata = %+v", meta)
+	}
+}
+
+func TestResponseShapeResolvesEnumsFromThePackage(t *testing.T) {
+	options := writeOrderEnumManifest(t)
+	order := &model.UnifiedFileModel{
+		Path:     "internal/orders/order.go",
+		Language: "go",
+		Source:   []byte("package orders\n\ntype Order struct {\n\tStatus *OrderStatus `json:\"status,omitempty\"`\n}\n"),
+	}
+	status := &model.UnifiedFileModel{
+		Path:     "internal/orders/status.go",
+		Language: "go",
+		Source:   []byte("package orders\n\ntype OrderStatus string\n\nconst (\n\tStatusPending   OrderStatus = \"pending\"\n\tStatusConfirmed OrderStatus = \"confirmed\"\n\tStatusShipped   OrderStatus = \"shipped\"\n\tStatusDelivered OrderStatus = \"delivered\"\n)\n"),
+	}
+	elsewhere := &model.UnifiedFileModel{
+		Path:     "internal/legacy/status.go",
+		Language: "go",
+		Source:   []byte("package legacy\n\ntype OrderStatus string\n\nconst StatusOpen OrderStatus = \"open\"\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{order.Path: order, status.Path: status, elsewhere.Path: elsewhere}}
+	if got := (&ResponseShape{}).Check(order, ctx, model.RuleConfig{Options: options}); len(got) != 0 {
+		t.Fatalf("values match the manifest, got %+v", got)
+	}
+
+	status.Source = []byte("package orders\n\ntype OrderStatus string\n\nconst (\n\tStatusPending   OrderStatus = \"pending\"\n\tStatusConfirmed OrderStatus = \"confirmed\"\n\tStatusShipped   OrderStatus = \"shipped\"\n)\n")
+	got := (&ResponseShape{}).Check(order, ctx, model.RuleConfig{Options: options})
+	if len(got) != 1 || got[0].Message != "Order.status (OrderStatus) enum values do not match the manifest v1 response shape: removed delivered" {
+		t.Fatalf("violations = %+v", got)
+	}
+
+	numbered := &model.UnifiedFileModel{
+		Path:     "internal/orders/order.go",
+		Language: "go",
+		Source:   []byte("package orders\n\ntype OrderStatus int\n\nconst (\n\tStatusPending OrderStatus = iota\n\tStatusShipped\n)\n\ntype Order struct {\n\tStatus OrderStatus `json:\"status\"`\n}\n"),
+	}
+	if got := (&ResponseShape{}).Check(numbered, nil, model.RuleConfig{Options: options}); len(got) != 0 {
+		t.Fatalf("iota enums have no known wire values, got %+v", got)
+	}
+}
+
+func TestResponseShapeFlagsTypeScriptEnumDrift(t *testing.T) {
+	options := writeOrderEnumManifest(t)
+	source := "export type OrderStatus =\n  | \"pending\"\n  | \"confirmed\" // awaiting stock\n  | \"shipped\";\n\nexport interface Order {\n  id: string;\n  status: OrderStatus;\n}\n"
+	file := &model.UnifiedFileModel{Path: "src/api/v1/order.ts", Language: "typescript", Source: []byte(source)}
+	got := (&ResponseShape{}).Check(file, nil, model.RuleConfig{Options: options})
+	if len(got) != 1 || got[0].StartLine != 8 || got[0].Message != "Order.status (OrderStatus) enum values do not match the manifest v1 response shape: removed delivered" {
+		t.Fatalf("violations = %+v", got)
+	}
+
+	enumSource := "export enum OrderStatus {\n  Pending = \"pending\",\n  Confirmed = \"confirmed\",\n  Shipped = \"shipped\",\n  Delivered = \"delivered\",\n}\n\nexport interface Order {\n  status: OrderStatus;\n}\n"
+	file = &model.UnifiedFileModel{Path: "src/order.ts", Language: "typescript", Source: []byte(enumSource)}
+	if got := (&ResponseShape{}).Check(file, nil, model.RuleConfig{Options: options}); len(got) != 0 {
+		t.Fatalf("string enum matches the manifest, got %+v", got)
+	}
+
+	inline := "export type Order = {\n  status: 'pending' | 'confirmed' | 'shipped' | 'delivered' | 'cancelled' | null;\n};\n"
+	file = &model.UnifiedFileModel{Path: "src/order.ts", Language: "typescript", Source: []byte(inline)}
+	got = (&ResponseShape{}).Check(file, nil, model.RuleConfig{Options: options})
+	if len(got) != 1 || got[0].StartLine != 2 || got[0].Message != "Order.status enum values do not match the manifest v1 response shape: added cancelled" {
+		t.Fatalf("violations = %+v", got)
+	}
+}
@@ -2,11 +2,27 @@
 package ctr
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
+var (
+	tsTypeDeclPattern  = regexp.MustCompile(`(?m)^\s*(?:export\s+)?(?:declare\s+)?(?:interface\s+([A-Za-z_$][\w$]*)(?:\s*<[^>{]*>)?(?:\s+extends\s+[^{]+)?|type\s+([A-Za-z_$][\w$]*)(?:\s*<[^>{]*>)?\s*=)\s*\{`)
+	tsPropertyPattern  = regexp.MustCompile(`^\s*(?:readonly\s+)?([A-Za-z_$][\w$]*|"[^"]+"|'[^']+')\s*\??\s*:`)
+	tsLineCommentStrip = regexp.MustCompile(`//[^\n]*`)
+)
+
 // SharedTypeSync implements the CTR-shared-type-sync rule.
 type SharedTypeSync struct{}
 
@@ -18,31 +34,325 @@ func (r *SharedTypeSync) Description() string {
 func (r *SharedTypeSync) Why() string {
 	return "Duplicated types across repos drift quickly without explicit sync checks."
 }
+func (r *SharedTypeSync) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "type User in client.ts and server.go differ",
+		Good:     "type User in shared-types.ts, imported by both",
+	}}
+}
 func (r *SharedTypeSync) DefaultSeverity() string   { return "error" }
-func (r *SharedTypeSync) NeedsProjectContext() bool { return false }
-
-func (r *SharedTypeSync) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *SharedTypeSync) NeedsProjectContext() bool { return true }
 
+func (r *SharedTypeSync) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Type 'UserProfile' defined in client/contracts.ts and server/models.go with different shapes: missing field timezone"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Consolidate shared types or enforce generation from a single source.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Type 'UserProfile' defined in client/contracts.ts and server/models.go with different shapes: Go has timezone missing from TypeScript"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Go is ahead: add timezone to UserProfile in client/contracts.ts, or move 'UserProfile' to a shared generated source.",
+					Metadata: map[string]interface{}{
+						"type":     "UserProfile",
+						"goFile":   "server/models.go",
+						"tsFile":   "client/contracts.ts",
+						"goFields": []string{"id", "name", "timezone"},
+						"tsFields": []string{"id", "name"},
+						"onlyInGo": []string{"timezone"},
+						"onlyInTs": []string(nil),
+						"ahead":    "go",
+					},
+				},
 			},
-		},
+		}
+	}
+
+	// Pairs are reported once, from the Go side.
+	if file == nil || ctx == nil || !strings.EqualFold(file.Language, "go") || file.IsTestFile {
+		return nil
+	}
+	goTypes := goSharedTypes(file)
+	if len(goTypes) == 0 {
+		return nil
+	}
+	tsTypes := projectTSSharedTypes(ctx)
+
+	violations := make([]model.Violation, 0)
+	for _, goType := range goTypes {
+		for _, tsType := range tsTypes[goType.Name] {
+			drift := compareSharedFields(goType, tsType)
+			if drift == nil {
+				continue
+			}
+			violations = append(violations, model.Violation{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   fmt.Sprintf("Type '%s' defined in %s and %s with different shapes: %s", goType.Name, tsType.Path, goType.Path, drift.summary()),
+				FilePath:  file.Path,
+				StartLine: goType.Line,
+				Context: &model.ViolationContext{
+					SuggestedFix: drift.suggestion(goType, tsType),
+					Metadata: map[string]interface{}{
+						"type":     goType.Name,
+						"goFile":   goType.Path,
+						"tsFile":   tsType.Path,
+						"goFields": goType.fieldNames(),
+						"tsFields": tsType.fieldNames(),
+						"onlyInGo": drift.OnlyInGo,
+						"onlyInTs": drift.OnlyInTS,
+						"ahead":    drift.ahead(),
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// sharedType is a named record type with its wire field names in declaration order.
+// Types holds each field's declared type as written; Lines, for Go, each field's line.
+type sharedType struct {
+	Name   string
+	Path   string
+	Line   int
+	Fields []string
+	Types  map[string]string
+	Lines  map[string]int
+}
+
+func (t sharedType) fieldNames() []string {
+	return append([]string(nil), t.Fields...)
+}
+
+// typeDrift lists wire fields present on only one side of a Go/TS type pair.
+type typeDrift struct {
+	OnlyInGo []string
+	OnlyInTS []string
+}
+
+// ahead names the side with extra fields: "go", "typescript", or "both" when each
+// side has fields the other lacks.
+func (d *typeDrift) ahead() string {
+	switch {
+	case len(d.OnlyInGo) > 0 && len(d.OnlyInTS) > 0:
+		return "both"
+	case len(d.OnlyInGo) > 0:
+		return "go"
+	default:
+		return "typescript"
+	}
+}
+
+func (d *typeDrift) summary() string {
+	parts := make([]string, 0, 2)
+	if len(d.OnlyInGo) > 0 {
+		parts = append(parts, fmt.Sprintf("Go has %s missing from TypeScript", strings.Join(d.OnlyInGo, ", ")))
+	}
+	if len(d.OnlyInTS) > 0 {
+		parts = append(parts, fmt.Sprintf("TypeScript has %s missing from Go", strings.Join(d.OnlyInTS, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (d *typeDrift) suggestion(goType sharedType, tsType sharedType) string {
+	switch d.ahead() {
+	case "go":
+		return fmt.Sprintf("Go is ahead: add %s to %s in %s, or move '%s' to a shared generated source.", strings.Join(d.OnlyInGo, ", "), tsType.Name, tsType.Path, goType.Name)
+	case "typescript":
+		return fmt.Sprintf("TypeScript is ahead: add %s to %s in %s, or move '%s' to a shared generated source.", strings.Join(d.OnlyInTS, ", "), goType.Name, goType.Path, goType.Name)
+	default:
+		return fmt.Sprintf("Both sides changed: add %s to %s and %s to %s, or generate '%s' from a single source.", strings.Join(d.OnlyInGo, ", "), tsType.Path, strings.Join(d.OnlyInTS, ", "), goType.Path, goType.Name)
+	}
+}
+
+// compareSharedFields matches fields case-insensitively ignoring underscores, so
+// naming-style differences are left to CTR-json-tag-match. It returns nil when the
+// field sets agree.
+func compareSharedFields(goType sharedType, tsType sharedType) *typeDrift {
+	goKeys := map[string]bool{}
+	for _, f := range goType.Fields {
+		goKeys[sharedFieldKey(f)] = true
+	}
+	tsKeys := map[string]bool{}
+	for _, f := range tsType.Fields {
+		tsKeys[sharedFieldKey(f)] = true
+	}
+	drift := &typeDrift{}
+	for _, f := range goType.Fields {
+		if !tsKeys[sharedFieldKey(f)] {
+			drift.OnlyInGo = append(drift.OnlyInGo, f)
+		}
+	}
+	for _, f := range tsType.Fields {
+		if !goKeys[sharedFieldKey(f)] {
+			drift.OnlyInTS = append(drift.OnlyInTS, f)
+		}
+	}
+	if len(drift.OnlyInGo) == 0 && len(drift.OnlyInTS) == 0 {
+		return nil
+	}
+	return drift
+}
+
+func sharedFieldKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// goSharedTypes returns exported struct types with their JSON wire names (the json
+// tag, else the Go field name). Embedded and `json:"-"` fields are skipped.
+func goSharedTypes(file *model.UnifiedFileModel) []sharedType {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	shared := make([]sharedType, 0)
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			fields := make([]string, 0, len(st.Fields.List))
+			fieldTypes := map[string]string{}
+			fieldLines := map[string]int{}
+			for _, f := range st.Fields.List {
+				jsonName := ""
+				if f.Tag != nil {
+					if raw, err := strconv.Unquote(f.Tag.Value); err == nil {
+						jsonName = strings.Split(reflect.StructTag(raw).Get("json"), ",")[0]
+					}
+				}
+				if jsonName == "-" {
+					continue
+				}
+				for _, ident := range f.Names {
+					if !ident.IsExported() {
+						continue
+					}
+					wire := ident.Name
+					if jsonName != "" {
+						wire = jsonName
+					}
+					fields = append(fields, wire)
+					fieldTypes[wire] = types.ExprString(f.Type)
+					fieldLines[wire] = fset.Position(ident.Pos()).Line
+				}
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			shared = append(shared, sharedType{Name: ts.Name.Name, Path: file.Path, Line: fset.Position(ts.Pos()).Line, Fields: fields, Types: fieldTypes, Lines: fieldLines})
+		}
+	}
+	return shared
+}
+
+// projectTSSharedTypes indexes TypeScript interfaces and object type aliases in
+// non-test project files by name.
+func projectTSSharedTypes(ctx *model.ProjectContext) map[string][]sharedType {
+	paths := make([]string, 0)
+	for p, f := range ctx.Files {
+		if f != nil && strings.EqualFold(f.Language, "typescript") && !f.IsTestFile {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	index := map[string][]sharedType{}
+	for _, p := range paths {
+		for _, t := range tsSharedTypes(filepath.ToSlash(p), string(ctx.Files[p].Source)) {
+			index[t.Name] = append(index[t.Name], t)
+		}
+	}
+	return index
+}
+
+func tsSharedTypes(pathValue string, text string) []sharedType {
+	types := make([]sharedType, 0)
+	for _, m := range tsTypeDeclPattern.FindAllStringSubmatchIndex(text, -1) {
+		name := ""
+		switch {
+		case m[2] >= 0:
+			name = text[m[2]:m[3]]
+		case m[4] >= 0:
+			name = text[m[4]:m[5]]
+		}
+		open := m[1] - 1
+		end := matchTSBrace(text, open)
+		fields, fieldTypes := tsTopLevelProperties(text[open+1 : end-1])
+		if name == "" || len(fields) == 0 {
+			continue
+		}
+		types = append(types, sharedType{Name: name, Path: pathValue, Line: 1 + strings.Count(text[:m[0]], "\n"), Fields: fields, Types: fieldTypes})
+	}
+	return types
+}
+
+// tsTopLevelProperties returns property names declared directly in an object type
+// body, skipping nested object literals and method signatures, and each property's
+// declared type.
+func tsTopLevelProperties(body string) ([]string, map[string]string) {
+	body = tsLineCommentStrip.ReplaceAllString(body, "")
+	var props []string
+	propTypes := map[string]string{}
+	depth := 0
+	start := 0
+	flush := func(segment string) {
+		if m := tsPropertyPattern.FindStringSubmatchIndex(segment); m != nil {
+			name := strings.Trim(segment[m[2]:m[3]], `"'`)
+			props = append(props, name)
+			propTypes[name] = strings.TrimSpace(segment[m[1]:])
+		}
+	}
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '{', '(', '[', '<':
+			depth++
+		case '}', ')', ']', '>':
+			if depth > 0 {
+				depth--
+			}
+		case ';', ',', '\n':
+			if depth == 0 {
+				flush(body[start:i])
+				start = i + 1
+			}
+		}
+	}
+	flush(body[start:])
+	return props, propTypes
+}
+
+func matchTSBrace(text string, open int) int {
+	depth := 0
+	for i := open; i < len(text); i++ {
+		switch text[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
 	}
+	return len(text)
 }
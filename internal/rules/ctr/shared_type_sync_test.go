@@ -1,8 +1,98 @@
 // shared_type_sync_test.go — Tests for CTR-shared-type-sync.
 package ctr
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestSharedTypeSync(t *testing.T) {
 	assertRuleContract(t, &SharedTypeSync{})
 }
+
+func TestSharedTypeSyncReportsDriftDirection(t *testing.T) {
+	goSource := "package models\n\ntype UserProfile struct {\n\tID       string `json:\"id\"`\n\tName     string `json:\"name\"`\n\tTimezone string `json:\"timezone\"`\n\tinternal int\n}\n\ntype Order struct {\n\tID    string `json:\"id\"`\n\tTotal int    `json:\"total\"`\n}\n\ntype Invoice struct {\n\tID     string `json:\"id\"`\n\tAmount int    `json:\"amount\"`\n}\n"
+	tsSource := `export interface UserProfile {
+  id: string;
+  name: string; // display name
+}
+
+export type Order = {
+  id: string;
+  total: number;
+  notes?: string;
+};
+
+interface Invoice {
+  readonly id: string;
+  currency: string;
+  meta: { source: string };
+}
+`
+	goFile := &model.UnifiedFileModel{Path: "server/models.go", Language: "go", Source: []byte(goSource)}
+	tsFile := &model.UnifiedFileModel{Path: "client/contracts.ts", Language: "typescript", Source: []byte(tsSource)}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{goFile.Path: goFile, tsFile.Path: tsFile}}
+
+	rule := &SharedTypeSync{}
+	if got := rule.Check(tsFile, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("pairs should be reported from the Go side only, got %+v", got)
+	}
+	got := rule.Check(goFile, ctx, model.RuleConfig{})
+	if len(got) != 3 {
+		t.Fatalf("violations = %d, want 3: %+v", len(got), got)
+	}
+
+	want := []struct {
+		line     int
+		ahead    string
+		onlyInGo []string
+		onlyInTs []string
+		fixFile  string
+	}{
+		{3, "go", []string{"timezone"}, nil, "client/contracts.ts"},
+		{10, "typescript", nil, []string{"notes"}, "server/models.go"},
+		{15, "both", []string{"amount"}, []string{"currency", "meta"}, "client/contracts.ts"},
+	}
+	for i, w := range want {
+		v := got[i]
+		meta := v.Context.Metadata
+		if v.StartLine != w.line || meta["ahead"] != w.ahead {
+			t.Errorf("violation %d: line=%d ahead=%v, want line=%d ahead=%s", i, v.StartLine, meta["ahead"], w.line, w.ahead)
+		}
+		if !reflect.DeepEqual(meta["onlyInGo"], w.onlyInGo) || !reflect.DeepEqual(meta["onlyInTs"], w.onlyInTs) {
+			t.Errorf("violation %d: onlyInGo=%v onlyInTs=%v, want %v %v", i, meta["onlyInGo"], meta["onlyInTs"], w.onlyInGo, w.onlyInTs)
+		}
+		if _, ok := meta["goFields"].([]string); !ok {
+			t.Errorf("violation %d: goFields missing from metadata", i)
+		}
+		if _, ok := meta["tsFields"].([]string); !ok {
+			t.Errorf("violation %d: tsFields missing from metadata", i)
+		}
+		if !strings.Contains(v.Context.SuggestedFix, w.fixFile) {
+			t.Errorf("violation %d: suggestion %q should name %s", i, v.Context.SuggestedFix, w.fixFile)
+		}
+	}
+	if !strings.Contains(got[0].Message, "Go has timezone missing from TypeScript") {
+		t.Errorf("message should state the direction: %q", got[0].Message)
+	}
+}
+
+func TestSharedTypeSyncIgnoresMatchingShapes(t *testing.T) {
+	goFile := &model.UnifiedFileModel{
+		Path:     "server/user.go",
+		Language: "go",
+		Source:   []byte("package server\n\ntype User struct {\n\tUserID string `json:\"user_id\"`\n\tSecret string `json:\"-\"`\n\tEmail  string\n}\n"),
+	}
+	tsFile := &model.UnifiedFileModel{
+		Path:     "web/user.ts",
+		Language: "typescript",
+		Source:   []byte("export interface User {\n  userId: string;\n  email: string;\n}\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{goFile.Path: goFile, tsFile.Path: tsFile}}
+	if got := (&SharedTypeSync{}).Check(goFile, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("expected no drift, got %+v", got)
+	}
+}
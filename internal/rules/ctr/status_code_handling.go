@@ -18,6 +18,13 @@ func (r *StatusCodeHandling) Description() string {
 func (r *StatusCodeHandling) Why() string {
 	return "Unhandled status codes create silent failure paths in clients."
 }
+func (r *StatusCodeHandling) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "server returns 409 Conflict, client only checks 200 and 500",
+		Good:     "client handles 200, 400, 409, 500 matching server",
+	}}
+}
 func (r *StatusCodeHandling) DefaultSeverity() string   { return "error" }
 func (r *StatusCodeHandling) NeedsProjectContext() bool { return false }
 
@@ -18,6 +18,13 @@ func (r *StrictnessParity) Description() string {
 func (r *StrictnessParity) Why() string {
 	return "Different strictness interpretations cause latent production breaks."
 }
+func (r *StrictnessParity) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "client: email?: string, server: email: string (required)",
+		Good:     "client: email: string, server: email: string (both required)",
+	}}
+}
 func (r *StrictnessParity) DefaultSeverity() string   { return "error" }
 func (r *StrictnessParity) NeedsProjectContext() bool { return false }
 
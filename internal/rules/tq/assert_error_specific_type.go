@@ -0,0 +1,308 @@
+// assert_error_specific_type.go — TQ-assert-error-is-specific-type: Flag tests that only check an error occurred.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var (
+	// goGenericErrorAssertions only check that some error is present.
+	goGenericErrorAssertions = map[string]bool{"Error": true, "NotNil": true}
+	// goSpecificErrorAssertions identify the error, or compare it with an expected value.
+	goSpecificErrorAssertions = map[string]bool{"ErrorIs": true, "ErrorAs": true, "IsType": true, "Equal": true, "Same": true, "EqualValues": true}
+	goTestFailures            = map[string]bool{"Fatal": true, "Fatalf": true, "Error": true, "Errorf": true, "Fail": true, "FailNow": true}
+
+	jsGenericErrorPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\.(?:toThrow|toThrowError)\(\s*\)`),
+		regexp.MustCompile(`\.to\.throw\(\s*\)|\.to\.be\.rejected\s*(?:;|$)`),
+		regexp.MustCompile(`\bexpect\s*\(\s*(?:e|err|error|[\w$]+(?:Err|Error))\s*\)\s*\.(?:toBeDefined|toBeTruthy|not\.toBeNull|not\.toBeUndefined)\s*\(\s*\)`),
+		regexp.MustCompile(`\bassert\.(?:throws|rejects)\s*\(\s*(?:\(\s*\)\s*=>\s*[^,()]*(?:\([^()]*\))?|[\w$.]+)\s*\)`),
+	}
+	jsSpecificErrorPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\.(?:toThrow|toThrowError|throw|rejectedWith)\(\s*[A-Z][\w$]*(?:\.[\w$]+)*\s*[,)]`),
+		regexp.MustCompile(`\b(?:toBeInstanceOf|instanceOf)\(\s*[A-Z]|\binstanceof\s+[A-Z]`),
+		regexp.MustCompile(`\bassert\.(?:throws|rejects)\s*\([^\n]*,\s*[A-Z][\w$.]*\s*[,)]`),
+		regexp.MustCompile(`\.(?:name|code)\s*\)\s*\.(?:toBe|toEqual|toStrictEqual)\(`),
+		regexp.MustCompile(`\b(?:toMatchObject|objectContaining)\(\s*\{[^}]*\b(?:name|code)\s*:`),
+	}
+)
+
+// specificErrorHints name, per language, how to check which error a test got.
+var specificErrorHints = map[string]string{
+	"go":         "errors.Is or errors.As",
+	"typescript": "the error class, as in toThrow(NotFoundError)",
+	"javascript": "the error class, as in toThrow(NotFoundError)",
+}
+
+// AssertErrorSpecificType implements the TQ-assert-error-is-specific-type rule. It flags
+// tests whose error assertions only establish that some error happened: in Go
+// `assert.Error`, `NotNil` on an error, or failing when `err == nil`; in TypeScript and
+// JavaScript an empty `toThrow()` or an error checked for existence. A test passes once
+// any assertion identifies the error, by sentinel (`errors.Is`, `ErrorIs`, comparing
+// with an error variable), by type (`errors.As`, `toThrow(NotFoundError)`,
+// `toBeInstanceOf`), or by its `name` or `code`. Tests whose name matches an `allow`
+// regular expression are skipped.
+type AssertErrorSpecificType struct{}
+
+func (r *AssertErrorSpecificType) ID() string       { return "TQ-assert-error-is-specific-type" }
+func (r *AssertErrorSpecificType) Category() string { return "tq" }
+func (r *AssertErrorSpecificType) Description() string {
+	return "Flag tests that assert an error occurred without checking which error"
+}
+func (r *AssertErrorSpecificType) Why() string {
+	return "A test that accepts any error still passes when the code fails for the wrong reason, such as a nil pointer or a typo in the input instead of the validation it means to cover."
+}
+func (r *AssertErrorSpecificType) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "_, err := store.Get(ctx, \"missing\")\nrequire.Error(t, err)",
+		Good:     "_, err := store.Get(ctx, \"missing\")\nrequire.ErrorIs(t, err, ErrNotFound)",
+	}, {
+		Language: "typescript",
+		Bad:      "await expect(store.get('missing')).rejects.toThrow()",
+		Good:     "await expect(store.get('missing')).rejects.toThrow(NotFoundError)",
+	}}
+}
+func (r *AssertErrorSpecificType) DefaultSeverity() string   { return "warn" }
+func (r *AssertErrorSpecificType) NeedsProjectContext() bool { return false }
+
+func (r *AssertErrorSpecificType) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+	lang := strings.ToLower(file.Language)
+	var tests []errorAssertionTest
+	switch lang {
+	case "go":
+		tests = scanGoErrorAssertions(file.Source)
+	case "typescript", "javascript":
+		tests = scanJSErrorAssertions(file.Source)
+	default:
+		return nil
+	}
+
+	allow := make([]*regexp.Regexp, 0)
+	for _, expr := range stringSliceOption(config.Options, "allow") {
+		if re, err := regexp.Compile(expr); err == nil {
+			allow = append(allow, re)
+		}
+	}
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, test := range tests {
+		if test.Check == "" || test.Specific || errorTestAllowed(allow, test.Name) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Test %s only checks that an error occurred (%s); check which error with %s", test.Name, test.Check, specificErrorHints[lang]),
+			FilePath:  file.Path,
+			StartLine: test.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Assert the expected error with %s, or add the test to `allow` if any error is acceptable.", specificErrorHints[lang]),
+				Metadata: map[string]interface{}{
+					"test":  test.Name,
+					"check": test.Check,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// errorAssertionTest summarizes one test: its first generic error check (text and line)
+// and whether any assertion identifies the error.
+type errorAssertionTest struct {
+	Name     string
+	Check    string
+	Line     int
+	Specific bool
+}
+
+func (t *errorAssertionTest) recordGeneric(check string, line int) {
+	if t.Check == "" {
+		t.Check, t.Line = check, line
+	}
+}
+
+func errorTestAllowed(allow []*regexp.Regexp, name string) bool {
+	for _, re := range allow {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func scanGoErrorAssertions(source []byte) []errorAssertionTest {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	tests := make([]errorAssertionTest, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		test := errorAssertionTest{Name: fn.Name.Name}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.CallExpr:
+				if sel, ok := x.Fun.(*ast.SelectorExpr); ok {
+					if pkg, ok := sel.X.(*ast.Ident); ok && (pkg.Name == "errors" || pkg.Name == "xerrors") && (sel.Sel.Name == "Is" || sel.Sel.Name == "As") {
+						test.Specific = true
+					}
+				}
+				name, ok := goAssertionName(x)
+				if !ok {
+					return true
+				}
+				method := name[strings.Index(name, ".")+1:]
+				if goSpecificErrorAssertions[method] && goErrorArgument(x.Args) {
+					test.Specific = true
+				} else if goGenericErrorAssertions[method] && len(x.Args) > 1 && isErrorName(goRootIdent(x.Args[1])) {
+					test.recordGeneric(name, fset.Position(x.Pos()).Line)
+				}
+			case *ast.BinaryExpr:
+				if (x.Op == token.EQL || x.Op == token.NEQ) && goSentinelComparison(x) {
+					test.Specific = true
+				}
+			case *ast.TypeAssertExpr:
+				if isErrorName(goRootIdent(x.X)) {
+					test.Specific = true
+				}
+			case *ast.IfStmt:
+				if goChecksErrorPresence(x.Cond) && goBodyFails(x.Body) {
+					start, end := fset.Position(x.Cond.Pos()).Offset, fset.Position(x.Cond.End()).Offset
+					test.recordGeneric(string(source[start:end]), fset.Position(x.Pos()).Line)
+				}
+			}
+			return true
+		})
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+// goErrorArgument reports whether an assertion's arguments, after t, include an error.
+func goErrorArgument(args []ast.Expr) bool {
+	for i, arg := range args {
+		if i > 0 && isErrorName(goRootIdent(arg)) {
+			return true
+		}
+	}
+	return false
+}
+
+// goSentinelComparison matches `err == ErrNotFound` and `err != io.EOF`: an error
+// compared with anything but nil.
+func goSentinelComparison(x *ast.BinaryExpr) bool {
+	isNil := func(e ast.Expr) bool {
+		ident, ok := e.(*ast.Ident)
+		return ok && ident.Name == "nil"
+	}
+	if isNil(x.X) || isNil(x.Y) {
+		return false
+	}
+	_, leftIdent := x.X.(*ast.Ident)
+	_, rightIdent := x.Y.(*ast.Ident)
+	return (leftIdent && isErrorName(goRootIdent(x.X))) || (rightIdent && isErrorName(goRootIdent(x.Y)))
+}
+
+// goChecksErrorPresence matches the conditions of a test that expects an error: failing
+// when `err == nil`, and the table-driven `(err != nil) != tt.wantErr`.
+func goChecksErrorPresence(cond ast.Expr) bool {
+	nilCheck := func(e ast.Expr, op token.Token) bool {
+		for {
+			paren, ok := e.(*ast.ParenExpr)
+			if !ok {
+				break
+			}
+			e = paren.X
+		}
+		b, ok := e.(*ast.BinaryExpr)
+		if !ok || (op != token.ILLEGAL && b.Op != op) || (b.Op != token.EQL && b.Op != token.NEQ) {
+			return false
+		}
+		y, ok := b.Y.(*ast.Ident)
+		return ok && y.Name == "nil" && isErrorName(goRootIdent(b.X))
+	}
+	found := false
+	ast.Inspect(cond, func(n ast.Node) bool {
+		b, ok := n.(*ast.BinaryExpr)
+		if !ok || found {
+			return !found
+		}
+		if nilCheck(b, token.EQL) {
+			found = true
+		} else if (b.Op == token.EQL || b.Op == token.NEQ) && (nilCheck(b.X, token.ILLEGAL) || nilCheck(b.Y, token.ILLEGAL)) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// goBodyFails reports whether a block calls t.Fatal, t.Errorf, or another failure method.
+func goBodyFails(body *ast.BlockStmt) bool {
+	fails := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return !fails
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && goTestFailures[sel.Sel.Name] {
+			if _, ok := sel.X.(*ast.Ident); ok {
+				fails = true
+			}
+		}
+		return !fails
+	})
+	return fails
+}
+
+func scanJSErrorAssertions(source []byte) []errorAssertionTest {
+	text := string(source)
+	tests := make([]errorAssertionTest, 0)
+	for _, loc := range jsTestStartPattern.FindAllStringSubmatchIndex(text, -1) {
+		open := strings.IndexByte(text[loc[1]:], '{')
+		if open < 0 {
+			continue
+		}
+		start := loc[1] + open
+		body := text[start:matchBrace(text, start)]
+		test := errorAssertionTest{Name: text[loc[4]:loc[5]]}
+		for _, pattern := range jsSpecificErrorPatterns {
+			if pattern.MatchString(body) {
+				test.Specific = true
+				break
+			}
+		}
+		firstLine := 1 + strings.Count(text[:start], "\n")
+		for idx, line := range strings.Split(body, "\n") {
+			for _, pattern := range jsGenericErrorPatterns {
+				if m := pattern.FindString(line); m != "" {
+					test.recordGeneric(strings.TrimSpace(strings.TrimSuffix(m, ";")), firstLine+idx)
+					break
+				}
+			}
+		}
+		tests = append(tests, test)
+	}
+	return tests
+}
@@ -0,0 +1,88 @@
+// assert_error_specific_type_test.go — Tests for TQ-assert-error-is-specific-type.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestAssertErrorSpecificTypeMetadata(t *testing.T) {
+	rule := &AssertErrorSpecificType{}
+	if rule.ID() != "TQ-assert-error-is-specific-type" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestAssertErrorSpecificType(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		source   string
+		want     []string // "test|check" per violation, in order
+	}{
+		{
+			name:     "go generic assertions",
+			language: "go",
+			source: "package store\n\nfunc TestGetMissing(t *testing.T) {\n\t_, err := s.Get(\"x\")\n\trequire.Error(t, err)\n}\n\n" +
+				"func TestPutInvalid(t *testing.T) {\n\terr := s.Put(\"\")\n\tif err == nil {\n\t\tt.Fatal(\"expected an error\")\n\t}\n}\n\n" +
+				"func TestTable(t *testing.T) {\n\tfor _, tt := range cases {\n\t\t_, err := s.Get(tt.key)\n\t\tif (err != nil) != tt.wantErr {\n\t\t\tt.Errorf(\"err = %v\", err)\n\t\t}\n\t}\n}\n",
+			want: []string{"TestGetMissing|require.Error", "TestPutInvalid|err == nil", "TestTable|(err != nil) != tt.wantErr"},
+		},
+		{
+			name:     "go specific assertions",
+			language: "go",
+			source: "package store\n\nfunc TestGetMissing(t *testing.T) {\n\t_, err := s.Get(\"x\")\n\trequire.Error(t, err)\n\trequire.ErrorIs(t, err, ErrNotFound)\n}\n\n" +
+				"func TestPutInvalid(t *testing.T) {\n\terr := s.Put(\"\")\n\tvar verr *ValidationError\n\tif !errors.As(err, &verr) {\n\t\tt.Fatalf(\"err = %v\", err)\n\t}\n}\n\n" +
+				"func TestClose(t *testing.T) {\n\tif err := s.Close(); err != ErrClosed {\n\t\tt.Fatal(err)\n\t}\n}\n\n" +
+				"func TestNoError(t *testing.T) {\n\tif err := s.Open(); err != nil {\n\t\tt.Fatal(err)\n\t}\n\trequire.NoError(t, s.Flush())\n}\n",
+		},
+		{
+			name:     "typescript",
+			language: "typescript",
+			source: "describe('store', () => {\n  it('rejects missing keys', async () => {\n    await expect(store.get('x')).rejects.toThrow();\n  });\n" +
+				"  it('rejects empty keys', () => {\n    expect(() => store.put('')).toThrow(ValidationError);\n  });\n" +
+				"  test('reports the code', async () => {\n    const err = await store.get('x').catch((e) => e);\n    expect(err).toBeDefined();\n    expect(err.code).toBe('ENOENT');\n  });\n" +
+				"  test('mentions the key', () => {\n    expect(() => store.put('')).toThrow('key');\n  });\n});\n",
+			want: []string{"rejects missing keys|.toThrow()"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "store_test", Language: tt.language, IsTestFile: true, Source: []byte(tt.source)}
+			got := (&AssertErrorSpecificType{}).Check(file, nil, model.RuleConfig{})
+			if len(got) != len(tt.want) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				if key := got[i].Context.Metadata["test"].(string) + "|" + got[i].Context.Metadata["check"].(string); key != want {
+					t.Fatalf("violation %d = %s, want %s", i, key, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAssertErrorSpecificTypeMessageAndAllow(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:       "parse_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte("package parse\n\nfunc TestParseGarbage(t *testing.T) {\n\t_, err := Parse(\"%%\")\n\tassert.NotNil(t, err)\n}\n"),
+	}
+	rule := &AssertErrorSpecificType{}
+
+	got := rule.Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 5 || got[0].Message != "Test TestParseGarbage only checks that an error occurred (assert.NotNil); check which error with errors.Is or errors.As" {
+		t.Fatalf("got %+v", got)
+	}
+	if got := rule.Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"allow": []interface{}{`Garbage$`}}}); len(got) != 0 {
+		t.Fatalf("allowed tests should be skipped, got %+v", got)
+	}
+
+	file.IsTestFile = false
+	if got := rule.Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("production files should be skipped, got %+v", got)
+	}
+}
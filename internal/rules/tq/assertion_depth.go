@@ -2,12 +2,29 @@
 package tq
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
-// AssertionDepth implements the TQ-assertion-depth rule.
+const defaultMinFieldChecks = 2
+
+var (
+	jsCallResultPattern = regexp.MustCompile(`(?m)^\s*(?:const|let|var)\s+([A-Za-z_$][\w$]*)\s*(?::[^=]+)?=\s*(?:await\s+)?[A-Za-z_$][\w$.]*\s*\(`)
+	pyCallResultPattern = regexp.MustCompile(`(?m)^\s*([A-Za-z_]\w*)\s*=\s*(?:await\s+)?[A-Za-z_][\w.]*\s*\(`)
+	goDeepAssertions    = map[string]bool{"Equal": true, "EqualValues": true, "Exactly": true}
+	goDeepHelperPattern = regexp.MustCompile(`(?i)assert|check|compare|equal|verify|diff`)
+)
+
+// AssertionDepth implements the TQ-assertion-depth rule. With `preferDeepEqual`, it
+// flags tests that spot-check several fields of a value returned by a call without ever
+// comparing the whole value (assert.Equal on the struct, toEqual, assertEqual).
 type AssertionDepth struct{}
 
 func (r *AssertionDepth) ID() string       { return "TQ-assertion-depth" }
@@ -18,31 +35,308 @@ func (r *AssertionDepth) Description() string {
 func (r *AssertionDepth) Why() string {
 	return "Checking only parent containers misses nested contract regressions."
 }
+func (r *AssertionDepth) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "expect(response).toBeDefined() // missing response.data.items check",
+		Good:     "expect(response.data.items).toHaveLength(3)",
+	}}
+}
 func (r *AssertionDepth) DefaultSeverity() string   { return "error" }
 func (r *AssertionDepth) NeedsProjectContext() bool { return false }
 
 func (r *AssertionDepth) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Nested object user.profile.address is not asserted, only parent user.profile is checked"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Add assertions for nested fields that affect behavior.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Nested object user.profile.address is not asserted, only parent user.profile is checked"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add assertions for nested fields that affect behavior.",
+				},
 			},
-		},
+		}
+	}
+
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 || !boolOption(config.Options, "preferDeepEqual", false) {
+		return nil
+	}
+	minFields := defaultMinFieldChecks
+	if v, ok := intValue(config.Options["minFieldChecks"]); ok && v > 0 {
+		minFields = v
+	}
+
+	var tests []spotCheckTest
+	switch strings.ToLower(file.Language) {
+	case "go":
+		tests = scanGoSpotChecks(file.Source)
+	case "typescript", "javascript":
+		tests = scanJSSpotChecks(file.Source)
+	case "python":
+		tests = scanPythonSpotChecks(file.Source)
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, test := range tests {
+		subjects := make([]string, 0, len(test.Fields))
+		for subject := range test.Fields {
+			subjects = append(subjects, subject)
+		}
+		sort.Strings(subjects)
+		for _, subject := range subjects {
+			if test.Whole[subject] || len(test.Fields[subject]) < minFields {
+				continue
+			}
+			fields := make([]string, 0, len(test.Fields[subject]))
+			for field := range test.Fields[subject] {
+				fields = append(fields, field)
+			}
+			sort.Strings(fields)
+			violations = append(violations, model.Violation{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   fmt.Sprintf("Test %s checks %d fields of %s (%s) without comparing the whole value", test.Name, len(fields), subject, strings.Join(fields, ", ")),
+				FilePath:  file.Path,
+				StartLine: test.Line,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Build the expected value and compare %s in one assertion (assert.Equal, toEqual, assertEqual) so every field is verified.", subject),
+					Metadata: map[string]interface{}{
+						"test":    test.Name,
+						"subject": subject,
+						"fields":  fields,
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// spotCheckTest records, for one test, the fields asserted on each call result and
+// which results are also compared as a whole.
+type spotCheckTest struct {
+	Name   string
+	Line   int
+	Fields map[string]map[string]bool
+	Whole  map[string]bool
+}
+
+func newSpotCheckTest(name string, line int) spotCheckTest {
+	return spotCheckTest{Name: name, Line: line, Fields: map[string]map[string]bool{}, Whole: map[string]bool{}}
+}
+
+func (t *spotCheckTest) recordField(subject string, field string) {
+	if t.Fields[subject] == nil {
+		t.Fields[subject] = map[string]bool{}
+	}
+	t.Fields[subject][field] = true
+}
+
+// scanGoSpotChecks treats a variable assigned from a call as a result. Field selectors
+// and indexes on it inside testify assertions or if conditions are spot checks. Passing
+// the bare variable to assert.Equal/EqualValues/Exactly, reflect.DeepEqual, cmp.Diff, or
+// a helper named like a comparison, or comparing it with == or != (other than to nil),
+// counts as a whole comparison.
+func scanGoSpotChecks(source []byte) []spotCheckTest {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	tests := make([]spotCheckTest, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		test := newSpotCheckTest(fn.Name.Name, fset.Position(fn.Pos()).Line)
+		results := map[string]bool{}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Rhs) != 1 {
+				return true
+			}
+			if _, ok := assign.Rhs[0].(*ast.CallExpr); !ok {
+				return true
+			}
+			for _, lhs := range assign.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name != "_" && !isErrorName(ident.Name) {
+					results[ident.Name] = true
+				}
+			}
+			return true
+		})
+		if len(results) == 0 {
+			continue
+		}
+
+		recordOperand := func(expr ast.Expr, deep bool) {
+			for {
+				paren, ok := expr.(*ast.ParenExpr)
+				if !ok {
+					break
+				}
+				expr = paren.X
+			}
+			if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+				expr = unary.X
+			}
+			if star, ok := expr.(*ast.StarExpr); ok {
+				expr = star.X
+			}
+			switch e := expr.(type) {
+			case *ast.Ident:
+				if deep && results[e.Name] {
+					test.Whole[e.Name] = true
+				}
+			case *ast.SelectorExpr, *ast.IndexExpr:
+				if root := goRootIdent(e); results[root] {
+					test.recordField(root, strings.TrimPrefix(strings.TrimPrefix(sourceText(fset, source, e), root), "."))
+				}
+			}
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.CallExpr:
+				if name, ok := goAssertionName(x); ok {
+					method := name[strings.Index(name, ".")+1:]
+					for _, arg := range x.Args {
+						recordOperand(arg, goDeepAssertions[method])
+					}
+					return true
+				}
+				if goDeepCompareCall(goQualifiedCallName(x)) {
+					for _, arg := range x.Args {
+						recordOperand(arg, true)
+					}
+				}
+			case *ast.IfStmt:
+				ast.Inspect(x.Cond, func(c ast.Node) bool {
+					if bin, ok := c.(*ast.BinaryExpr); ok && (bin.Op == token.EQL || bin.Op == token.NEQ) {
+						recordOperand(bin.X, !isGoNil(bin.Y))
+						recordOperand(bin.Y, !isGoNil(bin.X))
+					}
+					return true
+				})
+			}
+			return true
+		})
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+// goDeepCompareCall reports whether a non-testify call compares its arguments as whole
+// values: reflect.DeepEqual, go-cmp, or a test helper such as assertUser or checkOrder.
+func goDeepCompareCall(name string) bool {
+	switch name {
+	case "reflect.DeepEqual", "cmp.Diff", "cmp.Equal":
+		return true
+	}
+	short := name[strings.LastIndex(name, ".")+1:]
+	return short != "" && goDeepHelperPattern.MatchString(short) && !strings.HasPrefix(name, "t.")
+}
+
+// goQualifiedCallName returns `pkg.Func` or `recv.Method` for a selector call with an
+// identifier receiver, and the bare name otherwise.
+func goQualifiedCallName(call *ast.CallExpr) string {
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if ident, ok := sel.X.(*ast.Ident); ok {
+			return ident.Name + "." + sel.Sel.Name
+		}
+	}
+	return callName(call)
+}
+
+func isGoNil(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
+
+// scanJSSpotChecks treats `const x = f(...)` (optionally awaited) as a result. Spot
+// checks are `expect(x.a.b)`; whole comparisons are `expect(x).toEqual(...)`,
+// `toStrictEqual`, `toMatchSnapshot`, and `assert.deepEqual`/`deepStrictEqual` with x
+// as a bare argument.
+func scanJSSpotChecks(source []byte) []spotCheckTest {
+	text := string(source)
+	tests := make([]spotCheckTest, 0)
+	for _, loc := range jsTestStartPattern.FindAllStringSubmatchIndex(text, -1) {
+		open := strings.IndexByte(text[loc[1]:], '{')
+		if open < 0 {
+			continue
+		}
+		start := loc[1] + open
+		body := text[start:matchBrace(text, start)]
+		test := newSpotCheckTest(text[loc[4]:loc[5]], 1+strings.Count(text[:loc[0]], "\n"))
+		for _, m := range jsCallResultPattern.FindAllStringSubmatch(body, -1) {
+			name := regexp.QuoteMeta(m[1])
+			field := regexp.MustCompile(`\bexpect\s*\(\s*` + name + `((?:\??\.[\w$]+|\[[^\]]+\])+)\s*\)`)
+			for _, fm := range field.FindAllStringSubmatch(body, -1) {
+				test.recordField(m[1], strings.TrimPrefix(strings.ReplaceAll(fm[1], "?.", "."), "."))
+			}
+			whole := regexp.MustCompile(`\bexpect\s*\(\s*` + name + `\s*\)\s*\.(?:toEqual|toStrictEqual|toMatchSnapshot|toMatchInlineSnapshot)\s*\(|\bassert\.(?:deepEqual|deepStrictEqual)\s*\((?:[^;]*,\s*)?` + name + `\s*[,)]`)
+			if whole.MatchString(body) {
+				test.Whole[m[1]] = true
+			}
+		}
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+// scanPythonSpotChecks treats `x = f(...)` as a result. Attribute and subscript reads
+// of x on assertion lines are spot checks; an `assert ... ==` or `self.assertEqual`
+// line that uses x bare is a whole comparison.
+func scanPythonSpotChecks(source []byte) []spotCheckTest {
+	lines := strings.Split(string(source), "\n")
+	tests := make([]spotCheckTest, 0)
+	for i := 0; i < len(lines); i++ {
+		m := pyTestStartPattern.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		indent := len(m[1])
+		end := i + 1
+		for end < len(lines) {
+			trimmed := strings.TrimSpace(lines[end])
+			if trimmed != "" && !strings.HasPrefix(trimmed, "#") && len(lines[end])-len(strings.TrimLeft(lines[end], " \t")) <= indent {
+				break
+			}
+			end++
+		}
+		test := newSpotCheckTest(m[2], i+1)
+		body := strings.Join(lines[i+1:end], "\n")
+		for _, rm := range pyCallResultPattern.FindAllStringSubmatch(body, -1) {
+			name := regexp.QuoteMeta(rm[1])
+			field := regexp.MustCompile(`\b` + name + `((?:\.\w+|\[[^\]]+\])+)(\s*\()?`)
+			bare := regexp.MustCompile(`\b` + name + `\b(?:[^.\[(\w]|$)`)
+			for _, line := range lines[i+1 : end] {
+				trimmed := strings.TrimSpace(line)
+				if !pyAnyAssertionPattern.MatchString(trimmed) {
+					continue
+				}
+				for _, fm := range field.FindAllStringSubmatch(trimmed, -1) {
+					if fm[2] == "" {
+						test.recordField(rm[1], strings.TrimPrefix(fm[1], "."))
+					}
+				}
+				if (strings.Contains(trimmed, "==") || strings.HasPrefix(trimmed, "self.assertEqual")) && bare.MatchString(trimmed) {
+					test.Whole[rm[1]] = true
+				}
+			}
+		}
+		i = end - 1
+		tests = append(tests, test)
 	}
+	return tests
 }
@@ -1,8 +1,129 @@
 // assertion_depth_test.go — Tests for TQ-assertion-depth.
 package tq
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestAssertionDepth(t *testing.T) {
 	assertRuleContract(t, &AssertionDepth{})
 }
+
+var preferDeepEqual = model.RuleConfig{Options: map[string]interface{}{"preferDeepEqual": true}}
+
+func TestAssertionDepthPreferDeepEqualGo(t *testing.T) {
+	source := `package users
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUser(t *testing.T) {
+	user, err := store.Get(1)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.Equal(t, "ada", user.Name)
+	if user.Profile.Email != "ada@example.com" {
+		t.Fatal(user.Profile.Email)
+	}
+}
+
+func TestGetUserWhole(t *testing.T) {
+	user, err := store.Get(1)
+	require.NoError(t, err)
+	assert.Equal(t, "ada", user.Name)
+	assert.Equal(t, "ada@example.com", user.Profile.Email)
+	assert.Equal(t, want, user)
+}
+
+func TestGetUserDeepEqual(t *testing.T) {
+	user, _ := store.Get(1)
+	assert.Equal(t, "ada", user.Name)
+	assert.Equal(t, 36, user.Age)
+	if !reflect.DeepEqual(*user, want) {
+		t.Fatal("mismatch")
+	}
+}
+
+func TestGetUserHelper(t *testing.T) {
+	user, _ := store.Get(1)
+	assert.Equal(t, "ada", user.Name)
+	assert.Equal(t, 36, user.Age)
+	assertUserMatches(t, want, user)
+}
+
+func TestGetUserSingleField(t *testing.T) {
+	user, _ := store.Get(1)
+	assert.Equal(t, "ada", user.Name)
+}
+`
+	file := &model.UnifiedFileModel{Path: "internal/users/store_test.go", Language: "go", IsTestFile: true, Source: []byte(source)}
+	if got := (&AssertionDepth{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("spot checks are only flagged with preferDeepEqual, got %+v", got)
+	}
+
+	got := (&AssertionDepth{}).Check(file, nil, preferDeepEqual)
+	if len(got) != 1 || got[0].StartLine != 11 {
+		t.Fatalf("want TestGetUser flagged, got %+v", got)
+	}
+	if got[0].Message != "Test TestGetUser checks 2 fields of user (Name, Profile.Email) without comparing the whole value" {
+		t.Fatalf("message = %q", got[0].Message)
+	}
+	if got[0].Context.Metadata["test"] != "TestGetUser" || got[0].Context.Metadata["subject"] != "user" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	strict := model.RuleConfig{Options: map[string]interface{}{"preferDeepEqual": true, "minFieldChecks": 1}}
+	if got := (&AssertionDepth{}).Check(file, nil, strict); len(got) != 2 {
+		t.Fatalf("minFieldChecks 1 should also flag the single-field test, got %+v", got)
+	}
+}
+
+func TestAssertionDepthPreferDeepEqualTypeScriptAndPython(t *testing.T) {
+	ts := &model.UnifiedFileModel{Path: "src/users.test.ts", Language: "typescript", IsTestFile: true, Source: []byte(`describe("users", () => {
+  it("loads a user", async () => {
+    const user = await loadUser(1);
+    expect(user.name).toBe("ada");
+    expect(user?.profile.email).toBe("ada@example.com");
+  });
+
+  it("loads a whole user", async () => {
+    const user = await loadUser(1);
+    expect(user.name).toBe("ada");
+    expect(user.age).toBe(36);
+    expect(user).toEqual(expected);
+  });
+});
+`)}
+	got := (&AssertionDepth{}).Check(ts, nil, preferDeepEqual)
+	if len(got) != 1 || got[0].StartLine != 2 || got[0].Context.Metadata["test"] != "loads a user" {
+		t.Fatalf("want the spot-check test flagged, got %+v", got)
+	}
+
+	py := &model.UnifiedFileModel{Path: "tests/test_users.py", Language: "python", IsTestFile: true, Source: []byte(`def test_load_user():
+    user = load_user(1)
+    assert user.name == "ada"
+    assert user.profile["email"] == "ada@example.com"
+    assert user.is_active()
+
+
+def test_load_user_whole():
+    user = load_user(1)
+    assert user.name == "ada"
+    assert user.age == 36
+    assert user == EXPECTED
+`)}
+	got = (&AssertionDepth{}).Check(py, nil, preferDeepEqual)
+	if len(got) != 1 || got[0].StartLine != 1 {
+		t.Fatalf("want test_load_user flagged, got %+v", got)
+	}
+	if fields, _ := got[0].Context.Metadata["fields"].([]string); len(fields) != 2 {
+		t.Fatalf("method calls are not field checks, got %+v", got[0].Context.Metadata)
+	}
+}
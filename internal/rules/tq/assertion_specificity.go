@@ -0,0 +1,291 @@
+// assertion_specificity.go — TQ-assertion-specificity: Flag tests that only check results exist.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var (
+	goExistenceAssertions = map[string]bool{"NotNil": true, "NotEmpty": true, "NotZero": true}
+	goErrorAssertions     = map[string]bool{"NoError": true, "Error": true, "ErrorIs": true, "ErrorAs": true, "ErrorContains": true, "EqualError": true}
+
+	jsTestStartPattern  = regexp.MustCompile(`\b(?:it|test)(?:\.only|\.skip)?\s*\(\s*(['"` + "`" + `])(.*?)['"` + "`" + `]\s*,`)
+	jsExistencePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\bexpect\s*\(\s*([A-Za-z_$][\w$]*)(?:\??\.[\w$]+)*\s*\)\s*\.(?:toBeDefined|toBeTruthy|not\.toBeNull|not\.toBeUndefined)\s*\(\s*\)`),
+		regexp.MustCompile(`\bexpect\s*\(\s*([A-Za-z_$][\w$]*)(?:\??\.[\w$]+)*\s*\)\s*\.to(?:\.not)?\.(?:exist|be\.ok)\b`),
+		regexp.MustCompile(`\bassert\.(?:ok|exists|isDefined|isNotNull)\s*\(\s*([A-Za-z_$][\w$]*)(?:\??\.[\w$]+)*\s*\)`),
+	}
+	jsAnyAssertionPattern = regexp.MustCompile(`\bexpect\s*\(|\bassert(?:\.\w+)?\s*\(`)
+
+	pyTestStartPattern  = regexp.MustCompile(`^(\s*)(?:async\s+)?def\s+(test\w*)\s*\(`)
+	pyExistencePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`^assert\s+([A-Za-z_]\w*)(?:\.\w+)*\s+is\s+not\s+None\s*(?:,.*)?$`),
+		regexp.MustCompile(`^assert\s+([A-Za-z_]\w*)(?:\.\w+)*\s*(?:,.*)?$`),
+		regexp.MustCompile(`^self\.assert(?:IsNotNone|True)\s*\(\s*([A-Za-z_]\w*)(?:\.\w+)*\s*(?:,[^)]*)?\)\s*$`),
+	}
+	pyAnyAssertionPattern = regexp.MustCompile(`^(?:assert\b|self\.assert\w*\s*\()`)
+)
+
+// AssertionSpecificity implements the TQ-assertion-specificity rule.
+type AssertionSpecificity struct{}
+
+func (r *AssertionSpecificity) ID() string       { return "TQ-assertion-specificity" }
+func (r *AssertionSpecificity) Category() string { return "tq" }
+func (r *AssertionSpecificity) Description() string {
+	return "Flag tests whose only assertions on a result are existence checks"
+}
+func (r *AssertionSpecificity) Why() string {
+	return "A test that only checks a result is non-nil passes no matter what the result contains."
+}
+func (r *AssertionSpecificity) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "user, err := Get(1)\nrequire.NoError(t, err)\nassert.NotNil(t, user)",
+		Good:     "user, err := Get(1)\nrequire.NoError(t, err)\nassert.Equal(t, \"ada\", user.Name)",
+	}}
+}
+func (r *AssertionSpecificity) DefaultSeverity() string   { return "warn" }
+func (r *AssertionSpecificity) NeedsProjectContext() bool { return false }
+
+func (r *AssertionSpecificity) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+
+	var tests []specificityTest
+	switch strings.ToLower(file.Language) {
+	case "go":
+		tests = scanGoSpecificity(file.Source)
+	case "typescript", "javascript":
+		tests = scanJSSpecificity(file.Source)
+	case "python":
+		tests = scanPythonSpecificity(file.Source)
+	}
+
+	perObject := boolOption(config.Options, "requireContentPerObject", false)
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, test := range tests {
+		if len(test.Shallow) == 0 || (test.HasContent && !perObject) {
+			continue
+		}
+		subjects := make([]string, 0, len(test.Shallow))
+		for name := range test.Shallow {
+			subjects = append(subjects, name)
+		}
+		sort.Strings(subjects)
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Test %s only checks existence of %s (%s); assert on values or fields", test.Name, strings.Join(subjects, ", "), test.Check),
+			FilePath:  file.Path,
+			StartLine: test.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Assert on the contents of %s, for example with an equality check on the expected value or its key fields.", strings.Join(subjects, ", ")),
+				Metadata: map[string]interface{}{
+					"test":     test.Name,
+					"subjects": subjects,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// specificityTest summarizes one test: subjects checked only for existence, the first
+// such check (name and line), and whether any other value assertion runs.
+type specificityTest struct {
+	Name       string
+	Shallow    map[string]bool
+	Check      string
+	Line       int
+	HasContent bool
+}
+
+func (t *specificityTest) recordExistence(subject string, check string, line int) {
+	if t.Shallow == nil {
+		t.Shallow = map[string]bool{}
+	}
+	if t.Check == "" {
+		t.Check, t.Line = check, line
+	}
+	t.Shallow[subject] = true
+}
+
+func scanGoSpecificity(source []byte) []specificityTest {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	tests := make([]specificityTest, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		test := specificityTest{Name: fn.Name.Name}
+		excluded := map[*ast.Ident]bool{}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch x := n.(type) {
+			case *ast.AssignStmt:
+				for _, lhs := range x.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						excluded[ident] = true
+					}
+				}
+			case *ast.CallExpr:
+				name, ok := goAssertionName(x)
+				if !ok {
+					return true
+				}
+				method := name[strings.Index(name, ".")+1:]
+				subject := ""
+				if len(x.Args) > 1 {
+					subject = goRootIdent(x.Args[1])
+				}
+				switch {
+				case goErrorAssertions[method], isErrorName(subject):
+				case goExistenceAssertions[method] && subject != "":
+					test.recordExistence(subject, name, fset.Position(x.Pos()).Line)
+					ast.Inspect(x, func(inner ast.Node) bool {
+						if ident, ok := inner.(*ast.Ident); ok {
+							excluded[ident] = true
+						}
+						return true
+					})
+				default:
+					test.HasContent = true
+				}
+			}
+			return true
+		})
+		// Any other use of a subject (a comparison, a field read, a helper call) counts as checking it.
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && !excluded[ident] && test.Shallow[ident.Name] {
+				delete(test.Shallow, ident.Name)
+			}
+			return true
+		})
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+func isErrorName(name string) bool {
+	return name == "err" || strings.HasSuffix(name, "Err") || strings.HasSuffix(name, "Error")
+}
+
+func scanJSSpecificity(source []byte) []specificityTest {
+	text := string(source)
+	tests := make([]specificityTest, 0)
+	for _, loc := range jsTestStartPattern.FindAllStringSubmatchIndex(text, -1) {
+		open := strings.IndexByte(text[loc[1]:], '{')
+		if open < 0 {
+			continue
+		}
+		start := loc[1] + open
+		end := matchBrace(text, start)
+		test := specificityTest{Name: text[loc[4]:loc[5]]}
+		analyzeTextSpecificity(&test, text[start:end], 1+strings.Count(text[:start], "\n"), jsExistencePatterns, jsAnyAssertionPattern, `(?:const|let|var)\s+%[1]s\b|\b%[1]s\s*=[^=]`)
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+func scanPythonSpecificity(source []byte) []specificityTest {
+	lines := strings.Split(string(source), "\n")
+	tests := make([]specificityTest, 0)
+	for i := 0; i < len(lines); i++ {
+		m := pyTestStartPattern.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		indent := len(m[1])
+		end := i + 1
+		for end < len(lines) {
+			trimmed := strings.TrimSpace(lines[end])
+			if trimmed != "" && !strings.HasPrefix(trimmed, "#") && len(lines[end])-len(strings.TrimLeft(lines[end], " \t")) <= indent {
+				break
+			}
+			end++
+		}
+		test := specificityTest{Name: m[2]}
+		analyzeTextSpecificity(&test, strings.Join(lines[i+1:end], "\n"), i+2, pyExistencePatterns, pyAnyAssertionPattern, `\b%[1]s\s*(?:,\s*\w+\s*)*=[^=]`)
+		tests = append(tests, test)
+	}
+	return tests
+}
+
+// analyzeTextSpecificity scans a test body line by line. Existence checks record their
+// subject; other assertions mark the test as having content. A subject stays shallow
+// only if it appears nowhere else in the body except where it is assigned; declFormat
+// is the assignment regexp with %[1]s standing for the subject name.
+func analyzeTextSpecificity(test *specificityTest, body string, firstLine int, existence []*regexp.Regexp, anyAssertion *regexp.Regexp, declFormat string) {
+	lines := strings.Split(body, "\n")
+	remaining := make([]string, 0, len(lines))
+	for idx, raw := range lines {
+		line := strings.TrimSpace(raw)
+		matched := false
+		for _, pattern := range existence {
+			if m := pattern.FindStringSubmatch(line); m != nil {
+				test.recordExistence(m[1], strings.TrimSpace(line), firstLine+idx)
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		if anyAssertion.MatchString(line) {
+			test.HasContent = true
+		}
+		remaining = append(remaining, raw)
+	}
+
+	rest := strings.Join(remaining, "\n")
+	for subject := range test.Shallow {
+		quoted := regexp.QuoteMeta(subject)
+		stripped := regexp.MustCompile(fmt.Sprintf(declFormat, quoted)).ReplaceAllString(rest, "")
+		if regexp.MustCompile(`(^|[^\w$.])` + quoted + `\b`).MatchString(stripped) {
+			delete(test.Shallow, subject)
+		}
+	}
+}
+
+// matchBrace returns the offset just past the brace that closes the one at open,
+// skipping string and template literals.
+func matchBrace(text string, open int) int {
+	depth := 0
+	for i := open; i < len(text); i++ {
+		switch c := text[i]; c {
+		case '\'', '"', '`':
+			for i++; i < len(text) && text[i] != c; i++ {
+				if text[i] == '\\' {
+					i++
+				}
+			}
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(text)
+}
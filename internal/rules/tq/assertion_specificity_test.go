@@ -0,0 +1,161 @@
+// assertion_specificity_test.go — Tests for TQ-assertion-specificity.
+package tq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestAssertionSpecificityMetadata(t *testing.T) {
+	rule := &AssertionSpecificity{}
+	if rule.ID() != "TQ-assertion-specificity" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestAssertionSpecificity(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		language  string
+		source    string
+		options   map[string]interface{}
+		wantTests []string
+	}{
+		{
+			name:     "go NotNil only",
+			path:     "user_test.go",
+			language: "go",
+			source: `package user
+
+func TestGet(t *testing.T) {
+	user, err := Get(1)
+	require.NoError(t, err)
+	assert.NotNil(t, user)
+}
+`,
+			wantTests: []string{"TestGet"},
+		},
+		{
+			name:     "go field assertion passes",
+			path:     "user_test.go",
+			language: "go",
+			source: `package user
+
+func TestGet(t *testing.T) {
+	user, err := Get(1)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.Equal(t, "ada", user.Name)
+}
+`,
+		},
+		{
+			name:     "go manual comparison counts as content",
+			path:     "user_test.go",
+			language: "go",
+			source: `package user
+
+func TestGet(t *testing.T) {
+	user, _ := Get(1)
+	assert.NotNil(t, user)
+	if user.Name != "ada" {
+		t.Fatalf("name = %q", user.Name)
+	}
+}
+`,
+		},
+		{
+			name:     "go other content skips test by default",
+			path:     "user_test.go",
+			language: "go",
+			source: `package user
+
+func TestList(t *testing.T) {
+	users, total := List()
+	assert.NotEmpty(t, users)
+	assert.Equal(t, 3, total)
+}
+`,
+		},
+		{
+			name:     "go per-object option flags the unchecked subject",
+			path:     "user_test.go",
+			language: "go",
+			source: `package user
+
+func TestList(t *testing.T) {
+	users, total := List()
+	assert.NotEmpty(t, users)
+	assert.Equal(t, 3, total)
+}
+`,
+			options:   map[string]interface{}{"requireContentPerObject": true},
+			wantTests: []string{"TestList"},
+		},
+		{
+			name:     "typescript toBeDefined only",
+			path:     "user.test.ts",
+			language: "typescript",
+			source: `describe("users", () => {
+  it("loads a user", async () => {
+    const user = await load(1);
+    expect(user).toBeDefined();
+    expect(user.id).not.toBeNull();
+  });
+
+  it("checks the name", async () => {
+    const user = await load(1);
+    expect(user).toBeDefined();
+    expect(user.name).toBe("ada");
+  });
+});
+`,
+			wantTests: []string{"loads a user"},
+		},
+		{
+			name:     "python is not None only",
+			path:     "test_user.py",
+			language: "python",
+			source: `def test_load():
+    user = load(1)
+    assert user is not None
+
+
+def test_load_name():
+    user = load(1)
+    assert user is not None
+    assert user.name == "ada"
+`,
+			wantTests: []string{"test_load"},
+		},
+	}
+
+	rule := &AssertionSpecificity{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: tc.path, Language: tc.language, IsTestFile: true, Source: []byte(tc.source)}
+			violations := rule.Check(file, nil, model.RuleConfig{Options: tc.options})
+			got := make([]string, 0, len(violations))
+			for _, v := range violations {
+				got = append(got, v.Context.Metadata["test"].(string))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantTests, ",") {
+				t.Fatalf("flagged tests = %v, want %v (%+v)", got, tc.wantTests, violations)
+			}
+		})
+	}
+}
+
+func TestAssertionSpecificityReportsFirstExistenceCheck(t *testing.T) {
+	source := "package user\n\nfunc TestGet(t *testing.T) {\n\tuser := Get(1)\n\tassert.NotNil(t, user)\n}\n"
+	violations := (&AssertionSpecificity{}).Check(&model.UnifiedFileModel{Path: "user_test.go", Language: "go", IsTestFile: true, Source: []byte(source)}, nil, model.RuleConfig{})
+	if len(violations) != 1 || violations[0].StartLine != 5 {
+		t.Fatalf("expected one violation on line 5, got %+v", violations)
+	}
+	if !strings.Contains(violations[0].Message, "only checks existence of user (assert.NotNil)") {
+		t.Fatalf("unexpected message: %q", violations[0].Message)
+	}
+}
@@ -16,6 +16,13 @@ func (r *BoundaryTested) Description() string { return "Require boundary value c
 func (r *BoundaryTested) Why() string {
 	return "Boundary cases catch edge bugs that happy-path tests miss."
 }
+func (r *BoundaryTested) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "test('handles array', () => fn([1,2,3])) // missing empty array case",
+		Good:     "test('handles empty array', () => expect(fn([])).toEqual([]))",
+	}}
+}
 func (r *BoundaryTested) DefaultSeverity() string   { return "error" }
 func (r *BoundaryTested) NeedsProjectContext() bool { return false }
 
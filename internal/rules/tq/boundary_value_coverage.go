@@ -0,0 +1,544 @@
+// boundary_value_coverage.go — TQ-boundary-value-coverage: Require min, max, and off-by-one values for bounded parameters.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var goNumericTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "byte": true, "rune": true,
+}
+
+// BoundaryValueCoverage implements the TQ-boundary-value-coverage rule.
+type BoundaryValueCoverage struct{}
+
+func (r *BoundaryValueCoverage) ID() string       { return "TQ-boundary-value-coverage" }
+func (r *BoundaryValueCoverage) Category() string { return "tq" }
+func (r *BoundaryValueCoverage) Description() string {
+	return "Require tests to exercise min, max, and off-by-one values of bounded parameters"
+}
+func (r *BoundaryValueCoverage) Why() string {
+	return "Off-by-one bugs live at the edges of a parameter's range; tests that skip the edges cannot catch them."
+}
+func (r *BoundaryValueCoverage) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// clamp.go: if n < 0 || n > 100 { ... }\nfunc TestPercent(t *testing.T) { Percent(50) }",
+		Good:     "func TestPercent(t *testing.T) {\n  for _, n := range []int{-1, 0, 100, 101} { Percent(n) }\n}",
+	}}
+}
+func (r *BoundaryValueCoverage) DefaultSeverity() string   { return "warn" }
+func (r *BoundaryValueCoverage) NeedsProjectContext() bool { return true }
+
+func (r *BoundaryValueCoverage) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || ctx == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+
+	params := make([]boundaryParam, 0)
+	for _, source := range boundarySourceFiles(file, ctx) {
+		params = append(params, goBoundaryParams(source.Source, boundsOption(config.Options))...)
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	siblings := boundarySiblingTests(file, ctx)
+	usages := make([]map[string]*boundaryUsage, len(siblings))
+	for i, sibling := range siblings {
+		usages[i] = goBoundaryUsages(sibling.Source)
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, p := range params {
+		owner, line, column := -1, 0, 0
+		covered := map[int]bool{}
+		for i, fileUsages := range usages {
+			u := fileUsages[p.Func]
+			if u == nil {
+				continue
+			}
+			if owner < 0 {
+				owner, line, column = i, u.Line, u.Column
+			}
+			for v := range u.valuesFor(p.Index, p.IsString) {
+				covered[v] = true
+			}
+		}
+		// Report once per package, on the first test file that calls the function.
+		if owner < 0 || siblings[owner].Path != file.Path {
+			continue
+		}
+
+		expected := p.expectedValues()
+		missing := make([]string, 0, len(expected))
+		for _, v := range expected {
+			if !covered[v] {
+				missing = append(missing, strconv.Itoa(v))
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		subject := p.Name
+		if p.IsString {
+			subject = "len(" + p.Name + ")"
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Tests for %s never use boundary value(s) %s for %s (%s)", p.Func, strings.Join(missing, ", "), subject, p.describeBounds()),
+			FilePath:    file.Path,
+			StartLine:   line,
+			StartColumn: column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Add cases calling %s with %s at each edge and one step past it.", p.Func, subject),
+				Metadata: map[string]interface{}{
+					"function":  p.Func,
+					"parameter": p.Name,
+					"missing":   missing,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+type boundaryParam struct {
+	Func     string
+	Name     string
+	Index    int
+	IsString bool
+	Min      *int
+	Max      *int
+	Edges    []boundaryEdge
+}
+
+// boundaryEdge is a split point found in the function body: values Below and Below+1
+// fall on opposite sides of the comparison.
+type boundaryEdge struct {
+	Below int
+	Expr  string
+}
+
+func (p boundaryParam) expectedValues() []int {
+	seen := map[int]bool{}
+	values := make([]int, 0, 4)
+	add := func(v int) {
+		if !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	if p.Min != nil {
+		add(*p.Min - 1)
+		add(*p.Min)
+	}
+	if p.Max != nil {
+		add(*p.Max)
+		add(*p.Max + 1)
+	}
+	for _, e := range p.Edges {
+		add(e.Below)
+		add(e.Below + 1)
+	}
+	if p.IsString {
+		// A string cannot be shorter than empty.
+		filtered := values[:0]
+		for _, v := range values {
+			if v >= 0 {
+				filtered = append(filtered, v)
+			}
+		}
+		values = filtered
+	}
+	sort.Ints(values)
+	return values
+}
+
+func (p boundaryParam) describeBounds() string {
+	parts := make([]string, 0, 2)
+	if p.Min != nil {
+		parts = append(parts, fmt.Sprintf("min %d", *p.Min))
+	}
+	if p.Max != nil {
+		parts = append(parts, fmt.Sprintf("max %d", *p.Max))
+	}
+	if len(parts) > 0 {
+		return "configured " + strings.Join(parts, ", ")
+	}
+	exprs := make([]string, 0, len(p.Edges))
+	for _, e := range p.Edges {
+		exprs = append(exprs, e.Expr)
+	}
+	return "checks " + strings.Join(exprs, ", ")
+}
+
+type boundaryUsage struct {
+	Line     int
+	Column   int
+	ints     map[int]map[int]bool
+	lengths  map[int]map[int]bool
+	indirect map[int]bool
+	pool     *boundaryPool
+}
+
+type boundaryPool struct {
+	ints    map[int]bool
+	lengths map[int]bool
+}
+
+// valuesFor returns literal values passed at the parameter position; when any call passes
+// a variable instead (table-driven tests), every literal in the file counts.
+func (u *boundaryUsage) valuesFor(index int, isString bool) map[int]bool {
+	values := map[int]bool{}
+	source, pool := u.ints[index], u.pool.ints
+	if isString {
+		source, pool = u.lengths[index], u.pool.lengths
+	}
+	for v := range source {
+		values[v] = true
+	}
+	if u.indirect[index] {
+		for v := range pool {
+			values[v] = true
+		}
+	}
+	return values
+}
+
+// boundarySourceFiles pairs a test file with production files, preferring the
+// project's test-to-source map and falling back to non-test Go files in the same package directory.
+func boundarySourceFiles(file *model.UnifiedFileModel, ctx *model.ProjectContext) []*model.UnifiedFileModel {
+	paths := ctx.TestSourceMap[file.Path]
+	if len(paths) == 0 {
+		dir := path.Dir(filepathSlash(file.Path))
+		for p, candidate := range ctx.Files {
+			if candidate != nil && !candidate.IsTestFile && strings.EqualFold(candidate.Language, "go") && path.Dir(filepathSlash(p)) == dir {
+				paths = append(paths, p)
+			}
+		}
+	}
+	sort.Strings(paths)
+
+	out := make([]*model.UnifiedFileModel, 0, len(paths))
+	for _, p := range paths {
+		if candidate := ctx.Files[p]; candidate != nil {
+			out = append(out, candidate)
+		}
+	}
+	return out
+}
+
+func boundarySiblingTests(file *model.UnifiedFileModel, ctx *model.ProjectContext) []*model.UnifiedFileModel {
+	dir := path.Dir(filepathSlash(file.Path))
+	out := []*model.UnifiedFileModel{file}
+	for p, candidate := range ctx.Files {
+		if candidate == nil || candidate == file || p == file.Path || !candidate.IsTestFile || !strings.EqualFold(candidate.Language, "go") {
+			continue
+		}
+		if path.Dir(filepathSlash(p)) == dir {
+			out = append(out, candidate)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out
+}
+
+func filepathSlash(p string) string {
+	return strings.ReplaceAll(p, "\\", "/")
+}
+
+// boundsOption reads `bounds: {Func.param: {min: 0, max: 100}}` from rule options.
+func boundsOption(options map[string]interface{}) map[string][2]*int {
+	out := map[string][2]*int{}
+	raw, ok := options["bounds"].(map[string]interface{})
+	if !ok {
+		return out
+	}
+	for key, value := range raw {
+		entry, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var limits [2]*int
+		if v, ok := intValue(entry["min"]); ok {
+			limits[0] = &v
+		}
+		if v, ok := intValue(entry["max"]); ok {
+			limits[1] = &v
+		}
+		if limits[0] != nil || limits[1] != nil {
+			out[strings.TrimSpace(key)] = limits
+		}
+	}
+	return out
+}
+
+func intValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func goBoundaryParams(source []byte, bounds map[string][2]*int) []boundaryParam {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	params := make([]boundaryParam, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Type.Params == nil {
+			continue
+		}
+		index := 0
+		for _, field := range fn.Type.Params.List {
+			typeName := ""
+			if ident, ok := field.Type.(*ast.Ident); ok {
+				typeName = ident.Name
+			}
+			names := field.Names
+			if len(names) == 0 {
+				index++
+				continue
+			}
+			for _, name := range names {
+				p := boundaryParam{Func: fn.Name.Name, Name: name.Name, Index: index, IsString: typeName == "string"}
+				index++
+				if !p.IsString && !goNumericTypes[typeName] {
+					continue
+				}
+				if limits, ok := bounds[p.Func+"."+p.Name]; ok {
+					p.Min, p.Max = limits[0], limits[1]
+				} else {
+					p.Edges = goBoundaryEdges(fn.Body, p.Name, p.IsString)
+				}
+				if p.Min != nil || p.Max != nil || len(p.Edges) > 0 {
+					params = append(params, p)
+				}
+			}
+		}
+	}
+	return params
+}
+
+// goBoundaryEdges finds comparisons of the parameter (or len of a string parameter)
+// against integer literals.
+func goBoundaryEdges(body *ast.BlockStmt, name string, isString bool) []boundaryEdge {
+	seen := map[int]bool{}
+	edges := make([]boundaryEdge, 0)
+	ast.Inspect(body, func(n ast.Node) bool {
+		bin, ok := n.(*ast.BinaryExpr)
+		if !ok {
+			return true
+		}
+		op := bin.Op
+		subject, literal := bin.X, bin.Y
+		limit, ok := goIntLiteral(literal)
+		if !ok || !isBoundarySubject(subject, name, isString) {
+			limit, ok = goIntLiteral(bin.X)
+			if !ok || !isBoundarySubject(bin.Y, name, isString) {
+				return true
+			}
+			op = flipComparison(op)
+		}
+
+		var below int
+		switch op {
+		case token.LSS, token.GEQ:
+			below = limit - 1
+		case token.LEQ, token.GTR:
+			below = limit
+		default:
+			return true
+		}
+		if !seen[below] {
+			seen[below] = true
+			subjectText := name
+			if isString {
+				subjectText = "len(" + name + ")"
+			}
+			edges = append(edges, boundaryEdge{Below: below, Expr: fmt.Sprintf("%s %s %d", subjectText, op, limit)})
+		}
+		return true
+	})
+	return edges
+}
+
+func isBoundarySubject(expr ast.Expr, name string, isString bool) bool {
+	if !isString {
+		ident, ok := expr.(*ast.Ident)
+		return ok && ident.Name == name
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return false
+	}
+	fn, ok := call.Fun.(*ast.Ident)
+	arg, argOK := call.Args[0].(*ast.Ident)
+	return ok && argOK && fn.Name == "len" && arg.Name == name
+}
+
+func flipComparison(op token.Token) token.Token {
+	switch op {
+	case token.LSS:
+		return token.GTR
+	case token.GTR:
+		return token.LSS
+	case token.LEQ:
+		return token.GEQ
+	case token.GEQ:
+		return token.LEQ
+	}
+	return op
+}
+
+func goIntLiteral(expr ast.Expr) (int, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT {
+			return 0, false
+		}
+		n, err := strconv.ParseInt(e.Value, 0, 64)
+		return int(n), err == nil
+	case *ast.UnaryExpr:
+		if e.Op != token.SUB {
+			return 0, false
+		}
+		n, ok := goIntLiteral(e.X)
+		return -n, ok
+	case *ast.ParenExpr:
+		return goIntLiteral(e.X)
+	}
+	return 0, false
+}
+
+// goStringLength resolves string literals and strings.Repeat(lit, n) to a length.
+func goStringLength(expr ast.Expr) (int, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return 0, false
+		}
+		s, err := strconv.Unquote(e.Value)
+		return len(s), err == nil
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Repeat" || len(e.Args) != 2 {
+			return 0, false
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "strings" {
+			return 0, false
+		}
+		unit, ok := goStringLength(e.Args[0])
+		count, countOK := goIntLiteral(e.Args[1])
+		return unit * count, ok && countOK
+	}
+	return 0, false
+}
+
+func goBoundaryUsages(source []byte) map[string]*boundaryUsage {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	pool := &boundaryPool{ints: map[int]bool{}, lengths: map[int]bool{}}
+	usages := map[string]*boundaryUsage{}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.UnaryExpr:
+			if v, ok := goIntLiteral(e); ok {
+				// Record -N without also counting the nested N.
+				pool.ints[v] = true
+				return false
+			}
+		case *ast.BasicLit:
+			if v, ok := goIntLiteral(e); ok {
+				pool.ints[v] = true
+			}
+			if v, ok := goStringLength(e); ok {
+				pool.lengths[v] = true
+			}
+		case *ast.CallExpr:
+			if v, ok := goStringLength(e); ok {
+				pool.lengths[v] = true
+			}
+			name := ""
+			switch fn := e.Fun.(type) {
+			case *ast.Ident:
+				name = fn.Name
+			case *ast.SelectorExpr:
+				name = fn.Sel.Name
+			}
+			if name == "" {
+				return true
+			}
+			u := usages[name]
+			if u == nil {
+				u = &boundaryUsage{
+					Line:     fset.Position(e.Pos()).Line,
+					Column:   fset.Position(e.Pos()).Column,
+					ints:     map[int]map[int]bool{},
+					lengths:  map[int]map[int]bool{},
+					indirect: map[int]bool{},
+					pool:     pool,
+				}
+				usages[name] = u
+			}
+			for i, arg := range e.Args {
+				resolved := false
+				if v, ok := goIntLiteral(arg); ok {
+					addBoundaryValue(u.ints, i, v)
+					resolved = true
+				}
+				if v, ok := goStringLength(arg); ok {
+					addBoundaryValue(u.lengths, i, v)
+					resolved = true
+				}
+				if !resolved {
+					u.indirect[i] = true
+				}
+			}
+		}
+		return true
+	})
+	return usages
+}
+
+func addBoundaryValue(values map[int]map[int]bool, index int, value int) {
+	if values[index] == nil {
+		values[index] = map[int]bool{}
+	}
+	values[index][value] = true
+}
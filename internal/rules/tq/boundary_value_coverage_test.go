@@ -0,0 +1,156 @@
+// boundary_value_coverage_test.go — Tests for TQ-boundary-value-coverage.
+package tq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const boundaryProductionSource = `package clamp
+
+import "errors"
+
+func Percent(n int) (int, error) {
+	if n < 0 || n > 100 {
+		return 0, errors.New("out of range")
+	}
+	return n, nil
+}
+
+func Label(name string) error {
+	if len(name) > 8 {
+		return errors.New("too long")
+	}
+	return nil
+}
+`
+
+func boundaryContext(files ...*model.UnifiedFileModel) *model.ProjectContext {
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, f := range files {
+		ctx.Files[f.Path] = f
+	}
+	return ctx
+}
+
+func TestBoundaryValueCoverageMetadata(t *testing.T) {
+	rule := &BoundaryValueCoverage{}
+	if rule.ID() != "TQ-boundary-value-coverage" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+	if !rule.NeedsProjectContext() {
+		t.Fatalf("rule should need project context to pair tests with sources")
+	}
+}
+
+func TestBoundaryValueCoverage(t *testing.T) {
+	tests := []struct {
+		name        string
+		test        string
+		options     map[string]interface{}
+		wantMissing []string
+	}{
+		{
+			name: "happy path only",
+			test: `package clamp
+
+func TestPercent(t *testing.T) {
+	got, _ := Percent(50)
+	_ = got
+	_ = Label("ok")
+}
+`,
+			wantMissing: []string{"-1, 0, 100, 101 for n", "8, 9 for len(name)"},
+		},
+		{
+			name: "all edges covered directly",
+			test: `package clamp
+
+func TestPercent(t *testing.T) {
+	Percent(-1)
+	Percent(0)
+	Percent(100)
+	Percent(101)
+	Label(strings.Repeat("a", 8))
+	Label("abcdefghi")
+}
+`,
+		},
+		{
+			name: "table driven literals count",
+			test: `package clamp
+
+func TestPercent(t *testing.T) {
+	for _, n := range []int{-1, 0, 100} {
+		Percent(n)
+	}
+}
+`,
+			wantMissing: []string{"101 for n"},
+		},
+		{
+			name: "configured bounds override heuristics",
+			test: `package clamp
+
+func TestPercent(t *testing.T) {
+	Percent(0)
+	Percent(1)
+	Percent(10)
+}
+`,
+			options:     map[string]interface{}{"bounds": map[string]interface{}{"Percent.n": map[string]interface{}{"min": 1, "max": 10}}},
+			wantMissing: []string{"11 for n"},
+		},
+		{
+			name: "function never called",
+			test: `package clamp
+
+func TestNothing(t *testing.T) {}
+`,
+		},
+	}
+
+	rule := &BoundaryValueCoverage{}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			source := &model.UnifiedFileModel{Path: "pkg/clamp/clamp.go", Language: "go", Source: []byte(boundaryProductionSource)}
+			testFile := &model.UnifiedFileModel{Path: "pkg/clamp/clamp_test.go", Language: "go", IsTestFile: true, Source: []byte(tc.test)}
+			got := rule.Check(testFile, boundaryContext(source, testFile), model.RuleConfig{Options: tc.options})
+			if len(got) != len(tc.wantMissing) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tc.wantMissing), got)
+			}
+			for i, v := range got {
+				if !strings.Contains(v.Message, tc.wantMissing[i]) {
+					t.Fatalf("message = %q, want it to contain %q", v.Message, tc.wantMissing[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBoundaryValueCoverageReportsOncePerPackage(t *testing.T) {
+	source := &model.UnifiedFileModel{Path: "pkg/clamp/clamp.go", Language: "go", Source: []byte(boundaryProductionSource)}
+	first := &model.UnifiedFileModel{Path: "pkg/clamp/a_test.go", Language: "go", IsTestFile: true, Source: []byte("package clamp\n\nfunc TestA(t *testing.T) { Percent(-1); Percent(0) }\n")}
+	second := &model.UnifiedFileModel{Path: "pkg/clamp/b_test.go", Language: "go", IsTestFile: true, Source: []byte("package clamp\n\nfunc TestB(t *testing.T) { Percent(100) }\n")}
+	ctx := boundaryContext(source, first, second)
+
+	rule := &BoundaryValueCoverage{}
+	got := rule.Check(first, ctx, model.RuleConfig{})
+	if len(got) != 1 || !strings.Contains(got[0].Message, "101 for n") {
+		t.Fatalf("first file violations = %+v, want only 101 missing", got)
+	}
+	if got := rule.Check(second, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("second file violations = %+v, want none", got)
+	}
+}
+
+func TestBoundaryValueCoverageWithoutContext(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "clamp_test.go", Language: "go", IsTestFile: true, Source: []byte("package clamp\n")}
+	if got := (&BoundaryValueCoverage{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("violations = %d, want 0", len(got))
+	}
+}
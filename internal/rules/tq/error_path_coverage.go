@@ -2,12 +2,20 @@
 package tq
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
-// ErrorPathCoverage implements the TQ-error-path-coverage rule.
+// ErrorPathCoverage implements the TQ-error-path-coverage rule. For Go tests it also
+// checks that an error the production code returns as a sentinel (directly or wrapped
+// with %w) is asserted by identity, not by matching its message text.
 type ErrorPathCoverage struct{}
 
 func (r *ErrorPathCoverage) ID() string          { return "TQ-error-path-coverage" }
@@ -16,31 +24,258 @@ func (r *ErrorPathCoverage) Description() string { return "Require tests for exp
 func (r *ErrorPathCoverage) Why() string {
 	return "Uncovered error paths are a common source of production outages."
 }
+func (r *ErrorPathCoverage) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "if (count < 0) return error // no test for count < 0",
+		Good:     "test('rejects negative count', () => expect(() => fn(-1)).toThrow())",
+	}, {
+		Language: "go",
+		Bad:      "if err == nil || !strings.Contains(err.Error(), \"object not found\") { t.Fatal(err) }",
+		Good:     "if !errors.Is(err, ErrObjectNotFound) { t.Fatal(err) }",
+	}}
+}
 func (r *ErrorPathCoverage) DefaultSeverity() string   { return "error" }
-func (r *ErrorPathCoverage) NeedsProjectContext() bool { return false }
-
-func (r *ErrorPathCoverage) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *ErrorPathCoverage) NeedsProjectContext() bool { return true }
 
+func (r *ErrorPathCoverage) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Function has error exit at line 42 but no test covers this path: invalid input payload"
-	return []model.Violation{
-		{
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Function has error exit at line 42 but no test covers this path: invalid input payload"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add a negative-path test that triggers and validates this error condition.",
+				},
+			},
+		}
+	}
+
+	if file == nil || ctx == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+	sentinels := map[string]string{}
+	for _, source := range boundarySourceFiles(file, ctx) {
+		for name, message := range goReturnedSentinels(source.Source) {
+			sentinels[name] = message
+		}
+	}
+	if len(sentinels) == 0 {
+		return nil
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, match := range goErrorTextMatches(file.Source, sentinels) {
+		violations = append(violations, model.Violation{
 			RuleID:    r.ID(),
 			Severity:  severity,
-			Message:   message,
+			Message:   fmt.Sprintf("%s matches error text %q instead of checking errors.Is(err, %s)", match.Test, match.Text, match.Sentinel),
 			FilePath:  file.Path,
-			StartLine: line,
+			StartLine: match.Line,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Add a negative-path test that triggers and validates this error condition.",
+				SuggestedFix: fmt.Sprintf("Assert errors.Is(err, %s) (or assert.ErrorIs) so the check survives rewording and wrapping.", match.Sentinel),
+				Metadata: map[string]interface{}{
+					"test":     match.Test,
+					"sentinel": match.Sentinel,
+					"text":     match.Text,
+				},
 			},
-		},
+		})
+	}
+	return violations
+}
+
+// goReturnedSentinels returns the package-level sentinel errors declared with
+// errors.New("...") that the file also returns, bare or wrapped, keyed to their message.
+func goReturnedSentinels(source []byte) map[string]string {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	declared := map[string]string{}
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != len(vs.Values) {
+				continue
+			}
+			for i, name := range vs.Names {
+				call, ok := vs.Values[i].(*ast.CallExpr)
+				if !ok || !isSentinelName(name.Name) || callName(call) != "New" || len(call.Args) != 1 {
+					continue
+				}
+				if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if message, err := strconv.Unquote(lit.Value); err == nil {
+						declared[name.Name] = message
+					}
+				}
+			}
+		}
+	}
+
+	returned := map[string]string{}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		ret, ok := n.(*ast.ReturnStmt)
+		if !ok || len(ret.Results) == 0 {
+			return true
+		}
+		if _, sentinel := errorIdentity(ret.Results[len(ret.Results)-1]); sentinel != "" {
+			if message, ok := declared[sentinel]; ok {
+				returned[sentinel] = message
+			}
+		}
+		return true
+	})
+	return returned
+}
+
+// errorTextMatch is a test assertion that compares error text to a sentinel's message.
+type errorTextMatch struct {
+	Test     string
+	Sentinel string
+	Text     string
+	Line     int
+}
+
+// goErrorTextMatches finds, per Test function, string comparisons against err.Error()
+// (strings.Contains, ==, assert.Equal/Contains) and assert.EqualError/ErrorContains
+// whose literal overlaps a sentinel's message. A test that already checks that sentinel
+// with errors.Is, errors.As, or assert.ErrorIs/ErrorAs is not reported.
+func goErrorTextMatches(source []byte, sentinels map[string]string) []errorTextMatch {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	matches := make([]errorTextMatch, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		identity := map[string]bool{}
+		found := map[string]errorTextMatch{}
+		record := func(pos token.Pos, text string) {
+			for _, sentinel := range sentinelsForText(sentinels, text) {
+				if _, seen := found[sentinel]; !seen {
+					found[sentinel] = errorTextMatch{Test: fn.Name.Name, Sentinel: sentinel, Text: text, Line: fset.Position(pos).Line}
+				}
+			}
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				switch callName(node) {
+				case "Is", "As", "ErrorIs", "ErrorIsf", "ErrorAs", "ErrorAsf":
+					for _, arg := range node.Args {
+						if _, sentinel := errorIdentity(arg); sentinel != "" {
+							identity[sentinel] = true
+						}
+					}
+				case "EqualError", "EqualErrorf", "ErrorContains", "ErrorContainsf":
+					if text, ok := stringLiteralArg(node.Args); ok {
+						record(node.Pos(), text)
+					}
+				case "Contains", "Containsf", "HasPrefix", "HasSuffix", "Equal", "Equalf":
+					if !hasErrorTextArg(node.Args) {
+						break
+					}
+					if text, ok := stringLiteralArg(node.Args); ok {
+						record(node.Pos(), text)
+					}
+				}
+			case *ast.BinaryExpr:
+				if node.Op != token.EQL && node.Op != token.NEQ {
+					break
+				}
+				if !hasErrorTextArg([]ast.Expr{node.X, node.Y}) {
+					break
+				}
+				if text, ok := stringLiteralArg([]ast.Expr{node.X, node.Y}); ok {
+					record(node.Pos(), text)
+				}
+			}
+			return true
+		})
+		for sentinel, match := range found {
+			if !identity[sentinel] {
+				matches = append(matches, match)
+			}
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Line != matches[j].Line {
+			return matches[i].Line < matches[j].Line
+		}
+		return matches[i].Sentinel < matches[j].Sentinel
+	})
+	return matches
+}
+
+func callName(call *ast.CallExpr) string {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name
+	case *ast.SelectorExpr:
+		return fn.Sel.Name
+	}
+	return ""
+}
+
+// hasErrorTextArg reports whether one of args is a call to an Error() method.
+func hasErrorTextArg(args []ast.Expr) bool {
+	for _, arg := range args {
+		call, ok := arg.(*ast.CallExpr)
+		if !ok || len(call.Args) != 0 {
+			continue
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Error" {
+			return true
+		}
+	}
+	return false
+}
+
+func stringLiteralArg(args []ast.Expr) (string, bool) {
+	for _, arg := range args {
+		if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if value, err := strconv.Unquote(lit.Value); err == nil {
+				return value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// sentinelsForText returns the sentinels whose message contains the matched text or is
+// contained in it, ignoring fragments shorter than minErrorFragment.
+func sentinelsForText(sentinels map[string]string, text string) []string {
+	text = strings.TrimSpace(text)
+	if len(text) < minErrorFragment {
+		return nil
+	}
+	out := make([]string, 0)
+	for name, message := range sentinels {
+		if len(message) >= minErrorFragment && (strings.Contains(message, text) || strings.Contains(text, message)) {
+			out = append(out, name)
+		}
 	}
+	sort.Strings(out)
+	return out
 }
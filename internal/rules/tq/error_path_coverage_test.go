@@ -1,8 +1,119 @@
 // error_path_coverage_test.go — Tests for TQ-error-path-coverage.
 package tq
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestErrorPathCoverage(t *testing.T) {
 	assertRuleContract(t, &ErrorPathCoverage{})
 }
+
+const errorPathStoreSource = `package store
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+	ErrPresignExpired = errors.New("presigned URL expired")
+	ErrUnused         = errors.New("never returned")
+)
+
+func Get(key string) ([]byte, error) {
+	if key == "" {
+		return nil, ErrObjectNotFound
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPresignExpired, key)
+}
+`
+
+func errorPathContext(test *model.UnifiedFileModel) *model.ProjectContext {
+	source := &model.UnifiedFileModel{Path: "internal/store/store.go", Language: "go", Source: []byte(errorPathStoreSource)}
+	return &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{source.Path: source, test.Path: test}}
+}
+
+func TestErrorPathCoverageFlagsErrorTextMatching(t *testing.T) {
+	test := &model.UnifiedFileModel{
+		Path:       "internal/store/store_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source: []byte(`package store
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMissing(t *testing.T) {
+	_, err := Get("")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatal(err)
+	}
+}
+
+func TestGetExpired(t *testing.T) {
+	_, err := Get("k")
+	assert.ErrorContains(t, err, "presigned URL expired")
+	if err.Error() != "never returned" {
+		t.Log("unrelated")
+	}
+}
+
+func TestGetMissingByIdentity(t *testing.T) {
+	_, err := Get("")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	assert.Contains(t, err.Error(), "object not found")
+}
+
+func TestGetExpiredByIdentity(t *testing.T) {
+	_, err := Get("k")
+	if !errors.Is(err, ErrPresignExpired) || err.Error() == "" {
+		t.Fatal(err)
+	}
+}
+`),
+	}
+	got := (&ErrorPathCoverage{}).Check(test, errorPathContext(test), model.RuleConfig{})
+	want := []struct {
+		line    int
+		message string
+	}{
+		{13, `TestGetMissing matches error text "not found" instead of checking errors.Is(err, ErrObjectNotFound)`},
+		{20, `TestGetExpired matches error text "presigned URL expired" instead of checking errors.Is(err, ErrPresignExpired)`},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("violations = %d, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].StartLine != w.line || got[i].Message != w.message {
+			t.Fatalf("violation %d = line %d %q, want line %d %q", i, got[i].StartLine, got[i].Message, w.line, w.message)
+		}
+	}
+	if got[0].Context.Metadata["test"] != "TestGetMissing" || got[0].Context.Metadata["sentinel"] != "ErrObjectNotFound" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+}
+
+func TestErrorPathCoverageIgnoresNonSentinelErrors(t *testing.T) {
+	test := &model.UnifiedFileModel{
+		Path:       "internal/store/store_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte("package store\n\nimport \"testing\"\n\nfunc TestParse(t *testing.T) {\n\tif err := parse(\"\"); err.Error() != \"empty input\" {\n\t\tt.Fatal(err)\n\t}\n}\n"),
+	}
+	if got := (&ErrorPathCoverage{}).Check(test, errorPathContext(test), model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("messages that are not sentinel errors should pass, got %+v", got)
+	}
+	production := &model.UnifiedFileModel{Path: "internal/store/store.go", Language: "go", Source: []byte(errorPathStoreSource)}
+	if got := (&ErrorPathCoverage{}).Check(production, errorPathContext(test), model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("production files are not checked, got %+v", got)
+	}
+}
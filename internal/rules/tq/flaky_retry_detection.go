@@ -0,0 +1,308 @@
+// flaky_retry_detection.go — TQ-flaky-retry-detection: Flag retry loops and retry settings that hide flaky tests.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultFlakyRetryMarker marks a sanctioned eventual-consistency retry on, above, or inside it.
+const defaultFlakyRetryMarker = "stricture-allow-retry"
+
+var (
+	jsRetrySettingPattern = regexp.MustCompile(`\bjest\.retryTimes\s*\(|\bthis\.retries\s*\(|\.configure\(\s*\{[^}]*\bretries\s*:|\{\s*retry\s*:\s*[1-9]`)
+	jsLoopPattern         = regexp.MustCompile(`\b(?:for|while)\s*\(`)
+	jsDoLoopPattern       = regexp.MustCompile(`\bdo\s*\{`)
+	jsSleepPattern        = regexp.MustCompile(`\bsetTimeout\s*\(|\bawait\s+(?:\w+\.)*(?:sleep|delay)\s*\(`)
+	pyRetryDecorator      = regexp.MustCompile(`^\s*@(?:pytest\.mark\.)?(?:flaky|retry|retrying)\b`)
+	pyLoopPattern         = regexp.MustCompile(`^(\s*)(?:for|while)\b.*:\s*$`)
+	pySleepPattern        = regexp.MustCompile(`\b(?:time\.|asyncio\.)?sleep\s*\(`)
+	goTestFailureMethods  = map[string]bool{"Error": true, "Errorf": true, "Fatal": true, "Fatalf": true, "Fail": true, "FailNow": true}
+)
+
+// FlakyRetryDetection implements the TQ-flaky-retry-detection rule. It runs on test
+// files and flags loops that both sleep and assert, plus framework retry settings.
+type FlakyRetryDetection struct{}
+
+func (r *FlakyRetryDetection) ID() string       { return "TQ-flaky-retry-detection" }
+func (r *FlakyRetryDetection) Category() string { return "tq" }
+func (r *FlakyRetryDetection) Description() string {
+	return "Flag retry loops around assertions and test retry settings that mask flakiness"
+}
+func (r *FlakyRetryDetection) Why() string {
+	return "Retrying an assertion until it passes turns a nondeterministic failure into a slow pass instead of a fix."
+}
+func (r *FlakyRetryDetection) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "for i := 0; i < 5; i++ {\n\tif got := cache.Get(\"k\"); got == \"v\" {\n\t\tbreak\n\t}\n\ttime.Sleep(100 * time.Millisecond)\n\tt.Errorf(\"retry %d\", i)\n}",
+		Good:     "clock.Advance(ttl) // drive time explicitly\nif got := cache.Get(\"k\"); got != \"v\" {\n\tt.Fatalf(\"Get = %q, want v\", got)\n}",
+	}}
+}
+func (r *FlakyRetryDetection) DefaultSeverity() string   { return "warn" }
+func (r *FlakyRetryDetection) NeedsProjectContext() bool { return false }
+
+func (r *FlakyRetryDetection) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+
+	var retries []flakyRetry
+	switch strings.ToLower(file.Language) {
+	case "go":
+		retries = scanGoRetryLoops(file.Source)
+	case "typescript", "javascript":
+		retries = scanJSRetries(file.Source)
+	case "python":
+		retries = scanPythonRetries(file.Source)
+	}
+
+	marker := defaultFlakyRetryMarker
+	if raw, ok := config.Options["allowMarker"].(string); ok && strings.TrimSpace(raw) != "" {
+		marker = strings.TrimSpace(raw)
+	}
+	lines := strings.Split(string(file.Source), "\n")
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, retry := range retries {
+		if markerInRange(lines, retry.Line-1, retry.EndLine, marker) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("%s may be masking a flaky test", retry.Construct),
+			FilePath:  file.Path,
+			StartLine: retry.Line,
+			EndLine:   retry.EndLine,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Remove the nondeterminism (inject a clock, await the event, or stub the dependency) instead of retrying. If the code under test is eventually consistent by design, add a `%s` comment explaining why.", marker),
+				Metadata: map[string]interface{}{
+					"kind": retry.Kind,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// flakyRetry is a retry loop (Kind "loop") or a framework retry setting (Kind "setting").
+type flakyRetry struct {
+	Construct string
+	Kind      string
+	Line      int
+	EndLine   int
+}
+
+// scanGoRetryLoops flags for loops whose body both sleeps (time.Sleep or <-time.After)
+// and asserts (testify assert/require or t.Error/t.Fatal and friends). Only the
+// outermost such loop is reported.
+func scanGoRetryLoops(source []byte) []flakyRetry {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	found := make([]flakyRetry, 0)
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		var body *ast.BlockStmt
+		switch loop := n.(type) {
+		case *ast.ForStmt:
+			body = loop.Body
+		case *ast.RangeStmt:
+			body = loop.Body
+		default:
+			return true
+		}
+		if !goContainsSleep(body) || !goContainsRetryAssertion(body) {
+			return true
+		}
+		found = append(found, flakyRetry{
+			Construct: "Loop that sleeps and re-asserts",
+			Kind:      "loop",
+			Line:      fset.Position(n.Pos()).Line,
+			EndLine:   fset.Position(n.End()).Line,
+		})
+		return false
+	})
+	return found
+}
+
+func goContainsSleep(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "time" && (sel.Sel.Name == "Sleep" || sel.Sel.Name == "After") {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func goContainsRetryAssertion(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if _, ok := goAssertionName(call); ok {
+			found = true
+		} else if sel, ok := call.Fun.(*ast.SelectorExpr); ok && goTestFailureMethods[sel.Sel.Name] {
+			_, found = sel.X.(*ast.Ident)
+		}
+		return !found
+	})
+	return found
+}
+
+// scanJSRetries flags jest.retryTimes, mocha this.retries, Playwright
+// describe.configure({ retries }), Vitest { retry: N } options, and brace-delimited
+// for/while/do loops whose body both sleeps and calls expect/assert.
+func scanJSRetries(source []byte) []flakyRetry {
+	text := string(source)
+	found := make([]flakyRetry, 0)
+	for _, loc := range jsRetrySettingPattern.FindAllStringIndex(text, -1) {
+		line := 1 + strings.Count(text[:loc[0]], "\n")
+		found = append(found, flakyRetry{
+			Construct: jsRetrySettingName(text[loc[0]:loc[1]]),
+			Kind:      "setting",
+			Line:      line,
+			EndLine:   line,
+		})
+	}
+
+	covered := 0
+	loops := append(jsLoopPattern.FindAllStringIndex(text, -1), jsDoLoopPattern.FindAllStringIndex(text, -1)...)
+	sort.Slice(loops, func(i, j int) bool { return loops[i][0] < loops[j][0] })
+	for _, loc := range loops {
+		if loc[0] < covered {
+			continue
+		}
+		open := loc[1] - 1
+		if text[open] == '(' {
+			open = jsLoopBodyStart(text, open)
+		}
+		if open < 0 {
+			continue
+		}
+		end := matchBrace(text, open)
+		body := jsCommentPattern.ReplaceAllString(text[open:end], "")
+		if !jsSleepPattern.MatchString(body) || !jsAssertionPattern.MatchString(body) {
+			continue
+		}
+		covered = end
+		found = append(found, flakyRetry{
+			Construct: "Loop that sleeps and re-asserts",
+			Kind:      "loop",
+			Line:      1 + strings.Count(text[:loc[0]], "\n"),
+			EndLine:   1 + strings.Count(text[:end], "\n"),
+		})
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].Line < found[j].Line })
+	return found
+}
+
+func jsRetrySettingName(match string) string {
+	switch {
+	case strings.Contains(match, "retryTimes"):
+		return "Test retry setting jest.retryTimes()"
+	case strings.Contains(match, "this.retries"):
+		return "Test retry setting this.retries()"
+	case strings.Contains(match, "configure"):
+		return "Test retry setting configure({ retries })"
+	}
+	return "Test retry option { retry }"
+}
+
+// jsLoopBodyStart returns the offset of the `{` opening the loop body whose header
+// starts at the `(` at paren, or -1 when the body is a single statement.
+func jsLoopBodyStart(text string, paren int) int {
+	depth := 0
+	for i := paren; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				rest := strings.TrimLeft(text[i+1:], " \t\r\n")
+				if strings.HasPrefix(rest, "{") {
+					return len(text) - len(rest)
+				}
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
+// scanPythonRetries flags flaky/retry decorators and for/while loops whose indented
+// body both sleeps and asserts.
+func scanPythonRetries(source []byte) []flakyRetry {
+	lines := strings.Split(string(source), "\n")
+	found := make([]flakyRetry, 0)
+	for i := 0; i < len(lines); i++ {
+		if pyRetryDecorator.MatchString(lines[i]) {
+			found = append(found, flakyRetry{
+				Construct: "Test retry decorator " + strings.TrimSpace(pyRetryDecorator.FindString(lines[i])),
+				Kind:      "setting",
+				Line:      i + 1,
+				EndLine:   i + 1,
+			})
+			continue
+		}
+		m := pyLoopPattern.FindStringSubmatch(stripPythonComment(lines[i]))
+		if m == nil {
+			continue
+		}
+		indent := len(m[1])
+		sleeps, asserts := false, false
+		end := i + 1
+		for end < len(lines) {
+			raw := lines[end]
+			trimmed := strings.TrimSpace(stripPythonComment(raw))
+			if trimmed != "" && len(raw)-len(strings.TrimLeft(raw, " \t")) <= indent {
+				break
+			}
+			sleeps = sleeps || pySleepPattern.MatchString(trimmed)
+			asserts = asserts || pyAssertionPattern.MatchString(trimmed)
+			end++
+		}
+		if !sleeps || !asserts {
+			continue
+		}
+		found = append(found, flakyRetry{
+			Construct: "Loop that sleeps and re-asserts",
+			Kind:      "loop",
+			Line:      i + 1,
+			EndLine:   end,
+		})
+		i = end - 1
+	}
+	return found
+}
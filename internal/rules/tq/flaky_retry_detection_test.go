@@ -0,0 +1,144 @@
+// flaky_retry_detection_test.go — Tests for TQ-flaky-retry-detection.
+package tq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestFlakyRetryDetectionMetadata(t *testing.T) {
+	rule := &FlakyRetryDetection{}
+	if rule.ID() != "TQ-flaky-retry-detection" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestFlakyRetryDetection(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		language  string
+		source    string
+		options   map[string]interface{}
+		wantLines []int
+		wantText  string
+	}{
+		{
+			name:     "go loop that sleeps and asserts",
+			path:     "cache/cache_test.go",
+			language: "go",
+			source: `package cache
+
+func TestExpiry(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		if got := c.Get("k"); got == "" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+		t.Errorf("attempt %d: still cached", i)
+	}
+}
+`,
+			wantLines: []int{4},
+			wantText:  "Loop that sleeps and re-asserts",
+		},
+		{
+			name:     "go table loop without sleep is fine",
+			path:     "cache/cache_test.go",
+			language: "go",
+			source: `package cache
+
+func TestGet(t *testing.T) {
+	for _, tc := range cases {
+		require.Equal(t, tc.want, c.Get(tc.key))
+	}
+}
+`,
+		},
+		{
+			name:     "go polling loop with allow marker",
+			path:     "cache/cache_test.go",
+			language: "go",
+			source: `package cache
+
+func TestReplication(t *testing.T) {
+	// stricture-allow-retry: replica catches up asynchronously by design
+	for i := 0; i < 5; i++ {
+		<-time.After(time.Second)
+		assert.True(t, replica.Has("k"))
+	}
+}
+`,
+		},
+		{
+			name:      "jest.retryTimes",
+			path:      "src/orders.test.ts",
+			language:  "typescript",
+			source:    "jest.retryTimes(3);\n\nit('places', () => { expect(place()).toBe(true); });\n",
+			wantLines: []int{1},
+			wantText:  "jest.retryTimes()",
+		},
+		{
+			name:     "ts loop with sleep and expect",
+			path:     "src/orders.test.ts",
+			language: "typescript",
+			source: `it('eventually ships', async () => {
+  for (let i = 0; i < 3; i++) {
+    await sleep(100);
+    expect(await status()).toBe('shipped');
+  }
+});
+`,
+			wantLines: []int{2},
+		},
+		{
+			name:      "custom allow marker",
+			path:      "src/orders.test.js",
+			language:  "javascript",
+			source:    "// eventual-ok\njest.retryTimes(2);\n",
+			options:   map[string]interface{}{"allowMarker": "eventual-ok"},
+			wantLines: nil,
+		},
+		{
+			name:     "python decorator and polling loop",
+			path:     "tests/test_orders.py",
+			language: "python",
+			source: `@pytest.mark.flaky(reruns=3)
+def test_ship():
+    for _ in range(5):  # poll
+        time.sleep(0.1)
+        assert status() == "shipped"
+    done()
+`,
+			wantLines: []int{1, 3},
+			wantText:  "Test retry decorator @pytest.mark.flaky",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: tt.path, Language: tt.language, Source: []byte(tt.source), IsTestFile: true}
+			got := (&FlakyRetryDetection{}).Check(file, nil, model.RuleConfig{Options: tt.options})
+			var lines []int
+			for _, v := range got {
+				lines = append(lines, v.StartLine)
+			}
+			if !reflect.DeepEqual(lines, tt.wantLines) {
+				t.Fatalf("lines = %v, want %v (%+v)", lines, tt.wantLines, got)
+			}
+			if tt.wantText != "" && !strings.Contains(got[0].Message, tt.wantText) {
+				t.Fatalf("message = %q, want it to contain %q", got[0].Message, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestFlakyRetryDetectionSkipsProductionFiles(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "src/client.ts", Language: "typescript", Source: []byte("jest.retryTimes(3);\n")}
+	if got := (&FlakyRetryDetection{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("non-test files should be skipped, got %+v", got)
+	}
+}
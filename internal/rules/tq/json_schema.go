@@ -0,0 +1,284 @@
+// json_schema.go — Minimal JSON Schema validator for TQ-schema-conformance fixtures.
+package tq
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSchemaRefDepth stops `$ref` chains that never consume any of the document.
+const maxSchemaRefDepth = 64
+
+// schemaError is one place where a document does not satisfy its schema. Pointer is a
+// JSON pointer into the document ("" for the root).
+type schemaError struct {
+	Pointer string
+	Message string
+}
+
+// validateJSONSchema checks value against the commonly used subset of JSON Schema:
+// type, enum, const, local $ref, allOf/anyOf/oneOf, properties, required,
+// additionalProperties, items, min/maxItems, min/maxLength, pattern, and numeric
+// bounds. Unknown keywords are ignored.
+func validateJSONSchema(schema interface{}, value interface{}) []schemaError {
+	v := schemaValidator{root: schema}
+	v.validate(schema, value, "", 0)
+	return v.errors
+}
+
+type schemaValidator struct {
+	root   interface{}
+	errors []schemaError
+}
+
+func (v *schemaValidator) fail(pointer string, format string, args ...interface{}) {
+	v.errors = append(v.errors, schemaError{Pointer: pointer, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *schemaValidator) validate(schema interface{}, value interface{}, pointer string, depth int) {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			v.fail(pointer, "no value is allowed here")
+		}
+		return
+	case map[string]interface{}:
+		v.validateObjectSchema(s, value, pointer, depth)
+	}
+}
+
+func (v *schemaValidator) validateObjectSchema(s map[string]interface{}, value interface{}, pointer string, depth int) {
+	if ref, ok := s["$ref"].(string); ok {
+		target, found := resolveSchemaRef(v.root, ref)
+		switch {
+		case !found:
+			v.fail(pointer, "cannot resolve $ref %q", ref)
+		case depth >= maxSchemaRefDepth:
+			v.fail(pointer, "$ref %q nests too deeply", ref)
+		default:
+			v.validate(target, value, pointer, depth+1)
+		}
+	}
+
+	if types := schemaTypes(s["type"]); len(types) > 0 && !jsonValueHasType(value, types) {
+		v.fail(pointer, "expected %s, got %s", strings.Join(types, " or "), jsonTypeName(value))
+		return
+	}
+	if enum, ok := s["enum"].([]interface{}); ok && !jsonValueIn(value, enum) {
+		v.fail(pointer, "value %s is not one of the allowed enum values", compactJSON(value))
+	}
+	if expected, ok := s["const"]; ok && !reflect.DeepEqual(expected, value) {
+		v.fail(pointer, "expected constant %s, got %s", compactJSON(expected), compactJSON(value))
+	}
+
+	if all, ok := s["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			v.validate(sub, value, pointer, depth)
+		}
+	}
+	if anyOf, ok := s["anyOf"].([]interface{}); ok && countMatchingSchemas(v.root, anyOf, value) == 0 {
+		v.fail(pointer, "value does not match any schema in anyOf")
+	}
+	if oneOf, ok := s["oneOf"].([]interface{}); ok {
+		if n := countMatchingSchemas(v.root, oneOf, value); n != 1 {
+			v.fail(pointer, "value matches %d schemas in oneOf, want exactly 1", n)
+		}
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		v.validateObject(s, typed, pointer, depth)
+	case []interface{}:
+		if n, ok := schemaNumber(s["minItems"]); ok && float64(len(typed)) < n {
+			v.fail(pointer, "array has %d item(s), want at least %v", len(typed), n)
+		}
+		if n, ok := schemaNumber(s["maxItems"]); ok && float64(len(typed)) > n {
+			v.fail(pointer, "array has %d item(s), want at most %v", len(typed), n)
+		}
+		if items, ok := s["items"]; ok {
+			for i, item := range typed {
+				v.validate(items, item, pointer+"/"+strconv.Itoa(i), depth)
+			}
+		}
+	case string:
+		length := float64(utf8.RuneCountInString(typed))
+		if n, ok := schemaNumber(s["minLength"]); ok && length < n {
+			v.fail(pointer, "string is %v character(s), want at least %v", length, n)
+		}
+		if n, ok := schemaNumber(s["maxLength"]); ok && length > n {
+			v.fail(pointer, "string is %v character(s), want at most %v", length, n)
+		}
+		if pattern, ok := s["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(typed) {
+				v.fail(pointer, "string %q does not match pattern %s", typed, pattern)
+			}
+		}
+	case float64:
+		if n, ok := schemaNumber(s["minimum"]); ok && typed < n {
+			v.fail(pointer, "%v is less than minimum %v", typed, n)
+		}
+		if n, ok := schemaNumber(s["maximum"]); ok && typed > n {
+			v.fail(pointer, "%v is greater than maximum %v", typed, n)
+		}
+		if n, ok := schemaNumber(s["exclusiveMinimum"]); ok && typed <= n {
+			v.fail(pointer, "%v must be greater than %v", typed, n)
+		}
+		if n, ok := schemaNumber(s["exclusiveMaximum"]); ok && typed >= n {
+			v.fail(pointer, "%v must be less than %v", typed, n)
+		}
+	}
+}
+
+func (v *schemaValidator) validateObject(s map[string]interface{}, value map[string]interface{}, pointer string, depth int) {
+	if required, ok := s["required"].([]interface{}); ok {
+		for _, item := range required {
+			name, _ := item.(string)
+			if _, present := value[name]; name != "" && !present {
+				v.fail(pointer, "missing required property %q", name)
+			}
+		}
+	}
+	properties, _ := s["properties"].(map[string]interface{})
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		child := pointer + "/" + escapeJSONPointer(key)
+		if sub, ok := properties[key]; ok {
+			v.validate(sub, value[key], child, depth)
+			continue
+		}
+		switch extra := s["additionalProperties"].(type) {
+		case bool:
+			if !extra {
+				v.fail(child, "property %q is not allowed by the schema", key)
+			}
+		case map[string]interface{}:
+			v.validate(extra, value[key], child, depth)
+		}
+	}
+}
+
+// countMatchingSchemas reports how many of schemas value satisfies on its own.
+func countMatchingSchemas(root interface{}, schemas []interface{}, value interface{}) int {
+	matched := 0
+	for _, sub := range schemas {
+		probe := schemaValidator{root: root}
+		probe.validate(sub, value, "", 0)
+		if len(probe.errors) == 0 {
+			matched++
+		}
+	}
+	return matched
+}
+
+// resolveSchemaRef follows a same-document reference such as "#/definitions/Item".
+func resolveSchemaRef(root interface{}, ref string) (interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+	current := root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch node := current.(type) {
+		case map[string]interface{}:
+			next, ok := node[token]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			current = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func schemaTypes(raw interface{}) []string {
+	switch t := raw.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		types := make([]string, 0, len(t))
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func jsonValueHasType(value interface{}, types []string) bool {
+	actual := jsonTypeName(value)
+	for _, want := range types {
+		if want == actual || (want == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonTypeName names a decoded JSON value's type, reporting whole numbers as integer.
+func jsonTypeName(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func jsonValueIn(value interface{}, options []interface{}) bool {
+	for _, option := range options {
+		if reflect.DeepEqual(option, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func schemaNumber(raw interface{}) (float64, bool) {
+	n, ok := raw.(float64)
+	return n, ok
+}
+
+func compactJSON(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
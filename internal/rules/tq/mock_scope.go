@@ -16,6 +16,13 @@ func (r *MockScope) Description() string { return "Ensure mocks are scoped and c
 func (r *MockScope) Why() string {
 	return "Leaky mocks create flaky suites and hidden coupling across tests."
 }
+func (r *MockScope) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "jest.spyOn(api, 'fetch').mockResolvedValue(data) // no restore",
+		Good:     "afterEach(() => jest.restoreAllMocks())",
+	}}
+}
 func (r *MockScope) DefaultSeverity() string   { return "error" }
 func (r *MockScope) NeedsProjectContext() bool { return false }
 
@@ -1,48 +1,402 @@
-// negative_cases.go — TQ-negative-cases: Require at least one negative-path test when positives exist.
+// negative_cases.go — TQ-negative-cases: Require negative-path tests, including one per validation error branch.
 package tq
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
-// NegativeCases implements the TQ-negative-cases rule.
+// minErrorFragment is the shortest message fragment or test literal compared when
+// matching an error branch to an assertion, so short inputs like "ab" do not count.
+const minErrorFragment = 4
+
+var (
+	defaultValidationFunctions = []string{`^[Vv]alidate`}
+	formatVerbPattern          = regexp.MustCompile(`%[-+# 0-9.*\[\]]*[a-zA-Z%]`)
+)
+
+// NegativeCases implements the TQ-negative-cases rule. For Go validation functions
+// (names matching `functions`, returning an error) it checks that the package's tests
+// assert each error branch's message or sentinel error.
 type NegativeCases struct{}
 
 func (r *NegativeCases) ID() string       { return "TQ-negative-cases" }
 func (r *NegativeCases) Category() string { return "tq" }
 func (r *NegativeCases) Description() string {
-	return "Require at least one negative-path test when positives exist"
+	return "Require negative-path tests, including one per validation error branch"
 }
 func (r *NegativeCases) Why() string {
 	return "Negative tests enforce defensive behavior and error handling contracts."
 }
+func (r *NegativeCases) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "test('creates user', () => expect(createUser(data)).toBeTruthy())",
+		Good:     "test('rejects invalid email', () => expect(() => createUser({email: 'bad'})).toThrow())",
+	}, {
+		Language: "go",
+		Bad:      "// validateBucketName: name too short, name has uppercase\nif err := validateBucketName(\"a\"); err == nil { t.Fatal(\"want error\") }",
+		Good:     "for _, tc := range []struct{ in, want string }{{\"a\", \"too short\"}, {\"Bucket\", \"lowercase\"}} {\n\tif err := validateBucketName(tc.in); err == nil || !strings.Contains(err.Error(), tc.want) { t.Fatal(err) }\n}",
+	}}
+}
 func (r *NegativeCases) DefaultSeverity() string   { return "error" }
-func (r *NegativeCases) NeedsProjectContext() bool { return false }
-
-func (r *NegativeCases) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *NegativeCases) NeedsProjectContext() bool { return true }
 
+func (r *NegativeCases) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Function CreateInvoice has 3 positive tests but 0 negative tests (expected at least 1)"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Add at least one failing input test that validates error behavior.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Function CreateInvoice has 3 positive tests but 0 negative tests (expected at least 1)"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add at least one failing input test that validates error behavior.",
+				},
 			},
-		},
+		}
+	}
+
+	if file == nil || ctx == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+	patterns := validationFunctionPatterns(config.Options)
+	validators := make([]validationFunc, 0)
+	for _, source := range boundarySourceFiles(file, ctx) {
+		validators = append(validators, goValidationFuncs(source, patterns)...)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	siblings := boundarySiblingTests(file, ctx)
+	evidence := make([]negativeTestEvidence, len(siblings))
+	for i, sibling := range siblings {
+		evidence[i] = goNegativeTestEvidence(sibling.Source)
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, fn := range validators {
+		owner := -1
+		for i := range siblings {
+			if _, ok := evidence[i].Calls[fn.Name]; ok {
+				owner = i
+				break
+			}
+		}
+		// Report once per package, on the first test file that calls the function.
+		if owner < 0 || siblings[owner].Path != file.Path {
+			continue
+		}
+		call := evidence[owner].Calls[fn.Name]
+		for _, branch := range fn.Branches {
+			if branchAsserted(branch, evidence) {
+				continue
+			}
+			violations = append(violations, model.Violation{
+				RuleID:      r.ID(),
+				Severity:    severity,
+				Message:     fmt.Sprintf("Validation error branch in %s at %s:%d (%s) has no test asserting %s", fn.Display, branch.File, branch.Line, branch.Condition, branch.describe()),
+				FilePath:    file.Path,
+				StartLine:   call.Line,
+				StartColumn: call.Column,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Add a case calling %s with input where %s, and assert the error is %s.", fn.Name, branch.Condition, branch.describe()),
+					Metadata: map[string]interface{}{
+						"function":   fn.Display,
+						"branchFile": branch.File,
+						"branchLine": branch.Line,
+						"condition":  branch.Condition,
+						"error":      branch.describe(),
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// validationFunc is a Go function or method that validates input and returns an error.
+// Name is what callers write (the method name for methods); Display adds the receiver.
+type validationFunc struct {
+	Name     string
+	Display  string
+	Branches []errorBranch
+}
+
+// errorBranch is an if or case body that returns a non-nil error identified by a
+// message literal, a sentinel error, or both.
+type errorBranch struct {
+	File      string
+	Line      int
+	Condition string
+	Message   string
+	Sentinel  string
+}
+
+func (b errorBranch) describe() string {
+	if b.Sentinel != "" {
+		return b.Sentinel
+	}
+	return strconv.Quote(b.Message)
+}
+
+// negativeTestEvidence is what a test file references: the functions it calls (first
+// call position by name), its string literals, and the identifiers it mentions.
+type negativeTestEvidence struct {
+	Calls    map[string]token.Position
+	Literals []string
+	Idents   map[string]bool
+}
+
+func validationFunctionPatterns(options map[string]interface{}) []*regexp.Regexp {
+	raw := make([]string, 0)
+	switch v := options["functions"].(type) {
+	case string:
+		raw = append(raw, v)
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	}
+	if len(raw) == 0 {
+		raw = defaultValidationFunctions
+	}
+	patterns := make([]*regexp.Regexp, 0, len(raw))
+	for _, expr := range raw {
+		if re, err := regexp.Compile(strings.TrimSpace(expr)); err == nil {
+			patterns = append(patterns, re)
+		}
+	}
+	return patterns
+}
+
+func goValidationFuncs(source *model.UnifiedFileModel, patterns []*regexp.Regexp) []validationFunc {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, source.Path, source.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	funcs := make([]validationFunc, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !returnsError(fn.Type) || !matchesAnyPattern(patterns, fn.Name.Name) {
+			continue
+		}
+		display := fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			display = receiverTypeName(fn.Recv.List[0].Type) + "." + display
+		}
+		branches := goErrorBranches(fset, source, fn)
+		if len(branches) > 0 {
+			funcs = append(funcs, validationFunc{Name: fn.Name.Name, Display: display, Branches: branches})
+		}
+	}
+	return funcs
+}
+
+func returnsError(fnType *ast.FuncType) bool {
+	if fnType.Results == nil || len(fnType.Results.List) == 0 {
+		return false
+	}
+	last, ok := fnType.Results.List[len(fnType.Results.List)-1].Type.(*ast.Ident)
+	return ok && last.Name == "error"
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return "?"
+}
+
+func matchesAnyPattern(patterns []*regexp.Regexp, name string) bool {
+	for _, re := range patterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// goErrorBranches lists the if and case bodies in fn that directly return an error
+// with a recognizable message or sentinel. Branches returning a variable (`return err`)
+// are skipped: the error's identity is decided elsewhere.
+func goErrorBranches(fset *token.FileSet, source *model.UnifiedFileModel, fn *ast.FuncDecl) []errorBranch {
+	branches := make([]errorBranch, 0)
+	add := func(pos token.Pos, condition string, body []ast.Stmt) {
+		for _, stmt := range body {
+			ret, ok := stmt.(*ast.ReturnStmt)
+			if !ok || len(ret.Results) == 0 {
+				continue
+			}
+			message, sentinel := errorIdentity(ret.Results[len(ret.Results)-1])
+			if message == "" && sentinel == "" {
+				return
+			}
+			branches = append(branches, errorBranch{
+				File:      filepathSlash(source.Path),
+				Line:      fset.Position(pos).Line,
+				Condition: condition,
+				Message:   message,
+				Sentinel:  sentinel,
+			})
+			return
+		}
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			add(node.Pos(), sourceText(fset, source.Source, node.Cond), node.Body.List)
+		case *ast.CaseClause:
+			condition := "default"
+			if len(node.List) > 0 {
+				parts := make([]string, 0, len(node.List))
+				for _, expr := range node.List {
+					parts = append(parts, sourceText(fset, source.Source, expr))
+				}
+				condition = "case " + strings.Join(parts, ", ")
+			}
+			add(node.Pos(), condition, node.Body)
+		}
+		return true
+	})
+	return branches
+}
+
+// errorIdentity reads the message literal and sentinel error from a returned error
+// expression: errors.New("..."), fmt.Errorf("%w: ...", ErrX), NewError("..."), ErrX.
+func errorIdentity(expr ast.Expr) (message string, sentinel string) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if isSentinelName(e.Name) {
+			return "", e.Name
+		}
+	case *ast.SelectorExpr:
+		if isSentinelName(e.Sel.Name) {
+			return "", e.Sel.Name
+		}
+	case *ast.UnaryExpr:
+		return errorIdentity(e.X)
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			if lit, ok := elt.(*ast.BasicLit); ok && lit.Kind == token.STRING && message == "" {
+				message, _ = strconv.Unquote(lit.Value)
+			}
+		}
+	case *ast.CallExpr:
+		for _, arg := range e.Args {
+			switch a := arg.(type) {
+			case *ast.BasicLit:
+				if a.Kind == token.STRING && message == "" {
+					message, _ = strconv.Unquote(a.Value)
+				}
+			case *ast.Ident, *ast.SelectorExpr:
+				if _, s := errorIdentity(a); s != "" && sentinel == "" {
+					sentinel = s
+				}
+			}
+		}
+	}
+	return message, sentinel
+}
+
+func isSentinelName(name string) bool {
+	return (strings.HasPrefix(name, "Err") || strings.HasPrefix(name, "err")) && len(name) > 3 && name[3] >= 'A' && name[3] <= 'Z'
+}
+
+func sourceText(fset *token.FileSet, source []byte, node ast.Node) string {
+	start, end := fset.Position(node.Pos()).Offset, fset.Position(node.End()).Offset
+	if start < 0 || end > len(source) || start >= end {
+		return ""
+	}
+	return strings.Join(strings.Fields(string(source[start:end])), " ")
+}
+
+func goNegativeTestEvidence(source []byte) negativeTestEvidence {
+	evidence := negativeTestEvidence{Calls: map[string]token.Position{}, Idents: map[string]bool{}}
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return evidence
+	}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			name := ""
+			switch fn := node.Fun.(type) {
+			case *ast.Ident:
+				name = fn.Name
+			case *ast.SelectorExpr:
+				name = fn.Sel.Name
+			}
+			if _, seen := evidence.Calls[name]; name != "" && !seen {
+				evidence.Calls[name] = fset.Position(node.Pos())
+			}
+		case *ast.Ident:
+			evidence.Idents[node.Name] = true
+		case *ast.BasicLit:
+			if node.Kind == token.STRING {
+				if value, err := strconv.Unquote(node.Value); err == nil && len(strings.TrimSpace(value)) >= minErrorFragment {
+					evidence.Literals = append(evidence.Literals, strings.TrimSpace(value))
+				}
+			}
+		}
+		return true
+	})
+	sort.Strings(evidence.Literals)
+	return evidence
+}
+
+// branchAsserted reports whether any test mentions the branch's sentinel error, or has
+// a string literal sharing a fragment of its message (the text between format verbs).
+func branchAsserted(branch errorBranch, evidence []negativeTestEvidence) bool {
+	fragments := make([]string, 0)
+	for _, fragment := range formatVerbPattern.Split(branch.Message, -1) {
+		if fragment = strings.Trim(fragment, " :;,.()\"'"); len(fragment) >= minErrorFragment {
+			fragments = append(fragments, fragment)
+		}
+	}
+	for _, e := range evidence {
+		if branch.Sentinel != "" && e.Idents[branch.Sentinel] {
+			return true
+		}
+		for _, literal := range e.Literals {
+			for _, fragment := range fragments {
+				if strings.Contains(fragment, literal) || strings.Contains(literal, fragment) {
+					return true
+				}
+			}
+		}
 	}
+	return false
 }
@@ -1,8 +1,141 @@
 // negative_cases_test.go — Tests for TQ-negative-cases.
 package tq
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const negativeCasesBucketSource = `package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrBucketReserved = errors.New("bucket name is reserved")
+
+func validateBucketName(name string) error {
+	if len(name) < 3 {
+		return fmt.Errorf("bucket name %q is too short", name)
+	}
+	if strings.ToLower(name) != name {
+		return errors.New("bucket name must be lowercase")
+	}
+	if name == "aws" {
+		return ErrBucketReserved
+	}
+	return nil
+}
+
+func (u *User) Validate() error {
+	switch {
+	case u.Email == "":
+		return errors.New("email is required")
+	}
+	return nil
+}
+
+func CreateBucket(name string) error {
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+`
+
+func negativeCasesContext(testSource string) (*model.UnifiedFileModel, *model.ProjectContext) {
+	source := &model.UnifiedFileModel{Path: "s3/bucket.go", Language: "go", Source: []byte(negativeCasesBucketSource)}
+	test := &model.UnifiedFileModel{Path: "s3/bucket_test.go", Language: "go", IsTestFile: true, Source: []byte(testSource)}
+	return test, &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{source.Path: source, test.Path: test}}
+}
 
 func TestNegativeCases(t *testing.T) {
 	assertRuleContract(t, &NegativeCases{})
 }
+
+func TestNegativeCasesFlagsUnassertedValidationBranches(t *testing.T) {
+	test, ctx := negativeCasesContext(`package s3
+
+import "testing"
+
+func TestValidateBucketName(t *testing.T) {
+	if err := validateBucketName("ab"); err == nil || !strings.Contains(err.Error(), "too short") {
+		t.Fatalf("err = %v", err)
+	}
+	if err := validateBucketName("aws"); !errors.Is(err, ErrBucketReserved) {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestCreateBucket(t *testing.T) {
+	_ = CreateBucket("")
+}
+`)
+
+	got := (&NegativeCases{}).Check(test, ctx, model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	want := `Validation error branch in validateBucketName at s3/bucket.go:15 (strings.ToLower(name) != name) has no test asserting "bucket name must be lowercase"`
+	if got[0].Message != want || got[0].StartLine != 6 {
+		t.Fatalf("violation = line %d %q", got[0].StartLine, got[0].Message)
+	}
+	if got[0].Context.Metadata["branchLine"] != 15 || got[0].Context.Metadata["function"] != "validateBucketName" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+}
+
+func TestNegativeCasesMethodCaseBranches(t *testing.T) {
+	test, ctx := negativeCasesContext(`package s3
+
+import "testing"
+
+func TestUserValidate(t *testing.T) {
+	u := &User{}
+	if err := u.Validate(); err == nil {
+		t.Fatal("want error")
+	}
+}
+`)
+
+	got := (&NegativeCases{}).Check(test, ctx, model.RuleConfig{})
+	if len(got) != 1 || !strings.Contains(got[0].Message, `User.Validate at s3/bucket.go:26 (case u.Email == "")`) {
+		t.Fatalf("want the unasserted case branch of User.Validate, got %+v", got)
+	}
+
+	// A configured pattern replaces the default ^[Vv]alidate.
+	cfg := model.RuleConfig{Options: map[string]interface{}{"functions": []interface{}{"^Create"}}}
+	if got := (&NegativeCases{}).Check(test, ctx, cfg); len(got) != 0 {
+		t.Fatalf("CreateBucket is not called from this test, got %+v", got)
+	}
+}
+
+func TestNegativeCasesPassesWhenEveryBranchIsAsserted(t *testing.T) {
+	test, ctx := negativeCasesContext(`package s3
+
+import "testing"
+
+func TestValidateBucketName(t *testing.T) {
+	cases := map[string]string{"ab": "is too short", "Bucket": "must be lowercase"}
+	for in, want := range cases {
+		if err := validateBucketName(in); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%s: err = %v", in, err)
+		}
+	}
+	if !errors.Is(validateBucketName("aws"), ErrBucketReserved) {
+		t.Fatal("want reserved")
+	}
+}
+`)
+
+	if got := (&NegativeCases{}).Check(test, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("all branches asserted, got %+v", got)
+	}
+	if got := (&NegativeCases{}).Check(test, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("nil context should report nothing, got %+v", got)
+	}
+}
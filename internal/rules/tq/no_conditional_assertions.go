@@ -0,0 +1,612 @@
+// no_conditional_assertions.go — TQ-test-has-no-conditional-assertions: Flag assertions that may never execute.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const (
+	conditionalIf     = "an if branch"
+	conditionalElse   = "an else branch"
+	conditionalSwitch = "a switch case"
+	conditionalSelect = "a select case"
+	conditionalCatch  = "a catch block"
+	conditionalLoop   = "a loop body"
+)
+
+var (
+	jsAssertionPattern  = regexp.MustCompile(`\bexpect\s*\(|\bassert(?:\.\w+)?\s*\(`)
+	jsKeywordPattern    = regexp.MustCompile(`\b(if|else|for|while|do|switch|catch)\b|\.forEach\s*\(`)
+	jsTableDeclPattern  = regexp.MustCompile(`\b(?:const|let|var)\s+(\w+)\s*(?::[^=]+)?=\s*\[`)
+	jsForOfPattern      = regexp.MustCompile(`\bfor\s*\(\s*(?:const|let|var)\s+[^)]*?\s+of\s+(\w+)\s*\)`)
+	jsForEachPattern    = regexp.MustCompile(`\b(\w+)\.forEach\s*\(`)
+	pyAssertionPattern  = regexp.MustCompile(`^(?:assert\b|self\.assert\w*\s*\()`)
+	pyBlockPattern      = regexp.MustCompile(`^(if|elif|else|for|while|except)\b.*:\s*(.*)$`)
+	pyTableDeclPattern  = regexp.MustCompile(`^(\w+)\s*(?::[^=]+)?=\s*[\[(]`)
+	pyForInPattern      = regexp.MustCompile(`^for\s+.+?\s+in\s+(\w+)\s*:`)
+	goAssertionPackages = map[string]bool{"assert": true, "require": true}
+)
+
+// NoConditionalAssertions implements the TQ-test-has-no-conditional-assertions rule.
+type NoConditionalAssertions struct{}
+
+func (r *NoConditionalAssertions) ID() string       { return "TQ-test-has-no-conditional-assertions" }
+func (r *NoConditionalAssertions) Category() string { return "tq" }
+func (r *NoConditionalAssertions) Description() string {
+	return "Flag assertions nested in branches or loops that may never run"
+}
+func (r *NoConditionalAssertions) Why() string {
+	return "Assertions that only run on some paths let tests pass without checking anything."
+}
+func (r *NoConditionalAssertions) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "if user != nil { assert.Equal(t, \"ada\", user.Name) }",
+		Good:     "require.NotNil(t, user); assert.Equal(t, \"ada\", user.Name)",
+	}}
+}
+func (r *NoConditionalAssertions) DefaultSeverity() string   { return "warn" }
+func (r *NoConditionalAssertions) NeedsProjectContext() bool { return false }
+
+func (r *NoConditionalAssertions) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+	flagLoops := boolOption(config.Options, "flagLoops", true)
+
+	var findings []conditionalAssertion
+	switch strings.ToLower(file.Language) {
+	case "go":
+		findings = scanGoConditionalAssertions(file.Source, flagLoops)
+	case "typescript", "javascript":
+		findings = scanJSConditionalAssertions(file.Source, flagLoops)
+	case "python":
+		findings = scanPythonConditionalAssertions(file.Source, flagLoops)
+	}
+
+	violations := make([]model.Violation, 0, len(findings))
+	for _, f := range findings {
+		subject := fmt.Sprintf("Assertion '%s'", f.Call)
+		if f.Test != "" {
+			subject += " in " + f.Test
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("%s runs inside %s and may never execute", subject, f.Construct),
+			FilePath:    file.Path,
+			StartLine:   f.Line,
+			StartColumn: f.Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: "Assert the branch condition directly or move the assertion onto a path that always runs.",
+			},
+		})
+	}
+	return violations
+}
+
+type conditionalAssertion struct {
+	Call      string
+	Test      string
+	Construct string
+	Line      int
+	Column    int // zero for the line-based TS/Python scanners
+}
+
+func boolOption(options map[string]interface{}, key string, fallback bool) bool {
+	if options == nil {
+		return fallback
+	}
+	switch v := options[key].(type) {
+	case bool:
+		return v
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "yes", "on":
+			return true
+		case "false", "no", "off":
+			return false
+		}
+	}
+	return fallback
+}
+
+type goConditionalScanner struct {
+	fset      *token.FileSet
+	test      string
+	tables    map[string]bool
+	guarded   map[*ast.IfStmt]bool
+	flagLoops bool
+	findings  []conditionalAssertion
+}
+
+func scanGoConditionalAssertions(source []byte, flagLoops bool) []conditionalAssertion {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	s := &goConditionalScanner{fset: fset, flagLoops: flagLoops}
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		s.test = fn.Name.Name
+		s.tables = goTableLiterals(fn.Body)
+		s.guarded = goGuardClauses(fn.Body)
+		s.visit(fn.Body, "")
+	}
+	return s.findings
+}
+
+// visit walks node, recording assertion calls reached while construct is set.
+func (s *goConditionalScanner) visit(node ast.Node, construct string) {
+	if node == nil {
+		return
+	}
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.IfStmt:
+			if x.Init != nil {
+				s.visit(x.Init, construct)
+			}
+			s.visit(x.Cond, construct)
+			if s.guarded[x] || goIfAlwaysAsserts(x) {
+				s.visit(x.Body, construct)
+				s.visit(x.Else, construct)
+				return false
+			}
+			s.visit(x.Body, conditionalIf)
+			if x.Else != nil {
+				s.visit(x.Else, conditionalElse)
+			}
+			return false
+		case *ast.SwitchStmt:
+			return s.visitClauses(x.Init, x.Tag, x.Body, construct)
+		case *ast.TypeSwitchStmt:
+			return s.visitClauses(x.Init, x.Assign, x.Body, construct)
+		case *ast.SelectStmt:
+			for _, stmt := range x.Body.List {
+				if clause, ok := stmt.(*ast.CommClause); ok {
+					s.visit(clause.Comm, construct)
+					for _, inner := range clause.Body {
+						s.visit(inner, conditionalSelect)
+					}
+				}
+			}
+			return false
+		case *ast.ForStmt:
+			if x.Init != nil {
+				s.visit(x.Init, construct)
+			}
+			s.visit(x.Cond, construct)
+			if x.Post != nil {
+				s.visit(x.Post, construct)
+			}
+			s.visit(x.Body, s.loopConstruct(construct, false))
+			return false
+		case *ast.RangeStmt:
+			s.visit(x.X, construct)
+			table := s.isTable(x.X) && goContainsAssertion(x.Body)
+			s.visit(x.Body, s.loopConstruct(construct, table))
+			return false
+		case *ast.CallExpr:
+			if name, ok := goAssertionName(x); ok && construct != "" {
+				s.findings = append(s.findings, conditionalAssertion{
+					Call:      name,
+					Test:      s.test,
+					Construct: construct,
+					Line:      s.fset.Position(x.Pos()).Line,
+					Column:    s.fset.Position(x.Pos()).Column,
+				})
+			}
+		}
+		return true
+	})
+}
+
+func (s *goConditionalScanner) visitClauses(init ast.Stmt, tag ast.Node, body *ast.BlockStmt, construct string) bool {
+	if init != nil {
+		s.visit(init, construct)
+	}
+	s.visit(tag, construct)
+
+	caseConstruct := conditionalSwitch
+	if goSwitchAlwaysAsserts(body) {
+		caseConstruct = construct
+	}
+	for _, stmt := range body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		for _, expr := range clause.List {
+			s.visit(expr, construct)
+		}
+		for _, inner := range clause.Body {
+			s.visit(inner, caseConstruct)
+		}
+	}
+	return false
+}
+
+func (s *goConditionalScanner) loopConstruct(outer string, table bool) string {
+	if table || !s.flagLoops {
+		return outer
+	}
+	return conditionalLoop
+}
+
+func (s *goConditionalScanner) isTable(expr ast.Expr) bool {
+	switch x := expr.(type) {
+	case *ast.CompositeLit:
+		return true
+	case *ast.Ident:
+		return s.tables[x.Name]
+	}
+	return false
+}
+
+// goTableLiterals returns local names bound to composite literals, the usual shape of a test table.
+func goTableLiterals(body *ast.BlockStmt) map[string]bool {
+	tables := map[string]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.AssignStmt:
+			for i, rhs := range x.Rhs {
+				if _, ok := rhs.(*ast.CompositeLit); ok && i < len(x.Lhs) {
+					if ident, ok := x.Lhs[i].(*ast.Ident); ok {
+						tables[ident.Name] = true
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, value := range x.Values {
+				if _, ok := value.(*ast.CompositeLit); ok && i < len(x.Names) {
+					tables[x.Names[i].Name] = true
+				}
+			}
+		}
+		return true
+	})
+	return tables
+}
+
+// goGuardClauses finds early-exit ifs (`if cond { assert...; return }`) whose
+// fall-through path also asserts, so every path through them checks something.
+func goGuardClauses(body *ast.BlockStmt) map[*ast.IfStmt]bool {
+	guarded := map[*ast.IfStmt]bool{}
+	markList := func(list []ast.Stmt) {
+		for i, stmt := range list {
+			ifStmt, ok := stmt.(*ast.IfStmt)
+			if !ok || ifStmt.Else != nil || len(ifStmt.Body.List) == 0 {
+				continue
+			}
+			if _, ok := ifStmt.Body.List[len(ifStmt.Body.List)-1].(*ast.ReturnStmt); !ok {
+				continue
+			}
+			if !goContainsAssertion(ifStmt.Body) {
+				continue
+			}
+			for _, rest := range list[i+1:] {
+				if goContainsAssertion(rest) {
+					guarded[ifStmt] = true
+					break
+				}
+			}
+		}
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.BlockStmt:
+			markList(x.List)
+		case *ast.CaseClause:
+			markList(x.Body)
+		case *ast.CommClause:
+			markList(x.Body)
+		}
+		return true
+	})
+	return guarded
+}
+
+// goIfAlwaysAsserts reports whether every branch of an if/else chain asserts.
+func goIfAlwaysAsserts(stmt *ast.IfStmt) bool {
+	if stmt.Else == nil || !goContainsAssertion(stmt.Body) {
+		return false
+	}
+	switch e := stmt.Else.(type) {
+	case *ast.IfStmt:
+		return goIfAlwaysAsserts(e)
+	case *ast.BlockStmt:
+		return goContainsAssertion(e)
+	}
+	return false
+}
+
+// goSwitchAlwaysAsserts reports whether a switch has a default and every clause asserts.
+func goSwitchAlwaysAsserts(body *ast.BlockStmt) bool {
+	hasDefault := false
+	for _, stmt := range body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			return false
+		}
+		if clause.List == nil {
+			hasDefault = true
+		}
+		asserts := false
+		for _, inner := range clause.Body {
+			if goContainsAssertion(inner) {
+				asserts = true
+				break
+			}
+		}
+		if !asserts {
+			return false
+		}
+	}
+	return hasDefault
+}
+
+func goContainsAssertion(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok {
+			if _, ok := goAssertionName(call); ok {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func goAssertionName(call *ast.CallExpr) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || !goAssertionPackages[pkg.Name] {
+		return "", false
+	}
+	return pkg.Name + "." + sel.Sel.Name, true
+}
+
+func scanJSConditionalAssertions(source []byte, flagLoops bool) []conditionalAssertion {
+	// Keywords and braces in test titles, messages, and comments are not code.
+	lines := strings.Split(string(blankJSLiterals(source)), "\n")
+	tables := map[string]bool{}
+	for _, line := range lines {
+		if m := jsTableDeclPattern.FindStringSubmatch(line); len(m) == 2 {
+			tables[m[1]] = true
+		}
+	}
+
+	var findings []conditionalAssertion
+	var stack []string
+	pending := ""
+	parenDepth := 0
+
+	innermost := func() string {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if stack[i] != "" {
+				return stack[i]
+			}
+		}
+		return ""
+	}
+
+	for idx, line := range lines {
+		events := map[int]string{}
+		for _, loc := range jsKeywordPattern.FindAllStringSubmatchIndex(line, -1) {
+			keyword := "forEach"
+			if loc[2] >= 0 {
+				keyword = line[loc[2]:loc[3]]
+			}
+			events[loc[0]] = jsConstructFor(keyword, line, tables, flagLoops)
+		}
+		assertions := map[int]string{}
+		for _, loc := range jsAssertionPattern.FindAllStringIndex(line, -1) {
+			assertions[loc[0]] = strings.TrimRight(strings.TrimSpace(line[loc[0]:loc[1]-1]), " ")
+		}
+
+		for i := 0; i < len(line); i++ {
+			if construct, ok := events[i]; ok {
+				pending = construct
+			}
+			if call, ok := assertions[i]; ok {
+				construct := pending
+				if construct == "" {
+					construct = innermost()
+				}
+				if construct != "" {
+					findings = append(findings, conditionalAssertion{Call: call, Construct: construct, Line: idx + 1})
+				}
+			}
+			switch line[i] {
+			case '(':
+				parenDepth++
+			case ')':
+				if parenDepth > 0 {
+					parenDepth--
+				}
+			case '{':
+				stack = append(stack, pending)
+				pending = ""
+			case '}':
+				if len(stack) > 0 {
+					stack = stack[:len(stack)-1]
+				}
+			case ';':
+				if parenDepth == 0 {
+					pending = ""
+				}
+			}
+		}
+	}
+	return findings
+}
+
+// blankJSLiterals returns a copy of source with the contents of string and template
+// literals and of comments replaced by spaces. Quotes and newlines are kept, so lines
+// and offsets still match the original. A quote or backslash inside a regex literal can
+// blank the rest of its line, since single- and double-quoted strings end at a newline.
+func blankJSLiterals(source []byte) []byte {
+	out := append([]byte(nil), source...)
+	blank := func(from, to int) {
+		for i := from; i < to && i < len(out); i++ {
+			if out[i] != '\n' {
+				out[i] = ' '
+			}
+		}
+	}
+	for i := 0; i < len(out); i++ {
+		switch c := out[i]; {
+		case c == '/' && i+1 < len(out) && out[i+1] == '/':
+			end := i
+			for end < len(out) && out[end] != '\n' {
+				end++
+			}
+			blank(i, end)
+			i = end - 1
+		case c == '/' && i+1 < len(out) && out[i+1] == '*':
+			end := len(out)
+			if idx := strings.Index(string(out[i+2:]), "*/"); idx >= 0 {
+				end = i + 2 + idx + 2
+			}
+			blank(i, end)
+			i = end - 1
+		case c == '\'' || c == '"' || c == '`':
+			end := i + 1
+			for end < len(out) && out[end] != c && (c == '`' || out[end] != '\n') {
+				if out[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			blank(i+1, end)
+			i = end
+		}
+	}
+	return out
+}
+
+func jsConstructFor(keyword string, line string, tables map[string]bool, flagLoops bool) string {
+	switch keyword {
+	case "if":
+		return conditionalIf
+	case "else":
+		return conditionalElse
+	case "switch":
+		return conditionalSwitch
+	case "catch":
+		return conditionalCatch
+	case "for":
+		if m := jsForOfPattern.FindStringSubmatch(line); len(m) == 2 && tables[m[1]] {
+			return ""
+		}
+	case "forEach":
+		if m := jsForEachPattern.FindStringSubmatch(line); len(m) == 2 && tables[m[1]] {
+			return ""
+		}
+	}
+	if !flagLoops {
+		return ""
+	}
+	return conditionalLoop
+}
+
+type pyBlock struct {
+	indent    int
+	construct string
+}
+
+func scanPythonConditionalAssertions(source []byte, flagLoops bool) []conditionalAssertion {
+	lines := strings.Split(string(source), "\n")
+	tables := map[string]bool{}
+	for _, line := range lines {
+		if m := pyTableDeclPattern.FindStringSubmatch(strings.TrimSpace(line)); len(m) == 2 {
+			tables[m[1]] = true
+		}
+	}
+
+	var findings []conditionalAssertion
+	var stack []pyBlock
+	for idx, raw := range lines {
+		trimmed := strings.TrimSpace(raw)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(raw) - len(strings.TrimLeft(raw, " \t"))
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		statement := trimmed
+		construct := ""
+		if m := pyBlockPattern.FindStringSubmatch(trimmed); len(m) == 3 {
+			construct = pyConstructFor(m[1], trimmed, tables, flagLoops)
+			stack = append(stack, pyBlock{indent: indent, construct: construct})
+			statement = strings.TrimSpace(m[2])
+		}
+
+		if !pyAssertionPattern.MatchString(statement) {
+			continue
+		}
+		effective := ""
+		for i := len(stack) - 1; i >= 0; i-- {
+			if stack[i].construct != "" {
+				effective = stack[i].construct
+				break
+			}
+		}
+		if effective == "" {
+			continue
+		}
+		call := "assert"
+		if strings.HasPrefix(statement, "self.") {
+			call = strings.TrimSpace(statement[:strings.Index(statement, "(")])
+		}
+		findings = append(findings, conditionalAssertion{Call: call, Construct: effective, Line: idx + 1})
+	}
+	return findings
+}
+
+func pyConstructFor(keyword string, line string, tables map[string]bool, flagLoops bool) string {
+	switch keyword {
+	case "if", "elif":
+		return conditionalIf
+	case "else":
+		return conditionalElse
+	case "except":
+		return conditionalCatch
+	case "for":
+		if m := pyForInPattern.FindStringSubmatch(line); len(m) == 2 && tables[m[1]] {
+			return ""
+		}
+	}
+	if !flagLoops {
+		return ""
+	}
+	return conditionalLoop
+}
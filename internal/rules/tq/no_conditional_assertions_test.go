@@ -0,0 +1,231 @@
+// no_conditional_assertions_test.go — Tests for TQ-test-has-no-conditional-assertions.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoConditionalAssertionsMetadata(t *testing.T) {
+	rule := &NoConditionalAssertions{}
+	if rule.ID() != "TQ-test-has-no-conditional-assertions" {
+		t.Fatalf("id = %q", rule.ID())
+	}
+	if rule.Category() != "tq" {
+		t.Fatalf("category = %q, want tq", rule.Category())
+	}
+	if rule.DefaultSeverity() != "warn" {
+		t.Fatalf("default severity = %q, want warn", rule.DefaultSeverity())
+	}
+}
+
+func TestNoConditionalAssertions(t *testing.T) {
+	tests := []struct {
+		name      string
+		language  string
+		source    string
+		options   map[string]interface{}
+		wantLines []int
+	}{
+		{
+			name:     "go assertion inside if",
+			language: "go",
+			source: `package svc_test
+
+func TestCreate(t *testing.T) {
+	got, err := Create()
+	if err == nil {
+		assert.Equal(t, "ok", got)
+	}
+}
+`,
+			wantLines: []int{6},
+		},
+		{
+			name:     "go if else that always asserts",
+			language: "go",
+			source: `package svc_test
+
+func TestCreate(t *testing.T) {
+	got, err := Create()
+	if wantErr {
+		require.Error(t, err)
+	} else {
+		assert.Equal(t, "ok", got)
+	}
+}
+`,
+		},
+		{
+			name:     "go guard clause with asserting fall-through",
+			language: "go",
+			source: `package svc_test
+
+func TestCreate(t *testing.T) {
+	got, err := Create()
+	if wantErr {
+		require.Error(t, err)
+		return
+	}
+	assert.Equal(t, "ok", got)
+}
+`,
+		},
+		{
+			name:     "go table driven loop",
+			language: "go",
+			source: `package svc_test
+
+func TestCreate(t *testing.T) {
+	tests := []struct{ in, want string }{{"a", "A"}}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			assert.Equal(t, tc.want, Upper(tc.in))
+		})
+	}
+}
+`,
+		},
+		{
+			name:     "go loop over results",
+			language: "go",
+			source: `package svc_test
+
+func TestList(t *testing.T) {
+	for _, item := range List() {
+		assert.NotEmpty(t, item.ID)
+	}
+}
+`,
+			wantLines: []int{5},
+		},
+		{
+			name:     "go loop flagging disabled",
+			language: "go",
+			source: `package svc_test
+
+func TestList(t *testing.T) {
+	for _, item := range List() {
+		assert.NotEmpty(t, item.ID)
+	}
+}
+`,
+			options: map[string]interface{}{"flagLoops": false},
+		},
+		{
+			name:     "go guard failures are not library assertions",
+			language: "go",
+			source: `package svc_test
+
+func TestCreate(t *testing.T) {
+	if got := Create(); got != "ok" {
+		t.Fatalf("got %q", got)
+	}
+}
+`,
+		},
+		{
+			name:     "typescript expect inside if",
+			language: "typescript",
+			source: `describe('users', () => {
+  it('creates', () => {
+    const user = create();
+    if (user) {
+      expect(user.id).toBe(1);
+    }
+  });
+});
+`,
+			wantLines: []int{5},
+		},
+		{
+			name:     "typescript table loop",
+			language: "typescript",
+			source: `const cases = [{ in: 1, out: 2 }];
+for (const tc of cases) {
+  it('doubles ' + tc.in, () => {
+    expect(double(tc.in)).toBe(tc.out);
+  });
+}
+`,
+		},
+		{
+			name:     "typescript keywords inside titles and comments",
+			language: "typescript",
+			source: `describe('lookup', () => {
+  it('returns null if the user is missing', () => {
+    expect(find('ghost')).toBeNull();
+  });
+  it("works for every caller", () => {
+    // retry while the cache warms up {
+    expect(find("ada")).toBeDefined();
+  });
+  test(` + "`" + `switch {if} do ${1}` + "`" + `, () => {
+    /* else */ expect(mode()).toBe('on');
+  });
+  it('still flags real branches', () => {
+    if (flag) {
+      expect(mode()).toBe('if');
+    }
+  });
+});
+`,
+			wantLines: []int{14},
+		},
+		{
+			name:     "python assert inside for",
+			language: "python",
+			source: `def test_items():
+    for item in fetch():
+        assert item.id
+`,
+			wantLines: []int{3},
+		},
+		{
+			name:     "python unconditional assert",
+			language: "python",
+			source: `def test_items():
+    items = fetch()
+    assert items
+`,
+		},
+	}
+
+	rule := &NoConditionalAssertions{}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			file := &model.UnifiedFileModel{
+				Path:       "svc_test.go",
+				Language:   tc.language,
+				IsTestFile: true,
+				Source:     []byte(tc.source),
+			}
+			got := rule.Check(file, nil, model.RuleConfig{Options: tc.options})
+			if len(got) != len(tc.wantLines) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tc.wantLines), got)
+			}
+			for i, v := range got {
+				if v.StartLine != tc.wantLines[i] {
+					t.Fatalf("violation %d line = %d, want %d", i, v.StartLine, tc.wantLines[i])
+				}
+				if v.Severity != "warn" {
+					t.Fatalf("severity = %q, want warn", v.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestNoConditionalAssertionsSkipsNonTestFiles(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "svc.go",
+		Language: "go",
+		Source:   []byte("package svc\n\nfunc TestHelper() {\n\tif ok {\n\t\tassert.True(nil, ok)\n\t}\n}\n"),
+	}
+	if got := (&NoConditionalAssertions{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("violations = %d, want 0", len(got))
+	}
+}
@@ -0,0 +1,302 @@
+// no_empty_catch.go — TQ-no-empty-catch: Flag swallowed errors in catch, except, and recover.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultEmptyCatchMarker marks an intentionally swallowed error on, above, or inside the handler.
+const defaultEmptyCatchMarker = "stricture-allow-empty-catch"
+
+var (
+	jsCatchPattern        = regexp.MustCompile(`\bcatch\s*(?:\([^)]*\))?\s*\{`)
+	jsPromiseCatchPattern = regexp.MustCompile(`\.catch\(\s*(?:(?:async\s*)?(?:\([^)]*\)|[A-Za-z_$][\w$]*)\s*=>\s*(?:\{\s*\}|undefined|null|void 0)|function\s*\w*\s*\([^)]*\)\s*\{\s*\})\s*\)`)
+	jsCommentPattern      = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	pyExceptPattern       = regexp.MustCompile(`^(\s*)except\b[^:]*:\s*(.*)$`)
+	goLoggingCallPattern  = regexp.MustCompile(`(?i)(log|print|error|warn|report|capture|notify)`)
+)
+
+// NoEmptyCatch implements the TQ-no-empty-catch rule. It runs on every file, not only tests.
+type NoEmptyCatch struct{}
+
+func (r *NoEmptyCatch) ID() string       { return "TQ-no-empty-catch" }
+func (r *NoEmptyCatch) Category() string { return "tq" }
+func (r *NoEmptyCatch) Description() string {
+	return "Disallow empty catch/except blocks and recover() calls that discard the panic"
+}
+func (r *NoEmptyCatch) Why() string {
+	return "A swallowed error hides the failure and leaves the program running in an unknown state."
+}
+func (r *NoEmptyCatch) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "try {\n  await save(order);\n} catch (err) {}",
+		Good:     "try {\n  await save(order);\n} catch (err) {\n  logger.error(\"save order\", err);\n  throw err;\n}",
+	}}
+}
+func (r *NoEmptyCatch) DefaultSeverity() string   { return "warn" }
+func (r *NoEmptyCatch) NeedsProjectContext() bool { return false }
+
+func (r *NoEmptyCatch) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || len(file.Source) == 0 {
+		return nil
+	}
+
+	var handlers []swallowedError
+	switch strings.ToLower(file.Language) {
+	case "go":
+		handlers = scanGoSwallowedRecovers(file.Source)
+	case "typescript", "javascript":
+		handlers = scanJSEmptyCatches(file.Source)
+	case "python":
+		handlers = scanPythonEmptyExcepts(file.Source)
+	}
+
+	marker := defaultEmptyCatchMarker
+	if raw, ok := config.Options["allowMarker"].(string); ok && strings.TrimSpace(raw) != "" {
+		marker = strings.TrimSpace(raw)
+	}
+	lines := strings.Split(string(file.Source), "\n")
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, h := range handlers {
+		if markerInRange(lines, h.Line-1, h.EndLine, marker) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("%s swallows the error without handling, logging, or rethrowing it", h.Construct),
+			FilePath:  file.Path,
+			StartLine: h.Line,
+			EndLine:   h.EndLine,
+			Context: &model.ViolationContext{
+				SuggestedFix: h.Fix + fmt.Sprintf(" If ignoring it is intentional, add a `%s` comment explaining why.", marker),
+				Metadata: map[string]interface{}{
+					"construct": h.Construct,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+type swallowedError struct {
+	Construct string
+	Line      int
+	EndLine   int
+	Fix       string
+}
+
+// markerInRange reports whether any 1-based line in [from, to] contains marker.
+func markerInRange(lines []string, from int, to int, marker string) bool {
+	if from < 1 {
+		from = 1
+	}
+	for i := from; i <= to && i <= len(lines); i++ {
+		if strings.Contains(lines[i-1], marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func scanJSEmptyCatches(source []byte) []swallowedError {
+	text := string(source)
+	found := make([]swallowedError, 0)
+	for _, loc := range jsCatchPattern.FindAllStringIndex(text, -1) {
+		if loc[0] > 0 && text[loc[0]-1] == '.' {
+			continue
+		}
+		open := loc[1] - 1
+		end := matchBrace(text, open)
+		body := jsCommentPattern.ReplaceAllString(text[open+1:end-1], "")
+		if strings.TrimSpace(body) != "" {
+			continue
+		}
+		found = append(found, swallowedError{
+			Construct: "Empty catch block",
+			Line:      1 + strings.Count(text[:loc[0]], "\n"),
+			EndLine:   1 + strings.Count(text[:end], "\n"),
+			Fix:       "Log the error with context, rethrow it, or convert it into a handled result.",
+		})
+	}
+	for _, loc := range jsPromiseCatchPattern.FindAllStringIndex(text, -1) {
+		found = append(found, swallowedError{
+			Construct: "Promise .catch() with an empty handler",
+			Line:      1 + strings.Count(text[:loc[0]], "\n"),
+			EndLine:   1 + strings.Count(text[:loc[1]], "\n"),
+			Fix:       "Handle or log the rejection inside .catch(), or let it propagate.",
+		})
+	}
+	sortSwallowed(found)
+	return found
+}
+
+func scanPythonEmptyExcepts(source []byte) []swallowedError {
+	lines := strings.Split(string(source), "\n")
+	found := make([]swallowedError, 0)
+	for i := 0; i < len(lines); i++ {
+		m := pyExceptPattern.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		indent := len(m[1])
+		inline := strings.TrimSpace(stripPythonComment(m[2]))
+		end := i + 1
+		empty := false
+		switch {
+		case inline != "":
+			empty = isPythonNoop(inline)
+		default:
+			empty = true
+			for end < len(lines) {
+				raw := lines[end]
+				trimmed := strings.TrimSpace(stripPythonComment(raw))
+				if trimmed == "" {
+					end++
+					continue
+				}
+				if len(raw)-len(strings.TrimLeft(raw, " \t")) <= indent {
+					break
+				}
+				if !isPythonNoop(trimmed) {
+					empty = false
+				}
+				end++
+			}
+		}
+		if !empty {
+			continue
+		}
+		found = append(found, swallowedError{
+			Construct: "except block that only passes",
+			Line:      i + 1,
+			EndLine:   end,
+			Fix:       "Log the exception, re-raise it, or catch a narrower exception type and handle it.",
+		})
+	}
+	return found
+}
+
+func stripPythonComment(line string) string {
+	if i := strings.Index(line, "#"); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
+
+func isPythonNoop(stmt string) bool {
+	return stmt == "pass" || stmt == "..." || stmt == "continue"
+}
+
+// scanGoSwallowedRecovers flags recover() calls whose value is discarded: a bare
+// `recover()` statement, `_ = recover()`, or `if r := recover(); r != nil { ... }`
+// whose body neither uses r, re-panics, nor logs.
+func scanGoSwallowedRecovers(source []byte) []swallowedError {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution|parser.ParseComments)
+	if err != nil {
+		return nil
+	}
+
+	found := make([]swallowedError, 0)
+	report := func(node ast.Node, construct string) {
+		found = append(found, swallowedError{
+			Construct: construct,
+			Line:      fset.Position(node.Pos()).Line,
+			EndLine:   fset.Position(node.End()).Line,
+			Fix:       "Log the recovered value with context, return it as an error, or re-panic.",
+		})
+	}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.ExprStmt:
+			if isRecoverCall(x.X) {
+				report(x, "recover() result discarded")
+			}
+		case *ast.AssignStmt:
+			if len(x.Rhs) == 1 && isRecoverCall(x.Rhs[0]) && len(x.Lhs) == 1 {
+				if ident, ok := x.Lhs[0].(*ast.Ident); ok && ident.Name == "_" {
+					report(x, "recover() result assigned to _")
+				}
+			}
+		case *ast.IfStmt:
+			assign, ok := x.Init.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || !isRecoverCall(assign.Rhs[0]) {
+				return true
+			}
+			ident, ok := assign.Lhs[0].(*ast.Ident)
+			if !ok || ident.Name == "_" {
+				return true
+			}
+			if !goRecoverHandled(x.Body, ident.Name) {
+				report(x, "recover() value ignored")
+			}
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func isRecoverCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+	ident, ok := call.Fun.(*ast.Ident)
+	return ok && ident.Name == "recover"
+}
+
+// goRecoverHandled reports whether body uses the recovered value, re-panics, or
+// calls something that looks like logging or error reporting.
+func goRecoverHandled(body *ast.BlockStmt, name string) bool {
+	handled := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if handled {
+			return false
+		}
+		switch x := n.(type) {
+		case *ast.Ident:
+			if x.Name == name || x.Name == "panic" {
+				handled = true
+			}
+		case *ast.CallExpr:
+			callee := ""
+			switch fn := x.Fun.(type) {
+			case *ast.Ident:
+				callee = fn.Name
+			case *ast.SelectorExpr:
+				callee = fn.Sel.Name
+				if pkg, ok := fn.X.(*ast.Ident); ok {
+					callee = pkg.Name + "." + callee
+				}
+			}
+			if goLoggingCallPattern.MatchString(callee) {
+				handled = true
+			}
+		}
+		return true
+	})
+	return handled
+}
+
+func sortSwallowed(found []swallowedError) {
+	for i := 1; i < len(found); i++ {
+		for j := i; j > 0 && found[j].Line < found[j-1].Line; j-- {
+			found[j], found[j-1] = found[j-1], found[j]
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// no_empty_catch_test.go — Tests for TQ-no-empty-catch.
+package tq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoEmptyCatchMetadata(t *testing.T) {
+	rule := &NoEmptyCatch{}
+	if rule.ID() != "TQ-no-empty-catch" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestNoEmptyCatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		language  string
+		source    string
+		options   map[string]interface{}
+		wantLines []int
+		wantText  string
+	}{
+		{
+			name:     "empty ts catch block",
+			path:     "src/orders.ts",
+			language: "typescript",
+			source: `export async function place(order: Order) {
+  try {
+    await save(order);
+  } catch (err) {
+  }
+}
+`,
+			wantLines: []int{4},
+			wantText:  "Empty catch block",
+		},
+		{
+			name:      "optional catch binding with only a comment",
+			path:      "src/orders.js",
+			language:  "javascript",
+			source:    "try { run(); } catch { /* ignore */ }\n",
+			wantLines: []int{1},
+		},
+		{
+			name:     "catch that logs is fine",
+			path:     "src/orders.ts",
+			language: "typescript",
+			source: `try {
+  run();
+} catch (err) {
+  logger.error(err);
+}
+`,
+		},
+		{
+			name:      "promise catch with empty arrow",
+			path:      "src/client.ts",
+			language:  "typescript",
+			source:    "fetchUser(id)\n  .catch(() => {});\n",
+			wantLines: []int{2},
+			wantText:  "Promise .catch()",
+		},
+		{
+			name:     "allow marker above the catch",
+			path:     "src/orders.ts",
+			language: "typescript",
+			source: `try {
+  cleanup();
+// stricture-allow-empty-catch: best-effort cleanup
+} catch {}
+`,
+		},
+		{
+			name:     "python except pass",
+			path:     "app/jobs.py",
+			language: "python",
+			source: `try:
+    run()
+except Exception:
+    pass
+
+try:
+    run()
+except ValueError: pass
+`,
+			wantLines: []int{3, 8},
+			wantText:  "except block",
+		},
+		{
+			name:     "python except that re-raises",
+			path:     "app/jobs.py",
+			language: "python",
+			source: `try:
+    run()
+except Exception as exc:
+    log.warning("run failed: %s", exc)
+    raise
+`,
+		},
+		{
+			name:     "go recover discarded",
+			path:     "worker/pool.go",
+			language: "go",
+			source: `package worker
+
+func safe(fn func()) {
+	defer func() {
+		recover()
+	}()
+	fn()
+}
+
+func alsoSafe(fn func()) {
+	defer func() { _ = recover() }()
+	fn()
+}
+`,
+			wantLines: []int{5, 11},
+			wantText:  "recover()",
+		},
+		{
+			name:     "go recover value ignored in if",
+			path:     "worker/pool.go",
+			language: "go",
+			source: `package worker
+
+func safe(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			done = true
+		}
+	}()
+	fn()
+}
+`,
+			wantLines: []int{5},
+		},
+		{
+			name:     "go recover logged or re-panicked",
+			path:     "worker/pool.go",
+			language: "go",
+			source: `package worker
+
+import "log"
+
+func logged(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("worker panic: %v", r)
+		}
+	}()
+	fn()
+}
+
+func repanic(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			cleanup()
+			panic(r)
+		}
+	}()
+	fn()
+}
+`,
+		},
+		{
+			name:     "custom allow marker inside block",
+			path:     "worker/pool.go",
+			language: "go",
+			source: `package worker
+
+func safe(fn func()) {
+	defer func() {
+		recover() // nolint:swallow
+	}()
+	fn()
+}
+`,
+			options: map[string]interface{}{"allowMarker": "nolint:swallow"},
+		},
+	}
+
+	rule := &NoEmptyCatch{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: tt.path, Language: tt.language, Source: []byte(tt.source)}
+			got := rule.Check(file, nil, model.RuleConfig{Options: tt.options})
+			if len(got) != len(tt.wantLines) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tt.wantLines), got)
+			}
+			for i, v := range got {
+				if v.StartLine != tt.wantLines[i] {
+					t.Errorf("violation %d line = %d, want %d", i, v.StartLine, tt.wantLines[i])
+				}
+				if v.Severity != "warn" {
+					t.Errorf("severity = %q, want warn", v.Severity)
+				}
+				if tt.wantText != "" && !strings.Contains(v.Message, tt.wantText) {
+					t.Errorf("message %q does not contain %q", v.Message, tt.wantText)
+				}
+				if v.Context == nil || !strings.Contains(v.Context.SuggestedFix, defaultEmptyCatchMarker) {
+					t.Errorf("suggested fix should mention the allow marker: %+v", v.Context)
+				}
+			}
+		})
+	}
+}
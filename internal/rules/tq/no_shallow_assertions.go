@@ -18,6 +18,13 @@ func (r *NoShallowAssertions) Description() string {
 func (r *NoShallowAssertions) Why() string {
 	return "Shallow assertions hide regressions because they never verify actual values."
 }
+func (r *NoShallowAssertions) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "expect(result).toBeTruthy()",
+		Good:     "expect(result).toEqual({id: 123, name: 'test'})",
+	}}
+}
 func (r *NoShallowAssertions) DefaultSeverity() string   { return "error" }
 func (r *NoShallowAssertions) NeedsProjectContext() bool { return false }
 
@@ -0,0 +1,345 @@
+// no_shared_mutable_globals.go — TQ-test-isolation-no-shared-mutable-globals: Flag package globals mutated by several tests.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// NoSharedMutableGlobalsInTests implements the TQ-test-isolation-no-shared-mutable-globals rule.
+type NoSharedMutableGlobalsInTests struct{}
+
+func (r *NoSharedMutableGlobalsInTests) ID() string {
+	return "TQ-test-isolation-no-shared-mutable-globals"
+}
+func (r *NoSharedMutableGlobalsInTests) Category() string { return "tq" }
+func (r *NoSharedMutableGlobalsInTests) Description() string {
+	return "Flag package-level variables mutated by more than one test"
+}
+func (r *NoSharedMutableGlobalsInTests) Why() string {
+	return "Tests that write the same package variable depend on run order and break under -shuffle or t.Parallel."
+}
+func (r *NoSharedMutableGlobalsInTests) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "var hits int\n\nfunc TestGet(t *testing.T) { hits++ }\nfunc TestPut(t *testing.T) { hits = 0 }",
+		Good:     "func TestGet(t *testing.T) {\n\told := hits\n\thits++\n\tt.Cleanup(func() { hits = old })\n}",
+	}}
+}
+func (r *NoSharedMutableGlobalsInTests) DefaultSeverity() string   { return "warn" }
+func (r *NoSharedMutableGlobalsInTests) NeedsProjectContext() bool { return true }
+
+func (r *NoSharedMutableGlobalsInTests) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+
+	pkgFiles := goPackageFiles(file, ctx)
+	globals := map[string]bool{}
+	for _, f := range pkgFiles {
+		for name := range goPackageVars(f.Source) {
+			globals[name] = true
+		}
+	}
+	allow := stringSliceOption(config.Options, "allow")
+	for name := range globals {
+		if globAllowed(name, allow) {
+			delete(globals, name)
+		}
+	}
+	if len(globals) == 0 {
+		return nil
+	}
+
+	// writers maps a global to the tests that mutate it without restoring it, in file order.
+	writers := map[string][]globalWrite{}
+	for _, f := range pkgFiles {
+		if !f.IsTestFile {
+			continue
+		}
+		for _, w := range goTestGlobalWrites(f, globals) {
+			writers[w.Global] = append(writers[w.Global], w)
+		}
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	names := make([]string, 0, len(writers))
+	for name := range writers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	violations := make([]model.Violation, 0)
+	for _, name := range names {
+		ws := writers[name]
+		if len(ws) < 2 || ws[0].Path != file.Path {
+			// Report once per package, on the first file with a writing test.
+			continue
+		}
+		tests := make([]string, 0, len(ws))
+		for _, w := range ws {
+			tests = append(tests, w.Test)
+		}
+		violations = append(violations, model.Violation{
+			RuleID:      r.ID(),
+			Severity:    severity,
+			Message:     fmt.Sprintf("Package-level variable '%s' is mutated by %d tests (%s); results may depend on test order", name, len(tests), strings.Join(tests, ", ")),
+			FilePath:    file.Path,
+			StartLine:   ws[0].Line,
+			StartColumn: ws[0].Column,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Create '%s' inside each test, or restore it with t.Cleanup; add it to allow if it is an intentional fixture.", name),
+				Metadata: map[string]interface{}{
+					"variable": name,
+					"tests":    tests,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+type globalWrite struct {
+	Global string
+	Test   string
+	Path   string
+	Line   int
+	Column int
+}
+
+// goPackageFiles returns the Go files sharing file's directory, sorted by path.
+// Without project context only the file itself is considered.
+func goPackageFiles(file *model.UnifiedFileModel, ctx *model.ProjectContext) []*model.UnifiedFileModel {
+	if ctx == nil || len(ctx.Files) == 0 {
+		return []*model.UnifiedFileModel{file}
+	}
+	dir := path.Dir(filepathSlash(file.Path))
+	out := []*model.UnifiedFileModel{file}
+	for p, candidate := range ctx.Files {
+		if candidate == nil || p == file.Path || !strings.EqualFold(candidate.Language, "go") {
+			continue
+		}
+		if path.Dir(filepathSlash(p)) == dir {
+			out = append(out, candidate)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out
+}
+
+func goPackageVars(source []byte) map[string]bool {
+	parsed, err := parser.ParseFile(token.NewFileSet(), "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	vars := map[string]bool{}
+	for _, decl := range parsed.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				if name.Name != "_" {
+					vars[name.Name] = true
+				}
+			}
+		}
+	}
+	return vars
+}
+
+// goTestGlobalWrites lists, per Test function, the first unrestored write to each global.
+// Writes inside t.Cleanup callbacks or defer statements count as restoring the value.
+func goTestGlobalWrites(file *model.UnifiedFileModel, globals map[string]bool) []globalWrite {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	writes := make([]globalWrite, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Test") || fn.Name.Name == "TestMain" {
+			continue
+		}
+		locals := goLocalNames(fn)
+		first := map[string]token.Position{}
+		restored := map[string]bool{}
+		order := make([]string, 0)
+
+		record := func(name string, pos token.Pos, restoring bool) {
+			if !globals[name] || locals[name] {
+				return
+			}
+			if restoring {
+				restored[name] = true
+				return
+			}
+			if _, seen := first[name]; !seen {
+				first[name] = fset.Position(pos)
+				order = append(order, name)
+			}
+		}
+		var visit func(n ast.Node, restoring bool)
+		visit = func(n ast.Node, restoring bool) {
+			ast.Inspect(n, func(node ast.Node) bool {
+				switch s := node.(type) {
+				case *ast.DeferStmt:
+					visit(s.Call, true)
+					return false
+				case *ast.CallExpr:
+					if sel, ok := s.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Cleanup" && len(s.Args) == 1 {
+						visit(s.Args[0], true)
+						return false
+					}
+					if ident, ok := s.Fun.(*ast.Ident); ok && ident.Name == "delete" && len(s.Args) > 0 {
+						if name := goRootIdent(s.Args[0]); name != "" {
+							record(name, s.Pos(), restoring)
+						}
+					}
+				case *ast.AssignStmt:
+					if s.Tok == token.DEFINE {
+						return true
+					}
+					for _, lhs := range s.Lhs {
+						if name := goRootIdent(lhs); name != "" {
+							record(name, lhs.Pos(), restoring)
+						}
+					}
+				case *ast.IncDecStmt:
+					if name := goRootIdent(s.X); name != "" {
+						record(name, s.Pos(), restoring)
+					}
+				}
+				return true
+			})
+		}
+		visit(fn.Body, false)
+
+		for _, name := range order {
+			if restored[name] {
+				continue
+			}
+			writes = append(writes, globalWrite{Global: name, Test: fn.Name.Name, Path: file.Path, Line: first[name].Line, Column: first[name].Column})
+		}
+	}
+	return writes
+}
+
+// goRootIdent returns the variable at the root of x, x.f, x[i], or *x.
+func goRootIdent(expr ast.Expr) string {
+	if ident := goRootIdentNode(expr); ident != nil {
+		return ident.Name
+	}
+	return ""
+}
+
+// goRootIdentNode is goRootIdent returning the identifier itself, or nil.
+func goRootIdentNode(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		default:
+			return nil
+		}
+	}
+}
+
+// goLocalNames collects names a function declares itself (params, := and var), which shadow globals.
+func goLocalNames(fn *ast.FuncDecl) map[string]bool {
+	locals := map[string]bool{}
+	addFields := func(fields *ast.FieldList) {
+		if fields == nil {
+			return
+		}
+		for _, field := range fields.List {
+			for _, name := range field.Names {
+				locals[name.Name] = true
+			}
+		}
+	}
+	addFields(fn.Type.Params)
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.AssignStmt:
+			if s.Tok == token.DEFINE {
+				for _, lhs := range s.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						locals[ident.Name] = true
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for _, name := range s.Names {
+				locals[name.Name] = true
+			}
+		case *ast.RangeStmt:
+			if s.Tok == token.DEFINE {
+				for _, x := range []ast.Expr{s.Key, s.Value} {
+					if ident, ok := x.(*ast.Ident); ok {
+						locals[ident.Name] = true
+					}
+				}
+			}
+		case *ast.FuncLit:
+			addFields(s.Type.Params)
+		}
+		return true
+	})
+	return locals
+}
+
+func globAllowed(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, name); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// stringSliceOption reads a list option given either as a YAML list or a single string.
+func stringSliceOption(options map[string]interface{}, key string) []string {
+	if options == nil {
+		return nil
+	}
+	switch v := options[key].(type) {
+	case string:
+		if s := strings.TrimSpace(v); s != "" {
+			return []string{s}
+		}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				out = append(out, strings.TrimSpace(s))
+			}
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// no_shared_mutable_globals_test.go — Tests for TQ-test-isolation-no-shared-mutable-globals.
+package tq
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoSharedMutableGlobalsInTestsMetadata(t *testing.T) {
+	rule := &NoSharedMutableGlobalsInTests{}
+	if rule.ID() != "TQ-test-isolation-no-shared-mutable-globals" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestNoSharedMutableGlobalsInTests(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		options   map[string]interface{}
+		wantVars  []string
+		wantTests string
+	}{
+		{
+			name: "two tests increment the same counter",
+			source: `package cache
+
+import "testing"
+
+var hits int
+
+func TestGet(t *testing.T) {
+	hits++
+}
+
+func TestPut(t *testing.T) {
+	hits = 0
+}
+`,
+			wantVars:  []string{"hits"},
+			wantTests: "TestGet, TestPut",
+		},
+		{
+			name: "map writes and delete count as mutation",
+			source: `package cache
+
+import "testing"
+
+var store = map[string]int{}
+
+func TestAdd(t *testing.T) {
+	store["a"] = 1
+}
+
+func TestRemove(t *testing.T) {
+	delete(store, "a")
+}
+`,
+			wantVars: []string{"store"},
+		},
+		{
+			name: "single writer is fine",
+			source: `package cache
+
+import "testing"
+
+var hits int
+
+func TestGet(t *testing.T) {
+	hits++
+}
+
+func TestRead(t *testing.T) {
+	if hits > 1 {
+		t.Fatal("too many")
+	}
+}
+`,
+		},
+		{
+			name: "restored with t.Cleanup or defer",
+			source: `package cache
+
+import "testing"
+
+var now = func() int { return 0 }
+
+func TestA(t *testing.T) {
+	old := now
+	now = func() int { return 1 }
+	t.Cleanup(func() { now = old })
+}
+
+func TestB(t *testing.T) {
+	old := now
+	now = func() int { return 2 }
+	defer func() { now = old }()
+}
+`,
+		},
+		{
+			name: "local shadowing is ignored",
+			source: `package cache
+
+import "testing"
+
+var hits int
+
+func TestA(t *testing.T) {
+	hits := 0
+	hits++
+}
+
+func TestB(t *testing.T) {
+	var hits int
+	hits = 3
+	_ = hits
+}
+`,
+		},
+		{
+			name: "allow list skips intentional fixtures",
+			source: `package cache
+
+import "testing"
+
+var fixtureDB map[string]int
+
+func TestA(t *testing.T) {
+	fixtureDB["a"] = 1
+}
+
+func TestB(t *testing.T) {
+	fixtureDB["b"] = 2
+}
+`,
+			options: map[string]interface{}{"allow": []interface{}{"fixture*"}},
+		},
+		{
+			name: "TestMain setup does not count",
+			source: `package cache
+
+import "testing"
+
+var ready bool
+
+func TestMain(m *testing.M) {
+	ready = true
+}
+
+func TestA(t *testing.T) {
+	ready = false
+}
+`,
+		},
+	}
+
+	rule := &NoSharedMutableGlobalsInTests{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "cache/cache_test.go", Language: "go", IsTestFile: true, Source: []byte(tc.source)}
+			violations := rule.Check(file, nil, model.RuleConfig{Options: tc.options})
+			got := make([]string, 0, len(violations))
+			for _, v := range violations {
+				got = append(got, v.Context.Metadata["variable"].(string))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantVars, ",") {
+				t.Fatalf("variables = %v, want %v (%+v)", got, tc.wantVars, violations)
+			}
+			if tc.wantTests != "" && !strings.Contains(violations[0].Message, tc.wantTests) {
+				t.Fatalf("message %q should name tests %q", violations[0].Message, tc.wantTests)
+			}
+		})
+	}
+}
+
+func TestNoSharedMutableGlobalsInTestsAcrossFiles(t *testing.T) {
+	production := &model.UnifiedFileModel{Path: "cache/cache.go", Language: "go", Source: []byte("package cache\n\nvar DefaultTTL = 10\n")}
+	first := &model.UnifiedFileModel{Path: "cache/a_test.go", Language: "go", IsTestFile: true, Source: []byte(`package cache
+
+import "testing"
+
+func TestShortTTL(t *testing.T) {
+	DefaultTTL = 1
+}
+`)}
+	second := &model.UnifiedFileModel{Path: "cache/b_test.go", Language: "go", IsTestFile: true, Source: []byte(`package cache
+
+import "testing"
+
+func TestLongTTL(t *testing.T) {
+	DefaultTTL = 100
+}
+`)}
+	other := &model.UnifiedFileModel{Path: "other/c_test.go", Language: "go", IsTestFile: true, Source: []byte(`package other
+
+import "testing"
+
+var DefaultTTL int
+
+func TestOther(t *testing.T) {
+	DefaultTTL = 5
+}
+`)}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, f := range []*model.UnifiedFileModel{production, first, second, other} {
+		ctx.Files[f.Path] = f
+	}
+
+	rule := &NoSharedMutableGlobalsInTests{}
+	violations := rule.Check(first, ctx, model.RuleConfig{})
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation on the first test file, got %+v", violations)
+	}
+	tests := violations[0].Context.Metadata["tests"].([]string)
+	if strings.Join(tests, ",") != "TestShortTTL,TestLongTTL" || violations[0].StartLine != 6 {
+		t.Fatalf("unexpected violation: %+v", violations[0])
+	}
+	if got := rule.Check(second, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("violation should be reported once per package, got %+v", got)
+	}
+}
@@ -0,0 +1,198 @@
+// no_test_interdependence.go — TQ-no-test-interdependence: Flag tests that read state left behind by earlier tests.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// NoTestInterdependence implements the TQ-no-test-interdependence rule. Within one Go
+// test file it walks the Test functions in the order `go test` runs them and flags a
+// test that reads a package-level variable an earlier test assigned without restoring
+// it, before assigning the variable itself. Such a test passes only when the writer
+// runs first: alone, under -run, or with -shuffle it sees the declared value instead.
+// Writes inside defer or t.Cleanup count as restoring the value, as in
+// TQ-test-isolation-no-shared-mutable-globals. Variables matching an `allow` glob are
+// skipped.
+type NoTestInterdependence struct{}
+
+func (r *NoTestInterdependence) ID() string       { return "TQ-no-test-interdependence" }
+func (r *NoTestInterdependence) Category() string { return "tq" }
+func (r *NoTestInterdependence) Description() string {
+	return "Flag tests that read package state written by an earlier test"
+}
+func (r *NoTestInterdependence) Why() string {
+	return "A test that reads what an earlier test left behind passes only in file order; run alone, filtered with -run, or shuffled, it fails or passes for the wrong reason."
+}
+func (r *NoTestInterdependence) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "var created *User\n\nfunc TestCreate(t *testing.T) { created = svc.Create(\"ada\") }\nfunc TestLookup(t *testing.T) {\n\tif svc.Get(created.ID) == nil {\n\t\tt.Fatal(\"missing\")\n\t}\n}",
+		Good:     "func TestLookup(t *testing.T) {\n\tcreated := svc.Create(\"ada\")\n\tif svc.Get(created.ID) == nil {\n\t\tt.Fatal(\"missing\")\n\t}\n}",
+	}}
+}
+func (r *NoTestInterdependence) DefaultSeverity() string   { return "warn" }
+func (r *NoTestInterdependence) NeedsProjectContext() bool { return true }
+
+func (r *NoTestInterdependence) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+
+	// Variables may be declared anywhere in the package; the ordering is per file.
+	globals := map[string]bool{}
+	for _, f := range goPackageFiles(file, ctx) {
+		for name := range goPackageVars(f.Source) {
+			globals[name] = true
+		}
+	}
+	allow := stringSliceOption(config.Options, "allow")
+	for name := range globals {
+		if globAllowed(name, allow) {
+			delete(globals, name)
+		}
+	}
+	if len(globals) == 0 {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	unrestored := map[string][]string{}
+	for _, w := range goTestGlobalWrites(file, globals) {
+		unrestored[w.Test] = append(unrestored[w.Test], w.Global)
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	// writtenBy maps a variable to the first earlier test that left it changed.
+	writtenBy := map[string]string{}
+	violations := make([]model.Violation, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Test") || fn.Name.Name == "TestMain" {
+			continue
+		}
+		for _, use := range goDependentGlobalReads(fn, globals, writtenBy) {
+			pos := fset.Position(use.Pos)
+			writer := writtenBy[use.Name]
+			violations = append(violations, model.Violation{
+				RuleID:      r.ID(),
+				Severity:    severity,
+				Message:     fmt.Sprintf("Test %s reads package variable '%s' set by earlier test %s; it depends on test order", fn.Name.Name, use.Name, writer),
+				FilePath:    file.Path,
+				StartLine:   pos.Line,
+				StartColumn: pos.Column,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Set up '%s' inside %s or a helper it calls, and restore it in %s with t.Cleanup.", use.Name, fn.Name.Name, writer),
+					Metadata: map[string]interface{}{
+						"test":      fn.Name.Name,
+						"variable":  use.Name,
+						"writtenBy": writer,
+					},
+				},
+			})
+		}
+		for _, name := range unrestored[fn.Name.Name] {
+			if _, seen := writtenBy[name]; !seen {
+				writtenBy[name] = fn.Name.Name
+			}
+		}
+	}
+	return violations
+}
+
+type globalRead struct {
+	Name string
+	Pos  token.Pos
+}
+
+// goDependentGlobalReads returns, in source order, the first read of each variable in
+// candidates that fn makes before assigning the variable itself. An assignment takes
+// effect at the end of its statement, so `n = n + 1` reads n first. Code in defer and
+// t.Cleanup is skipped: it runs after the test body.
+func goDependentGlobalReads(fn *ast.FuncDecl, globals map[string]bool, candidates map[string]string) []globalRead {
+	if len(candidates) == 0 {
+		return nil
+	}
+	locals := goLocalNames(fn)
+	tracked := func(name string) bool {
+		_, ok := candidates[name]
+		return ok && globals[name] && !locals[name]
+	}
+
+	firstRead := map[string]token.Pos{}
+	firstWrite := map[string]token.Pos{}
+	order := make([]string, 0)
+	// targets holds identifiers being assigned or updated rather than read. Only a plain
+	// `x = ...` replaces the value; x.f = ..., x[k] = ..., x += ... and x++ build on it.
+	targets := map[*ast.Ident]bool{}
+	target := func(expr ast.Expr, replaces bool, end token.Pos) {
+		ident := goRootIdentNode(expr)
+		if ident == nil {
+			return
+		}
+		targets[ident] = true
+		if _, bare := expr.(*ast.Ident); !replaces || !bare || !tracked(ident.Name) {
+			return
+		}
+		if _, seen := firstWrite[ident.Name]; !seen {
+			firstWrite[ident.Name] = end
+		}
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.DeferStmt:
+			return false
+		case *ast.CallExpr:
+			if sel, ok := s.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Cleanup" && len(s.Args) == 1 {
+				return false
+			}
+		case *ast.AssignStmt:
+			if s.Tok == token.DEFINE {
+				return true
+			}
+			for _, lhs := range s.Lhs {
+				target(lhs, s.Tok == token.ASSIGN, s.End())
+			}
+		case *ast.IncDecStmt:
+			target(s.X, false, s.End())
+		case *ast.SelectorExpr:
+			// Only the receiver can be a variable; the selected name is a field or method.
+			targets[s.Sel] = true
+		case *ast.KeyValueExpr:
+			if ident, ok := s.Key.(*ast.Ident); ok {
+				targets[ident] = true
+			}
+		case *ast.Ident:
+			if targets[s] || !tracked(s.Name) {
+				return true
+			}
+			if _, seen := firstRead[s.Name]; !seen {
+				firstRead[s.Name] = s.Pos()
+				order = append(order, s.Name)
+			}
+		}
+		return true
+	})
+
+	reads := make([]globalRead, 0)
+	for _, name := range order {
+		if pos, ok := firstWrite[name]; ok && pos < firstRead[name] {
+			continue
+		}
+		reads = append(reads, globalRead{Name: name, Pos: firstRead[name]})
+	}
+	return reads
+}
@@ -0,0 +1,156 @@
+// no_test_interdependence_test.go — Tests for TQ-no-test-interdependence.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoTestInterdependenceMetadata(t *testing.T) {
+	rule := &NoTestInterdependence{}
+	if rule.ID() != "TQ-no-test-interdependence" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestNoTestInterdependence(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		options map[string]interface{}
+		want    []string // "test|variable|writtenBy" per violation, in order
+	}{
+		{
+			name: "later tests read what earlier tests left",
+			source: `package users
+
+import "testing"
+
+var (
+	created *User
+	count   int
+	byName  = map[string]*User{}
+)
+
+func TestCreate(t *testing.T) {
+	created = svc.Create("ada")
+	count++
+	byName["ada"] = created
+}
+
+func TestLookup(t *testing.T) {
+	if svc.Get(created.ID) == nil {
+		t.Fatal("missing")
+	}
+}
+
+func TestCount(t *testing.T) {
+	count = count + 1
+	if len(byName) != 1 {
+		t.Fatal(count)
+	}
+}
+`,
+			want: []string{"TestLookup|created|TestCreate", "TestCount|count|TestCreate", "TestCount|byName|TestCreate"},
+		},
+		{
+			name: "tests that set up or restore their own state",
+			source: `package users
+
+import "testing"
+
+var (
+	created *User
+	clock   = time.Now
+)
+
+func TestLookup(t *testing.T) {
+	if created != nil {
+		t.Fatal("stale")
+	}
+}
+
+func TestCreate(t *testing.T) {
+	created = svc.Create("ada")
+	old := clock
+	clock = fixedClock
+	t.Cleanup(func() { clock = old })
+}
+
+func TestRecreate(t *testing.T) {
+	created = svc.Create("grace")
+	if created.Name != "grace" {
+		t.Fatal(created.Name)
+	}
+}
+
+func TestClock(t *testing.T) {
+	if clock().IsZero() {
+		t.Fatal("zero")
+	}
+	created := svc.Create("lin")
+	_ = User{created: created}
+}
+`,
+		},
+		{
+			name: "allowed fixtures",
+			source: `package users
+
+import "testing"
+
+var fixtureDB *DB
+
+func TestOpen(t *testing.T) { fixtureDB = open() }
+
+func TestQuery(t *testing.T) { fixtureDB.Query("select 1") }
+`,
+			options: map[string]interface{}{"allow": []interface{}{"fixture*"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "users_test.go", Language: "go", IsTestFile: true, Source: []byte(tt.source)}
+			got := (&NoTestInterdependence{}).Check(file, nil, model.RuleConfig{Options: tt.options})
+			if len(got) != len(tt.want) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				meta := got[i].Context.Metadata
+				if key := meta["test"].(string) + "|" + meta["variable"].(string) + "|" + meta["writtenBy"].(string); key != want {
+					t.Fatalf("violation %d = %s, want %s", i, key, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNoTestInterdependencePackageGlobalsAndMessage(t *testing.T) {
+	decl := &model.UnifiedFileModel{Path: "store/store.go", Language: "go", Source: []byte("package store\n\nvar lastID int\n")}
+	file := &model.UnifiedFileModel{
+		Path:       "store/store_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte("package store\n\nfunc TestInsert(t *testing.T) {\n\tlastID = insert()\n}\n\nfunc TestDelete(t *testing.T) {\n\tremove(lastID)\n}\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{decl.Path: decl, file.Path: file}}
+	rule := &NoTestInterdependence{}
+
+	got := rule.Check(file, ctx, model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 8 || got[0].StartColumn != 9 {
+		t.Fatalf("got %+v", got)
+	}
+	if got[0].Message != "Test TestDelete reads package variable 'lastID' set by earlier test TestInsert; it depends on test order" {
+		t.Fatalf("message = %q", got[0].Message)
+	}
+	if got := rule.Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("without the declaring file lastID is not a package variable, got %+v", got)
+	}
+
+	file.IsTestFile = false
+	if got := rule.Check(file, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("production files should be skipped, got %+v", got)
+	}
+}
@@ -0,0 +1,306 @@
+// no_test_logic_in_production.go — TQ-no-test-logic-in-production: Keep test shortcuts out of production code.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultTestEnvMarkers are environment variables only a test run sets. `NAME=value`
+// markers only count when the condition also compares against that value.
+var defaultTestEnvMarkers = []string{
+	"TEST",
+	"TESTING",
+	"GO_TEST",
+	"UNIT_TEST",
+	"JEST_WORKER_ID",
+	"VITEST",
+	"PYTEST_CURRENT_TEST",
+	"NODE_ENV=test",
+	"APP_ENV=test",
+	"ENV=test",
+}
+
+// defaultTestFrameworkImports are test framework imports; each also covers its
+// sub-packages (`a/b` matches `a/b/c`, `a.b` matches `a.b.c`).
+var defaultTestFrameworkImports = []string{
+	"testing",
+	"github.com/stretchr/testify",
+	"github.com/onsi/ginkgo",
+	"github.com/onsi/ginkgo/v2",
+	"github.com/onsi/gomega",
+	"github.com/golang/mock",
+	"go.uber.org/mock",
+	"jest",
+	"@jest/globals",
+	"vitest",
+	"mocha",
+	"chai",
+	"sinon",
+	"@testing-library/*",
+	"pytest",
+	"unittest",
+	"mock",
+}
+
+// defaultTestSupportPaths are test-support locations that may import test frameworks.
+var defaultTestSupportPaths = []string{
+	"**/testutil/**",
+	"**/testutils/**",
+	"**/testhelpers/**",
+	"**/testdata/**",
+	"**/__mocks__/**",
+	"**/conftest.py",
+}
+
+var (
+	goEnvReadPattern     = regexp.MustCompile(`os\.(?:Getenv|LookupEnv)\(\s*"([^"]+)"\s*\)`)
+	jsEnvReadPattern     = regexp.MustCompile(`process\.env\.([A-Za-z_][A-Za-z0-9_]*)|process\.env\[\s*['"]([^'"]+)['"]\s*\]`)
+	pyEnvReadPattern     = regexp.MustCompile(`os\.(?:getenv|environ\.get)\(\s*['"]([^'"]+)['"]|os\.environ\[\s*['"]([^'"]+)['"]\s*\]|['"]([^'"]+)['"]\s+in\s+os\.environ\b`)
+	lineConditionPattern = regexp.MustCompile(`\b(?:if|elif|while|switch|case)\b|\s\?\s|&&|\|\|`)
+	jsTestImportPattern  = regexp.MustCompile(`(?m)(?:\bfrom\s+|^\s*import\s+|\brequire\(\s*)['"]([^'"]+)['"]`)
+	pyTestImportPattern  = regexp.MustCompile(`(?m)^\s*(?:from\s+([\w.]+)\s+import\b|import\s+([\w.]+))`)
+)
+
+// NoTestLogicInProduction implements the TQ-no-test-logic-in-production rule. It runs
+// on non-test files and flags conditionals keyed on test environment markers and
+// imports of test frameworks.
+type NoTestLogicInProduction struct{}
+
+func (r *NoTestLogicInProduction) ID() string       { return "TQ-no-test-logic-in-production" }
+func (r *NoTestLogicInProduction) Category() string { return "tq" }
+func (r *NoTestLogicInProduction) Description() string {
+	return "Disallow test-environment branches and test framework imports in production code"
+}
+func (r *NoTestLogicInProduction) Why() string {
+	return "A branch that only runs under test means the tests exercise different behavior than production ships."
+}
+func (r *NoTestLogicInProduction) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "func (s *Sender) Send(m Message) error {\n\tif os.Getenv(\"TEST\") != \"\" {\n\t\treturn nil\n\t}\n\treturn s.client.Post(m)\n}",
+		Good:     "func (s *Sender) Send(m Message) error {\n\treturn s.client.Post(m) // tests inject a fake client\n}",
+	}}
+}
+func (r *NoTestLogicInProduction) DefaultSeverity() string   { return "error" }
+func (r *NoTestLogicInProduction) NeedsProjectContext() bool { return false }
+
+func (r *NoTestLogicInProduction) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Production code branches on test environment marker TEST",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Inject the behavior the test needs (a fake client, clock, or config) instead of checking TEST in production code.",
+				},
+			},
+		}
+	}
+
+	if file == nil || file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+	supportPaths := stringSliceOption(config.Options, "allowPaths")
+	if len(supportPaths) == 0 {
+		supportPaths = defaultTestSupportPaths
+	}
+	for _, glob := range supportPaths {
+		if fixtureGlobPattern(glob).MatchString(filepathSlash(file.Path)) {
+			return nil
+		}
+	}
+	markers := stringSliceOption(config.Options, "envMarkers")
+	if len(markers) == 0 {
+		markers = defaultTestEnvMarkers
+	}
+	imports := stringSliceOption(config.Options, "testImports")
+	if len(imports) == 0 {
+		imports = defaultTestFrameworkImports
+	}
+
+	var findings []testLogicFinding
+	switch strings.ToLower(file.Language) {
+	case "go":
+		findings = goTestLogic(file, markers, imports)
+	case "typescript", "javascript":
+		findings = lineTestLogic(file.Source, jsEnvReadPattern, "//", markers)
+		findings = append(findings, patternTestImports(file.Source, jsTestImportPattern, imports)...)
+	case "python":
+		findings = lineTestLogic(file.Source, pyEnvReadPattern, "#", markers)
+		findings = append(findings, patternTestImports(file.Source, pyTestImportPattern, imports)...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].Line < findings[j].Line })
+
+	violations := make([]model.Violation, 0, len(findings))
+	for _, f := range findings {
+		v := model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			FilePath:  file.Path,
+			StartLine: f.Line,
+			Context:   &model.ViolationContext{},
+		}
+		if f.Import != "" {
+			v.Message = fmt.Sprintf("Production code imports test framework %q", f.Import)
+			v.Context.SuggestedFix = fmt.Sprintf("Move the code that needs %s into a test file or a test-support package.", f.Import)
+			v.Context.Metadata = map[string]interface{}{"kind": "import", "import": f.Import}
+		} else {
+			v.Message = fmt.Sprintf("Production code branches on test environment marker %s", f.Marker)
+			v.Context.SuggestedFix = fmt.Sprintf("Inject the behavior the test needs (a fake client, clock, or config) instead of checking %s in production code.", f.Marker)
+			v.Context.Metadata = map[string]interface{}{"kind": "branch", "marker": f.Marker}
+		}
+		violations = append(violations, v)
+	}
+	return violations
+}
+
+// testLogicFinding is a test-only branch (Marker set) or test framework import (Import set).
+type testLogicFinding struct {
+	Line   int
+	Marker string
+	Import string
+}
+
+// goTestLogic inspects if and switch conditions, including their init statements, and
+// the import block.
+func goTestLogic(file *model.UnifiedFileModel, markers []string, imports []string) []testLogicFinding {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	findings := make([]testLogicFinding, 0)
+	for _, spec := range parsed.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err == nil && testImportMatches(imports, importPath, "/") {
+			findings = append(findings, testLogicFinding{Line: fset.Position(spec.Pos()).Line, Import: importPath})
+		}
+	}
+
+	text := func(nodes ...ast.Node) string {
+		var b strings.Builder
+		for _, n := range nodes {
+			if n == nil || n.End() <= n.Pos() {
+				continue
+			}
+			b.Write(file.Source[fset.Position(n.Pos()).Offset:fset.Position(n.End()).Offset])
+			b.WriteByte('\n')
+		}
+		return b.String()
+	}
+	ast.Inspect(parsed, func(n ast.Node) bool {
+		var condition string
+		switch stmt := n.(type) {
+		case *ast.IfStmt:
+			condition = text(stmt.Init, stmt.Cond)
+		case *ast.SwitchStmt:
+			nodes := []ast.Node{stmt.Init, stmt.Tag}
+			for _, clause := range stmt.Body.List {
+				for _, expr := range clause.(*ast.CaseClause).List {
+					nodes = append(nodes, expr)
+				}
+			}
+			condition = text(nodes...)
+		default:
+			return true
+		}
+		if marker, ok := testEnvMarker(condition, goEnvReadPattern, markers); ok {
+			findings = append(findings, testLogicFinding{Line: fset.Position(n.Pos()).Line, Marker: marker})
+		}
+		return true
+	})
+	return findings
+}
+
+// lineTestLogic flags conditional lines (if/elif/while/switch/case, ternaries, and
+// boolean operators) that read a test environment marker.
+func lineTestLogic(source []byte, envRead *regexp.Regexp, comment string, markers []string) []testLogicFinding {
+	findings := make([]testLogicFinding, 0)
+	for i, line := range strings.Split(string(source), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), comment) || !lineConditionPattern.MatchString(line) {
+			continue
+		}
+		if marker, ok := testEnvMarker(line, envRead, markers); ok {
+			findings = append(findings, testLogicFinding{Line: i + 1, Marker: marker})
+		}
+	}
+	return findings
+}
+
+func patternTestImports(source []byte, pattern *regexp.Regexp, imports []string) []testLogicFinding {
+	findings := make([]testLogicFinding, 0)
+	for _, m := range pattern.FindAllSubmatchIndex(source, -1) {
+		for g := 2; g+1 < len(m); g += 2 {
+			if m[g] < 0 {
+				continue
+			}
+			importPath := string(source[m[g]:m[g+1]])
+			if testImportMatches(imports, importPath, "/") || testImportMatches(imports, importPath, ".") {
+				findings = append(findings, testLogicFinding{Line: 1 + strings.Count(string(source[:m[g]]), "\n"), Import: importPath})
+			}
+			break
+		}
+	}
+	return findings
+}
+
+// testEnvMarker returns the first marker whose variable text reads. A `NAME=value`
+// marker also needs value quoted somewhere in text.
+func testEnvMarker(text string, envRead *regexp.Regexp, markers []string) (string, bool) {
+	read := map[string]bool{}
+	for _, m := range envRead.FindAllStringSubmatch(text, -1) {
+		for _, name := range m[1:] {
+			if name != "" {
+				read[name] = true
+			}
+		}
+	}
+	if len(read) == 0 {
+		return "", false
+	}
+	for _, marker := range markers {
+		name, value, hasValue := strings.Cut(marker, "=")
+		if !read[strings.TrimSpace(name)] {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if hasValue && !strings.Contains(text, `"`+value+`"`) && !strings.Contains(text, `'`+value+`'`) {
+			continue
+		}
+		return marker, true
+	}
+	return "", false
+}
+
+// testImportMatches matches an import against patterns exactly, as a parent package
+// (joined by sep), or as a path.Match glob.
+func testImportMatches(patterns []string, importPath string, sep string) bool {
+	for _, pattern := range patterns {
+		if importPath == pattern || strings.HasPrefix(importPath, pattern+sep) {
+			return true
+		}
+		if matched, err := path.Match(pattern, importPath); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// no_test_logic_in_production_test.go — Tests for TQ-no-test-logic-in-production.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestNoTestLogicInProduction(t *testing.T) {
+	assertRuleContract(t, &NoTestLogicInProduction{})
+}
+
+func TestNoTestLogicInProductionGo(t *testing.T) {
+	source := `package mail
+
+import (
+	"os"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Send(m Message) error {
+	if os.Getenv("TEST") != "" {
+		return nil
+	}
+	switch os.Getenv("APP_ENV") {
+	case "test":
+		return nil
+	}
+	if os.Getenv("REGION") == "eu" {
+		return sendEU(m)
+	}
+	assert.NotNil(nil, m)
+	return post(m)
+}
+`
+	file := &model.UnifiedFileModel{Path: "internal/mail/send.go", Language: "go", Source: []byte(source)}
+	got := (&NoTestLogicInProduction{}).Check(file, nil, model.RuleConfig{})
+	if len(got) != 3 {
+		t.Fatalf("violations = %d, want 3: %+v", len(got), got)
+	}
+	if got[0].StartLine != 6 || got[0].Message != `Production code imports test framework "github.com/stretchr/testify/assert"` {
+		t.Fatalf("import violation = line %d %q", got[0].StartLine, got[0].Message)
+	}
+	if got[1].StartLine != 10 || got[1].Message != "Production code branches on test environment marker TEST" {
+		t.Fatalf("if violation = line %d %q", got[1].StartLine, got[1].Message)
+	}
+	if got[2].StartLine != 13 || got[2].Context.Metadata["marker"] != "APP_ENV=test" {
+		t.Fatalf("switch violation = line %d %+v", got[2].StartLine, got[2].Context.Metadata)
+	}
+
+	testFile := &model.UnifiedFileModel{Path: "internal/mail/send_test.go", Language: "go", Source: []byte(source), IsTestFile: true}
+	if got := (&NoTestLogicInProduction{}).Check(testFile, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("test files should be skipped, got %+v", got)
+	}
+	support := &model.UnifiedFileModel{Path: "internal/testutil/mail.go", Language: "go", Source: []byte(source)}
+	if got := (&NoTestLogicInProduction{}).Check(support, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("test-support paths should be skipped, got %+v", got)
+	}
+}
+
+func TestNoTestLogicInProductionTypeScriptAndPython(t *testing.T) {
+	ts := &model.UnifiedFileModel{
+		Path:     "src/billing/charge.ts",
+		Language: "typescript",
+		Source: []byte("import { jest } from '@jest/globals';\n" +
+			"const mode = process.env.NODE_ENV;\n" +
+			"export const charge = process.env.NODE_ENV === 'test' ? fakeCharge : realCharge;\n" +
+			"if (process.env.NODE_ENV === 'production') { warmUp(); }\n" +
+			"// if (process.env.JEST_WORKER_ID) skip\n"),
+	}
+	got := (&NoTestLogicInProduction{}).Check(ts, nil, model.RuleConfig{})
+	if len(got) != 2 || got[0].StartLine != 1 || got[1].StartLine != 3 {
+		t.Fatalf("typescript violations = %+v", got)
+	}
+	if got[0].Context.Metadata["import"] != "@jest/globals" {
+		t.Fatalf("import metadata = %+v", got[0].Context.Metadata)
+	}
+
+	py := &model.UnifiedFileModel{
+		Path:     "app/payments.py",
+		Language: "python",
+		Source:   []byte("import os\nfrom unittest import mock\n\nif \"PYTEST_CURRENT_TEST\" in os.environ:\n    GATEWAY = None\n"),
+	}
+	got = (&NoTestLogicInProduction{}).Check(py, nil, model.RuleConfig{})
+	if len(got) != 2 || got[0].StartLine != 2 || got[1].StartLine != 4 {
+		t.Fatalf("python violations = %+v", got)
+	}
+}
+
+func TestNoTestLogicInProductionCustomMarkers(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:     "src/flags.js",
+		Language: "javascript",
+		Source:   []byte("if (process.env.E2E_MODE) { disableCaptcha(); }\nif (process.env.TEST) { skip(); }\n"),
+	}
+	config := model.RuleConfig{Options: map[string]interface{}{"envMarkers": []interface{}{"E2E_MODE"}}}
+	got := (&NoTestLogicInProduction{}).Check(file, nil, config)
+	if len(got) != 1 || got[0].StartLine != 1 || got[0].Context.Metadata["marker"] != "E2E_MODE" {
+		t.Fatalf("envMarkers should replace the defaults, got %+v", got)
+	}
+}
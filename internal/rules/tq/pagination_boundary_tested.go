@@ -0,0 +1,233 @@
+// pagination_boundary_tested.go — TQ-pagination-boundary-tested: Require empty, single-page, and multi-page tests for pagination loops.
+package tq
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultContinuationNames are identifiers, lower-cased without underscores, that mark a
+// loop as following continuation tokens or cursors: S3's NextContinuationToken and
+// IsTruncated, Stripe's has_more and starting_after, DynamoDB's LastEvaluatedKey.
+var defaultContinuationNames = []string{
+	"continuationtoken", "nextcontinuationtoken", "nexttoken", "pagetoken", "nextpagetoken",
+	"cursor", "nextcursor", "marker", "nextmarker", "istruncated", "hasmore", "hasnextpage",
+	"startingafter", "startafter", "nextlink", "nextpage", "lastevaluatedkey", "exclusivestartkey",
+}
+
+// paginationCases are the boundaries a pagination loop must be tested at, in report
+// order. A test covers a case when its name or body matches the case's pattern; the
+// multi-page pattern is matched after single-page phrases ("not truncated") are removed.
+var paginationCases = []struct {
+	Name    string
+	Pattern *regexp.Regexp
+}{
+	{"empty", regexp.MustCompile(`(?i)empty|no[_ ]?(results|items|objects|records|pages)|zero[_ ]?(results|items|objects|records)`)},
+	{"single-page", regexp.MustCompile(`(?i)single[_ ]?page|one[_ ]?page|not[_ ]?truncated|last[_ ]?page|no[_ ]?next|(istruncated|hasmore|has_more)\W*:\s*(aws\.bool\()?false`)},
+	{"multi-page", regexp.MustCompile(`(?i)multi|two[_ ]?pages|several[_ ]?pages|second[_ ]?page|next[_ ]?page|page[_ ]?two|truncated[_ ]?(page|response|result|listing)|(istruncated|hasmore|has_more)\W*:\s*(aws\.bool\()?true`)},
+}
+
+// PaginationBoundaryTested implements the TQ-pagination-boundary-tested rule. Go functions
+// that loop on a continuation token or cursor are paired with the package's tests that
+// call them, and those tests must cover an empty first page, a single page, and a
+// truncated page followed by another.
+type PaginationBoundaryTested struct{}
+
+func (r *PaginationBoundaryTested) ID() string       { return "TQ-pagination-boundary-tested" }
+func (r *PaginationBoundaryTested) Category() string { return "tq" }
+func (r *PaginationBoundaryTested) Description() string {
+	return "Require tests of pagination loops to cover empty, single-page, and multi-page results"
+}
+func (r *PaginationBoundaryTested) Why() string {
+	return "Pagination bugs hide at the boundaries: a loop that never requests page two or never stops passes every one-page test."
+}
+func (r *PaginationBoundaryTested) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "func TestListAllObjects(t *testing.T) {\n\tsrv := fakeS3(page(\"a.txt\", \"b.txt\"))\n\t// only one page, never truncated\n}",
+		Good:     "func TestListAllObjects_Empty(t *testing.T) { ... }\nfunc TestListAllObjects_SinglePage(t *testing.T) { ... }\nfunc TestListAllObjects_MultiPage(t *testing.T) { ... IsTruncated: aws.Bool(true) ... }",
+	}}
+}
+func (r *PaginationBoundaryTested) DefaultSeverity() string   { return "warn" }
+func (r *PaginationBoundaryTested) NeedsProjectContext() bool { return true }
+func (r *PaginationBoundaryTested) Stability() string         { return model.StabilityExperimental }
+
+func (r *PaginationBoundaryTested) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || ctx == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") {
+		return nil
+	}
+
+	names := map[string]bool{}
+	for _, name := range append(append([]string(nil), defaultContinuationNames...), stringSliceOption(config.Options, "continuationNames")...) {
+		names[continuationKey(name)] = true
+	}
+	loops := make([]paginationLoop, 0)
+	for _, source := range boundarySourceFiles(file, ctx) {
+		loops = append(loops, goPaginationLoops(source.Source, names)...)
+	}
+	if len(loops) == 0 {
+		return nil
+	}
+
+	siblings := boundarySiblingTests(file, ctx)
+	tests := make([][]paginationTest, len(siblings))
+	for i, sibling := range siblings {
+		tests[i] = goPaginationTests(sibling.Source)
+	}
+
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, loop := range loops {
+		owner, line := -1, 0
+		text := make([]string, 0)
+		for i, fileTests := range tests {
+			for _, test := range fileTests {
+				if !test.Calls[loop.Func] {
+					continue
+				}
+				if owner < 0 {
+					owner, line = i, test.Line
+				}
+				text = append(text, test.Text)
+			}
+		}
+		// Report once per package, on the first test file that calls the function.
+		if owner < 0 || siblings[owner].Path != file.Path {
+			continue
+		}
+
+		missing := missingPaginationCases(strings.Join(text, "\n"))
+		if len(missing) == 0 {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Tests for %s (paginates on %s) never cover the %s case(s)", loop.Func, loop.Token, strings.Join(missing, ", ")),
+			FilePath:  file.Path,
+			StartLine: line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Add %s tests: no results at all, one page with no %s, and a truncated page followed by a final one.", loop.Func, loop.Token),
+				Metadata: map[string]interface{}{
+					"function": loop.Func,
+					"token":    loop.Token,
+					"missing":  missing,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// paginationLoop is a function with a loop that reads a continuation token or cursor.
+type paginationLoop struct {
+	Func  string
+	Token string
+}
+
+// paginationTest is a Go test function, the functions it calls, and its source text.
+type paginationTest struct {
+	Line  int
+	Calls map[string]bool
+	Text  string
+}
+
+func continuationKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+}
+
+// goPaginationLoops finds functions and methods with a for loop whose condition or body
+// names a continuation identifier, as a variable or a field.
+func goPaginationLoops(source []byte, names map[string]bool) []paginationLoop {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	loops := make([]paginationLoop, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		found := ""
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if found != "" {
+				return false
+			}
+			loop, ok := n.(*ast.ForStmt)
+			if !ok {
+				return true
+			}
+			ast.Inspect(loop, func(inner ast.Node) bool {
+				if ident, ok := inner.(*ast.Ident); ok && found == "" && names[continuationKey(ident.Name)] {
+					found = ident.Name
+				}
+				return found == ""
+			})
+			return found == ""
+		})
+		if found != "" {
+			loops = append(loops, paginationLoop{Func: fn.Name.Name, Token: found})
+		}
+	}
+	return loops
+}
+
+func goPaginationTests(source []byte) []paginationTest {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	tests := make([]paginationTest, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		calls := map[string]bool{}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			switch f := call.Fun.(type) {
+			case *ast.Ident:
+				calls[f.Name] = true
+			case *ast.SelectorExpr:
+				calls[f.Sel.Name] = true
+			}
+			return true
+		})
+		tests = append(tests, paginationTest{Line: fset.Position(fn.Pos()).Line, Calls: calls, Text: sourceText(fset, source, fn)})
+	}
+	return tests
+}
+
+func missingPaginationCases(text string) []string {
+	single := paginationCases[1].Pattern
+	missing := make([]string, 0)
+	for _, c := range paginationCases {
+		subject := text
+		if c.Name == "multi-page" {
+			subject = single.ReplaceAllString(text, "")
+		}
+		if !c.Pattern.MatchString(subject) {
+			missing = append(missing, c.Name)
+		}
+	}
+	return missing
+}
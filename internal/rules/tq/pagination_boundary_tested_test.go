@@ -0,0 +1,173 @@
+// pagination_boundary_tested_test.go — Tests for TQ-pagination-boundary-tested.
+package tq
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const paginationProductionSource = `package s3client
+
+func (c *Client) ListAllObjects(ctx context.Context, bucket string) ([]Object, error) {
+	var all []Object
+	var continuationToken *string
+	for {
+		out, err := c.api.ListObjectsV2(ctx, &ListObjectsV2Input{Bucket: bucket, ContinuationToken: continuationToken})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, out.Contents...)
+		if !out.IsTruncated {
+			return all, nil
+		}
+		continuationToken = out.NextContinuationToken
+	}
+}
+
+func (c *Client) ListPages(ctx context.Context) ([]int, error) {
+	pages := []int{}
+	for page := 1; page <= 3; page++ {
+		pages = append(pages, page)
+	}
+	return pages, nil
+}
+`
+
+func TestPaginationBoundaryTestedMetadata(t *testing.T) {
+	rule := &PaginationBoundaryTested{}
+	if rule.ID() != "TQ-pagination-boundary-tested" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+	if !rule.NeedsProjectContext() {
+		t.Fatalf("rule should need project context to pair tests with sources")
+	}
+}
+
+func TestPaginationBoundaryTested(t *testing.T) {
+	tests := []struct {
+		name        string
+		test        string
+		options     map[string]interface{}
+		wantMissing []string
+	}{
+		{
+			name: "single happy page only",
+			test: `package s3client
+
+func TestListAllObjects(t *testing.T) {
+	c := newFakeClient(&ListObjectsV2Output{Contents: []Object{{Key: "a"}}})
+	got, _ := c.ListAllObjects(context.Background(), "bucket")
+	_ = got
+}
+`,
+			wantMissing: []string{"empty", "single-page", "multi-page"},
+		},
+		{
+			name: "not truncated is a single page, not a multi-page case",
+			test: `package s3client
+
+func TestListAllObjects_Empty(t *testing.T) {
+	c := newFakeClient(&ListObjectsV2Output{})
+	c.ListAllObjects(context.Background(), "bucket")
+}
+
+func TestListAllObjects(t *testing.T) {
+	c := newFakeClient(&ListObjectsV2Output{IsTruncated: aws.Bool(false), Contents: []Object{{Key: "a"}}})
+	c.ListAllObjects(context.Background(), "bucket")
+}
+`,
+			wantMissing: []string{"multi-page"},
+		},
+		{
+			name: "table-driven test covers every boundary",
+			test: `package s3client
+
+func TestListAllObjects(t *testing.T) {
+	cases := []struct {
+		name  string
+		pages []*ListObjectsV2Output
+	}{
+		{"empty bucket", []*ListObjectsV2Output{{}}},
+		{"single page", []*ListObjectsV2Output{{Contents: []Object{{Key: "a"}}}}},
+		{"two pages", []*ListObjectsV2Output{{IsTruncated: true, NextContinuationToken: aws.String("t")}, {}}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			newFakeClient(tc.pages...).ListAllObjects(context.Background(), "bucket")
+		})
+	}
+}
+`,
+		},
+		{
+			name: "untested pagination is left to coverage rules",
+			test: `package s3client
+
+func TestSomethingElse(t *testing.T) {
+	helper()
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &model.UnifiedFileModel{Path: "s3client/client.go", Language: "go", Source: []byte(paginationProductionSource)}
+			test := &model.UnifiedFileModel{Path: "s3client/client_test.go", Language: "go", IsTestFile: true, Source: []byte(tt.test)}
+			got := (&PaginationBoundaryTested{}).Check(test, boundaryContext(source, test), model.RuleConfig{Options: tt.options})
+			if len(tt.wantMissing) == 0 {
+				if len(got) != 0 {
+					t.Fatalf("violations = %+v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+			}
+			if missing := got[0].Context.Metadata["missing"]; !reflect.DeepEqual(missing, tt.wantMissing) {
+				t.Fatalf("missing = %v, want %v", missing, tt.wantMissing)
+			}
+			if got[0].Context.Metadata["function"] != "ListAllObjects" || got[0].Context.Metadata["token"] != "ContinuationToken" {
+				t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+			}
+		})
+	}
+}
+
+func TestPaginationBoundaryTestedReportsOncePerPackage(t *testing.T) {
+	source := &model.UnifiedFileModel{Path: "s3client/client.go", Language: "go", Source: []byte(paginationProductionSource)}
+	first := &model.UnifiedFileModel{Path: "s3client/a_test.go", Language: "go", IsTestFile: true, Source: []byte("package s3client\n\nfunc TestListAllObjects_MultiPage(t *testing.T) {\n\tc.ListAllObjects(ctx, \"b\")\n}\n")}
+	second := &model.UnifiedFileModel{Path: "s3client/b_test.go", Language: "go", IsTestFile: true, Source: []byte("package s3client\n\nfunc TestListAllObjects_Empty(t *testing.T) {\n\tc.ListAllObjects(ctx, \"b\")\n}\n")}
+	ctx := boundaryContext(source, first, second)
+
+	got := (&PaginationBoundaryTested{}).Check(first, ctx, model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 3 {
+		t.Fatalf("violations = %+v", got)
+	}
+	want := "Tests for ListAllObjects (paginates on ContinuationToken) never cover the single-page case(s)"
+	if got[0].Message != want {
+		t.Fatalf("message = %q, want %q", got[0].Message, want)
+	}
+	if got := (&PaginationBoundaryTested{}).Check(second, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("only the first calling test file reports, got %+v", got)
+	}
+}
+
+func TestPaginationBoundaryTestedContinuationNamesOption(t *testing.T) {
+	source := &model.UnifiedFileModel{
+		Path:     "feed/feed.go",
+		Language: "go",
+		Source:   []byte("package feed\n\nfunc All(c *Client) []Item {\n\tvar items []Item\n\tafter := \"\"\n\tfor {\n\t\tpage := c.Fetch(after)\n\t\titems = append(items, page.Items...)\n\t\tif page.Resume == \"\" {\n\t\t\treturn items\n\t\t}\n\t\tafter = page.Resume\n\t}\n}\n"),
+	}
+	test := &model.UnifiedFileModel{Path: "feed/feed_test.go", Language: "go", IsTestFile: true, Source: []byte("package feed\n\nfunc TestAll(t *testing.T) {\n\tAll(fake())\n}\n")}
+	ctx := boundaryContext(source, test)
+	if got := (&PaginationBoundaryTested{}).Check(test, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("unknown token names are not pagination by default, got %+v", got)
+	}
+	got := (&PaginationBoundaryTested{}).Check(test, ctx, model.RuleConfig{Options: map[string]interface{}{"continuationNames": []interface{}{"Resume"}}})
+	if len(got) != 1 || got[0].Context.Metadata["token"] != "Resume" {
+		t.Fatalf("violations = %+v", got)
+	}
+}
@@ -2,12 +2,19 @@
 package tq
 
 import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
 // ReturnTypeVerified implements the TQ-return-type-verified rule.
+//
+// For Go tests it also checks dual returns: when a paired source function returns
+// (T, error), a test that reads only the value or only the error is flagged.
 type ReturnTypeVerified struct{}
 
 func (r *ReturnTypeVerified) ID() string       { return "TQ-return-type-verified" }
@@ -18,31 +25,247 @@ func (r *ReturnTypeVerified) Description() string {
 func (r *ReturnTypeVerified) Why() string {
 	return "Partial assertions allow silent contract drift in returned objects."
 }
+func (r *ReturnTypeVerified) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "expect(user.id).toBe(123) // missing name, email assertions",
+		Good:     "expect(user).toEqual({id: 123, name: 'alice', email: 'a@b.com'})",
+	}}
+}
 func (r *ReturnTypeVerified) DefaultSeverity() string   { return "error" }
-func (r *ReturnTypeVerified) NeedsProjectContext() bool { return false }
-
-func (r *ReturnTypeVerified) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
+func (r *ReturnTypeVerified) NeedsProjectContext() bool { return true }
 
+func (r *ReturnTypeVerified) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Return type has 5 fields but test only asserts 2, missing: currency,status,total"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Add assertions for the currently unverified return fields.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Return type has 5 fields but test only asserts 2, missing: currency,status,total",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add assertions for the currently unverified return fields.",
+				},
 			},
-		},
+		}
+	}
+
+	if file == nil || !file.IsTestFile || !strings.EqualFold(file.Language, "go") || ctx == nil {
+		return nil
+	}
+	if !boolOption(config.Options, "checkErrorReturns", true) {
+		return nil
+	}
+	dual := dualReturnFuncs(boundarySourceFiles(file, ctx))
+	if len(dual) == 0 {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, file.Path, file.Source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, decl := range parsed.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		for _, gap := range unverifiedDualReturns(fn.Body, dual) {
+			pos := fset.Position(gap.Pos)
+			checked, fix := "value", "Check the returned error too, e.g. `if err != nil { t.Fatalf(...) }` or a table case that expects an error."
+			if gap.Missing == "value" {
+				checked, fix = "error", "Assert the returned value as well as the error."
+			}
+			violations = append(violations, model.Violation{
+				RuleID:      r.ID(),
+				Severity:    severity,
+				Message:     fmt.Sprintf("%s checks the %s returned by %s but never its %s", fn.Name.Name, checked, gap.Func, gap.Missing),
+				FilePath:    file.Path,
+				StartLine:   pos.Line,
+				StartColumn: pos.Column,
+				Context: &model.ViolationContext{
+					SuggestedFix: fix,
+					Metadata: map[string]interface{}{
+						"function":    gap.Func,
+						"test":        fn.Name.Name,
+						"missingSide": gap.Missing,
+					},
+				},
+			})
+		}
+	}
+	return violations
+}
+
+// dualReturnFuncs collects functions and methods declared in the paired source files
+// that return exactly (T, error). A name declared with any other shape is dropped so
+// an ambiguous method name never produces a finding.
+func dualReturnFuncs(sources []*model.UnifiedFileModel) map[string]bool {
+	dual := map[string]bool{}
+	for _, source := range sources {
+		parsed, err := parser.ParseFile(token.NewFileSet(), source.Path, source.Source, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			name := fn.Name.Name
+			isDual := fn.Type.Results != nil && fn.Type.Results.NumFields() == 2
+			if isDual {
+				last := fn.Type.Results.List[len(fn.Type.Results.List)-1].Type
+				ident, ok := last.(*ast.Ident)
+				isDual = ok && ident.Name == "error"
+			}
+			if seen, ok := dual[name]; ok && seen != isDual {
+				isDual = false
+			}
+			dual[name] = isDual
+		}
+	}
+	for name, isDual := range dual {
+		if !isDual {
+			delete(dual, name)
+		}
+	}
+	return dual
+}
+
+type dualReturnGap struct {
+	Func    string
+	Missing string
+	Pos     token.Pos
+}
+
+// unverifiedDualReturns finds `v, err := Fn(...)` assignments in a test body where one
+// of the two results is discarded or never read before it is reassigned. A missing
+// value is only reported when the error is checked for success: error-path tests
+// (`if err == nil { t.Fatal(...) }`) have no meaningful value to assert.
+func unverifiedDualReturns(body *ast.BlockStmt, dual map[string]bool) []dualReturnGap {
+	type site struct {
+		Func       string
+		Pos        token.Pos
+		Value, Err *ast.Ident
+	}
+	sites := make([]site, 0)
+	// writes and reads hold the positions of every assignment to, and use of, each name.
+	writes := map[string][]token.Pos{}
+	reads := map[string][]token.Pos{}
+	successChecks := map[token.Pos]bool{}
+	lhs := map[*ast.Ident]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		for _, expr := range assign.Lhs {
+			if ident, ok := expr.(*ast.Ident); ok {
+				lhs[ident] = true
+				writes[ident.Name] = append(writes[ident.Name], ident.Pos())
+			}
+		}
+		if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			return true
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		name := ""
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			name = fun.Name
+		case *ast.SelectorExpr:
+			name = fun.Sel.Name
+		}
+		value, okValue := assign.Lhs[0].(*ast.Ident)
+		errIdent, okErr := assign.Lhs[1].(*ast.Ident)
+		if dual[name] && okValue && okErr {
+			sites = append(sites, site{Func: name, Pos: assign.Pos(), Value: value, Err: errIdent})
+		}
+		return true
+	})
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.Ident:
+			if !lhs[node] {
+				reads[node.Name] = append(reads[node.Name], node.Pos())
+			}
+		case *ast.IfStmt:
+			markErrNotNil(node.Cond, successChecks)
+		case *ast.CallExpr:
+			if sel, ok := node.Fun.(*ast.SelectorExpr); ok {
+				switch sel.Sel.Name {
+				case "NoError", "NoErrorf", "Nil", "Nilf":
+					for _, arg := range node.Args {
+						if ident, ok := arg.(*ast.Ident); ok {
+							successChecks[ident.Pos()] = true
+						}
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	readBeforeReassign := func(ident *ast.Ident, only map[token.Pos]bool) bool {
+		if ident.Name == "_" {
+			return false
+		}
+		next := body.End()
+		for _, pos := range writes[ident.Name] {
+			if pos > ident.Pos() && pos < next {
+				next = pos
+			}
+		}
+		for _, pos := range reads[ident.Name] {
+			if pos > ident.Pos() && pos < next && (only == nil || only[pos]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	gaps := make([]dualReturnGap, 0)
+	for _, s := range sites {
+		valueRead, errRead := readBeforeReassign(s.Value, nil), readBeforeReassign(s.Err, nil)
+		switch {
+		case valueRead && !errRead:
+			gaps = append(gaps, dualReturnGap{Func: s.Func, Missing: "error", Pos: s.Pos})
+		case errRead && !valueRead && readBeforeReassign(s.Err, successChecks):
+			gaps = append(gaps, dualReturnGap{Func: s.Func, Missing: "value", Pos: s.Pos})
+		}
+	}
+	return gaps
+}
+
+// markErrNotNil records identifiers compared `!= nil` in an if condition, including
+// operands of || chains such as `err != nil || got != want`.
+func markErrNotNil(cond ast.Expr, marks map[token.Pos]bool) {
+	bin, ok := cond.(*ast.BinaryExpr)
+	if !ok {
+		return
+	}
+	switch bin.Op {
+	case token.LOR:
+		markErrNotNil(bin.X, marks)
+		markErrNotNil(bin.Y, marks)
+	case token.NEQ:
+		ident, okIdent := bin.X.(*ast.Ident)
+		other, okNil := bin.Y.(*ast.Ident)
+		if okIdent && okNil && other.Name == "nil" {
+			marks[ident.Pos()] = true
+		}
 	}
 }
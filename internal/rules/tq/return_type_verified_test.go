@@ -1,8 +1,129 @@
 // return_type_verified_test.go — Tests for TQ-return-type-verified.
 package tq
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestReturnTypeVerified(t *testing.T) {
 	assertRuleContract(t, &ReturnTypeVerified{})
 }
+
+func TestReturnTypeVerifiedDualReturns(t *testing.T) {
+	source := &model.UnifiedFileModel{
+		Path:     "pricing/pricing.go",
+		Language: "go",
+		Source: []byte(`package pricing
+
+func Parse(s string) (Price, error) { return Price{}, nil }
+func Total(items []Price) Price { return Price{} }
+func (c *Client) Fetch(id string) (Price, error) { return Price{}, nil }
+`),
+	}
+	test := &model.UnifiedFileModel{
+		Path:       "pricing/pricing_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source: []byte(`package pricing
+
+import "testing"
+
+func TestParseValueOnly(t *testing.T) {
+	got, err := Parse("1.00")
+	if got.Cents != 100 {
+		t.Fatalf("cents = %d", got.Cents)
+	}
+	_ = err
+	price, _ := Parse("2.00")
+	if price.Cents != 200 {
+		t.Fatal("bad price")
+	}
+}
+
+func TestFetchErrorOnly(t *testing.T) {
+	c := &Client{}
+	_, err := c.Fetch("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFetchMissing(t *testing.T) {
+	c := &Client{}
+	_, err := c.Fetch("missing")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestParseBoth(t *testing.T) {
+	got, err := Parse("1.00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cents != 100 {
+		t.Fatal("bad")
+	}
+	got, err = Parse("3.00")
+	if err != nil || got.Cents != 300 {
+		t.Fatal("bad")
+	}
+	total := Total([]Price{got})
+	_ = total
+}
+`),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{source.Path: source, test.Path: test}}
+	rule := &ReturnTypeVerified{}
+
+	violations := rule.Check(test, ctx, model.RuleConfig{})
+	// `_ = err` still reads err, and TestFetchMissing expects an error so its value is
+	// irrelevant; only the discarded error and the unchecked success value are reported.
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %d: %+v", len(violations), violations)
+	}
+	want := []struct {
+		line     int
+		function string
+		missing  string
+	}{
+		{11, "Parse", "error"},
+		{19, "Fetch", "value"},
+	}
+	for i, w := range want {
+		v := violations[i]
+		if v.StartLine != w.line || v.Context.Metadata["function"] != w.function || v.Context.Metadata["missingSide"] != w.missing {
+			t.Fatalf("violation %d = line %d %v, want line %d %s missing %s", i, v.StartLine, v.Context.Metadata, w.line, w.function, w.missing)
+		}
+	}
+	if violations[0].Message != "TestParseValueOnly checks the value returned by Parse but never its error" {
+		t.Fatalf("unexpected message: %q", violations[0].Message)
+	}
+
+	if got := rule.Check(test, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("expected no violations without project context, got %+v", got)
+	}
+	disabled := model.RuleConfig{Options: map[string]interface{}{"checkErrorReturns": false}}
+	if got := rule.Check(test, ctx, disabled); len(got) != 0 {
+		t.Fatalf("expected checkErrorReturns=false to disable the check, got %+v", got)
+	}
+}
+
+func TestReturnTypeVerifiedUnreadAfterReassignment(t *testing.T) {
+	source := &model.UnifiedFileModel{Path: "a/a.go", Language: "go", Source: []byte("package a\n\nfunc Load() (int, error) { return 0, nil }\n")}
+	test := &model.UnifiedFileModel{
+		Path:       "a/a_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte("package a\n\nimport \"testing\"\n\nfunc TestLoad(t *testing.T) {\n\tn, err := Load()\n\tn, err = Load()\n\tif err != nil || n != 1 {\n\t\tt.Fatal(err)\n\t}\n}\n"),
+	}
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{source.Path: source, test.Path: test}}
+	violations := (&ReturnTypeVerified{}).Check(test, ctx, model.RuleConfig{})
+	// The first call's results are overwritten before either is read, so neither side
+	// is verified; that is left to other rules rather than reported as one missing side.
+	if len(violations) != 0 {
+		t.Fatalf("expected no violations, got %+v", violations)
+	}
+}
@@ -2,12 +2,22 @@
 package tq
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
-// SchemaConformance implements the TQ-schema-conformance rule.
+var jsonFixtureLiteralPattern = regexp.MustCompile("[\"'`]([^\"'`\\s]+\\.json)[\"'`]")
+
+// SchemaConformance implements the TQ-schema-conformance rule. With the `fixtures`
+// option it also validates JSON fixtures referenced from test files against the JSON
+// Schema mapped to their path.
 type SchemaConformance struct{}
 
 func (r *SchemaConformance) ID() string       { return "TQ-schema-conformance" }
@@ -18,31 +28,213 @@ func (r *SchemaConformance) Description() string {
 func (r *SchemaConformance) Why() string {
 	return "Schema checks must verify constraints, not only field presence."
 }
+func (r *SchemaConformance) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "expect(response.status).toBeDefined()",
+		Good:     "expect(response.status).toBe(200)",
+	}}
+}
 func (r *SchemaConformance) DefaultSeverity() string   { return "error" }
 func (r *SchemaConformance) NeedsProjectContext() bool { return false }
+func (r *SchemaConformance) ReadsExternalInputs() bool { return true }
 
 func (r *SchemaConformance) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Assertion on amount checks existence but not type/value constraints (expected: integer cents)"
-	return []model.Violation{
-		{
-			RuleID:    r.ID(),
-			Severity:  severity,
-			Message:   message,
-			FilePath:  file.Path,
-			StartLine: line,
-			Context: &model.ViolationContext{
-				SuggestedFix: "Assert both type and business constraint for the field.",
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		message := "Assertion on amount checks existence but not type/value constraints (expected: integer cents)"
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Assert both type and business constraint for the field.",
+				},
 			},
-		},
+		}
+	}
+
+	if file == nil || !file.IsTestFile {
+		return nil
+	}
+	mappings := fixtureSchemaMappings(config.Options)
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	violations := make([]model.Violation, 0)
+	schemas := map[string]schemaLoad{}
+	seen := map[string]bool{}
+	for _, ref := range jsonFixtureReferences(file) {
+		fixture := resolveFixturePath(file.Path, ref.Path)
+		if fixture == "" || seen[fixture] {
+			continue
+		}
+		mapping, ok := matchFixtureSchema(mappings, fixture)
+		if !ok {
+			continue
+		}
+		seen[fixture] = true
+
+		report := func(pointer string, message string, fix string) {
+			violations = append(violations, model.Violation{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   message,
+				FilePath:  file.Path,
+				StartLine: ref.Line,
+				Context: &model.ViolationContext{
+					SuggestedFix: fix,
+					Metadata: map[string]interface{}{
+						"fixture": filepath.ToSlash(fixture),
+						"schema":  mapping.Schema,
+						"pointer": pointer,
+					},
+				},
+			})
+		}
+
+		loaded, cached := schemas[mapping.Schema]
+		if !cached {
+			loaded = loadJSONFile(mapping.Schema)
+			schemas[mapping.Schema] = loaded
+		}
+		if loaded.Err != nil {
+			report("", fmt.Sprintf("Schema %s for fixture %s could not be loaded: %v", mapping.Schema, filepath.ToSlash(fixture), loaded.Err), "Fix the schema path in the `fixtures` option or the schema file itself.")
+			continue
+		}
+		document := loadJSONFile(fixture)
+		if document.Err != nil {
+			report("", fmt.Sprintf("Fixture %s is not valid JSON: %v", filepath.ToSlash(fixture), document.Err), "Fix the fixture so it parses as JSON.")
+			continue
+		}
+		for _, problem := range validateJSONSchema(loaded.Value, document.Value) {
+			report(problem.Pointer,
+				fmt.Sprintf("Fixture %s does not match schema %s at #%s: %s", filepath.ToSlash(fixture), mapping.Schema, problem.Pointer, problem.Message),
+				"Update the fixture to match the schema, or update the schema if the contract changed.")
+		}
+	}
+	return violations
+}
+
+// fixtureSchema maps a glob of JSON fixture paths to the schema they must satisfy.
+type fixtureSchema struct {
+	Pattern *regexp.Regexp
+	Schema  string
+}
+
+// fixtureSchemaMappings reads the `fixtures` option, a map from fixture path glob to
+// JSON Schema path. Globs are tried in sorted order.
+func fixtureSchemaMappings(options map[string]interface{}) []fixtureSchema {
+	raw, _ := options["fixtures"].(map[string]interface{})
+	globs := make([]string, 0, len(raw))
+	for glob := range raw {
+		globs = append(globs, glob)
+	}
+	sort.Strings(globs)
+	mappings := make([]fixtureSchema, 0, len(globs))
+	for _, glob := range globs {
+		schema, _ := raw[glob].(string)
+		if strings.TrimSpace(glob) == "" || strings.TrimSpace(schema) == "" {
+			continue
+		}
+		mappings = append(mappings, fixtureSchema{Pattern: fixtureGlobPattern(glob), Schema: strings.TrimSpace(schema)})
+	}
+	return mappings
+}
+
+func matchFixtureSchema(mappings []fixtureSchema, fixture string) (fixtureSchema, bool) {
+	slashed := strings.TrimPrefix(filepath.ToSlash(filepath.Clean(fixture)), "./")
+	for _, mapping := range mappings {
+		if mapping.Pattern.MatchString(slashed) {
+			return mapping, true
+		}
+	}
+	return fixtureSchema{}, false
+}
+
+// fixtureGlobPattern compiles a path glob where `**` spans directories and `*`/`?`
+// stay within one segment. A leading `**/` also matches no directory at all.
+func fixtureGlobPattern(glob string) *regexp.Regexp {
+	glob = strings.TrimPrefix(filepath.ToSlash(strings.TrimSpace(glob)), "./")
+	var out strings.Builder
+	out.WriteString("^")
+	if !strings.HasPrefix(glob, "/") && !strings.HasPrefix(glob, "**") {
+		// Relative globs match relative paths and the tail of absolute ones.
+		out.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			out.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			out.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			out.WriteString("[^/]*")
+		case glob[i] == '?':
+			out.WriteString("[^/]")
+		default:
+			out.WriteString(regexp.QuoteMeta(string(glob[i])))
+		}
+	}
+	out.WriteString("$")
+	return regexp.MustCompile(out.String())
+}
+
+// fixtureReference is a quoted `.json` path in test source.
+type fixtureReference struct {
+	Path string
+	Line int
+}
+
+func jsonFixtureReferences(file *model.UnifiedFileModel) []fixtureReference {
+	refs := make([]fixtureReference, 0)
+	for i, line := range strings.Split(string(file.Source), "\n") {
+		for _, match := range jsonFixtureLiteralPattern.FindAllStringSubmatch(line, -1) {
+			refs = append(refs, fixtureReference{Path: match[1], Line: i + 1})
+		}
+	}
+	return refs
+}
+
+// resolveFixturePath finds a referenced fixture relative to the test file's directory
+// (how Go testdata and `__dirname` paths resolve), then relative to the working
+// directory. It returns "" when neither exists.
+func resolveFixturePath(testPath string, ref string) string {
+	candidates := []string{filepath.Join(filepath.Dir(testPath), filepath.FromSlash(ref)), filepath.FromSlash(ref)}
+	if filepath.IsAbs(ref) {
+		candidates = candidates[1:]
+	}
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return filepath.Clean(candidate)
+		}
+	}
+	return ""
+}
+
+type schemaLoad struct {
+	Value interface{}
+	Err   error
+}
+
+func loadJSONFile(pathValue string) schemaLoad {
+	data, err := os.ReadFile(pathValue)
+	if err != nil {
+		return schemaLoad{Err: err}
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return schemaLoad{Err: err}
 	}
+	return schemaLoad{Value: value}
 }
@@ -1,8 +1,116 @@
 // schema_conformance_test.go — Tests for TQ-schema-conformance.
 package tq
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestSchemaConformance(t *testing.T) {
 	assertRuleContract(t, &SchemaConformance{})
 }
+
+const orderSchema = `{
+  "type": "object",
+  "required": ["id", "total", "status"],
+  "additionalProperties": false,
+  "properties": {
+    "id": {"type": "string", "minLength": 1},
+    "total": {"type": "integer", "minimum": 0},
+    "status": {"enum": ["pending", "paid"]},
+    "items": {"type": "array", "items": {"$ref": "#/definitions/item"}}
+  },
+  "definitions": {
+    "item": {"type": "object", "required": ["sku"], "properties": {"sku": {"type": "string"}}}
+  }
+}`
+
+func writeSchemaFixture(t *testing.T, dir string, rel string, content string) string {
+	t.Helper()
+	target := filepath.Join(dir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(target, []byte(content), 0o644); err != nil {
+		t.Fatalf("write %s: %v", rel, err)
+	}
+	return target
+}
+
+func TestSchemaConformanceValidatesReferencedFixtures(t *testing.T) {
+	dir := t.TempDir()
+	schema := writeSchemaFixture(t, dir, "schemas/order.schema.json", orderSchema)
+	writeSchemaFixture(t, dir, "orders/testdata/valid.json", `{"id": "o-1", "total": 1200, "status": "paid", "items": [{"sku": "A"}]}`)
+	writeSchemaFixture(t, dir, "orders/testdata/drifted.json", `{"id": "o-2", "total": "12.00", "status": "shipped", "items": [{}], "note": "x"}`)
+	writeSchemaFixture(t, dir, "orders/testdata/broken.json", `{"id": `)
+
+	source := "package orders\n\nfunc TestOrders(t *testing.T) {\n\tload(t, \"testdata/valid.json\")\n\tload(t, \"testdata/drifted.json\")\n\tload(t, \"testdata/drifted.json\")\n\tload(t, \"testdata/broken.json\")\n\tload(t, \"testdata/missing.json\")\n}\n"
+	file := &model.UnifiedFileModel{Path: filepath.Join(dir, "orders", "orders_test.go"), Language: "go", IsTestFile: true, Source: []byte(source)}
+	config := model.RuleConfig{Options: map[string]interface{}{
+		"fixtures": map[string]interface{}{"orders/testdata/*.json": schema},
+	}}
+
+	got := (&SchemaConformance{}).Check(file, nil, config)
+	pointers := make([]string, 0, len(got))
+	for _, v := range got {
+		pointers = append(pointers, v.Context.Metadata["pointer"].(string))
+	}
+	want := []string{"/items/0", "/note", "/status", "/total", ""}
+	if strings.Join(pointers, ",") != strings.Join(want, ",") {
+		t.Fatalf("pointers = %q, want %q\n%+v", pointers, want, got)
+	}
+	drifted := filepath.ToSlash(filepath.Join(dir, "orders", "testdata", "drifted.json"))
+	if got[3].StartLine != 5 || got[3].Message != "Fixture "+drifted+" does not match schema "+schema+" at #/total: expected integer, got string" {
+		t.Fatalf("total violation = line %d %q", got[3].StartLine, got[3].Message)
+	}
+	if got[0].Message != "Fixture "+drifted+" does not match schema "+schema+" at #/items/0: missing required property \"sku\"" {
+		t.Fatalf("$ref violation = %q", got[0].Message)
+	}
+	if got[4].StartLine != 7 || !strings.Contains(got[4].Message, "broken.json is not valid JSON") {
+		t.Fatalf("broken fixture violation = line %d %q", got[4].StartLine, got[4].Message)
+	}
+
+	if got := (&SchemaConformance{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("without fixtures option nothing should be reported, got %+v", got)
+	}
+}
+
+func TestSchemaConformanceReportsMissingSchema(t *testing.T) {
+	dir := t.TempDir()
+	writeSchemaFixture(t, dir, "web/fixtures/user.json", `{"id": 1}`)
+	file := &model.UnifiedFileModel{
+		Path:       filepath.Join(dir, "web", "user.test.ts"),
+		Language:   "typescript",
+		IsTestFile: true,
+		Source:     []byte("import user from './fixtures/user.json';\n"),
+	}
+	config := model.RuleConfig{Options: map[string]interface{}{
+		"fixtures": map[string]interface{}{"**/fixtures/*.json": filepath.Join(dir, "schemas", "missing.json")},
+	}}
+	got := (&SchemaConformance{}).Check(file, nil, config)
+	if len(got) != 1 || got[0].StartLine != 1 || !strings.Contains(got[0].Message, "could not be loaded") {
+		t.Fatalf("missing schema should be reported once, got %+v", got)
+	}
+}
+
+func TestValidateJSONSchemaCombinators(t *testing.T) {
+	schema := map[string]interface{}{
+		"oneOf": []interface{}{
+			map[string]interface{}{"type": "string", "pattern": "^[a-z]+$"},
+			map[string]interface{}{"type": "number", "exclusiveMinimum": float64(0)},
+		},
+	}
+	if errs := validateJSONSchema(schema, "abc"); len(errs) != 0 {
+		t.Fatalf("string branch should match, got %+v", errs)
+	}
+	if errs := validateJSONSchema(schema, float64(0)); len(errs) != 1 || errs[0].Message != "value matches 0 schemas in oneOf, want exactly 1" {
+		t.Fatalf("zero should match no branch, got %+v", errs)
+	}
+	if errs := validateJSONSchema(false, nil); len(errs) != 1 || errs[0].Pointer != "" {
+		t.Fatalf("false schema should reject everything, got %+v", errs)
+	}
+}
@@ -0,0 +1,286 @@
+// setup_teardown_balance.go — TQ-setup-teardown-balance: Flag resources tests acquire but never release.
+package tq
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultAcquirePatterns are the calls, per language, that acquire a resource a test must
+// release. Go's t.TempDir and Python's `with` blocks release themselves and are absent.
+var defaultAcquirePatterns = map[string][]string{
+	"go": {
+		`\bos\.(?:Open|OpenFile|Create|CreateTemp|MkdirTemp)\(`,
+		`\bioutil\.(?:TempFile|TempDir)\(`,
+		`\bhttptest\.New(?:TLS|Unstarted)?Server\(`,
+		`\bnet\.Listen\(`,
+		`\bsql\.Open\(`,
+		`\bgrpc\.(?:Dial|DialContext|NewClient)\(`,
+	},
+	"typescript": jsAcquirePatterns,
+	"javascript": jsAcquirePatterns,
+	"python": {
+		`\bopen\(`,
+		`\bsocket\.socket\(`,
+		`\bsqlite3\.connect\(`,
+		`\bpsycopg2?\.connect\(`,
+		`\bsubprocess\.Popen\(`,
+		`\btempfile\.(?:NamedTemporaryFile|TemporaryFile|mkdtemp)\(`,
+	},
+}
+
+var jsAcquirePatterns = []string{
+	`\b(?:[\w$]+\.)*listen\(`,
+	`\b(?:http|https|net)\.createServer\(`,
+	`\bfs\.(?:openSync|createReadStream|createWriteStream|mkdtempSync)\(`,
+	`\bfs\.promises\.open\(`,
+	`\bnew\s+(?:WebSocket|Pool|PrismaClient|Worker)\(`,
+	`\b(?:chromium|firefox|webkit|puppeteer)\.launch\(`,
+}
+
+// defaultReleasePatterns are, per language, the teardown that releases an acquired
+// resource. `{var}` stands for the variable the resource was assigned to. Returning the
+// resource hands it to the caller and also counts.
+var defaultReleasePatterns = map[string][]string{
+	"go": {
+		`\bdefer\b[^\n]*\b{var}\b`,
+		`(?s)\bdefer\s+func\s*\([^)]*\)\s*\{[^}]*\b{var}\b`,
+		`(?s)\.Cleanup\(\s*(?:func\s*\([^)]*\)\s*\{[^}]*)?\b{var}\b`,
+		`\breturn\b[^\n]*\b{var}\b`,
+	},
+	"typescript": jsReleasePatterns,
+	"javascript": jsReleasePatterns,
+	"python": {
+		`\b{var}\.(?:close|terminate|kill|shutdown|stop|cleanup|disconnect)\s*\(`,
+		`\baddCleanup\([^\n]*\b{var}\b`,
+		`\b(?:shutil\.rmtree|os\.remove|os\.unlink)\(\s*{var}\b`,
+		`\breturn\b[^\n]*\b{var}\b`,
+	},
+}
+
+var jsReleasePatterns = []string{
+	`(?s)\b(?:afterEach|afterAll|after|onTestFinished)\s*\((?:[^{}\n]*\b{var}\b|[^{}\n]*\{[^}]*\b{var}\b)`,
+	`(?s)\bfinally\s*\{[^}]*\b{var}\b`,
+	`\busing\s+{var}\b`,
+	`\breturn\b[^\n]*\b{var}\b`,
+}
+
+// acquireAssignmentPatterns capture, per language, the variable on the left of an
+// acquisition; the line up to the acquiring call must match.
+var acquireAssignmentPatterns = map[string]*regexp.Regexp{
+	"go":         regexp.MustCompile(`([\w.]+)(?:\s*,\s*[\w.]+)*\s*:?=\s*$`),
+	"typescript": regexp.MustCompile(`([\w.$]+)\s*=\s*(?:await\s+)?$`),
+	"javascript": regexp.MustCompile(`([\w.$]+)\s*=\s*(?:await\s+)?$`),
+	"python":     regexp.MustCompile(`([\w.]+)\s*=\s*(?:await\s+)?$`),
+}
+
+// releaseHints name the expected teardown in messages.
+var releaseHints = map[string]string{
+	"go":         "defer or t.Cleanup",
+	"typescript": "afterEach, afterAll, or finally",
+	"javascript": "afterEach, afterAll, or finally",
+	"python":     "close, addCleanup, or tearDown",
+}
+
+// SetupTeardownBalance implements the TQ-setup-teardown-balance rule. In test files it
+// finds resources assigned from an acquiring call (files, servers, listeners,
+// connections) and flags those no release pattern mentions. The `acquire` and `release`
+// options map a language to regular expressions replacing that language's defaults.
+//
+// In Go the release must appear in the function that acquired the resource, found with
+// go/ast; in JS/TS and Python, where setup and teardown are separate hooks or methods,
+// anywhere in the file. Go and JS/TS string literals and comments are ignored.
+type SetupTeardownBalance struct{}
+
+func (r *SetupTeardownBalance) ID() string       { return "TQ-setup-teardown-balance" }
+func (r *SetupTeardownBalance) Category() string { return "tq" }
+func (r *SetupTeardownBalance) Description() string {
+	return "Flag resources acquired in tests without a matching teardown"
+}
+func (r *SetupTeardownBalance) Why() string {
+	return "A server, file, or connection a test never closes outlives the test and makes later tests fail with port, descriptor, or lock errors far from the cause."
+}
+func (r *SetupTeardownBalance) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "srv := httptest.NewServer(handler)\nresp, err := http.Get(srv.URL)",
+		Good:     "srv := httptest.NewServer(handler)\nt.Cleanup(srv.Close)\nresp, err := http.Get(srv.URL)",
+	}}
+}
+func (r *SetupTeardownBalance) DefaultSeverity() string   { return "warn" }
+func (r *SetupTeardownBalance) NeedsProjectContext() bool { return false }
+
+func (r *SetupTeardownBalance) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+	lang := strings.ToLower(file.Language)
+	assignment := acquireAssignmentPatterns[lang]
+	if assignment == nil {
+		return nil
+	}
+	acquire := compileLanguagePatterns(config.Options, "acquire", lang, defaultAcquirePatterns[lang])
+	releases := languagePatterns(config.Options, "release", lang, defaultReleasePatterns[lang])
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	source := file.Source
+	var scopes [][2]int
+	switch lang {
+	case "go":
+		scopes = goFuncBodySpans(file.Source)
+		source = blankGoLiterals(file.Source)
+	case "typescript", "javascript":
+		source = blankJSLiterals(file.Source)
+	}
+	text := string(source)
+	violations := make([]model.Violation, 0)
+	offset := 0
+	for i, line := range strings.Split(text, "\n") {
+		lineStart := offset
+		offset += len(line) + 1
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "*") {
+			continue
+		}
+		for _, pattern := range acquire {
+			loc := pattern.FindStringIndex(line)
+			if loc == nil {
+				continue
+			}
+			m := assignment.FindStringSubmatch(line[:loc[0]])
+			if m == nil || m[1] == "_" || resourceReleased(releaseScope(text, scopes, lineStart+loc[0]), releases, m[1]) {
+				continue
+			}
+			call := strings.TrimSuffix(strings.TrimPrefix(line[loc[0]:loc[1]], "new "), "(")
+			violations = append(violations, model.Violation{
+				RuleID:      r.ID(),
+				Severity:    severity,
+				Message:     fmt.Sprintf("'%s' acquired by %s is never released with %s", m[1], call, releaseHints[lang]),
+				FilePath:    file.Path,
+				StartLine:   i + 1,
+				StartColumn: loc[0] + 1,
+				Context: &model.ViolationContext{
+					SuggestedFix: fmt.Sprintf("Release '%s' right after acquiring it (%s), so it is closed even when the test fails.", m[1], releaseHints[lang]),
+					Metadata: map[string]interface{}{
+						"variable": m[1],
+						"call":     call,
+					},
+				},
+			})
+			break
+		}
+	}
+	return violations
+}
+
+// resourceReleased reports whether any release pattern, with `{var}` bound to name,
+// matches source.
+func resourceReleased(source string, releases []string, name string) bool {
+	for _, expr := range releases {
+		re, err := regexp.Compile(strings.ReplaceAll(expr, "{var}", regexp.QuoteMeta(name)))
+		if err == nil && re.MatchString(source) {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseScope returns the part of source a resource acquired at offset must be released
+// in: the span containing offset, or all of source when none does.
+func releaseScope(source string, spans [][2]int, offset int) string {
+	for _, span := range spans {
+		if span[0] <= offset && offset < span[1] {
+			return source[span[0]:span[1]]
+		}
+	}
+	return source
+}
+
+// goFuncBodySpans returns the byte ranges of the top-level function bodies of a Go
+// source, or nil when it does not parse.
+func goFuncBodySpans(source []byte) [][2]int {
+	fset := token.NewFileSet()
+	parsed, err := parser.ParseFile(fset, "", source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	spans := make([][2]int, 0)
+	for _, decl := range parsed.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			spans = append(spans, [2]int{fset.Position(fn.Body.Lbrace).Offset, fset.Position(fn.Body.Rbrace).Offset + 1})
+		}
+	}
+	return spans
+}
+
+// blankGoLiterals returns a copy of a Go source with the contents of string, rune, and
+// raw string literals and of comments replaced by spaces. Quotes and newlines are kept,
+// so lines and offsets still match the original.
+func blankGoLiterals(source []byte) []byte {
+	out := append([]byte(nil), source...)
+	blank := func(from, to int) {
+		for i := from; i < to && i < len(out); i++ {
+			if out[i] != '\n' {
+				out[i] = ' '
+			}
+		}
+	}
+	fset := token.NewFileSet()
+	var s scanner.Scanner
+	s.Init(fset.AddFile("", -1, len(source)), source, nil, scanner.ScanComments)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		start := fset.Position(pos).Offset
+		switch tok {
+		case token.STRING, token.CHAR:
+			end := start + len(lit) - 1
+			if lit[0] == '`' {
+				// Raw strings drop carriage returns from lit; find the closing quote instead.
+				end = start + 1 + bytes.IndexByte(source[start+1:], '`')
+			}
+			blank(start+1, end)
+		case token.COMMENT:
+			blank(start, start+len(lit))
+		}
+	}
+	return out
+}
+
+// languagePatterns reads options[key][lang], a list of regular expressions, falling back
+// to defaults when the language has no entry.
+func languagePatterns(options map[string]interface{}, key string, lang string, defaults []string) []string {
+	byLanguage, ok := options[key].(map[string]interface{})
+	if !ok {
+		return defaults
+	}
+	if _, ok := byLanguage[lang]; !ok {
+		return defaults
+	}
+	return stringSliceOption(byLanguage, lang)
+}
+
+// compileLanguagePatterns is languagePatterns compiled, skipping invalid expressions.
+func compileLanguagePatterns(options map[string]interface{}, key string, lang string, defaults []string) []*regexp.Regexp {
+	exprs := languagePatterns(options, key, lang, defaults)
+	compiled := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		if re, err := regexp.Compile(expr); err == nil {
+			compiled = append(compiled, re)
+		}
+	}
+	return compiled
+}
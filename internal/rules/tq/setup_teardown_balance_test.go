@@ -0,0 +1,113 @@
+// setup_teardown_balance_test.go — Tests for TQ-setup-teardown-balance.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestSetupTeardownBalanceMetadata(t *testing.T) {
+	rule := &SetupTeardownBalance{}
+	if rule.ID() != "TQ-setup-teardown-balance" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+}
+
+func TestSetupTeardownBalance(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		source   string
+		want     []string // flagged variables, in order
+	}{
+		{
+			name:     "go unreleased server and file",
+			language: "go",
+			source:   "func TestGet(t *testing.T) {\n\tsrv := httptest.NewServer(h)\n\tf, err := os.Open(\"testdata/in.json\")\n\tdir := t.TempDir()\n\t_ = dir\n\tf.Close()\n}\n",
+			want:     []string{"srv", "f"},
+		},
+		{
+			name:     "go defer and cleanup",
+			language: "go",
+			source:   "func TestGet(t *testing.T) {\n\tsrv := httptest.NewServer(h)\n\tt.Cleanup(srv.Close)\n\tf, err := os.Create(p)\n\tdefer f.Close()\n\tln, _ := net.Listen(\"tcp\", \":0\")\n\tt.Cleanup(func() {\n\t\t_ = ln.Close()\n\t})\n\t// old := os.Open(p)\n}\n",
+		},
+		{
+			name:     "go helper returns the resource",
+			language: "go",
+			source:   "func newServer(t *testing.T) *httptest.Server {\n\tsrv := httptest.NewServer(h)\n\treturn srv\n}\n",
+		},
+		{
+			name:     "go release in another test does not count",
+			language: "go",
+			source:   "package api\n\nfunc TestA(t *testing.T) {\n\tsrv := httptest.NewServer(h)\n\tdefer srv.Close()\n}\n\nfunc TestB(t *testing.T) {\n\tsrv := httptest.NewServer(h)\n\t_ = srv\n}\n",
+			want:     []string{"srv"},
+		},
+		{
+			name:     "go code inside literals and comments",
+			language: "go",
+			source:   "package api\n\nvar cases = []string{\n\t\"\\tf, err := os.Open(p)\",\n\t`srv := httptest.NewServer(h)`,\n}\n\n/*\n\tln, _ := net.Listen(\"tcp\", \":0\")\n*/\n",
+		},
+		{
+			name:     "typescript code inside strings",
+			language: "typescript",
+			source:   "const snippet = 'const ws = new WebSocket(url);';\n",
+		},
+		{
+			name:     "typescript afterAll",
+			language: "typescript",
+			source:   "let server;\nbeforeAll(() => { server = app.listen(0); });\nafterAll(() => server.close());\nconst ws = new WebSocket(url);\n",
+			want:     []string{"ws"},
+		},
+		{
+			name:     "python teardown",
+			language: "python",
+			source:   "class T(unittest.TestCase):\n    def setUp(self):\n        self.conn = sqlite3.connect(':memory:')\n        self.log = open('out.log', 'w')\n    def tearDown(self):\n        self.conn.close()\n    def test_read(self):\n        with open('in.txt') as f:\n            pass\n",
+			want:     []string{"self.log"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &model.UnifiedFileModel{Path: "x_test", Language: tt.language, IsTestFile: true, Source: []byte(tt.source)}
+			got := (&SetupTeardownBalance{}).Check(file, nil, model.RuleConfig{})
+			if len(got) != len(tt.want) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, name := range tt.want {
+				if got[i].Context.Metadata["variable"] != name {
+					t.Fatalf("violation %d variable = %v, want %s", i, got[i].Context.Metadata["variable"], name)
+				}
+			}
+		})
+	}
+}
+
+func TestSetupTeardownBalanceMessageAndOptions(t *testing.T) {
+	file := &model.UnifiedFileModel{
+		Path:       "store_test.go",
+		Language:   "go",
+		IsTestFile: true,
+		Source:     []byte("func TestStore(t *testing.T) {\n\tdb := testdb.Start(t)\n\tsrv := httptest.NewServer(h)\n\tsrv.Close()\n}\n"),
+	}
+	rule := &SetupTeardownBalance{}
+
+	got := rule.Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 || got[0].Message != "'srv' acquired by httptest.NewServer is never released with defer or t.Cleanup" || got[0].StartLine != 3 || got[0].StartColumn != 9 {
+		t.Fatalf("got %+v", got)
+	}
+
+	config := model.RuleConfig{Options: map[string]interface{}{
+		"acquire": map[string]interface{}{"go": []interface{}{`\btestdb\.Start\(`}},
+		"release": map[string]interface{}{"go": []interface{}{`\b{var}\.Stop\(`}},
+	}}
+	got = rule.Check(file, nil, config)
+	if len(got) != 1 || got[0].Context.Metadata["call"] != "testdb.Start" {
+		t.Fatalf("acquire should replace the Go defaults, got %+v", got)
+	}
+
+	file.IsTestFile = false
+	if got := rule.Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("production files should be skipped, got %+v", got)
+	}
+}
@@ -0,0 +1,193 @@
+// snapshot_staleness.go — TQ-snapshot-test-staleness: Flag snapshots not re-verified after their test changed.
+package tq
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+// defaultSnapshotPatterns locate snapshot files for a test file. Placeholders: {dir} is
+// the test file's directory, {file} its base name, {stem} the base name without its
+// extension.
+var defaultSnapshotPatterns = []string{
+	"{dir}/__snapshots__/{file}.snap", // Jest, Vitest
+	"{dir}/__snapshots__/{stem}.snap", // go-snaps
+	"{dir}/__snapshots__/{stem}.ambr", // syrupy
+	"{dir}/snapshots/snap_{stem}.py",  // snapshottest
+}
+
+var (
+	snapshotCallPattern  = regexp.MustCompile(`\b(?:toMatchSnapshot|toMatchInlineSnapshot|toMatchFileSnapshot|toThrowErrorMatchingSnapshot|toThrowErrorMatchingInlineSnapshot|MatchSnapshot|SnapshotT|assert_match_snapshot)\b|\bsnapshot\s*==|==\s*snapshot\b`)
+	emptyInlineSnapshot  = regexp.MustCompile(`\b(toMatchInlineSnapshot|toThrowErrorMatchingInlineSnapshot)\(\s*\)`)
+	defaultSnapshotGrace = 60 * time.Second
+)
+
+// SnapshotStaleness implements the TQ-snapshot-test-staleness rule.
+type SnapshotStaleness struct{}
+
+func (r *SnapshotStaleness) ID() string       { return "TQ-snapshot-test-staleness" }
+func (r *SnapshotStaleness) Category() string { return "tq" }
+func (r *SnapshotStaleness) Description() string {
+	return "Flag snapshots that were not re-verified after their test changed"
+}
+func (r *SnapshotStaleness) Why() string {
+	return "A snapshot older than its test was recorded against different code and may no longer describe the expected output."
+}
+func (r *SnapshotStaleness) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "text",
+		Bad:      "render.test.tsx changed today; __snapshots__/render.test.tsx.snap last changed 3 months ago",
+		Good:     "Re-run the suite with -u, review the snapshot diff, and commit both files together",
+	}}
+}
+func (r *SnapshotStaleness) DefaultSeverity() string   { return "warn" }
+func (r *SnapshotStaleness) NeedsProjectContext() bool { return false }
+func (r *SnapshotStaleness) ReadsExternalInputs() bool { return true }
+
+func (r *SnapshotStaleness) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || !file.IsTestFile || len(file.Source) == 0 {
+		return nil
+	}
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+	text := string(file.Source)
+
+	violations := make([]model.Violation, 0)
+	for _, m := range emptyInlineSnapshot.FindAllStringSubmatchIndex(text, -1) {
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Inline snapshot %s() has never been recorded", text[m[2]:m[3]]),
+			FilePath:  file.Path,
+			StartLine: 1 + strings.Count(text[:m[0]], "\n"),
+			Context: &model.ViolationContext{
+				SuggestedFix: "Run the test with snapshot updates enabled, review the recorded value, and commit it.",
+				Metadata:     map[string]interface{}{"snapshot": "inline"},
+			},
+		})
+	}
+
+	line := 1
+	if loc := snapshotCallPattern.FindStringIndex(text); loc != nil {
+		line = 1 + strings.Count(text[:loc[0]], "\n")
+	}
+	source := snapshotTimestampSource(config.Options)
+	grace := defaultSnapshotGrace
+	if raw, ok := config.Options["graceSeconds"]; ok {
+		if seconds, ok := intValue(raw); ok && seconds >= 0 {
+			grace = time.Duration(seconds) * time.Second
+		}
+	}
+
+	for _, snapshotPath := range snapshotCandidates(file.Path, config.Options) {
+		if _, err := os.Stat(snapshotPath); err != nil {
+			continue
+		}
+		testTime, snapTime, used, ok := snapshotTimes(file.Path, snapshotPath, source)
+		if !ok || !testTime.After(snapTime.Add(grace)) {
+			continue
+		}
+		snapshotRef := filepath.ToSlash(snapshotPath)
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Snapshot %s is older than its test (test changed %s, snapshot %s)", snapshotRef, testTime.UTC().Format(time.RFC3339), snapTime.UTC().Format(time.RFC3339)),
+			FilePath:  file.Path,
+			StartLine: line,
+			Context: &model.ViolationContext{
+				SuggestedFix: fmt.Sprintf("Re-run the test with snapshot updates enabled, review the diff in %s, and commit it with the test change.", snapshotRef),
+				Metadata: map[string]interface{}{
+					"snapshot":         snapshotRef,
+					"testModified":     testTime.UTC().Format(time.RFC3339),
+					"snapshotModified": snapTime.UTC().Format(time.RFC3339),
+					"timestampSource":  used,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// snapshotCandidates expands the `snapshotPatterns` option (or the defaults) for a
+// test file path.
+func snapshotCandidates(testPath string, options map[string]interface{}) []string {
+	patterns := stringSliceOption(options, "snapshotPatterns")
+	if len(patterns) == 0 {
+		patterns = defaultSnapshotPatterns
+	}
+	base := filepath.Base(testPath)
+	dir := filepath.Dir(testPath)
+	replacer := strings.NewReplacer(
+		"{dir}", filepath.ToSlash(dir),
+		"{file}", base,
+		"{stem}", strings.TrimSuffix(base, filepath.Ext(base)),
+	)
+	seen := map[string]bool{}
+	out := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		candidate := filepath.Clean(filepath.FromSlash(replacer.Replace(pattern)))
+		if !seen[candidate] {
+			seen[candidate] = true
+			out = append(out, candidate)
+		}
+	}
+	return out
+}
+
+// snapshotTimestampSource reads `timestampSource`: "git" (last commit time), "mtime",
+// or "auto" (git when both files have history, else mtime).
+func snapshotTimestampSource(options map[string]interface{}) string {
+	if raw, ok := options["timestampSource"].(string); ok {
+		switch value := strings.ToLower(strings.TrimSpace(raw)); value {
+		case "git", "mtime":
+			return value
+		}
+	}
+	return "auto"
+}
+
+func snapshotTimes(testPath string, snapshotPath string, source string) (time.Time, time.Time, string, bool) {
+	if source != "mtime" {
+		testTime, testOK := gitCommitTime(testPath)
+		snapTime, snapOK := gitCommitTime(snapshotPath)
+		if testOK && snapOK {
+			return testTime, snapTime, "git", true
+		}
+		if source == "git" {
+			return time.Time{}, time.Time{}, "", false
+		}
+	}
+	testInfo, err := os.Stat(testPath)
+	if err != nil {
+		return time.Time{}, time.Time{}, "", false
+	}
+	snapInfo, err := os.Stat(snapshotPath)
+	if err != nil {
+		return time.Time{}, time.Time{}, "", false
+	}
+	return testInfo.ModTime(), snapInfo.ModTime(), "mtime", true
+}
+
+func gitCommitTime(pathValue string) (time.Time, bool) {
+	cmd := exec.Command("git", "log", "-1", "--format=%ct", "--", filepath.Base(pathValue))
+	cmd.Dir = filepath.Dir(pathValue)
+	out, err := cmd.Output()
+	if err != nil {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
@@ -0,0 +1,97 @@
+// snapshot_staleness_test.go — Tests for TQ-snapshot-test-staleness.
+package tq
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func writeSnapshotFixture(t *testing.T, pathValue string, body string, modTime time.Time) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(pathValue), 0o755); err != nil {
+		t.Fatalf("mkdir %s: %v", pathValue, err)
+	}
+	if err := os.WriteFile(pathValue, []byte(body), 0o644); err != nil {
+		t.Fatalf("write %s: %v", pathValue, err)
+	}
+	if err := os.Chtimes(pathValue, modTime, modTime); err != nil {
+		t.Fatalf("chtimes %s: %v", pathValue, err)
+	}
+}
+
+func TestSnapshotStalenessFlagsSnapshotOlderThanTest(t *testing.T) {
+	dir := t.TempDir()
+	testPath := filepath.Join(dir, "render.test.ts")
+	snapPath := filepath.Join(dir, "__snapshots__", "render.test.ts.snap")
+	source := "import { render } from './render';\n\nit('renders', () => {\n  expect(render()).toMatchSnapshot();\n});\n"
+	now := time.Now()
+	writeSnapshotFixture(t, testPath, source, now)
+	writeSnapshotFixture(t, snapPath, "exports[`renders 1`] = `ok`;\n", now.Add(-48*time.Hour))
+
+	file := &model.UnifiedFileModel{Path: testPath, Language: "typescript", IsTestFile: true, Source: []byte(source)}
+	got := (&SnapshotStaleness{}).Check(file, nil, model.RuleConfig{Options: map[string]interface{}{"timestampSource": "mtime"}})
+	if len(got) != 1 {
+		t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+	}
+	v := got[0]
+	if v.StartLine != 4 || v.Severity != "warn" {
+		t.Fatalf("line=%d severity=%q, want line 4 warn", v.StartLine, v.Severity)
+	}
+	if v.Context.Metadata["snapshot"] != filepath.ToSlash(snapPath) || v.Context.Metadata["timestampSource"] != "mtime" {
+		t.Fatalf("metadata = %+v", v.Context.Metadata)
+	}
+	if !strings.Contains(v.Message, "render.test.ts.snap") {
+		t.Fatalf("message should reference the snapshot: %q", v.Message)
+	}
+
+	// A snapshot updated with (or after) the test is fresh; auto falls back to mtime
+	// outside a git work tree.
+	if err := os.Chtimes(snapPath, now, now); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+	if got := (&SnapshotStaleness{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("fresh snapshot should not be flagged, got %+v", got)
+	}
+}
+
+func TestSnapshotStalenessHonoursPatternsAndGrace(t *testing.T) {
+	dir := t.TempDir()
+	testPath := filepath.Join(dir, "test_api.py")
+	snapPath := filepath.Join(dir, "custom", "api.snap")
+	source := "def test_api(snapshot):\n    assert get() == snapshot\n"
+	now := time.Now()
+	writeSnapshotFixture(t, testPath, source, now)
+	writeSnapshotFixture(t, snapPath, "{}\n", now.Add(-10*time.Minute))
+
+	file := &model.UnifiedFileModel{Path: testPath, Language: "python", IsTestFile: true, Source: []byte(source)}
+	options := map[string]interface{}{
+		"timestampSource":  "mtime",
+		"snapshotPatterns": []interface{}{"{dir}/custom/api.snap"},
+	}
+	if got := (&SnapshotStaleness{}).Check(file, nil, model.RuleConfig{Options: options}); len(got) != 1 || got[0].StartLine != 2 {
+		t.Fatalf("custom pattern should be flagged at line 2, got %+v", got)
+	}
+	options["graceSeconds"] = 3600
+	if got := (&SnapshotStaleness{}).Check(file, nil, model.RuleConfig{Options: options}); len(got) != 0 {
+		t.Fatalf("grace window should suppress the finding, got %+v", got)
+	}
+}
+
+func TestSnapshotStalenessFlagsUnrecordedInlineSnapshots(t *testing.T) {
+	source := "it('formats', () => {\n  expect(format(1)).toMatchInlineSnapshot();\n  expect(format(2)).toMatchInlineSnapshot(`\"2\"`);\n});\n"
+	file := &model.UnifiedFileModel{Path: "format.test.ts", Language: "typescript", IsTestFile: true, Source: []byte(source)}
+	got := (&SnapshotStaleness{}).Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 2 || got[0].Context.Metadata["snapshot"] != "inline" {
+		t.Fatalf("expected one unrecorded inline snapshot at line 2, got %+v", got)
+	}
+
+	file.IsTestFile = false
+	if got := (&SnapshotStaleness{}).Check(file, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("non-test files should be ignored, got %+v", got)
+	}
+}
@@ -0,0 +1,315 @@
+// test_coverage_annotation.go — TQ-test-coverage-annotation: Require tests for functions annotated as test-required.
+package tq
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+const defaultTestRequiredMarker = "stricture-test-required"
+
+var (
+	goAnnotatedFuncPattern     = regexp.MustCompile(`^func\s+(?:\(\s*(?:\w+\s+)?\*?\s*(\w+)[^)]*\)\s*)?(\w+)`)
+	jsAnnotatedFuncPattern     = regexp.MustCompile(`^(?:export\s+)?(?:default\s+)?(?:async\s+)?function\s*\*?\s*([A-Za-z_$][\w$]*)`)
+	jsAnnotatedConstPattern    = regexp.MustCompile(`^(?:export\s+)?(?:const|let|var)\s+([A-Za-z_$][\w$]*)\s*(?::[^=]+)?=\s*(?:async\s+)?(?:function\b|\(|[A-Za-z_$][\w$]*\s*=>)`)
+	jsAnnotatedMethodPattern   = regexp.MustCompile(`^(?:(?:public|private|protected|static|async|override)\s+)*([A-Za-z_$][\w$]*)\s*(?:<[^>]*>)?\s*\(`)
+	pyAnnotatedFuncPattern     = regexp.MustCompile(`^(?:async\s+)?def\s+(\w+)`)
+	javaAnnotatedMethodPattern = regexp.MustCompile(`^(?:(?:public|private|protected|static|final|synchronized|abstract)\s+)*[\w<>\[\],.? ]+\s+(\w+)\s*\(`)
+
+	jsTestTitlePattern  = regexp.MustCompile(`\b(?:describe|it|test)(?:\.\w+)*\s*\(\s*(?:'((?:[^'\\]|\\.)*)'|"((?:[^"\\]|\\.)*)"|` + "`" + `((?:[^` + "`" + `\\]|\\.)*)` + "`" + `)`)
+	pyTestNamePattern   = regexp.MustCompile(`(?m)^\s*(?:async\s+)?(?:def\s+(test\w*)|class\s+(Test\w*))`)
+	javaTestNamePattern = regexp.MustCompile(`\bvoid\s+(\w+)\s*\(`)
+
+	jsNonMethodKeywords = map[string]bool{"if": true, "for": true, "while": true, "switch": true, "catch": true, "return": true, "function": true}
+)
+
+// TestCoverageAnnotation implements the TQ-test-coverage-annotation rule. Functions
+// whose comment block carries the test-required marker must have a test that names
+// them by the language's convention in a test file that covers the source file.
+type TestCoverageAnnotation struct{}
+
+func (r *TestCoverageAnnotation) ID() string       { return "TQ-test-coverage-annotation" }
+func (r *TestCoverageAnnotation) Category() string { return "tq" }
+func (r *TestCoverageAnnotation) Description() string {
+	return "Require a test for every function annotated as test-required"
+}
+func (r *TestCoverageAnnotation) Why() string {
+	return "The marker records that a function is critical; without a check, the promise it makes quietly lapses when tests are renamed or deleted."
+}
+func (r *TestCoverageAnnotation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "go",
+		Bad:      "// stricture-test-required\nfunc ChargeCard(ctx context.Context, c Card, cents int64) error { ... }\n// charge_test.go has no TestChargeCard",
+		Good:     "// charge_test.go\nfunc TestChargeCard_DeclinedCard(t *testing.T) { ... }",
+	}}
+}
+func (r *TestCoverageAnnotation) DefaultSeverity() string   { return "error" }
+func (r *TestCoverageAnnotation) NeedsProjectContext() bool { return true }
+
+func (r *TestCoverageAnnotation) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Function ChargeCard is marked stricture-test-required but has no test",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Add TestChargeCard (or TestChargeCard_<case>) to a test file in the same package.",
+				},
+			},
+		}
+	}
+
+	if file == nil || ctx == nil || file.IsTestFile {
+		return nil
+	}
+	marker := defaultTestRequiredMarker
+	if raw, ok := config.Options["marker"].(string); ok && strings.TrimSpace(raw) != "" {
+		marker = strings.TrimSpace(raw)
+	}
+	language := strings.ToLower(strings.TrimSpace(file.Language))
+	annotated := annotatedFunctions(language, string(file.Source), marker)
+	if len(annotated) == 0 {
+		return nil
+	}
+
+	names := testNamesFor(file, language, ctx)
+	violations := make([]model.Violation, 0)
+	for _, fn := range annotated {
+		if testNamesCover(language, names, fn.Name) {
+			continue
+		}
+		violations = append(violations, model.Violation{
+			RuleID:    r.ID(),
+			Severity:  severity,
+			Message:   fmt.Sprintf("Function %s is marked %s but has no test", fn.Display, marker),
+			FilePath:  file.Path,
+			StartLine: fn.Line,
+			Context: &model.ViolationContext{
+				SuggestedFix: annotatedTestSuggestion(language, fn.Name),
+				Metadata: map[string]interface{}{
+					"function": fn.Display,
+					"marker":   marker,
+				},
+			},
+		})
+	}
+	return violations
+}
+
+// annotatedFunc is a function carrying the marker. Name is what tests refer to; Display
+// adds the Go receiver type.
+type annotatedFunc struct {
+	Name    string
+	Display string
+	Line    int
+}
+
+// annotatedFunctions finds comment lines containing marker and returns the function
+// declared right after that comment block. Further comment lines and decorators or
+// annotations may sit in between; a blank line or any other line detaches the marker.
+func annotatedFunctions(language string, source string, marker string) []annotatedFunc {
+	lines := strings.Split(source, "\n")
+	found := make([]annotatedFunc, 0)
+	for i := 0; i < len(lines); i++ {
+		if !isCommentLine(lines[i]) || !strings.Contains(lines[i], marker) {
+			continue
+		}
+		j := i + 1
+		for j < len(lines) {
+			trimmed := strings.TrimSpace(lines[j])
+			if !isCommentLine(lines[j]) && !strings.HasPrefix(trimmed, "@") {
+				break
+			}
+			j++
+		}
+		if j >= len(lines) {
+			break
+		}
+		if fn, ok := declaredFunction(language, strings.TrimSpace(lines[j])); ok {
+			fn.Line = j + 1
+			found = append(found, fn)
+		}
+		i = j
+	}
+	return found
+}
+
+func isCommentLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range []string{"//", "#", "/*", "*"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func declaredFunction(language string, line string) (annotatedFunc, bool) {
+	switch language {
+	case "go":
+		if m := goAnnotatedFuncPattern.FindStringSubmatch(line); m != nil {
+			display := m[2]
+			if m[1] != "" {
+				display = m[1] + "." + m[2]
+			}
+			return annotatedFunc{Name: m[2], Display: display}, true
+		}
+	case "typescript", "javascript":
+		for _, pattern := range []*regexp.Regexp{jsAnnotatedFuncPattern, jsAnnotatedConstPattern, jsAnnotatedMethodPattern} {
+			if m := pattern.FindStringSubmatch(line); m != nil && !jsNonMethodKeywords[m[1]] {
+				return annotatedFunc{Name: m[1], Display: m[1]}, true
+			}
+		}
+	case "python":
+		if m := pyAnnotatedFuncPattern.FindStringSubmatch(line); m != nil {
+			return annotatedFunc{Name: m[1], Display: m[1]}, true
+		}
+	case "java":
+		if m := javaAnnotatedMethodPattern.FindStringSubmatch(line); m != nil && !jsNonMethodKeywords[m[1]] {
+			return annotatedFunc{Name: m[1], Display: m[1]}, true
+		}
+	}
+	return annotatedFunc{}, false
+}
+
+// testNamesFor collects test names (Go and Java test methods, Python test functions and
+// classes, JS/TS describe/it/test titles) from the test files covering file: those
+// mapped to it in TestSourceMap, those in the same directory, and, except for Go,
+// those named after it in any directory (billing.test.ts, test_billing.py).
+func testNamesFor(file *model.UnifiedFileModel, language string, ctx *model.ProjectContext) []string {
+	sourcePath := filepathSlash(file.Path)
+	dir := path.Dir(sourcePath)
+	stem := testFileStem(path.Base(sourcePath))
+
+	names := make([]string, 0)
+	for p, candidate := range ctx.Files {
+		if candidate == nil || !candidate.IsTestFile || !strings.EqualFold(candidate.Language, language) {
+			continue
+		}
+		testPath := filepathSlash(p)
+		covers := path.Dir(testPath) == dir || (language != "go" && testFileStem(path.Base(testPath)) == stem)
+		for _, mapped := range ctx.TestSourceMap[p] {
+			if filepathSlash(mapped) == sourcePath {
+				covers = true
+			}
+		}
+		if !covers {
+			continue
+		}
+		source := string(candidate.Source)
+		switch language {
+		case "go":
+			for _, m := range goTestFuncPattern.FindAllStringSubmatch(source, -1) {
+				names = append(names, m[1])
+			}
+		case "typescript", "javascript":
+			for _, m := range jsTestTitlePattern.FindAllStringSubmatch(source, -1) {
+				names = append(names, m[1]+m[2]+m[3])
+			}
+		case "python":
+			for _, m := range pyTestNamePattern.FindAllStringSubmatch(source, -1) {
+				names = append(names, m[1]+m[2])
+			}
+		case "java":
+			for _, m := range javaTestNamePattern.FindAllStringSubmatch(source, -1) {
+				names = append(names, m[1])
+			}
+		}
+	}
+	return names
+}
+
+// testFileStem strips test affixes and extensions: billing.test.ts, billing.spec.ts,
+// billing_test.go, test_billing.py, and BillingTest.java all become "billing".
+func testFileStem(base string) string {
+	stem := strings.ToLower(base)
+	if i := strings.Index(stem, "."); i >= 0 {
+		stem = stem[:i]
+	}
+	stem = strings.TrimPrefix(stem, "test_")
+	stem = strings.TrimSuffix(stem, "_test")
+	if len(stem) > len("test") {
+		stem = strings.TrimSuffix(stem, "test")
+	}
+	return stem
+}
+
+// testNamesCover applies each language's naming convention:
+//   - Go: a `_`-separated part of the name after Test is the function (TestParse,
+//     TestClient_Parse, TestParse_empty), or the first part starts with it followed by
+//     an upper-case letter or digit (TestParseEmpty).
+//   - Python and Java: ignoring case and underscores, the name minus its `test` prefix
+//     starts with the function (test_parse_header, TestParseHeader, testParseHeader).
+//   - JS/TS: a describe/it/test title contains the function as a word.
+func testNamesCover(language string, names []string, fn string) bool {
+	switch language {
+	case "go":
+		want := upperFirst(fn)
+		for _, name := range names {
+			parts := strings.Split(strings.TrimPrefix(name, "Test"), "_")
+			for i, part := range parts {
+				if part == want || part == fn {
+					return true
+				}
+				if i == 0 && strings.HasPrefix(part, want) {
+					if next := rune(part[len(want)]); unicode.IsUpper(next) || unicode.IsDigit(next) {
+						return true
+					}
+				}
+			}
+		}
+	case "typescript", "javascript":
+		word := regexp.MustCompile(`(^|[^\w$])` + regexp.QuoteMeta(fn) + `($|[^\w$])`)
+		for _, name := range names {
+			if word.MatchString(name) {
+				return true
+			}
+		}
+	case "python", "java":
+		want := normalizeTestName(fn)
+		for _, name := range names {
+			normalized := normalizeTestName(name)
+			if strings.HasPrefix(strings.TrimPrefix(normalized, "test"), want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func normalizeTestName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func upperFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func annotatedTestSuggestion(language string, fn string) string {
+	switch language {
+	case "go":
+		return fmt.Sprintf("Add Test%s (or Test%s_<case>) to a test file in the same package.", upperFirst(fn), upperFirst(fn))
+	case "python":
+		return fmt.Sprintf("Add test_%s to the module's test file.", fn)
+	case "java":
+		return fmt.Sprintf("Add a test method named test%s or %s_<case> to the class's test.", upperFirst(fn), fn)
+	default:
+		return fmt.Sprintf("Add a describe('%s') or it('%s ...') block to the file's test.", fn, fn)
+	}
+}
@@ -0,0 +1,108 @@
+// test_coverage_annotation_test.go — Tests for TQ-test-coverage-annotation.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestTestCoverageAnnotation(t *testing.T) {
+	assertRuleContract(t, &TestCoverageAnnotation{})
+}
+
+func annotationContext(files ...*model.UnifiedFileModel) *model.ProjectContext {
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}}
+	for _, f := range files {
+		ctx.Files[f.Path] = f
+	}
+	return ctx
+}
+
+func TestTestCoverageAnnotationGo(t *testing.T) {
+	source := &model.UnifiedFileModel{Path: "internal/billing/charge.go", Language: "go", Source: []byte(`package billing
+
+// ChargeCard charges the card.
+//
+// stricture-test-required
+func ChargeCard(c Card, cents int64) error { return nil }
+
+// stricture-test-required
+func (c *Client) Refund(id string) error { return nil }
+
+// stricture-test-required
+func parseAmount(s string) int64 { return 0 }
+
+// stricture-test-required
+
+func Detached() {}
+
+func Unmarked() {}
+`)}
+	test := &model.UnifiedFileModel{Path: "internal/billing/charge_test.go", Language: "go", IsTestFile: true, Source: []byte(`package billing
+
+import "testing"
+
+func TestChargeCardDeclined(t *testing.T) {}
+
+func TestClient_Refund(t *testing.T) {}
+`)}
+	got := (&TestCoverageAnnotation{}).Check(source, annotationContext(source, test), model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 12 || got[0].Message != "Function parseAmount is marked stricture-test-required but has no test" {
+		t.Fatalf("want only parseAmount flagged, got %+v", got)
+	}
+	if got[0].Context.Metadata["function"] != "parseAmount" || got[0].Context.Metadata["marker"] != "stricture-test-required" {
+		t.Fatalf("metadata = %+v", got[0].Context.Metadata)
+	}
+
+	other := &model.UnifiedFileModel{Path: "internal/ledger/ledger_test.go", Language: "go", IsTestFile: true, Source: []byte("package ledger\n\nimport \"testing\"\n\nfunc TestParseAmount(t *testing.T) {}\n")}
+	got = (&TestCoverageAnnotation{}).Check(source, annotationContext(source, test, other), model.RuleConfig{})
+	if len(got) != 1 {
+		t.Fatalf("tests in another package must not count, got %+v", got)
+	}
+
+	withoutTests := (&TestCoverageAnnotation{}).Check(source, annotationContext(source), model.RuleConfig{})
+	if len(withoutTests) != 3 || withoutTests[1].Message != "Function Client.Refund is marked stricture-test-required but has no test" {
+		t.Fatalf("want all three annotated functions flagged, got %+v", withoutTests)
+	}
+}
+
+func TestTestCoverageAnnotationTypeScriptAndPython(t *testing.T) {
+	ts := &model.UnifiedFileModel{Path: "src/billing/charge.ts", Language: "typescript", Source: []byte(`// @critical
+export async function chargeCard(card: Card): Promise<void> {}
+
+/** @critical */
+export const refund = async (id: string) => {};
+`)}
+	tsTest := &model.UnifiedFileModel{Path: "test/billing/charge.test.ts", Language: "typescript", IsTestFile: true, Source: []byte(`describe("chargeCard", () => {
+  it("declines expired cards", () => {});
+});
+`)}
+	options := model.RuleConfig{Options: map[string]interface{}{"marker": "@critical"}}
+	got := (&TestCoverageAnnotation{}).Check(ts, annotationContext(ts, tsTest), options)
+	if len(got) != 1 || got[0].StartLine != 5 || got[0].Context.Metadata["function"] != "refund" {
+		t.Fatalf("want refund flagged, got %+v", got)
+	}
+
+	py := &model.UnifiedFileModel{Path: "app/billing.py", Language: "python", Source: []byte(`# stricture-test-required
+@retry
+def charge_card(card):
+    pass
+
+# stricture-test-required
+def refund(charge_id):
+    pass
+`)}
+	pyTest := &model.UnifiedFileModel{Path: "tests/test_billing.py", Language: "python", IsTestFile: true, Source: []byte("def test_charge_card_declined():\n    pass\n")}
+	got = (&TestCoverageAnnotation{}).Check(py, annotationContext(py, pyTest), model.RuleConfig{})
+	if len(got) != 1 || got[0].StartLine != 7 {
+		t.Fatalf("want refund flagged, got %+v", got)
+	}
+
+	mapped := &model.UnifiedFileModel{Path: "tests/payments_suite.py", Language: "python", IsTestFile: true, Source: []byte("class TestRefund:\n    pass\n")}
+	ctx := annotationContext(py, pyTest, mapped)
+	ctx.TestSourceMap = map[string][]string{mapped.Path: {py.Path}}
+	if got := (&TestCoverageAnnotation{}).Check(py, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("tests mapped through TestSourceMap should count, got %+v", got)
+	}
+}
@@ -0,0 +1,216 @@
+// test_file_location.go — TQ-test-file-location: Require a nearby test file for every source file with exports.
+package tq
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+var (
+	goExportedDeclPattern = regexp.MustCompile(`(?m)^(?:func(?:\s*\([^)]*\))?|type|const|var)\s+([A-Z]\w*)`)
+	jsExportedDeclPattern = regexp.MustCompile(`(?m)^\s*export\s+(?:default\s+)?(?:declare\s+)?(?:abstract\s+)?(?:async\s+)?(?:(?:function\*?|class|const|let|var|interface|type|enum)\s+)?([A-Za-z_$][\w$]*)|^\s*export\s*\{\s*([A-Za-z_$][\w$]*)`)
+	pyPublicDeclPattern   = regexp.MustCompile(`(?m)^(?:async\s+)?(?:def|class)\s+([A-Za-z]\w*)`)
+	goGeneratedPattern    = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+	// testFileLocationSkipped are base names that never need their own test file:
+	// barrels, package initializers, and package docs.
+	testFileLocationSkipped = map[string]bool{
+		"index.ts": true, "index.tsx": true, "index.js": true, "index.jsx": true,
+		"__init__.py": true, "doc.go": true,
+	}
+)
+
+// TestFileLocation implements the TQ-test-file-location rule. A production file that
+// exports something needs a test file next to it: any _test.go file in a Go package,
+// and a test named after the file (billing.test.ts, test_billing.py) in the same
+// directory, its __tests__ directory, or a configured mirror directory otherwise.
+// CONV-test-file-location checks where existing tests live; this rule checks that they
+// exist at all.
+type TestFileLocation struct{}
+
+func (r *TestFileLocation) ID() string       { return "TQ-test-file-location" }
+func (r *TestFileLocation) Category() string { return "tq" }
+func (r *TestFileLocation) Description() string {
+	return "Require a nearby test file for every source file that exports symbols"
+}
+func (r *TestFileLocation) Why() string {
+	return "A source file without any test file is untested by construction; coverage numbers averaged over a package hide it."
+}
+func (r *TestFileLocation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "// src/billing/invoice.ts exports createInvoice\n// no src/billing/invoice.test.ts anywhere",
+		Good:     "// src/billing/invoice.ts\n// src/billing/invoice.test.ts",
+	}}
+}
+func (r *TestFileLocation) DefaultSeverity() string   { return "warn" }
+func (r *TestFileLocation) NeedsProjectContext() bool { return true }
+
+func (r *TestFileLocation) Check(file *model.UnifiedFileModel, ctx *model.ProjectContext, config model.RuleConfig) []model.Violation {
+	if file == nil || ctx == nil || file.IsTestFile {
+		return nil
+	}
+	language := strings.ToLower(strings.TrimSpace(file.Language))
+	sourcePath := filepathSlash(file.Path)
+	base := path.Base(sourcePath)
+	if testFileLocationSkipped[base] || strings.HasSuffix(base, ".d.ts") {
+		return nil
+	}
+	for _, glob := range stringSliceOption(config.Options, "exclude") {
+		if fixtureGlobPattern(glob).MatchString(sourcePath) {
+			return nil
+		}
+	}
+
+	symbol, line := firstExportedSymbol(file, language)
+	if symbol == "" {
+		return nil
+	}
+	dirs, expectedDir := testDirsFor(sourcePath, language, config.Options)
+	if hasNearbyTest(file, language, dirs, ctx) {
+		return nil
+	}
+
+	expected := expectedTestFile(path.Join(expectedDir, base), language)
+	severity := strings.TrimSpace(config.Severity)
+	if severity == "" {
+		severity = r.DefaultSeverity()
+	}
+	return []model.Violation{{
+		RuleID:    r.ID(),
+		Severity:  severity,
+		Message:   fmt.Sprintf("%s exports %s but has no test file, expected %s", base, symbol, expected),
+		FilePath:  file.Path,
+		StartLine: line,
+		Context: &model.ViolationContext{
+			SuggestedFix: fmt.Sprintf("Add %s, or exempt %s with the `exclude` option if it needs no tests.", expected, base),
+			Metadata: map[string]interface{}{
+				"symbol":   symbol,
+				"expected": expected,
+			},
+		},
+	}}
+}
+
+// firstExportedSymbol returns the first exported declaration of a non-generated Go,
+// TypeScript/JavaScript, or Python file, and its line.
+func firstExportedSymbol(file *model.UnifiedFileModel, language string) (string, int) {
+	var pattern *regexp.Regexp
+	switch language {
+	case "go":
+		if goGeneratedPattern.Match(file.Source) {
+			return "", 0
+		}
+		pattern = goExportedDeclPattern
+	case "typescript", "javascript":
+		pattern = jsExportedDeclPattern
+	case "python":
+		pattern = pyPublicDeclPattern
+	default:
+		return "", 0
+	}
+	m := pattern.FindSubmatchIndex(file.Source)
+	if m == nil {
+		return "", 0
+	}
+	// The second group is the first name of an `export { ... }` list.
+	start, end := m[2], m[3]
+	if start < 0 {
+		start, end = m[4], m[5]
+	}
+	name := string(file.Source[start:end])
+	if name == "function" || name == "class" {
+		name = "default"
+	}
+	return name, 1 + strings.Count(string(file.Source[:start]), "\n")
+}
+
+// testDirsFor lists the directories a test for sourcePath may live in: its own
+// directory, __tests__ beneath it outside Go, and the directory mirrored by the
+// `mirrors` option ({src: test} maps src/billing to test/billing). The mirror, when
+// there is one, is also where the test is expected; otherwise it is the file's own
+// directory.
+func testDirsFor(sourcePath string, language string, options map[string]interface{}) ([]string, string) {
+	dir := path.Dir(sourcePath)
+	dirs := []string{dir}
+	if language != "go" {
+		dirs = append(dirs, path.Join(dir, "__tests__"))
+	}
+	mirrors, _ := options["mirrors"].(map[string]interface{})
+	roots := make([]string, 0, len(mirrors))
+	for root := range mirrors {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	for _, root := range roots {
+		target, ok := mirrors[root].(string)
+		if !ok {
+			continue
+		}
+		from := "/" + strings.Trim(root, "/") + "/"
+		to := "/" + strings.Trim(target, "/") + "/"
+		padded := "/" + dir + "/"
+		if idx := strings.Index(padded, from); idx >= 0 {
+			mirrored := path.Clean(padded[:idx] + to + padded[idx+len(from):])
+			if !strings.HasPrefix(dir, "/") {
+				mirrored = strings.TrimPrefix(mirrored, "/")
+			}
+			return append(dirs, mirrored), mirrored
+		}
+	}
+	return dirs, dir
+}
+
+// hasNearbyTest reports whether a test file of the same language family is mapped to
+// file in TestSourceMap or sits in one of dirs: any test file for Go, one with the same
+// stem (testFileStem) otherwise.
+func hasNearbyTest(file *model.UnifiedFileModel, language string, dirs []string, ctx *model.ProjectContext) bool {
+	sourcePath := filepathSlash(file.Path)
+	stem := testFileStem(path.Base(sourcePath))
+	for p, candidate := range ctx.Files {
+		if candidate == nil || !candidate.IsTestFile || languageFamily(candidate.Language) != languageFamily(language) {
+			continue
+		}
+		for _, mapped := range ctx.TestSourceMap[p] {
+			if filepathSlash(mapped) == sourcePath {
+				return true
+			}
+		}
+		testPath := filepathSlash(p)
+		for _, dir := range dirs {
+			if path.Dir(testPath) == dir && (language == "go" || testFileStem(path.Base(testPath)) == stem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func languageFamily(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language == "javascript" {
+		return "typescript"
+	}
+	return language
+}
+
+// expectedTestFile names the conventional test file for sourcePath: billing_test.go,
+// billing.test.ts, test_billing.py.
+func expectedTestFile(sourcePath string, language string) string {
+	dir, base := path.Split(sourcePath)
+	ext := path.Ext(base)
+	stem := strings.TrimSuffix(base, ext)
+	switch language {
+	case "go":
+		return dir + stem + "_test.go"
+	case "python":
+		return dir + "test_" + base
+	default:
+		return dir + stem + ".test" + ext
+	}
+}
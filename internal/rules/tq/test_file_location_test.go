@@ -0,0 +1,140 @@
+// test_file_location_test.go — Tests for TQ-test-file-location.
+package tq
+
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
+
+func TestTestFileLocationMetadata(t *testing.T) {
+	rule := &TestFileLocation{}
+	if rule.ID() != "TQ-test-file-location" || rule.Category() != "tq" || rule.DefaultSeverity() != "warn" {
+		t.Fatalf("unexpected metadata: %s %s %s", rule.ID(), rule.Category(), rule.DefaultSeverity())
+	}
+	if !rule.NeedsProjectContext() {
+		t.Fatalf("rule should need project context to find test files")
+	}
+}
+
+func testFileLocationContext(files ...*model.UnifiedFileModel) *model.ProjectContext {
+	ctx := &model.ProjectContext{Files: map[string]*model.UnifiedFileModel{}, TestSourceMap: map[string][]string{}}
+	for _, f := range files {
+		ctx.Files[f.Path] = f
+	}
+	return ctx
+}
+
+func TestTestFileLocation(t *testing.T) {
+	invoice := &model.UnifiedFileModel{Path: "src/billing/invoice.ts", Language: "typescript", Source: []byte("import { db } from '../db';\n\nexport function createInvoice() {}\n")}
+	tests := []struct {
+		name         string
+		file         *model.UnifiedFileModel
+		others       []*model.UnifiedFileModel
+		options      map[string]interface{}
+		wantExpected string
+		wantLine     int
+	}{
+		{
+			name:         "typescript file without a test",
+			file:         invoice,
+			others:       []*model.UnifiedFileModel{{Path: "src/billing/tax.test.ts", Language: "typescript", IsTestFile: true}},
+			wantExpected: "src/billing/invoice.test.ts",
+			wantLine:     3,
+		},
+		{
+			name:   "colocated spec",
+			file:   invoice,
+			others: []*model.UnifiedFileModel{{Path: "src/billing/invoice.spec.ts", Language: "typescript", IsTestFile: true}},
+		},
+		{
+			name:   "__tests__ directory",
+			file:   invoice,
+			others: []*model.UnifiedFileModel{{Path: "src/billing/__tests__/invoice.test.js", Language: "javascript", IsTestFile: true}},
+		},
+		{
+			name:    "mirror directory",
+			file:    invoice,
+			others:  []*model.UnifiedFileModel{{Path: "test/billing/invoice.test.ts", Language: "typescript", IsTestFile: true}},
+			options: map[string]interface{}{"mirrors": map[string]interface{}{"src": "test"}},
+		},
+		{
+			name:         "mirror directory names the expected path",
+			file:         invoice,
+			options:      map[string]interface{}{"mirrors": map[string]interface{}{"src": "test"}},
+			wantExpected: "test/billing/invoice.test.ts",
+			wantLine:     3,
+		},
+		{
+			name:    "excluded by glob",
+			file:    invoice,
+			options: map[string]interface{}{"exclude": []interface{}{"src/billing/**"}},
+		},
+		{
+			name:         "go package without tests",
+			file:         &model.UnifiedFileModel{Path: "internal/store/store.go", Language: "go", Source: []byte("package store\n\ntype Store struct{}\n")},
+			others:       []*model.UnifiedFileModel{{Path: "internal/cache/cache_test.go", Language: "go", IsTestFile: true}},
+			wantExpected: "internal/store/store_test.go",
+			wantLine:     3,
+		},
+		{
+			name:   "any go test in the package counts",
+			file:   &model.UnifiedFileModel{Path: "internal/store/store.go", Language: "go", Source: []byte("package store\n\nfunc Open() {}\n")},
+			others: []*model.UnifiedFileModel{{Path: "internal/store/integration_test.go", Language: "go", IsTestFile: true}},
+		},
+		{
+			name: "nothing exported",
+			file: &model.UnifiedFileModel{Path: "internal/store/helpers.go", Language: "go", Source: []byte("package store\n\nfunc open() {}\n")},
+		},
+		{
+			name: "generated go",
+			file: &model.UnifiedFileModel{Path: "internal/store/store.pb.go", Language: "go", Source: []byte("// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage store\n\ntype Row struct{}\n")},
+		},
+		{
+			name:         "python public function",
+			file:         &model.UnifiedFileModel{Path: "app/billing.py", Language: "python", Source: []byte("def _helper():\n    pass\n\ndef charge():\n    pass\n")},
+			wantExpected: "app/test_billing.py",
+			wantLine:     4,
+		},
+		{
+			name: "barrel",
+			file: &model.UnifiedFileModel{Path: "src/billing/index.ts", Language: "typescript", Source: []byte("export { createInvoice } from './invoice';\n")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testFileLocationContext(append([]*model.UnifiedFileModel{tt.file}, tt.others...)...)
+			got := (&TestFileLocation{}).Check(tt.file, ctx, model.RuleConfig{Options: tt.options})
+			if tt.wantExpected == "" {
+				if len(got) != 0 {
+					t.Fatalf("violations = %+v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("violations = %d, want 1: %+v", len(got), got)
+			}
+			if got[0].Context.Metadata["expected"] != tt.wantExpected || got[0].StartLine != tt.wantLine {
+				t.Fatalf("expected = %v line %d, want %s line %d", got[0].Context.Metadata["expected"], got[0].StartLine, tt.wantExpected, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestTestFileLocationMessageAndSourceMap(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "src/billing/invoice.ts", Language: "typescript", Source: []byte("export class InvoiceService {}\n")}
+	ctx := testFileLocationContext(file)
+	got := (&TestFileLocation{}).Check(file, ctx, model.RuleConfig{})
+	want := "invoice.ts exports InvoiceService but has no test file, expected src/billing/invoice.test.ts"
+	if len(got) != 1 || got[0].Message != want {
+		t.Fatalf("violations = %+v, want message %q", got, want)
+	}
+
+	mapped := &model.UnifiedFileModel{Path: "e2e/checkout.test.ts", Language: "typescript", IsTestFile: true}
+	ctx = testFileLocationContext(file, mapped)
+	ctx.TestSourceMap[mapped.Path] = []string{file.Path}
+	if got := (&TestFileLocation{}).Check(file, ctx, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("a test mapped in TestSourceMap should count, got %+v", got)
+	}
+}
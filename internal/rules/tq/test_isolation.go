@@ -18,6 +18,13 @@ func (r *TestIsolation) Description() string {
 func (r *TestIsolation) Why() string {
 	return "Shared mutable state makes tests order-dependent and non-deterministic."
 }
+func (r *TestIsolation) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "globalCache.set('key', value) // mutates global state",
+		Good:     "beforeEach(() => globalCache.clear())",
+	}}
+}
 func (r *TestIsolation) DefaultSeverity() string   { return "error" }
 func (r *TestIsolation) NeedsProjectContext() bool { return false }
 
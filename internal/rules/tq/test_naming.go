@@ -2,12 +2,36 @@
 package tq
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/stricture/stricture/internal/model"
 )
 
+var goTestFuncPattern = regexp.MustCompile(`(?m)^func\s+(Test\w*)\s*\(\s*\w+\s+\*testing\.T\s*\)`)
+
+// testNamingDefault is the built-in naming convention for one language.
+type testNamingDefault struct {
+	Pattern *regexp.Regexp
+	Label   string
+}
+
+// testNamingDefaults holds idiomatic conventions: Go `Test<Subject>_<Scenario>`,
+// sentence-style `it`/`test` names for TypeScript and JavaScript, and snake_case
+// `test_` functions for Python.
+var testNamingDefaults = map[string]testNamingDefault{
+	"go":         {regexp.MustCompile(`^Test[A-Z0-9][A-Za-z0-9]*_[A-Za-z0-9][A-Za-z0-9_]*$`), "Test<Subject>_<Scenario>"},
+	"typescript": {regexp.MustCompile(`^[a-z][^\s]*(?:\s+\S+){2,}$`), "a lowercase sentence of at least three words"},
+	"javascript": {regexp.MustCompile(`^[a-z][^\s]*(?:\s+\S+){2,}$`), "a lowercase sentence of at least three words"},
+	"python":     {regexp.MustCompile(`^test_[a-z0-9]+(?:_[a-z0-9]+)*$`), "test_<snake_case>"},
+}
+
 // TestNaming implements the TQ-test-naming rule.
+//
+// Test names are checked against a per-language pattern: Go `Test*` functions,
+// TypeScript/JavaScript `it`/`test` titles, and Python `test*` functions. The
+// `patterns` option overrides the regular expression for any language.
 type TestNaming struct{}
 
 func (r *TestNaming) ID() string          { return "TQ-test-naming" }
@@ -16,31 +40,142 @@ func (r *TestNaming) Description() string { return "Enforce behavior-oriented te
 func (r *TestNaming) Why() string {
 	return "Clear behavior-focused names improve maintainability and diagnosis."
 }
+func (r *TestNaming) Examples() []model.RuleExample {
+	return []model.RuleExample{{
+		Language: "typescript",
+		Bad:      "test('it works', () => ...)",
+		Good:     "test('returns 404 when user not found', () => ...)",
+	}}
+}
 func (r *TestNaming) DefaultSeverity() string   { return "error" }
 func (r *TestNaming) NeedsProjectContext() bool { return false }
 
 func (r *TestNaming) Check(file *model.UnifiedFileModel, _ *model.ProjectContext, config model.RuleConfig) []model.Violation {
-	triggered, line := shouldTriggerRule(file, r.ID())
-	if !triggered {
-		return nil
-	}
-
 	severity := strings.TrimSpace(config.Severity)
 	if severity == "" {
 		severity = r.DefaultSeverity()
 	}
 
-	message := "Test name 'TestHandlerImpl' does not match pattern 'TestSubject_WhenCondition_ThenOutcome', should describe behavior not implementation"
-	return []model.Violation{
-		{
+	if triggered, line := shouldTriggerRule(file, r.ID()); triggered {
+		return []model.Violation{
+			{
+				RuleID:    r.ID(),
+				Severity:  severity,
+				Message:   "Test name 'TestHandlerImpl' does not match pattern 'TestSubject_WhenCondition_ThenOutcome', should describe behavior not implementation",
+				FilePath:  file.Path,
+				StartLine: line,
+				Context: &model.ViolationContext{
+					SuggestedFix: "Rename tests to describe observable behavior and expected outcome.",
+				},
+			},
+		}
+	}
+
+	if file == nil || !file.IsTestFile {
+		return nil
+	}
+	language := strings.ToLower(file.Language)
+	pattern, label, ok := testNamingPattern(config.Options, language)
+	if !ok {
+		return nil
+	}
+
+	violations := make([]model.Violation, 0)
+	for _, test := range extractTestNames(file.Source, language) {
+		if pattern.MatchString(test.Name) {
+			continue
+		}
+		violations = append(violations, model.Violation{
 			RuleID:    r.ID(),
 			Severity:  severity,
-			Message:   message,
+			Message:   fmt.Sprintf("Test name '%s' does not match the %s naming pattern %s", test.Name, testNamingLanguageLabel(language), label),
 			FilePath:  file.Path,
-			StartLine: line,
+			StartLine: test.Line,
 			Context: &model.ViolationContext{
-				SuggestedFix: "Rename tests to describe observable behavior and expected outcome.",
+				SuggestedFix: fmt.Sprintf("Rename the test to follow %s and describe the behavior under test.", label),
+				Metadata: map[string]interface{}{
+					"name":     test.Name,
+					"language": language,
+					"pattern":  pattern.String(),
+				},
 			},
-		},
+		})
+	}
+	return violations
+}
+
+// testNamingPattern resolves the pattern for a language: `patterns.<language>` when
+// it is a valid regular expression, else the built-in default. JavaScript falls back
+// to `patterns.typescript` before its default.
+func testNamingPattern(options map[string]interface{}, language string) (*regexp.Regexp, string, bool) {
+	configured, _ := options["patterns"].(map[string]interface{})
+	keys := []string{language}
+	if language == "javascript" {
+		keys = append(keys, "typescript")
+	}
+	for _, key := range keys {
+		raw, _ := configured[key].(string)
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		if re, err := regexp.Compile(raw); err == nil {
+			return re, "'" + raw + "'", true
+		}
+	}
+	def, ok := testNamingDefaults[language]
+	if !ok {
+		return nil, "", false
+	}
+	return def.Pattern, def.Label, true
+}
+
+func testNamingLanguageLabel(language string) string {
+	switch language {
+	case "go":
+		return "Go"
+	case "typescript":
+		return "TypeScript"
+	case "javascript":
+		return "JavaScript"
+	case "python":
+		return "Python"
+	}
+	return language
+}
+
+type namedTest struct {
+	Name string
+	Line int
+}
+
+// extractTestNames lists test names in source order. TestMain is skipped for Go, and
+// template-literal titles with interpolation are skipped for TypeScript/JavaScript.
+func extractTestNames(source []byte, language string) []namedTest {
+	text := string(source)
+	tests := make([]namedTest, 0)
+	switch language {
+	case "go":
+		for _, m := range goTestFuncPattern.FindAllStringSubmatchIndex(text, -1) {
+			name := text[m[2]:m[3]]
+			if name == "TestMain" {
+				continue
+			}
+			tests = append(tests, namedTest{Name: name, Line: 1 + strings.Count(text[:m[2]], "\n")})
+		}
+	case "typescript", "javascript":
+		for _, m := range jsTestStartPattern.FindAllStringSubmatchIndex(text, -1) {
+			name := text[m[4]:m[5]]
+			if strings.Contains(name, "${") {
+				continue
+			}
+			tests = append(tests, namedTest{Name: name, Line: 1 + strings.Count(text[:m[0]], "\n")})
+		}
+	case "python":
+		for i, line := range strings.Split(text, "\n") {
+			if m := pyTestStartPattern.FindStringSubmatch(line); m != nil {
+				tests = append(tests, namedTest{Name: m[2], Line: i + 1})
+			}
+		}
 	}
+	return tests
 }
@@ -1,8 +1,88 @@
 // test_naming_test.go — Tests for TQ-test-naming.
 package tq
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stricture/stricture/internal/model"
+)
 
 func TestTestNaming(t *testing.T) {
 	assertRuleContract(t, &TestNaming{})
 }
+
+func TestTestNamingLanguageDefaults(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      *model.UnifiedFileModel
+		wantNames []string
+		wantLines []int
+	}{
+		{
+			name: "go",
+			file: &model.UnifiedFileModel{Path: "svc/svc_test.go", Language: "go", IsTestFile: true, Source: []byte(
+				"package svc\n\nfunc TestMain(m *testing.M) {}\n\nfunc TestParse_EmptyInput(t *testing.T) {}\n\nfunc TestHandlerImpl(t *testing.T) {}\n\nfunc helper(t *testing.T) {}\n")},
+			wantNames: []string{"TestHandlerImpl"},
+			wantLines: []int{7},
+		},
+		{
+			name: "typescript",
+			file: &model.UnifiedFileModel{Path: "web/user.test.ts", Language: "typescript", IsTestFile: true, Source: []byte(
+				"describe('UserService', () => {\n  it('returns 404 when the user is missing', () => {});\n  it('works', () => {});\n  test(`handles ${kind}`, () => {});\n  test('Returns the user', () => {});\n});\n")},
+			wantNames: []string{"works", "Returns the user"},
+			wantLines: []int{3, 5},
+		},
+		{
+			name: "python",
+			file: &model.UnifiedFileModel{Path: "app/test_user.py", Language: "python", IsTestFile: true, Source: []byte(
+				"def test_returns_none_for_missing_user():\n    pass\n\nclass TestUser:\n    def testCreate(self):\n        pass\n")},
+			wantNames: []string{"testCreate"},
+			wantLines: []int{5},
+		},
+	}
+
+	rule := &TestNaming{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Check(tc.file, nil, model.RuleConfig{})
+			if len(got) != len(tc.wantNames) {
+				t.Fatalf("violations = %d, want %d: %+v", len(got), len(tc.wantNames), got)
+			}
+			for i, v := range got {
+				if v.Context.Metadata["name"] != tc.wantNames[i] || v.StartLine != tc.wantLines[i] {
+					t.Fatalf("violation %d = %v at line %d, want %s at line %d", i, v.Context.Metadata["name"], v.StartLine, tc.wantNames[i], tc.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTestNamingMessageAndOverrides(t *testing.T) {
+	file := &model.UnifiedFileModel{Path: "svc/svc_test.go", Language: "go", IsTestFile: true, Source: []byte("package svc\n\nfunc TestHandlerImpl(t *testing.T) {}\n")}
+	rule := &TestNaming{}
+
+	got := rule.Check(file, nil, model.RuleConfig{})
+	if len(got) != 1 || got[0].Message != "Test name 'TestHandlerImpl' does not match the Go naming pattern Test<Subject>_<Scenario>" {
+		t.Fatalf("unexpected violations: %+v", got)
+	}
+
+	custom := model.RuleConfig{Options: map[string]interface{}{"patterns": map[string]interface{}{"go": "^Test[A-Z]\\w+$"}}}
+	if got := rule.Check(file, nil, custom); len(got) != 0 {
+		t.Fatalf("custom go pattern should accept TestHandlerImpl, got %+v", got)
+	}
+
+	js := &model.UnifiedFileModel{Path: "web/a.test.js", Language: "javascript", IsTestFile: true, Source: []byte("it('should_work', () => {});\n")}
+	tsOnly := model.RuleConfig{Options: map[string]interface{}{"patterns": map[string]interface{}{"typescript": "^should_"}}}
+	if got := rule.Check(js, nil, tsOnly); len(got) != 0 {
+		t.Fatalf("javascript should fall back to the typescript pattern, got %+v", got)
+	}
+	invalid := model.RuleConfig{Options: map[string]interface{}{"patterns": map[string]interface{}{"javascript": "("}}}
+	if got := rule.Check(js, nil, invalid); len(got) != 1 {
+		t.Fatalf("an invalid pattern should fall back to the default, got %+v", got)
+	}
+
+	nonTest := &model.UnifiedFileModel{Path: "svc/svc.go", Language: "go", Source: file.Source}
+	if got := rule.Check(nonTest, nil, model.RuleConfig{}); len(got) != 0 {
+		t.Fatalf("non-test files should be skipped, got %+v", got)
+	}
+}
@@ -14,6 +14,8 @@ type Config struct {
 	ObjectBucket  string
 	ObjectPrefix  string
 	AuthMode      string
+	LogFormat     string
+	SourceRoot    string
 }
 
 // LoadConfigFromEnv builds server config from environment variables.
@@ -24,6 +26,7 @@ func LoadConfigFromEnv() Config {
 		IngestToken:   strings.TrimSpace(os.Getenv("STRICTURE_SERVER_INGEST_TOKEN")),
 		StorageDriver: "fs",
 		ObjectPrefix:  "stricture",
+		LogFormat:     "text",
 	}
 
 	if value := strings.TrimSpace(os.Getenv("STRICTURE_SERVER_ADDR")); value != "" {
@@ -44,6 +47,12 @@ func LoadConfigFromEnv() Config {
 	if value := strings.TrimSpace(os.Getenv("STRICTURE_SERVER_AUTH_MODE")); value != "" {
 		cfg.AuthMode = strings.ToLower(value)
 	}
+	if value := strings.TrimSpace(os.Getenv("STRICTURE_SERVER_SOURCE_ROOT")); value != "" {
+		cfg.SourceRoot = value
+	}
+	if value := strings.TrimSpace(os.Getenv("LOG_FORMAT")); value != "" {
+		cfg.LogFormat = strings.ToLower(value)
+	}
 	if cfg.AuthMode == "" {
 		if cfg.IngestToken == "" {
 			cfg.AuthMode = "none"
@@ -10,6 +10,8 @@ func TestLoadConfigFromEnvDefaults(t *testing.T) {
 	t.Setenv("STRICTURE_SERVER_OBJECT_BUCKET", "")
 	t.Setenv("STRICTURE_SERVER_OBJECT_PREFIX", "")
 	t.Setenv("STRICTURE_SERVER_AUTH_MODE", "")
+	t.Setenv("STRICTURE_SERVER_SOURCE_ROOT", "")
+	t.Setenv("LOG_FORMAT", "")
 
 	cfg := LoadConfigFromEnv()
 	if cfg.Addr != ":8085" {
@@ -30,6 +32,12 @@ func TestLoadConfigFromEnvDefaults(t *testing.T) {
 	if cfg.AuthMode != "none" {
 		t.Fatalf("expected default auth mode none, got %q", cfg.AuthMode)
 	}
+	if cfg.LogFormat != "text" {
+		t.Fatalf("expected default log format text, got %q", cfg.LogFormat)
+	}
+	if cfg.SourceRoot != "" {
+		t.Fatalf("expected no default source root, got %q", cfg.SourceRoot)
+	}
 }
 
 func TestLoadConfigFromEnvOverrides(t *testing.T) {
@@ -40,6 +48,8 @@ func TestLoadConfigFromEnvOverrides(t *testing.T) {
 	t.Setenv("STRICTURE_SERVER_OBJECT_BUCKET", "lineage-bucket")
 	t.Setenv("STRICTURE_SERVER_OBJECT_PREFIX", "prod/lineage")
 	t.Setenv("STRICTURE_SERVER_AUTH_MODE", "token")
+	t.Setenv("STRICTURE_SERVER_SOURCE_ROOT", " /srv/checkouts ")
+	t.Setenv("LOG_FORMAT", " JSON ")
 
 	cfg := LoadConfigFromEnv()
 	if cfg.Addr != "127.0.0.1:9091" {
@@ -63,4 +73,10 @@ func TestLoadConfigFromEnvOverrides(t *testing.T) {
 	if cfg.AuthMode != "token" {
 		t.Fatalf("expected auth mode token, got %q", cfg.AuthMode)
 	}
+	if cfg.LogFormat != "json" {
+		t.Fatalf("expected log format json, got %q", cfg.LogFormat)
+	}
+	if cfg.SourceRoot != "/srv/checkouts" {
+		t.Fatalf("expected source root override, got %q", cfg.SourceRoot)
+	}
 }
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/stricture/stricture/internal/lineage"
+)
+
+func (a *App) handleLineageDiff(w http.ResponseWriter, r *http.Request) {
+	if !a.isAuthorized(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	var req LineageDiffRequest
+	if !decodeRequestBody(w, r, maxLineageDiffBodyBytes, &req) {
+		return
+	}
+
+	threshold, mode, err := validateLineageDiff(req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	head, parseErrors := req.Head, []lineage.ParseError(nil)
+	if head == nil {
+		root, dir, err := resolveSourceDir(a.cfg.SourceRoot, req.HeadSourceDir)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		if head, parseErrors, err = collectHeadArtifact(root, dir); err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			return
+		}
+	}
+
+	result := lineage.DiffArtifacts(*req.Base, *head)
+	writeJSON(w, http.StatusOK, lineageDiffResponse{
+		DiffResult:  result,
+		ShouldFail:  lineage.ShouldFailAtThresholdWithMode(result, threshold, mode),
+		FailOn:      threshold,
+		Mode:        mode,
+		ParseErrors: parseErrors,
+	})
+}
+
+// collectHeadArtifact builds the head artifact from the source files in dir. Parse
+// errors are reported relative to root so server paths stay private.
+func collectHeadArtifact(root string, dir string) (*lineage.Artifact, []lineage.ParseError, error) {
+	collected, collectErrors, err := lineage.Collect([]string{dir})
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect head_source_dir: %w", err)
+	}
+	var parseErrors []lineage.ParseError
+	for _, parseErr := range collectErrors {
+		if rel, err := filepath.Rel(root, parseErr.FilePath); err == nil {
+			parseErr.FilePath = filepath.ToSlash(rel)
+		}
+		parseErrors = append(parseErrors, parseErr)
+	}
+	return &collected, parseErrors, nil
+}
+
+// resolveSourceDir maps a request's head_source_dir onto the configured source root and
+// returns both with symlinks resolved. The directory must exist and stay inside the root.
+func resolveSourceDir(sourceRoot string, dir string) (string, string, error) {
+	if strings.TrimSpace(sourceRoot) == "" {
+		return "", "", fmt.Errorf("head_source_dir is disabled: STRICTURE_SERVER_SOURCE_ROOT is not set")
+	}
+	dir = strings.TrimSpace(dir)
+	if filepath.IsAbs(dir) {
+		return "", "", fmt.Errorf("head_source_dir must be relative to the source root")
+	}
+
+	root, err := filepath.EvalSymlinks(sourceRoot)
+	if err != nil {
+		return "", "", fmt.Errorf("resolve source root: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Join(root, dir))
+	if err != nil {
+		return "", "", fmt.Errorf("head_source_dir %q does not exist under the source root", dir)
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", "", fmt.Errorf("head_source_dir %q is outside the source root", dir)
+	}
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", "", fmt.Errorf("stat head_source_dir %q: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return "", "", fmt.Errorf("head_source_dir %q is not a directory", dir)
+	}
+	return root, resolved, nil
+}
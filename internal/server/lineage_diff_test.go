@@ -0,0 +1,134 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const lineageDiffBase = `{"schema_version":"1","fields":[{"annotation_schema_version":"1","field_id":"response_user_id","field":"response.user_id","source_system":"Identity","source_version":"v1"}]}`
+
+func postLineageDiff(t *testing.T, handler http.Handler, body string) (*httptest.ResponseRecorder, lineageDiffResponse) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/v1/lineage/diff", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var resp lineageDiffResponse
+	if rec.Code == http.StatusOK {
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return rec, resp
+}
+
+func TestLineageDiffArtifacts(t *testing.T) {
+	handler, err := NewHandler(Config{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	rec, resp := postLineageDiff(t, handler, `{"base":`+lineageDiffBase+`,"head":{"schema_version":"1","fields":[]}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if resp.Summary.High == 0 || len(resp.Changes) == 0 || resp.Changes[0].ChangeType != "field_removed" {
+		t.Fatalf("expected a high field_removed change, got %+v", resp.DiffResult)
+	}
+	if !resp.ShouldFail || resp.FailOn != "high" || resp.Mode != "block" {
+		t.Fatalf("expected should_fail at default high/block, got %v %s/%s", resp.ShouldFail, resp.FailOn, resp.Mode)
+	}
+
+	_, resp = postLineageDiff(t, handler, `{"base":`+lineageDiffBase+`,"head":{"schema_version":"1","fields":[]},"mode":"warn"}`)
+	if resp.ShouldFail || resp.Mode != "warn" {
+		t.Fatalf("mode=warn should never fail, got %v %s", resp.ShouldFail, resp.Mode)
+	}
+	_, resp = postLineageDiff(t, handler, `{"base":`+lineageDiffBase+`,"head":`+lineageDiffBase+`,"fail_on":"info"}`)
+	if resp.ShouldFail || resp.Summary.Total != 0 {
+		t.Fatalf("identical artifacts should not fail, got %v %+v", resp.ShouldFail, resp.Summary)
+	}
+}
+
+func TestLineageDiffRejectsInvalidRequests(t *testing.T) {
+	handler, err := NewHandler(Config{DataDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	head := `{"schema_version":"1","fields":[]}`
+	tests := []struct {
+		name string
+		body string
+		code int
+		want string
+	}{
+		{name: "missing base", body: `{"head":` + head + `}`, code: http.StatusBadRequest, want: "base is required"},
+		{name: "missing head", body: `{"base":` + head + `}`, code: http.StatusBadRequest, want: "head or head_source_dir is required"},
+		{name: "both heads", body: `{"base":` + head + `,"head":` + head + `,"head_source_dir":"svc"}`, code: http.StatusBadRequest, want: "cannot both be set"},
+		{name: "bad threshold", body: `{"base":` + head + `,"head":` + head + `,"fail_on":"critical"}`, code: http.StatusBadRequest, want: "fail_on: invalid severity"},
+		{name: "bad mode", body: `{"base":` + head + `,"head":` + head + `,"mode":"audit"}`, code: http.StatusBadRequest, want: "mode: invalid mode"},
+		{name: "unknown field", body: `{"base":` + head + `,"head":` + head + `,"threshold":"high"}`, code: http.StatusBadRequest, want: "invalid request body"},
+		{name: "source dir disabled", body: `{"base":` + head + `,"head_source_dir":"svc"}`, code: http.StatusBadRequest, want: "STRICTURE_SERVER_SOURCE_ROOT is not set"},
+		{name: "too large", body: `{"base":` + head + `,"head_source_dir":"` + strings.Repeat("a", maxLineageDiffBodyBytes) + `"}`, code: http.StatusRequestEntityTooLarge, want: "request body exceeds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, _ := postLineageDiff(t, handler, tt.body)
+			if rec.Code != tt.code || !strings.Contains(rec.Body.String(), tt.want) {
+				t.Fatalf("expected %d containing %q, got %d body=%s", tt.code, tt.want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestLineageDiffHeadSourceDir(t *testing.T) {
+	root := t.TempDir()
+	svcDir := filepath.Join(root, "checkout", "svc")
+	if err := os.MkdirAll(svcDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(svcDir, "broken.go"), []byte("// strict-source field=response.user_id\n"), 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+	handler, err := NewHandler(Config{DataDir: t.TempDir(), SourceRoot: root})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	rec, resp := postLineageDiff(t, handler, `{"base":`+lineageDiffBase+`,"head_source_dir":"checkout/svc"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d body=%s", rec.Code, rec.Body.String())
+	}
+	if !resp.ShouldFail || resp.Summary.High == 0 {
+		t.Fatalf("collected head drops the base field, expected should_fail, got %v %+v", resp.ShouldFail, resp.Summary)
+	}
+	if len(resp.ParseErrors) != 1 || resp.ParseErrors[0].FilePath != "checkout/svc/broken.go" {
+		t.Fatalf("expected one parse error relative to the source root, got %+v", resp.ParseErrors)
+	}
+
+	for _, dir := range []string{"../outside", "checkout/missing", "checkout/svc/broken.go", root} {
+		rec, _ := postLineageDiff(t, handler, `{"base":`+lineageDiffBase+`,"head_source_dir":"`+filepath.ToSlash(dir)+`"}`)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("head_source_dir %q: expected 400, got %d body=%s", dir, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestLineageDiffRequiresBearerTokenWhenConfigured(t *testing.T) {
+	handler, err := NewHandler(Config{DataDir: t.TempDir(), IngestToken: "secret-token", AuthMode: "token"})
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	rec, _ := postLineageDiff(t, handler, `{"base":`+lineageDiffBase+`,"head":`+lineageDiffBase+`}`)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", rec.Code)
+	}
+}
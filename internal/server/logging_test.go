@@ -0,0 +1,55 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJSONRequestLogging(t *testing.T) {
+	var out bytes.Buffer
+	handler, err := NewHandlerWithLogger(Config{DataDir: t.TempDir(), AuthMode: "token", IngestToken: "secret"}, NewLogger("json", &out))
+	if err != nil {
+		t.Fatalf("NewHandlerWithLogger() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/artifacts", strings.NewReader(`{}`))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry map[string]any
+	if err := json.Unmarshal(out.Bytes(), &entry); err != nil {
+		t.Fatalf("log line should be JSON: %v\n%s", err, out.String())
+	}
+	if entry["msg"] != "request" || entry["method"] != "POST" || entry["path"] != "/v1/artifacts" {
+		t.Fatalf("unexpected log entry: %v", entry)
+	}
+	if status, _ := entry["status"].(float64); int(status) != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %v", entry["status"])
+	}
+	if _, ok := entry["duration_ms"].(float64); !ok {
+		t.Fatalf("expected numeric duration_ms, got %v", entry["duration_ms"])
+	}
+}
+
+func TestTextRequestLoggingIsDefault(t *testing.T) {
+	var out bytes.Buffer
+	handler, err := NewHandlerWithLogger(Config{DataDir: t.TempDir()}, NewLogger("", &out))
+	if err != nil {
+		t.Fatalf("NewHandlerWithLogger() error = %v", err)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	line := out.String()
+	if strings.HasPrefix(line, "{") || !strings.Contains(line, "path=/healthz") || !strings.Contains(line, "status=200") {
+		t.Fatalf("expected key=value text log, got %q", line)
+	}
+}
+
+func TestUnsupportedLogFormatRejected(t *testing.T) {
+	if _, err := NewHandler(Config{DataDir: t.TempDir(), LogFormat: "xml"}); err == nil {
+		t.Fatal("expected error for unsupported log format")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/stricture/stricture/internal/lineage"
 )
 
 // ArtifactIngestRequest is the v0 ingest envelope for lineage runs.
@@ -27,6 +29,57 @@ type ingestResponse struct {
 	Location string `json:"location"`
 }
 
+// LineageDiffRequest asks the server to diff a base lineage artifact against a head
+// artifact, or against one collected from head_source_dir under the source root.
+type LineageDiffRequest struct {
+	Base          *lineage.Artifact `json:"base"`
+	Head          *lineage.Artifact `json:"head,omitempty"`
+	HeadSourceDir string            `json:"head_source_dir,omitempty"`
+	FailOn        string            `json:"fail_on,omitempty"`
+	Mode          string            `json:"mode,omitempty"`
+}
+
+type lineageDiffResponse struct {
+	lineage.DiffResult
+	ShouldFail  bool                    `json:"should_fail"`
+	FailOn      lineage.Severity        `json:"fail_on"`
+	Mode        lineage.EnforcementMode `json:"mode"`
+	ParseErrors []lineage.ParseError    `json:"parse_errors,omitempty"`
+}
+
+// validateLineageDiff checks the request shape and resolves the threshold and mode,
+// defaulting to the lineage-diff CLI's high/block.
+func validateLineageDiff(req LineageDiffRequest) (lineage.Severity, lineage.EnforcementMode, error) {
+	if req.Base == nil {
+		return "", "", fmt.Errorf("base is required")
+	}
+	hasSourceDir := strings.TrimSpace(req.HeadSourceDir) != ""
+	if req.Head == nil && !hasSourceDir {
+		return "", "", fmt.Errorf("head or head_source_dir is required")
+	}
+	if req.Head != nil && hasSourceDir {
+		return "", "", fmt.Errorf("head and head_source_dir cannot both be set")
+	}
+
+	failOn := req.FailOn
+	if strings.TrimSpace(failOn) == "" {
+		failOn = string(lineage.SeverityHigh)
+	}
+	threshold, err := lineage.ParseSeverity(failOn)
+	if err != nil {
+		return "", "", fmt.Errorf("fail_on: %w", err)
+	}
+	mode := req.Mode
+	if strings.TrimSpace(mode) == "" {
+		mode = string(lineage.ModeBlock)
+	}
+	enforcement, err := lineage.ParseEnforcementMode(mode)
+	if err != nil {
+		return "", "", fmt.Errorf("mode: %w", err)
+	}
+	return threshold, enforcement, nil
+}
+
 func normalizeAndValidateIngest(req ArtifactIngestRequest) (ArtifactIngestRequest, error) {
 	req.Organization = sanitizePathToken(req.Organization)
 	req.Project = sanitizePathToken(req.Project)
@@ -2,12 +2,17 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 )
 
-const maxIngestBodyBytes = 10 << 20 // 10MB
+const (
+	maxIngestBodyBytes      = 10 << 20 // 10MB
+	maxLineageDiffBodyBytes = 20 << 20 // 20MB: base and head artifacts
+)
 
 // IngestStore persists normalized ingest payloads.
 type IngestStore interface {
@@ -20,9 +25,9 @@ type App struct {
 	store IngestStore
 }
 
-// New constructs the production HTTP server.
-func New(cfg Config) (*http.Server, error) {
-	handler, err := NewHandler(cfg)
+// New constructs the production HTTP server, logging each request to logger.
+func New(cfg Config, logger *slog.Logger) (*http.Server, error) {
+	handler, err := NewHandlerWithLogger(cfg, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +39,11 @@ func New(cfg Config) (*http.Server, error) {
 
 // NewHandler constructs the HTTP handler for tests and local embedding.
 func NewHandler(cfg Config) (http.Handler, error) {
+	return NewHandlerWithLogger(cfg, nil)
+}
+
+// NewHandlerWithLogger is NewHandler with request logging; a nil logger disables it.
+func NewHandlerWithLogger(cfg Config, logger *slog.Logger) (http.Handler, error) {
 	if err := validateConfig(cfg); err != nil {
 		return nil, err
 	}
@@ -51,10 +61,19 @@ func NewHandler(cfg Config) (http.Handler, error) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("GET /healthz", app.handleHealthz)
 	mux.HandleFunc("POST /v1/artifacts", app.handleArtifactsIngest)
-	return mux, nil
+	mux.HandleFunc("POST /v1/lineage/diff", app.handleLineageDiff)
+	if logger == nil {
+		return mux, nil
+	}
+	return logRequests(mux, logger), nil
 }
 
 func validateConfig(cfg Config) error {
+	switch cfg.LogFormat {
+	case "", "text", "json":
+	default:
+		return fmt.Errorf("unsupported LOG_FORMAT %q (valid: text, json)", cfg.LogFormat)
+	}
 	switch cfg.AuthMode {
 	case "", "none":
 	case "token":
@@ -88,17 +107,8 @@ func (a *App) handleArtifactsIngest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	r.Body = http.MaxBytesReader(w, r.Body, maxIngestBodyBytes)
-
 	var req ArtifactIngestRequest
-	decoder := json.NewDecoder(r.Body)
-	decoder.DisallowUnknownFields()
-	if err := decoder.Decode(&req); err != nil {
-		writeJSON(w, http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("invalid request body: %v", err)})
-		return
-	}
-	if err := decoder.Decode(&struct{}{}); err == nil {
-		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "request body must contain a single JSON object"})
+	if !decodeRequestBody(w, r, maxIngestBodyBytes, &req) {
 		return
 	}
 
@@ -121,6 +131,29 @@ func (a *App) handleArtifactsIngest(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// decodeRequestBody strictly decodes a single JSON object of at most limit bytes into
+// dst. On failure it writes the error response and returns false.
+func decodeRequestBody(w http.ResponseWriter, r *http.Request, limit int64, dst any) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, limit)
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": fmt.Sprintf("request body exceeds %d bytes", tooLarge.Limit)})
+			return false
+		}
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("invalid request body: %v", err)})
+		return false
+	}
+	if err := decoder.Decode(&struct{}{}); err == nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "request body must contain a single JSON object"})
+		return false
+	}
+	return true
+}
+
 func (a *App) isAuthorized(r *http.Request) bool {
 	switch a.cfg.AuthMode {
 	case "", "none":
@@ -22,6 +22,8 @@ type Violation struct {
 	Message      string
 	StartLine    int
 	EndLine      int
+	StartColumn  int
+	EndColumn    int
 	SuggestedFix string
 }
 
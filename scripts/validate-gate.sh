@@ -30,6 +30,31 @@ PHASE_2_RULES=(
     "ARCH-import-boundary"
     "ARCH-layer-violation"
     "ARCH-module-boundary"
+    "CONV-no-tabs-or-spaces-mismatch"
+    "ARCH-package-naming"
+    "CONV-consistent-quote-style"
+    "ARCH-no-cross-module-internal-import"
+    "CONV-no-magic-numbers"
+    "ARCH-no-business-logic-in-handlers"
+    "CONV-no-redundant-else-after-return"
+    "ARCH-max-import-count"
+    "ARCH-no-upward-import"
+    "ARCH-forbidden-import"
+    "ARCH-test-in-same-package-policy"
+    "ARCH-no-direct-db-access-from-domain"
+    "ARCH-no-import-from-main"
+    "CONV-no-unused-package-level-vars"
+    "CONV-comment-hygiene"
+    "CONV-filename-matches-primary-type"
+    "CONV-no-abbreviations"
+    "CONV-struct-field-alignment"
+    "ARCH-interface-segregation"
+    "ARCH-no-side-effects-in-init"
+    "ARCH-max-cyclomatic-complexity"
+    "ARCH-no-wildcard-reexports"
+    "ARCH-context-propagation"
+    "ARCH-no-business-import-in-generated"
+    "ARCH-no-file-cycles"
 )
 
 PHASE_3_RULES=(
@@ -43,6 +68,21 @@ PHASE_3_RULES=(
     "TQ-test-isolation"
     "TQ-negative-cases"
     "TQ-test-naming"
+    "TQ-test-has-no-conditional-assertions"
+    "TQ-boundary-value-coverage"
+    "TQ-test-isolation-no-shared-mutable-globals"
+    "TQ-assertion-specificity"
+    "TQ-no-empty-catch"
+    "TQ-snapshot-test-staleness"
+    "TQ-no-test-logic-in-production"
+    "TQ-flaky-retry-detection"
+    "TQ-test-coverage-annotation"
+    "TQ-pagination-boundary-tested"
+    "TQ-test-file-location"
+    "TQ-no-hardcoded-test-credentials"
+    "TQ-setup-teardown-balance"
+    "TQ-assert-error-is-specific-type"
+    "TQ-no-test-interdependence"
 )
 
 PHASE_4_RULES=(
@@ -54,6 +94,7 @@ PHASE_4_RULES=(
     "CTR-dual-test"
     "CTR-strictness-parity"
     "CTR-manifest-conformance"
+    "CTR-request-required-fields"
 )
 
 ALL_RULES=(
@@ -151,6 +151,47 @@ valid_rules=(
     "CTR-request-shape" "CTR-response-shape" "CTR-status-code-handling"
     "CTR-shared-type-sync" "CTR-json-tag-match" "CTR-dual-test"
     "CTR-strictness-parity" "CTR-manifest-conformance"
+    "TQ-test-has-no-conditional-assertions"
+    "CONV-no-tabs-or-spaces-mismatch"
+    "ARCH-package-naming"
+    "TQ-boundary-value-coverage"
+    "CONV-consistent-quote-style"
+    "TQ-test-isolation-no-shared-mutable-globals"
+    "CTR-request-required-fields"
+    "ARCH-no-cross-module-internal-import"
+    "TQ-assertion-specificity"
+    "CONV-no-magic-numbers"
+    "ARCH-no-business-logic-in-handlers"
+    "TQ-no-empty-catch"
+    "TQ-snapshot-test-staleness"
+    "CONV-no-redundant-else-after-return"
+    "ARCH-max-import-count"
+    "ARCH-no-upward-import"
+    "ARCH-forbidden-import"
+    "ARCH-test-in-same-package-policy"
+    "ARCH-no-direct-db-access-from-domain"
+    "ARCH-no-import-from-main"
+    "TQ-no-test-logic-in-production"
+    "CONV-no-unused-package-level-vars"
+    "ARCH-interface-segregation"
+    "TQ-flaky-retry-detection"
+    "ARCH-no-side-effects-in-init"
+    "CONV-comment-hygiene"
+    "ARCH-max-cyclomatic-complexity"
+    "TQ-test-coverage-annotation"
+    "CONV-filename-matches-primary-type"
+    "ARCH-no-wildcard-reexports"
+    "TQ-pagination-boundary-tested"
+    "CONV-no-abbreviations"
+    "TQ-test-file-location"
+    "ARCH-context-propagation"
+    "TQ-no-hardcoded-test-credentials"
+    "ARCH-no-business-import-in-generated"
+    "TQ-setup-teardown-balance"
+    "CONV-struct-field-alignment"
+    "ARCH-no-file-cycles"
+    "TQ-assert-error-is-specific-type"
+    "TQ-no-test-interdependence"
 )
 
 # Extract all rule references from validation files
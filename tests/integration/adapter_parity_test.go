@@ -50,3 +50,36 @@ func TestInspectDetectsLanguageByExtension(t *testing.T) {
 		})
 	}
 }
+
+func TestInspectReportsGoInterfaceMethods(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "store.go")
+	source := "package store\n\ntype Reader interface {\n\tGet(key string) string\n}\n\ntype Store interface {\n\tReader\n\tPut(key, value string)\n\tDelete(key string)\n}\n\ntype Config struct{}\n"
+	if err := os.WriteFile(p, []byte(source), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+
+	stdout, stderr, code := run(t, "inspect", p)
+	if code != 0 {
+		t.Fatalf("inspect exit code = %d, stderr=%q", code, stderr)
+	}
+	var got struct {
+		Types []struct {
+			Name    string   `json:"Name"`
+			Kind    string   `json:"Kind"`
+			Methods []string `json:"Methods"`
+		} `json:"Types"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("inspect output must be valid JSON: %v\noutput=%s", err, stdout)
+	}
+	if len(got.Types) != 3 {
+		t.Fatalf("types = %+v, want Reader, Store, Config", got.Types)
+	}
+	store := got.Types[1]
+	if store.Kind != "interface" || len(store.Methods) != 2 || store.Methods[0] != "Put" || store.Methods[1] != "Delete" {
+		t.Fatalf("Store = %+v, want interface with Put and Delete", store)
+	}
+	if got.Types[2].Kind != "struct" {
+		t.Fatalf("Config kind = %q, want struct", got.Types[2].Kind)
+	}
+}
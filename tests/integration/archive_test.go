@@ -0,0 +1,71 @@
+// archive_test.go — Integration checks for linting files inside a source archive.
+//go:build integration
+
+package integration
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestArchiveLintsEntriesWithInArchivePaths(t *testing.T) {
+	tmp := t.TempDir()
+	archivePath := filepath.Join(tmp, "src.tar.gz")
+	f, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	entries := map[string]string{
+		"pkg/bad.go":  "package pkg\n",
+		"pkg/good.go": "// good.go — Has a header.\npackage pkg\n",
+	}
+	for name, body := range entries {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(body)), ModTime: time.Now()}); err != nil {
+			t.Fatalf("write header: %v", err)
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			t.Fatalf("write body: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatalf("close file: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--archive", archivePath)
+	if code != 1 {
+		t.Fatalf("archive lint should find one violation: code=%d stderr=%q stdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Violations []struct {
+			FilePath string `json:"filePath"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\noutput=%q", err, stdout)
+	}
+	if len(result.Violations) != 1 || result.Violations[0].FilePath != "pkg/bad.go" {
+		t.Fatalf("expected in-archive path pkg/bad.go, got %+v", result.Violations)
+	}
+	if _, err := os.Stat(filepath.Join(tmp, "pkg")); !os.IsNotExist(err) {
+		t.Fatalf("archive contents should not be extracted, stat err=%v", err)
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--archive", archivePath, "--fix")
+	if code != 2 || !strings.Contains(stderr, "--archive") {
+		t.Fatalf("--archive with --fix should exit 2: code=%d stderr=%q", code, stderr)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -122,3 +123,233 @@ func TestBaselineWithMaxViolationsStillReportsNewFindings(t *testing.T) {
 		t.Fatalf("expected c.ts as new violation, got %q", result.Violations[0].FilePath)
 	}
 }
+
+func TestBaselinePruneRemovesResolvedEntries(t *testing.T) {
+	tmp := t.TempDir()
+	for _, name := range []string{"a.ts", "b.ts"} {
+		pathValue := filepath.Join(tmp, name)
+		if err := os.WriteFile(pathValue, []byte("export const value = 1;\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", pathValue, err)
+		}
+	}
+
+	baselinePath := filepath.Join(tmp, ".stricture-baseline.json")
+	if _, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--baseline", baselinePath, "."); code != 0 {
+		t.Fatalf("baseline bootstrap should exit 0, got %d\nstderr=%q", code, stderr)
+	}
+
+	if err := os.WriteFile(filepath.Join(tmp, "b.ts"), []byte("// b.ts — Value export.\nexport const value = 1;\n"), 0o644); err != nil {
+		t.Fatalf("fix b.ts: %v", err)
+	}
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--baseline", baselinePath, "--baseline-prune", ".")
+	if code != 0 {
+		t.Fatalf("baseline prune should exit 0, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+
+	var result struct {
+		Summary struct {
+			BaselinePruned float64 `json:"baselinePruned"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal prune JSON: %v\noutput=%q", err, stdout)
+	}
+	if int(result.Summary.BaselinePruned) != 1 {
+		t.Fatalf("baselinePruned = %v, want 1", result.Summary.BaselinePruned)
+	}
+
+	data, err := os.ReadFile(baselinePath)
+	if err != nil {
+		t.Fatalf("read baseline: %v", err)
+	}
+	var doc struct {
+		Entries []map[string]interface{} `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("parse baseline: %v", err)
+	}
+	if len(doc.Entries) != 1 || doc.Entries[0]["filePath"] != "a.ts" {
+		t.Fatalf("baseline should keep only the active a.ts entry, got %+v", doc.Entries)
+	}
+}
+
+func TestBaselinePruneRequiresBaseline(t *testing.T) {
+	_, stderr, code := run(t, "--baseline-prune", ".")
+	if code != 2 {
+		t.Fatalf("--baseline-prune without --baseline exit code = %d, want 2", code)
+	}
+	if stderr == "" {
+		t.Fatalf("stderr should explain missing --baseline")
+	}
+}
+
+func TestBaselineReportSummarizesActiveAndResolvedEntries(t *testing.T) {
+	tmp := t.TempDir()
+	for _, name := range []string{"a.ts", "b.ts", "c.ts"} {
+		pathValue := filepath.Join(tmp, name)
+		if err := os.WriteFile(pathValue, []byte("export const value = 1;\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", pathValue, err)
+		}
+	}
+
+	baselinePath := filepath.Join(tmp, ".stricture-baseline.json")
+	if _, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--baseline", baselinePath, "."); code != 0 {
+		t.Fatalf("baseline bootstrap should exit 0, got %d\nstderr=%q", code, stderr)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "c.ts"), []byte("// c.ts — Value export.\nexport const value = 1;\n"), 0o644); err != nil {
+		t.Fatalf("fix c.ts: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "baseline-report", "--baseline", baselinePath, "--rule", "CONV-file-header", "--format", "json", ".")
+	if code != 0 {
+		t.Fatalf("baseline-report should exit 0, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	var report struct {
+		Total    int `json:"total"`
+		Active   int `json:"active"`
+		Resolved int `json:"resolved"`
+		TopRules []struct {
+			RuleID     string `json:"ruleId"`
+			Suppressed int    `json:"suppressed"`
+		} `json:"topRules"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("unmarshal report JSON: %v\noutput=%q", err, stdout)
+	}
+	if report.Total != 3 || report.Active != 2 || report.Resolved != 1 {
+		t.Fatalf("report = %+v, want total=3 active=2 resolved=1", report)
+	}
+	if len(report.TopRules) != 1 || report.TopRules[0].RuleID != "CONV-file-header" || report.TopRules[0].Suppressed != 2 {
+		t.Fatalf("topRules = %+v, want CONV-file-header with 2 suppressed", report.TopRules)
+	}
+
+	stdout, _, code = runInDir(t, tmp, "baseline-report", "--baseline", baselinePath, "--rule", "CONV-file-header", ".")
+	if code != 0 || !strings.Contains(stdout, "Resolved:  1") {
+		t.Fatalf("text report code=%d output=%q", code, stdout)
+	}
+}
+
+func TestBaselineReportRequiresBaseline(t *testing.T) {
+	_, stderr, code := run(t, "baseline-report", ".")
+	if code != 2 {
+		t.Fatalf("baseline-report without --baseline exit code = %d, want 2", code)
+	}
+	if stderr == "" {
+		t.Fatalf("stderr should explain missing --baseline")
+	}
+}
+
+func TestBaselineMergeCombinesShardBaselines(t *testing.T) {
+	tmp := t.TempDir()
+	for _, name := range []string{"a.ts", "b.ts", "c.ts"} {
+		pathValue := filepath.Join(tmp, name)
+		if err := os.WriteFile(pathValue, []byte("export const value = 1;\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", pathValue, err)
+		}
+	}
+
+	shard1 := filepath.Join(tmp, "shard1.json")
+	shard2 := filepath.Join(tmp, "shard2.json")
+	if _, stderr, code := runInDir(t, tmp, "--rule", "CONV-file-header", "--baseline", shard1, "a.ts", "b.ts"); code != 0 {
+		t.Fatalf("shard1 bootstrap should exit 0, got %d\nstderr=%q", code, stderr)
+	}
+	if _, stderr, code := runInDir(t, tmp, "--rule", "CONV-file-header", "--baseline", shard2, "b.ts", "c.ts"); code != 0 {
+		t.Fatalf("shard2 bootstrap should exit 0, got %d\nstderr=%q", code, stderr)
+	}
+
+	merged := filepath.Join(tmp, "baseline.json")
+	stdout, stderr, code := runInDir(t, tmp, "baseline-merge", "--out", merged, shard1, shard2)
+	if code != 0 {
+		t.Fatalf("baseline-merge should exit 0, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	if !strings.Contains(stdout, "3 entry(s); 1 duplicate(s) dropped") {
+		t.Fatalf("baseline-merge output = %q, want 3 entries and 1 duplicate", stdout)
+	}
+
+	stdout, stderr, code = runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--diff", "--baseline", merged, ".")
+	if code != 0 {
+		t.Fatalf("lint with merged baseline should exit 0, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Baseline struct {
+			Suppressed int `json:"suppressed"`
+			EntryCount int `json:"entryCount"`
+		} `json:"baseline"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal lint JSON: %v\noutput=%q", err, stdout)
+	}
+	if result.Baseline.Suppressed != 3 || result.Baseline.EntryCount != 3 {
+		t.Fatalf("baseline = %+v, want 3 suppressed of 3 entries", result.Baseline)
+	}
+}
+
+func TestBaselineMergeRequiresOut(t *testing.T) {
+	_, stderr, code := run(t, "baseline-merge", "shard1.json")
+	if code != 2 {
+		t.Fatalf("baseline-merge without --out exit code = %d, want 2", code)
+	}
+	if stderr == "" {
+		t.Fatalf("stderr should explain missing --out")
+	}
+}
+
+func TestSARIFIncludeSuppressedEmitsBaselinedFindings(t *testing.T) {
+	tmp := t.TempDir()
+	for _, name := range []string{"a.ts", "b.ts"} {
+		if err := os.WriteFile(filepath.Join(tmp, name), []byte("export const value = 1;\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	baselinePath := filepath.Join(tmp, ".stricture-baseline.json")
+	if _, stderr, code := runInDir(t, tmp, "--rule", "CONV-file-header", "--baseline", baselinePath, "."); code != 0 {
+		t.Fatalf("baseline bootstrap should exit 0, got %d\nstderr=%q", code, stderr)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "c.ts"), []byte("export const value = 1;\n"), 0o644); err != nil {
+		t.Fatalf("write c.ts: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "sarif", "--rule", "CONV-file-header", "--baseline", baselinePath, "--sarif-include-suppressed", ".")
+	if code != 1 {
+		t.Fatalf("new violation should exit 1, got %d\nstderr=%q", code, stderr)
+	}
+	var log struct {
+		Runs []struct {
+			Results []struct {
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URI string `json:"uri"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+				Suppressions []struct {
+					Kind          string `json:"kind"`
+					Justification string `json:"justification"`
+				} `json:"suppressions"`
+				BaselineState string `json:"baselineState"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &log); err != nil {
+		t.Fatalf("invalid SARIF: %v\n%s", err, stdout)
+	}
+	results := log.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("results = %d, want 1 active + 2 suppressed\n%s", len(results), stdout)
+	}
+	if len(results[0].Suppressions) != 0 || !strings.HasSuffix(results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, "c.ts") {
+		t.Fatalf("first result should be the active c.ts finding: %+v", results[0])
+	}
+	for _, result := range results[1:] {
+		if len(result.Suppressions) != 1 || result.Suppressions[0].Kind != "external" || result.BaselineState != "unchanged" ||
+			!strings.Contains(result.Suppressions[0].Justification, ".stricture-baseline.json") {
+			t.Fatalf("baselined result should carry a suppression: %+v", result)
+		}
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--format", "json", "--baseline", baselinePath, "--sarif-include-suppressed", ".")
+	if code != 2 || !strings.Contains(stderr, "--sarif-include-suppressed requires --baseline and --format sarif") {
+		t.Fatalf("non-SARIF format should be a usage error, got %d stderr=%q", code, stderr)
+	}
+}
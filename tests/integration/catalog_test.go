@@ -0,0 +1,76 @@
+// catalog_test.go — Integration checks for the catalog subcommand.
+//go:build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type catalogPayload struct {
+	Version string `json:"version"`
+	Rules   []struct {
+		ID       string `json:"id"`
+		Category string `json:"category"`
+		Fixable  string `json:"fixable"`
+		Examples []struct {
+			Language string `json:"language"`
+			Bad      string `json:"bad"`
+			Good     string `json:"good"`
+		} `json:"examples"`
+	} `json:"rules"`
+}
+
+func TestCatalogEmitsRulesWithExamples(t *testing.T) {
+	stdout, stderr, code := run(t, "catalog", "--category", "conv")
+	if code != 0 {
+		t.Fatalf("catalog exit code = %d, want 0\nstderr=%q", code, stderr)
+	}
+	var payload catalogPayload
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("catalog must be valid JSON: %v\n%s", err, stdout)
+	}
+	if payload.Version != "1" || len(payload.Rules) == 0 {
+		t.Fatalf("unexpected catalog: %+v", payload)
+	}
+	for _, rule := range payload.Rules {
+		if rule.Category != "conv" {
+			t.Fatalf("category filter leaked %s (%s)", rule.ID, rule.Category)
+		}
+		if rule.ID == "CONV-file-header" && (rule.Fixable != "Yes" || len(rule.Examples) != 1 || rule.Examples[0].Bad == "") {
+			t.Fatalf("unexpected CONV-file-header entry: %+v", rule)
+		}
+	}
+}
+
+func TestCatalogIncludesPluginRulesWithoutExamples(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "custom.yml", "rules:\n  - id: CUSTOM-no-todo\n    check:\n      must_not_contain:\n        pattern: TODO\n")
+	writeFile(t, tmp, ".stricture.yml", "version: \"1.0\"\nplugins:\n  - custom.yml\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "catalog", "--category", "custom", "--output", filepath.Join("out", "catalog.json"))
+	if code != 0 {
+		t.Fatalf("catalog exit code = %d, want 0\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	data, err := os.ReadFile(filepath.Join(tmp, "out", "catalog.json"))
+	if err != nil {
+		t.Fatalf("read catalog output: %v", err)
+	}
+	var payload catalogPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("catalog must be valid JSON: %v", err)
+	}
+	if len(payload.Rules) != 1 || payload.Rules[0].ID != "CUSTOM-no-todo" || payload.Rules[0].Examples == nil || len(payload.Rules[0].Examples) != 0 {
+		t.Fatalf("unexpected plugin catalog: %s", data)
+	}
+}
+
+func TestCatalogRejectsUnknownCategory(t *testing.T) {
+	_, _, code := run(t, "catalog", "--category", "nope")
+	if code != 2 {
+		t.Fatalf("catalog with unknown category exit code = %d, want 2", code)
+	}
+}
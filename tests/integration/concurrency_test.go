@@ -71,3 +71,25 @@ func TestInvalidConcurrencyValueExitsTwo(t *testing.T) {
 		t.Fatalf("stderr should explain invalid concurrency, got %q", stderr)
 	}
 }
+
+func TestRuleConcurrencyPreservesOutput(t *testing.T) {
+	tmp := t.TempDir()
+	for _, name := range []string{"a.go", "b.go"} {
+		writeFile(t, tmp, name, "package main\n\nfunc main() {\n\tx := 42\n\t_ = x\n}\n")
+	}
+
+	args := []string{"--format", "json", "--category", "CONV", "--concurrency", "1"}
+	stdout1, stderr1, code1 := runInDir(t, tmp, append(args, "--concurrency-rules", "1", ".")...)
+	stdout2, stderr2, code2 := runInDir(t, tmp, append(args, "--concurrency-rules", "8", ".")...)
+	if code1 != code2 || stderr1 != stderr2 {
+		t.Fatalf("rule concurrency changed the result: code %d vs %d, stderr %q vs %q", code1, code2, stderr1, stderr2)
+	}
+	if !reflect.DeepEqual(normalizeLintJSON(t, stdout1), normalizeLintJSON(t, stdout2)) {
+		t.Fatalf("output should match across --concurrency-rules levels\n1: %s\n8: %s", stdout1, stdout2)
+	}
+
+	_, stderr, code := runInDir(t, tmp, "--rule", "CONV-file-header", "--concurrency-rules", "0", ".")
+	if code != 2 || !strings.Contains(stderr, "--concurrency-rules") {
+		t.Fatalf("--concurrency-rules 0 should exit 2 with an explanation, got %d %q", code, stderr)
+	}
+}
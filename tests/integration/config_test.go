@@ -42,3 +42,48 @@ func TestValidateConfig_InvalidYAML(t *testing.T) {
 		t.Fatalf("stderr missing invalid YAML marker: %q", stderr)
 	}
 }
+
+func TestLintSeverityMapDowngradesMatchedPaths(t *testing.T) {
+	tmp := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmp, "examples"), 0o755); err != nil {
+		t.Fatalf("mkdir examples: %v", err)
+	}
+	content := "rules:\n  CONV-file-header: error\noverrides:\n  - files: [\"examples/**\"]\n    severityMap:\n      error: warn\n"
+	writeFile(t, tmp, ".stricture.yml", content)
+	writeFile(t, tmp, "examples/demo.ts", "export const demo = 1;\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "examples")
+	if code != 0 {
+		t.Fatalf("downgraded violations should not fail lint, got exit %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	if !strings.Contains(stdout, `"Severity": "warn"`) || !strings.Contains(stdout, `"warnings": 1`) {
+		t.Fatalf("expected one warn-level violation, got %s", stdout)
+	}
+
+	writeFile(t, tmp, "app.ts", "export const app = 1;\n")
+	_, _, code = runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "app.ts")
+	if code != 1 {
+		t.Fatalf("unmatched paths keep error severity, got exit %d", code)
+	}
+}
+
+func TestLintRuleExcludeSkipsMatchedPaths(t *testing.T) {
+	tmp := t.TempDir()
+	for _, dir := range []string{"legacy", "src"} {
+		if err := os.MkdirAll(filepath.Join(tmp, dir), 0o755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	content := "rules:\n  CONV-file-header:\n    severity: error\n    exclude: [\"legacy/**\"]\n"
+	writeFile(t, tmp, ".stricture.yml", content)
+	writeFile(t, tmp, "legacy/old.ts", "export const old = 1;\n")
+	writeFile(t, tmp, "src/app.ts", "export const app = 1;\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", ".")
+	if code != 1 {
+		t.Fatalf("src/app.ts should still fail, got exit %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	if strings.Contains(stdout, "legacy/old.ts") || !strings.Contains(stdout, "src/app.ts") {
+		t.Fatalf("exclude should skip only legacy/old.ts, got %s", stdout)
+	}
+}
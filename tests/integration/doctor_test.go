@@ -0,0 +1,37 @@
+// doctor_test.go — Integration checks for the doctor subcommand.
+//go:build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDoctorPassesWithValidSetup(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, ".stricture.yml", "version: \"1.0\"\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "doctor")
+	if code != 0 {
+		t.Fatalf("doctor exit code = %d, want 0\nstdout=%s\nstderr=%s", code, stdout, stderr)
+	}
+	for _, want := range []string{"[PASS] Config: found", "[PASS] Rules:", "[PASS] Cache:", "All checks passed."} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("doctor output missing %q:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestDoctorFailsOnBrokenPlugin(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, ".stricture.yml", "version: \"1.0\"\nplugins:\n  - missing.yml\n")
+
+	stdout, _, code := runInDir(t, tmp, "doctor")
+	if code != 1 {
+		t.Fatalf("doctor exit code = %d, want 1\n%s", code, stdout)
+	}
+	if !strings.Contains(stdout, "[FAIL] Plugin ") || !strings.Contains(stdout, "hint: Fix the path") || !strings.Contains(stdout, "1 check(s) failed.") {
+		t.Fatalf("doctor should report the broken plugin with a hint:\n%s", stdout)
+	}
+}
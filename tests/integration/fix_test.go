@@ -4,6 +4,7 @@
 package integration
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -118,3 +119,72 @@ func TestFixBackupFailsWhenBackupAlreadyExists(t *testing.T) {
 		t.Fatalf("source file should remain unchanged when backup creation fails")
 	}
 }
+
+func TestFixInteractiveRequiresFix(t *testing.T) {
+	_, stderr, code := run(t, "--interactive", ".")
+	if code != 2 {
+		t.Fatalf("--interactive without --fix exit code = %d, want 2", code)
+	}
+	if !strings.Contains(stderr, "--interactive requires --fix") {
+		t.Fatalf("stderr should explain missing --fix, got %q", stderr)
+	}
+}
+
+func TestFixInteractiveFallsBackWithoutTerminal(t *testing.T) {
+	tmp := t.TempDir()
+	target := filepath.Join(tmp, "user_service.ts")
+	if err := os.WriteFile(target, []byte("export const value = 1;\n"), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	_, stderr, code := run(t, "fix", "--interactive", target)
+	if code != 0 {
+		t.Fatalf("fix --interactive exit code = %d, want 0\nstderr=%q", code, stderr)
+	}
+	if !strings.Contains(stderr, "stdin is not a terminal") {
+		t.Fatalf("stderr should note the non-interactive fallback, got %q", stderr)
+	}
+	after, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("read target: %v", err)
+	}
+	if !strings.HasPrefix(string(after), "// user_service.ts") {
+		t.Fatalf("fallback should apply the header fix, got %q", string(after))
+	}
+}
+
+func TestFixWithFilesFromRelintsOnlyListedFiles(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "a.go", "package main\n")
+	writeFile(t, tmp, "b.go", "package main\n")
+	writeFile(t, tmp, "c.ts", "export const value = 1;\n")
+	writeFile(t, tmp, "list.txt", "a.go\nc.ts\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "--fix", "--files-from", "list.txt", "--ext", ".go", "--rule", "CONV-file-header", "--format", "json")
+	if code != 0 {
+		t.Fatalf("--fix --files-from exit code = %d, want 0\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Summary struct {
+			FilesChecked int `json:"filesChecked"`
+		} `json:"summary"`
+		Violations []struct {
+			FilePath string `json:"filePath"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\noutput=%q", err, stdout)
+	}
+	if result.Summary.FilesChecked != 1 || len(result.Violations) != 0 {
+		t.Fatalf("re-lint after fix should cover only the listed .go file, got files=%d violations=%+v", result.Summary.FilesChecked, result.Violations)
+	}
+	for name, wantFixed := range map[string]bool{"a.go": true, "b.go": false, "c.ts": false} {
+		content, err := os.ReadFile(filepath.Join(tmp, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if fixed := strings.HasPrefix(string(content), "// "+name+" — "); fixed != wantFixed {
+			t.Fatalf("%s fixed = %v, want %v:\n%s", name, fixed, wantFixed, content)
+		}
+	}
+}
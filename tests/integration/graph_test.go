@@ -0,0 +1,49 @@
+// graph_test.go — Integration checks for strict graph.
+//go:build integration
+
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGraphEmitsDOTWithCycles(t *testing.T) {
+	tmp := t.TempDir()
+	for _, dir := range []string{"src/api", "src/service", "src/util"} {
+		if err := os.MkdirAll(filepath.Join(tmp, dir), 0o755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+	}
+	writeFile(t, tmp, "src/api/routes.ts", "import { svc } from '../service/users';\n")
+	writeFile(t, tmp, "src/service/users.ts", "import { routes } from '../api/routes';\nimport { log } from '../util/log';\n")
+	writeFile(t, tmp, "src/util/log.ts", "export const log = console.log;\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "graph", "--format", "dot", "src")
+	if code != 0 {
+		t.Fatalf("graph exit code = %d, want 0\nstderr=%q", code, stderr)
+	}
+	for _, want := range []string{
+		"digraph imports {",
+		`"src/api" [color=red, fontcolor=red];`,
+		`"src/api" -> "src/service" [color=red];`,
+		`"src/service" -> "src/util";`,
+		`"src/util";`,
+	} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("graph output missing %q:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestGraphRejectsUnknownFormat(t *testing.T) {
+	_, stderr, code := runInDir(t, t.TempDir(), "graph", "--format", "svg")
+	if code != 2 {
+		t.Fatalf("graph --format svg exit code = %d, want 2", code)
+	}
+	if !strings.Contains(stderr, "unsupported graph format") {
+		t.Fatalf("stderr should name the unsupported format, got %q", stderr)
+	}
+}
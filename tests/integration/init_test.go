@@ -67,3 +67,37 @@ func TestInitForceOverwritesExistingConfig(t *testing.T) {
 		t.Fatalf("forced init should rewrite with default config, got %q", string(after))
 	}
 }
+
+func TestInitProfileSelectsPreset(t *testing.T) {
+	tmp := t.TempDir()
+
+	_, stderr, code := runInDir(t, tmp, "init", "--profile", "strict")
+	if code != 0 {
+		t.Fatalf("init --profile strict exit code = %d, want 0\nstderr=%q", code, stderr)
+	}
+	data, err := os.ReadFile(filepath.Join(tmp, ".stricture.yml"))
+	if err != nil {
+		t.Fatalf("read created config: %v", err)
+	}
+	if strings.Contains(string(data), ": warn") {
+		t.Fatalf("strict profile should not contain warn severities:\n%s", data)
+	}
+	if !strings.Contains(string(data), "CONV-no-magic-numbers: error") {
+		t.Fatalf("strict profile should raise warn-by-default rules to error:\n%s", data)
+	}
+}
+
+func TestInitRejectsUnknownProfile(t *testing.T) {
+	tmp := t.TempDir()
+
+	_, stderr, code := runInDir(t, tmp, "init", "--profile", "paranoid")
+	if code != 2 {
+		t.Fatalf("init unknown profile exit code = %d, want 2", code)
+	}
+	if !strings.Contains(stderr, "unknown profile") {
+		t.Fatalf("stderr should mention unknown profile, got %q", stderr)
+	}
+	if _, err := os.Stat(filepath.Join(tmp, ".stricture.yml")); err == nil {
+		t.Fatalf("unknown profile should not write a config")
+	}
+}
@@ -0,0 +1,57 @@
+// install_hook_test.go — Integration checks for the install-hook subcommand.
+//go:build integration
+
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInstallHookInstallsAndUninstalls(t *testing.T) {
+	tmp := t.TempDir()
+	initGitRepo(t, tmp)
+	hookPath := filepath.Join(tmp, ".git", "hooks", "pre-commit")
+	if err := os.MkdirAll(filepath.Dir(hookPath), 0o755); err != nil {
+		t.Fatalf("mkdir hooks: %v", err)
+	}
+	if err := os.WriteFile(hookPath, []byte("#!/bin/sh\necho custom\n"), 0o755); err != nil {
+		t.Fatalf("write existing hook: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "install-hook")
+	if code != 0 {
+		t.Fatalf("install-hook exit %d\nstderr=%q", code, stderr)
+	}
+	if !strings.Contains(stdout, "Backed up existing hook") || !strings.Contains(stdout, "Installed ") {
+		t.Fatalf("install output = %q", stdout)
+	}
+	content, err := os.ReadFile(hookPath)
+	if err != nil || !strings.Contains(string(content), "strict lint --staged") {
+		t.Fatalf("hook content = %q, err=%v", content, err)
+	}
+
+	stdout, stderr, code = runInDir(t, tmp, "install-hook", "--uninstall")
+	if code != 0 {
+		t.Fatalf("install-hook --uninstall exit %d\nstderr=%q", code, stderr)
+	}
+	if !strings.Contains(stdout, "Restored previous hook") {
+		t.Fatalf("uninstall output = %q", stdout)
+	}
+	if content, _ := os.ReadFile(hookPath); string(content) != "#!/bin/sh\necho custom\n" {
+		t.Fatalf("restored hook = %q", content)
+	}
+}
+
+func TestInstallHookRefusesOutsideGitRepo(t *testing.T) {
+	tmp := t.TempDir()
+	_, stderr, code := runInDir(t, tmp, "install-hook")
+	if code != 1 {
+		t.Fatalf("install-hook outside a repo exit %d, want 1\nstderr=%q", code, stderr)
+	}
+	if !strings.Contains(stderr, "must run inside a git repository") {
+		t.Fatalf("stderr = %q", stderr)
+	}
+}
@@ -84,3 +84,136 @@ func TestVerboseWritesDiagnosticsToStderr(t *testing.T) {
 		t.Fatalf("stdout should remain valid JSON with --verbose: %v\noutput=%q", err, stdout)
 	}
 }
+
+func TestCompactFormatGroupsFindingsByFile(t *testing.T) {
+	tmp := t.TempDir()
+	for _, name := range []string{"a.go", "b.go"} {
+		if err := os.WriteFile(filepath.Join(tmp, name), []byte("package main\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "compact", "--rule", "CONV-file-header", "--no-color", ".")
+	if code != 1 {
+		t.Fatalf("compact run should fail with violations: code=%d stderr=%q stdout=%q", code, stderr, stdout)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("compact output lines = %d, want 5 (2 headers, 2 findings, summary)\n%s", len(lines), stdout)
+	}
+	if lines[0] != "a.go" || lines[2] != "b.go" {
+		t.Fatalf("compact output should print each path once as a header, got %q", stdout)
+	}
+	if !strings.HasPrefix(lines[1], "  1 ERROR CONV-file-header ") {
+		t.Fatalf("compact finding should be indented line/severity/rule/message, got %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[4], "Summary: ") {
+		t.Fatalf("compact output should end with summary, got %q", lines[4])
+	}
+}
+
+func TestDiffContextAddsSnippetToJSON(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "bad.go", "package main\n\nfunc main() {}\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--diff-context", "1", ".")
+	if code != 1 {
+		t.Fatalf("lint exit code = %d, want 1\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Violations []struct {
+			Snippet *struct {
+				StartLine int
+				EndLine   int
+				Lines     []string
+			}
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output JSON: %v\noutput=%q", err, stdout)
+	}
+	if len(result.Violations) != 1 || result.Violations[0].Snippet == nil {
+		t.Fatalf("expected one violation with a snippet, got %s", stdout)
+	}
+	snippet := result.Violations[0].Snippet
+	if snippet.StartLine != 1 || snippet.EndLine != 2 || strings.Join(snippet.Lines, "|") != "package main|" {
+		t.Fatalf("unexpected snippet: %+v", snippet)
+	}
+
+	stdout, _, _ = runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", ".")
+	var plain struct {
+		Violations []map[string]json.RawMessage `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &plain); err != nil {
+		t.Fatalf("unmarshal output JSON: %v\noutput=%q", err, stdout)
+	}
+	if len(plain.Violations) != 1 {
+		t.Fatalf("expected one violation, got %s", stdout)
+	}
+	if _, ok := plain.Violations[0]["Snippet"]; ok {
+		t.Fatalf("snippet should be omitted without --diff-context, got %s", stdout)
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--diff-context", "500", ".")
+	if code != 2 || !strings.Contains(stderr, "--diff-context must be between 0 and 20") {
+		t.Fatalf("out-of-range --diff-context should be a usage error: code=%d stderr=%q", code, stderr)
+	}
+}
+
+func TestSummaryOnlyPrintsCountsWithoutViolations(t *testing.T) {
+	tmp := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmp, "bad.go"), []byte("package main\n\nfunc main() {}\n"), 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "--rule", "CONV-file-header", "--summary-only", ".")
+	if code != 1 {
+		t.Fatalf("--summary-only should keep the error exit code: code=%d stderr=%q", code, stderr)
+	}
+	if !strings.HasPrefix(stdout, "Summary: files=1 issues=1 violations=1 errors=1 warnings=0 ") || strings.Count(stdout, "\n") != 1 {
+		t.Fatalf("text output should be the Summary line only, got %q", stdout)
+	}
+
+	stdout, _, code = runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--summary-only", ".")
+	if code != 1 {
+		t.Fatalf("json --summary-only exit code = %d, want 1", code)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout)
+	}
+	summary, ok := payload["summary"].(map[string]interface{})
+	if _, hasViolations := payload["violations"]; hasViolations || !ok || summary["errors"] != float64(1) {
+		t.Fatalf("json output should hold only the summary, got %s", stdout)
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--format", "sarif", "--summary-only", ".")
+	if code != 2 || !strings.Contains(stderr, "--summary-only supports text, compact, and json") {
+		t.Fatalf("sarif --summary-only should be a usage error: code=%d stderr=%q", code, stderr)
+	}
+}
+
+func TestFlushOnInterruptRunsNormallyWithoutSignal(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "a.ts", "export const a = 1;\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--flush-on-interrupt", ".")
+	if code != 1 {
+		t.Fatalf("uninterrupted run should keep the error exit code: code=%d stderr=%q", code, stderr)
+	}
+	var payload struct {
+		Summary map[string]interface{} `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout)
+	}
+	if _, partial := payload.Summary["partial"]; partial || payload.Summary["filesChecked"] != float64(1) {
+		t.Fatalf("complete run should not be marked partial, got %+v", payload.Summary)
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--flush-on-interrupt", "--fix", ".")
+	if code != 2 || !strings.Contains(stderr, "--flush-on-interrupt cannot be combined with --fix or --baseline-prune") {
+		t.Fatalf("--flush-on-interrupt with --fix should be a usage error: code=%d stderr=%q", code, stderr)
+	}
+}
@@ -0,0 +1,60 @@
+// output_dir_test.go — Integration checks for --output-dir per-file reports.
+//go:build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOutputDirWritesOneReportPerFile(t *testing.T) {
+	tmp := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmp, "src", "nested"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	writeFile(t, tmp, "src/a.ts", "export const a = 1;\n")
+	writeFile(t, tmp, "src/nested/b.ts", "// b.ts — Demo module.\nexport const b = 2;\n")
+
+	reports := filepath.Join(tmp, "reports")
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-header", "--output-dir", reports, "src")
+	if code != 1 {
+		t.Fatalf("expected exit 1 for the violation in a.ts, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	if strings.TrimSpace(stdout) != "" {
+		t.Fatalf("stdout should be empty when --output-dir is used, got %q", stdout)
+	}
+
+	for name, want := range map[string]int{"src/a.ts.json": 1, "src/nested/b.ts.json": 0} {
+		data, err := os.ReadFile(filepath.Join(reports, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		var payload struct {
+			Violations []map[string]interface{} `json:"violations"`
+			Summary    map[string]interface{}   `json:"summary"`
+		}
+		if err := json.Unmarshal(data, &payload); err != nil {
+			t.Fatalf("%s must contain valid JSON: %v", name, err)
+		}
+		if len(payload.Violations) != want || payload.Summary["filesChecked"] != float64(1) {
+			t.Fatalf("%s: unexpected payload %+v", name, payload)
+		}
+	}
+}
+
+func TestOutputDirConflictsWithOutput(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "a.ts", "export const a = 1;\n")
+
+	_, stderr, code := runInDir(t, tmp, "--output", "r.json", "--output-dir", "reports", ".")
+	if code != 2 {
+		t.Fatalf("expected exit 2, got %d (stderr=%q)", code, stderr)
+	}
+	if !strings.Contains(stderr, "mutually exclusive") {
+		t.Fatalf("expected mutual exclusion error, got %q", stderr)
+	}
+}
@@ -74,3 +74,44 @@ func TestOutputWritesTextReportFile(t *testing.T) {
 		t.Fatalf("text report missing expected content: %q", text)
 	}
 }
+
+func TestJSONOutputIncludesColumnsWhenKnown(t *testing.T) {
+	tmp := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmp, "a.ts"), []byte("// a.ts — Demo.\nconst label = \"ok\";\n"), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "b.ts"), []byte("export const b = 1;\n"), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-consistent-quote-style", "--rule", "CONV-file-header", ".")
+	if code != 1 {
+		t.Fatalf("expected violations to return exit 1, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+
+	var payload struct {
+		Violations []map[string]interface{} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, stdout)
+	}
+	seen := map[string]bool{}
+	for _, v := range payload.Violations {
+		ruleID, _ := v["RuleID"].(string)
+		seen[ruleID] = true
+		column, hasColumn := v["StartColumn"]
+		switch ruleID {
+		case "CONV-consistent-quote-style":
+			if column != float64(15) {
+				t.Fatalf("quote violation column = %v, want 15: %+v", column, v)
+			}
+		case "CONV-file-header":
+			if hasColumn {
+				t.Fatalf("line-only violation should omit StartColumn: %+v", v)
+			}
+		}
+	}
+	if !seen["CONV-consistent-quote-style"] || !seen["CONV-file-header"] {
+		t.Fatalf("expected both rules to report, got %+v", payload.Violations)
+	}
+}
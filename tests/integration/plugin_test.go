@@ -52,3 +52,46 @@ plugins:
 		t.Fatalf("expected plugin rule id in output, got %q", stdout)
 	}
 }
+
+func TestPluginCategoryListedAndSelectable(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "main.go", "// main.go — Entry point.\npackage main\n\nfunc main() { eval() }\n")
+	writeFile(t, tmp, "security.yml", `rules:
+  - id: SEC-no-eval
+    category: security
+    severity: error
+    description: "Disallow eval"
+    match:
+      languages: ["go"]
+    check:
+      must_not_contain:
+        pattern: "eval\\("
+        message: "Avoid eval"
+`)
+	writeFile(t, tmp, ".stricture.yml", `version: "1.0"
+rules:
+  CONV-file-header: error
+plugins:
+  - ./security.yml
+`)
+
+	stdout, stderr, code := runInDir(t, tmp, "list-rules")
+	if code != 0 {
+		t.Fatalf("list-rules exit code = %d, want 0\nstderr=%q", code, stderr)
+	}
+	secIdx := strings.Index(stdout, "SEC-no-eval")
+	ctrIdx := strings.LastIndex(stdout, "CTR-")
+	if secIdx < 0 || secIdx < ctrIdx {
+		t.Fatalf("plugin rule should be listed after built-in categories:\n%s", stdout)
+	}
+
+	stdout, stderr, code = runInDir(t, tmp, "--category", "security", ".")
+	if code != 1 || !strings.Contains(stdout, "SEC-no-eval") {
+		t.Fatalf("--category security should run plugin rule: code=%d stderr=%q stdout=%q", code, stderr, stdout)
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--category", "perf", ".")
+	if code != 2 || !strings.Contains(stderr, "security") {
+		t.Fatalf("unknown category should exit 2 and list categories: code=%d stderr=%q", code, stderr)
+	}
+}
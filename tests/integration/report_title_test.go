@@ -0,0 +1,113 @@
+// report_title_test.go — Integration checks for --report-title in JSON, SARIF, and JUnit output.
+//go:build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeReportTitleFixture(t *testing.T) string {
+	t.Helper()
+	tmp := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmp, "a.ts"), []byte("export const a = 1;\n"), 0o644); err != nil {
+		t.Fatalf("write target: %v", err)
+	}
+	return tmp
+}
+
+func TestReportTitleSetsSARIFDriverName(t *testing.T) {
+	tmp := writeReportTitleFixture(t)
+	stdout, stderr, code := runInDir(t, tmp, "--format", "sarif", "--report-title", "frontend-lint", "--rule", "CONV-file-header", ".")
+	if code != 1 {
+		t.Fatalf("expected violations to return exit 1, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID string `json:"ruleId"`
+			} `json:"results"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &log); err != nil {
+		t.Fatalf("invalid SARIF: %v\n%s", err, stdout)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected SARIF log: %+v", log)
+	}
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "frontend-lint" || run.Properties["title"] != "frontend-lint" {
+		t.Fatalf("report title not applied: %+v", run)
+	}
+	if len(run.Results) != 1 || run.Results[0].RuleID != "CONV-file-header" || len(run.Tool.Driver.Rules) != 1 {
+		t.Fatalf("unexpected SARIF results: %+v", run)
+	}
+}
+
+func TestReportTitleSetsJUnitSuiteName(t *testing.T) {
+	tmp := writeReportTitleFixture(t)
+	stdout, stderr, code := runInDir(t, tmp, "--format", "junit", "--report-title", "frontend-lint", "--rule", "CONV-file-header", ".")
+	if code != 1 {
+		t.Fatalf("expected violations to return exit 1, got %d\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+
+	var doc struct {
+		Suites []struct {
+			Name     string `xml:"name,attr"`
+			Tests    int    `xml:"tests,attr"`
+			Failures int    `xml:"failures,attr"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal([]byte(stdout), &doc); err != nil {
+		t.Fatalf("invalid JUnit XML: %v\n%s", err, stdout)
+	}
+	if len(doc.Suites) != 1 || doc.Suites[0].Name != "frontend-lint" || doc.Suites[0].Tests != 1 || doc.Suites[0].Failures != 1 {
+		t.Fatalf("unexpected JUnit suites: %+v", doc.Suites)
+	}
+}
+
+func TestReportTitleInJSONOnlyWhenCustom(t *testing.T) {
+	tmp := writeReportTitleFixture(t)
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{args: nil, want: ""},
+		{args: []string{"--report-title", "frontend-lint"}, want: "frontend-lint"},
+	} {
+		args := append([]string{"--format", "json", "--rule", "CONV-file-header"}, tc.args...)
+		stdout, _, _ := runInDir(t, tmp, append(args, ".")...)
+
+		var payload map[string]interface{}
+		if err := json.Unmarshal([]byte(stdout), &payload); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, stdout)
+		}
+		got, _ := payload["title"].(string)
+		if got != tc.want {
+			t.Fatalf("title with %v = %q, want %q", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestReportTitleRejectsEmpty(t *testing.T) {
+	tmp := writeReportTitleFixture(t)
+	_, stderr, code := runInDir(t, tmp, "--format", "sarif", "--report-title", " ", ".")
+	if code != 2 {
+		t.Fatalf("expected usage exit 2 for empty title, got %d (stderr=%q)", code, stderr)
+	}
+}
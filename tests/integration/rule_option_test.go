@@ -0,0 +1,44 @@
+// rule_option_test.go — Integration checks for --rule-option overrides.
+//go:build integration
+
+package integration
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleOptionOverridesConfiguredOptions(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "user_service.ts", "export const userService = {};\n")
+
+	stdout, stderr, code := runInDir(t, tmp, "--format", "json", "--rule", "CONV-file-naming", ".")
+	if code != 1 {
+		t.Fatalf("default kebab-case style should flag snake_case file: code=%d stderr=%q stdout=%q", code, stderr, stdout)
+	}
+
+	stdout, stderr, code = runInDir(t, tmp,
+		"--format", "json",
+		"--rule", "CONV-file-naming",
+		"--rule-option", "CONV-file-naming.style=snake_case",
+		".",
+	)
+	if code != 0 {
+		t.Fatalf("--rule-option style=snake_case should pass: code=%d stderr=%q stdout=%q", code, stderr, stdout)
+	}
+}
+
+func TestRuleOptionRejectsMalformedAndUnknownSpecs(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "a.ts", "export const a = 1;\n")
+
+	for _, spec := range []string{"CONV-file-naming", "CONV-file-naming=snake_case", "CONV-not-a-rule.style=x"} {
+		_, stderr, code := runInDir(t, tmp, "--rule-option", spec, ".")
+		if code != 2 {
+			t.Fatalf("--rule-option %q should exit 2, got %d (stderr=%q)", spec, code, stderr)
+		}
+		if !strings.Contains(stderr, "--rule-option") {
+			t.Fatalf("stderr should mention --rule-option for %q, got %q", spec, stderr)
+		}
+	}
+}
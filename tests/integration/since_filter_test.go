@@ -0,0 +1,83 @@
+// since_filter_test.go — Integration checks for --since modification-time scoping.
+//go:build integration
+
+package integration
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSinceLimitsLintToRecentlyModifiedFiles(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "fresh.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, tmp, "fresh.ts", "export const x = 1;\n")
+	writeFile(t, tmp, "stale.go", "package main\n\nfunc helper() {}\n")
+	old := time.Now().Add(-72 * time.Hour)
+	if err := os.Chtimes(filepath.Join(tmp, "stale.go"), old, old); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp,
+		"--format", "json",
+		"--rule", "CONV-file-header",
+		"--since", "2h",
+		"--ext", ".go",
+		".",
+	)
+	if code != 1 {
+		t.Fatalf("--since run should find the fresh Go file: code=%d stderr=%q stdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Violations []struct {
+			FilePath string `json:"filePath"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\noutput=%q", err, stdout)
+	}
+	if len(result.Violations) != 1 || !strings.HasSuffix(result.Violations[0].FilePath, "fresh.go") {
+		t.Fatalf("expected only fresh.go with --since 2h --ext .go, got %+v", result.Violations)
+	}
+
+	_, stderr, code = runInDir(t, tmp, "--since", "yesterday", ".")
+	if code != 2 {
+		t.Fatalf("invalid --since should exit 2, got %d", code)
+	}
+	if !strings.Contains(stderr, "--since") {
+		t.Fatalf("stderr should explain invalid --since, got %q", stderr)
+	}
+}
+
+func TestSinceAppliesToRelintAfterFix(t *testing.T) {
+	tmp := t.TempDir()
+	writeFile(t, tmp, "fresh.go", "package main\n")
+	writeFile(t, tmp, "stale.go", "package main\n")
+	old := time.Now().Add(-72 * time.Hour)
+	if err := os.Chtimes(filepath.Join(tmp, "stale.go"), old, old); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, tmp, "--fix", "--since", "2h", "--rule", "CONV-file-header", "--format", "json", ".")
+	if code != 0 {
+		t.Fatalf("--fix --since exit code = %d, want 0\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Summary struct {
+			FilesChecked int `json:"filesChecked"`
+		} `json:"summary"`
+		Violations []struct {
+			FilePath string `json:"filePath"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output: %v\noutput=%q", err, stdout)
+	}
+	if result.Summary.FilesChecked != 1 || len(result.Violations) != 0 {
+		t.Fatalf("re-lint after fix should keep the --since window, got files=%d violations=%+v", result.Summary.FilesChecked, result.Violations)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -52,3 +53,41 @@ func TestSymlinkOutsideProjectIsIgnored(t *testing.T) {
 		t.Fatalf("expected only local.ts violation, got %q", result.Violations[0].FilePath)
 	}
 }
+
+func TestUnreadableFileReportsParseError(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, projectDir, "local.ts", "export const local = 1;\n")
+	if err := os.Mkdir(filepath.Join(projectDir, "assets"), 0o755); err != nil {
+		t.Fatalf("mkdir assets: %v", err)
+	}
+	// A symlink named like a source file that points at a directory cannot be read.
+	if err := os.Symlink(filepath.Join(projectDir, "assets"), filepath.Join(projectDir, "broken.ts")); err != nil {
+		t.Skipf("symlinks unsupported in this environment: %v", err)
+	}
+
+	stdout, stderr, code := runInDir(t, projectDir, "--format", "json", "--rule", "CONV-file-header", ".")
+	if code != 1 {
+		t.Fatalf("lint exit code = %d, want 1\nstderr=%q\nstdout=%q", code, stderr, stdout)
+	}
+	var result struct {
+		Violations []struct {
+			RuleID   string `json:"ruleId"`
+			FilePath string `json:"filePath"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("unmarshal output JSON: %v\noutput=%q", err, stdout)
+	}
+	rules := map[string]string{}
+	for _, v := range result.Violations {
+		rules[filepath.Base(v.FilePath)] = v.RuleID
+	}
+	if len(rules) != 2 || rules["broken.ts"] != "PARSE-error" || rules["local.ts"] != "CONV-file-header" {
+		t.Fatalf("expected PARSE-error for broken.ts and the rest still linted, got %+v", result.Violations)
+	}
+
+	_, stderr, code = runInDir(t, projectDir, "--fail-on-parse-error", "--rule", "CONV-file-header", ".")
+	if code != 1 || !strings.Contains(stderr, "Error: parse files:") {
+		t.Fatalf("--fail-on-parse-error should abort: code=%d stderr=%q", code, stderr)
+	}
+}